@@ -89,7 +89,7 @@ type Verifier interface {
 	ProcessHeaders(headers []*types.Header) error
 	GetBlockCreator(header *types.Header) (types.Address, error)
 	PreCommitState(block *types.Block, txn *state.Transition) error
-	GetLatestChainConfig() (*chain.Params, error)
+	GetChainConfigAt(blockNumber uint64) (*chain.Params, error)
 }
 
 type Executor interface {
@@ -350,14 +350,17 @@ func (b *Blockchain) CalculateGasLimit(number uint64) (uint64, error) {
 		return 0, fmt.Errorf("parent of block %d not found", number)
 	}
 
-	return b.calculateGasLimit(parent.GasLimit), nil
+	return b.calculateGasLimit(parent), nil
 }
 
 // calculateGasLimit calculates gas limit in reference to the block gas target
-func (b *Blockchain) calculateGasLimit(parentGasLimit uint64) uint64 {
+// in effect at the parent block
+func (b *Blockchain) calculateGasLimit(parent *types.Header) uint64 {
+	parentGasLimit := parent.GasLimit
+
 	// The gas limit cannot move more than 1/1024 * parentGasLimit
 	// in either direction per block
-	blockGasTarget := b.Config().BlockGasTarget
+	blockGasTarget := b.chainConfigAt(parent.Number).BlockGasTarget
 
 	// Check if the gas limit target has been set
 	if blockGasTarget == 0 {
@@ -1394,7 +1397,14 @@ func (b *Blockchain) CalculateBaseFee(parent *types.Header) uint64 {
 		return chain.GenesisBaseFee
 	}
 
-	parentGasTarget := parent.GasLimit / b.genesisConfig.Params.BaseFeeEM
+	chainConfig := b.chainConfigAt(parent.Number)
+
+	baseFeeEM := chainConfig.BaseFeeEM
+	if baseFeeEM == 0 {
+		baseFeeEM = b.genesisConfig.Params.BaseFeeEM
+	}
+
+	parentGasTarget := parent.GasLimit / baseFeeEM
 
 	// If the parent gasUsed is the same as the target, the baseFee remains unchanged.
 	if parent.GasUsed == parentGasTarget {
@@ -1404,34 +1414,40 @@ func (b *Blockchain) CalculateBaseFee(parent *types.Header) uint64 {
 	// If the parent block used more gas than its target, the baseFee should increase.
 	if parent.GasUsed > parentGasTarget {
 		gasUsedDelta := parent.GasUsed - parentGasTarget
-		baseFeeDelta := b.calcBaseFeeDelta(gasUsedDelta, parentGasTarget, parent.BaseFee)
+		baseFeeDelta := calcBaseFeeDelta(gasUsedDelta, parentGasTarget, parent.BaseFee, chainConfig.BaseFeeChangeDenom)
 
 		return parent.BaseFee + common.Max(baseFeeDelta, 1)
 	}
 
 	// Otherwise, if the parent block used less gas than its target, the baseFee should decrease.
 	gasUsedDelta := parentGasTarget - parent.GasUsed
-	baseFeeDelta := b.calcBaseFeeDelta(gasUsedDelta, parentGasTarget, parent.BaseFee)
+	baseFeeDelta := calcBaseFeeDelta(gasUsedDelta, parentGasTarget, parent.BaseFee, chainConfig.BaseFeeChangeDenom)
 
 	return common.Max(parent.BaseFee-baseFeeDelta, 0)
 }
 
-func (b *Blockchain) calcBaseFeeDelta(gasUsedDelta, parentGasTarget, baseFee uint64) uint64 {
-	baseFeeChangeDenom := b.genesisConfig.Params.BaseFeeChangeDenom
+// chainConfigAt returns the chain configuration in effect at the given block. Once the governance fork is active,
+// the configuration is provided by the consensus, since it can be changed by the governance proposals
+func (b *Blockchain) chainConfigAt(blockNumber uint64) *chain.Params {
+	if forks := b.Config().Forks; forks == nil || !forks.IsActive(chain.Governance, blockNumber) {
+		return b.genesisConfig.Params
+	}
 
-	if b.Config().Forks.IsActive(chain.Governance, b.Header().Number) {
-		chainConfig, err := b.consensus.GetLatestChainConfig()
-		if err != nil {
-			b.logger.Error("failed to calculate base fee delta", "error", err)
+	chainConfig, err := b.consensus.GetChainConfigAt(blockNumber)
+	if err != nil {
+		b.logger.Error("failed to get chain config", "block", blockNumber, "error", err)
 
-			return b.genesisConfig.Params.BaseFeeChangeDenom
-		}
+		return b.genesisConfig.Params
+	}
 
-		if chainConfig != nil {
-			baseFeeChangeDenom = chainConfig.BaseFeeChangeDenom
-		}
+	if chainConfig == nil {
+		return b.genesisConfig.Params
 	}
 
+	return chainConfig
+}
+
+func calcBaseFeeDelta(gasUsedDelta, parentGasTarget, baseFee, baseFeeChangeDenom uint64) uint64 {
 	y := baseFee * gasUsedDelta / parentGasTarget
 
 	return y / baseFeeChangeDenom
@@ -997,6 +997,44 @@ func TestCalculateGasLimit(t *testing.T) {
 	}
 }
 
+func TestCalculateGasLimit_Governance(t *testing.T) {
+	h := &types.Header{GasLimit: 20000000}
+	h.ComputeHash()
+
+	storageCallback := func(storage *storagev2.Storage) {
+		w := storage.NewWriter()
+
+		w.PutBlockLookup(h.Hash, h.Number)
+		w.PutHeader(h)
+		w.PutCanonicalHash(h.Number, h.Hash)
+		require.NoError(t, w.WriteBatch())
+	}
+
+	b, err := NewMockBlockchain(map[TestCallbackType]interface{}{
+		StorageCallback: storageCallback,
+		VerifierCallback: func(verifier *MockVerifier) {
+			verifier.getChainConfigFn = func(blockNumber uint64) (*chain.Params, error) {
+				// block gas target in effect at the parent block
+				require.Equal(t, h.Number, blockNumber)
+
+				// block gas target changed by the governance
+				return &chain.Params{BlockGasTarget: 15000000}, nil
+			}
+		},
+	})
+	require.NoError(t, err)
+
+	b.genesisConfig.Params = &chain.Params{
+		Forks:          chain.AllForksEnabled,
+		BlockGasTarget: 25000000,
+	}
+	b.setCurrentHeader(h, big.NewInt(1))
+
+	nextGas, err := b.CalculateGasLimit(1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20000000-20000000/1024), nextGas)
+}
+
 // TestGasPriceAverage tests the average gas price of the
 // blockchain
 func TestGasPriceAverage(t *testing.T) {
@@ -1357,7 +1395,7 @@ func TestBlockchain_CalculateBaseFee(t *testing.T) {
 		parentGasUsed        uint64
 		elasticityMultiplier uint64
 		forks                *chain.Forks
-		getLatestConfigFn    getChainConfigDelegate
+		getChainConfigFn     getChainConfigDelegate
 		expectedBaseFee      uint64
 	}{
 		{
@@ -1459,11 +1497,27 @@ func TestBlockchain_CalculateBaseFee(t *testing.T) {
 			parentGasUsed:        10000000,
 			elasticityMultiplier: 4,
 			forks:                chain.AllForksEnabled,
-			getLatestConfigFn: func() (*chain.Params, error) {
+			getChainConfigFn: func(blockNumber uint64) (*chain.Params, error) {
+				if blockNumber != 6 {
+					return nil, errors.New("chain config is expected to be read at the parent block")
+				}
+
 				return &chain.Params{BaseFeeChangeDenom: 4}, nil
 			},
 			expectedBaseFee: 1250000000,
 		}, // governance hard fork enabled
+		{
+			blockNumber:          6,
+			parentBaseFee:        chain.GenesisBaseFee,
+			parentGasLimit:       20000000,
+			parentGasUsed:        10000000,
+			elasticityMultiplier: 4,
+			forks:                &chain.Forks{chain.London: chain.NewFork(5), chain.Governance: chain.NewFork(7)},
+			getChainConfigFn: func(uint64) (*chain.Params, error) {
+				return &chain.Params{BaseFeeChangeDenom: 4}, nil
+			},
+			expectedBaseFee: 1125000000,
+		}, // governance hard fork enabled after the parent block (genesis config is used)
 		{
 			blockNumber:          6,
 			parentBaseFee:        chain.GenesisBaseFee,
@@ -1471,11 +1525,23 @@ func TestBlockchain_CalculateBaseFee(t *testing.T) {
 			parentGasUsed:        10000000,
 			elasticityMultiplier: 4,
 			forks:                chain.AllForksEnabled,
-			getLatestConfigFn: func() (*chain.Params, error) {
+			getChainConfigFn: func(uint64) (*chain.Params, error) {
 				return nil, errors.New("failed to retrieve chain config")
 			},
-			expectedBaseFee: 1000000008,
-		}, // governance hard fork enabled
+			expectedBaseFee: 1125000000,
+		}, // governance hard fork enabled (genesis config is used)
+		{
+			blockNumber:          6,
+			parentBaseFee:        chain.GenesisBaseFee,
+			parentGasLimit:       20000000,
+			parentGasUsed:        10000000,
+			elasticityMultiplier: 4,
+			forks:                chain.AllForksEnabled,
+			getChainConfigFn: func(uint64) (*chain.Params, error) {
+				return &chain.Params{BaseFeeChangeDenom: 4, BaseFeeEM: 2}, nil
+			},
+			expectedBaseFee: chain.GenesisBaseFee,
+		}, // governance hard fork enabled (elasticity multiplier changed, usage == target)
 	}
 
 	for i, test := range tests {
@@ -1513,7 +1579,7 @@ func TestBlockchain_CalculateBaseFee(t *testing.T) {
 				BaseFee:  test.parentBaseFee,
 			}, big.NewInt(1))
 
-			blockchain.SetConsensus(&MockVerifier{getChainConfigFn: test.getLatestConfigFn})
+			blockchain.SetConsensus(&MockVerifier{getChainConfigFn: test.getChainConfigFn})
 
 			parent := &types.Header{
 				Number:   test.blockNumber,
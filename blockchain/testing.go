@@ -231,7 +231,7 @@ type verifyHeaderDelegate func(*types.Header) error
 type processHeadersDelegate func([]*types.Header) error
 type getBlockCreatorDelegate func(*types.Header) (types.Address, error)
 type preStateCommitDelegate func(*types.Block, *state.Transition) error
-type getChainConfigDelegate func() (*chain.Params, error)
+type getChainConfigDelegate func(uint64) (*chain.Params, error)
 
 type MockVerifier struct {
 	verifyHeaderFn    verifyHeaderDelegate
@@ -273,9 +273,9 @@ func (m *MockVerifier) GetBlockCreator(header *types.Header) (types.Address, err
 	return types.BytesToAddress(header.Miner), nil
 }
 
-func (m *MockVerifier) GetLatestChainConfig() (*chain.Params, error) {
+func (m *MockVerifier) GetChainConfigAt(blockNumber uint64) (*chain.Params, error) {
 	if m.getChainConfigFn != nil {
-		return m.getChainConfigFn()
+		return m.getChainConfigFn(blockNumber)
 	}
 
 	return &chain.Params{}, nil
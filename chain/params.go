@@ -96,6 +96,16 @@ const (
 	EIP3855        = "EIP3855"
 	Berlin         = "Berlin"
 	EIP3607        = "EIP3607"
+
+	// CardanoPrecompiles enables cardano address, key hash, native script and transaction decoding precompiles
+	CardanoPrecompiles = "cardanoPrecompiles"
+
+	// PrecompileGasSchedule enables input size aware gas schedule of the signature verification precompiles
+	PrecompileGasSchedule = "precompileGasSchedule"
+
+	// GovernanceAddressLists enables the address list roles changed by the governance,
+	// which are applied by the state transactions of the system caller in the first block of the epoch
+	GovernanceAddressLists = "governanceAddressLists"
 )
 
 // Forks is map which contains all forks and their starting blocks from genesis
@@ -135,6 +145,11 @@ func (f *Forks) At(block uint64) ForksInTime {
 		EIP3855:        f.IsActive(EIP3855, block),
 		Berlin:         f.IsActive(Berlin, block),
 		EIP3607:        f.IsActive(EIP3607, block),
+
+		CardanoPrecompiles:    f.IsActive(CardanoPrecompiles, block),
+		PrecompileGasSchedule: f.IsActive(PrecompileGasSchedule, block),
+
+		GovernanceAddressLists: f.IsActive(GovernanceAddressLists, block),
 	}
 }
 
@@ -188,18 +203,23 @@ type ForksInTime struct {
 	Governance,
 	EIP3855,
 	Berlin,
-	EIP3607 bool
+	EIP3607,
+	CardanoPrecompiles,
+	PrecompileGasSchedule,
+	GovernanceAddressLists bool
 }
 
 func (f ForksInTime) String() string {
 	return fmt.Sprintf("EIP150: %t, EIP158: %t, EIP155: %t, "+
 		"Homestead: %t, Byzantium: %t, Constantinople: %t, "+
 		"Petersburg: %t, Istanbul: %t, Berlin: %t, London: %t"+
-		"Governance: %t, EIP3855: %t, EIP3607: %t",
+		"Governance: %t, EIP3855: %t, EIP3607: %t, CardanoPrecompiles: %t, PrecompileGasSchedule: %t, "+
+		"GovernanceAddressLists: %t",
 		f.EIP150, f.EIP158, f.EIP155,
 		f.Homestead, f.Byzantium, f.Constantinople, f.Petersburg,
 		f.Istanbul, f.Berlin, f.London,
-		f.Governance, f.EIP3855, f.EIP3607)
+		f.Governance, f.EIP3855, f.EIP3607, f.CardanoPrecompiles, f.PrecompileGasSchedule,
+		f.GovernanceAddressLists)
 }
 
 // AllForksEnabled should contain all supported forks by current edge version
@@ -217,4 +237,9 @@ var AllForksEnabled = &Forks{
 	EIP3855:        NewFork(0),
 	Berlin:         NewFork(0),
 	EIP3607:        NewFork(0),
+
+	CardanoPrecompiles:    NewFork(0),
+	PrecompileGasSchedule: NewFork(0),
+
+	GovernanceAddressLists: NewFork(0),
 }
@@ -0,0 +1,34 @@
+package apex
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command/apex/chains"
+	"github.com/0xPolygon/polygon-edge/command/apex/validators"
+	"github.com/0xPolygon/polygon-edge/command/apex/votes"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+// GetCommand creates "apex" helper command
+func GetCommand() *cobra.Command {
+	apexCmd := &cobra.Command{
+		Use:   "apex",
+		Short: "Top level command for inspecting the apex bridge contracts through JSON-RPC",
+	}
+
+	helper.RegisterJSONRPCFlag(apexCmd)
+	registerSubcommands(apexCmd)
+
+	return apexCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// apex chains
+		chains.GetCommand(),
+		// apex validators
+		validators.GetCommand(),
+		// apex votes
+		votes.GetCommand(),
+	)
+}
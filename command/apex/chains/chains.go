@@ -0,0 +1,68 @@
+package chains
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	apexHelper "github.com/0xPolygon/polygon-edge/command/apex/helper"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
+)
+
+// GetCommand returns the apex chains command
+func GetCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "chains",
+		Short: "Lists the source/destination chains registered on the apex bridge",
+		Run:   runCommand,
+	}
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	client, err := jsonrpc.NewEthClient(helper.GetJSONRPCAddress(cmd))
+	if err != nil {
+		outputter.SetError(fmt.Errorf("could not create JSON RPC client: %w", err))
+
+		return
+	}
+
+	registeredChains, err := apexHelper.GetRegisteredChains(client)
+	if err != nil {
+		outputter.SetError(fmt.Errorf("failed to get registered chains: %w", err))
+
+		return
+	}
+
+	result := &chainsResult{Chains: make([]*chainResult, len(registeredChains))}
+
+	for i, chain := range registeredChains {
+		var tokenQuantity string
+		if err := apexHelper.Call(client, apexHelper.GetChainTokenQuantityFn, &tokenQuantity,
+			apexHelper.EncodeChainID(chain.ID.Value)); err != nil {
+			outputter.SetError(fmt.Errorf("failed to get token quantity of chain %d: %w", chain.ID.Value, err))
+
+			return
+		}
+
+		if tokenQuantity, err = apexHelper.FormatBig(tokenQuantity); err != nil {
+			outputter.SetError(err)
+
+			return
+		}
+
+		result.Chains[i] = &chainResult{
+			ID:              chain.ID.Value,
+			ChainType:       apexHelper.ChainTypeName(chain.ChainType.Value),
+			AddressMultisig: chain.AddressMultisig,
+			AddressFeePayer: chain.AddressFeePayer,
+			TokenQuantity:   tokenQuantity,
+		}
+	}
+
+	outputter.SetCommandResult(result)
+}
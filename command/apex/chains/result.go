@@ -0,0 +1,45 @@
+package chains
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type chainResult struct {
+	ID              uint64 `json:"id"`
+	ChainType       string `json:"chainType"`
+	AddressMultisig string `json:"addressMultisig"`
+	AddressFeePayer string `json:"addressFeePayer"`
+	TokenQuantity   string `json:"tokenQuantity"`
+}
+
+type chainsResult struct {
+	Chains []*chainResult `json:"chains"`
+}
+
+func (r *chainsResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[APEX REGISTERED CHAINS]\n")
+
+	if len(r.Chains) == 0 {
+		buffer.WriteString("No chains registered\n")
+
+		return buffer.String()
+	}
+
+	vals := make([]string, 0, len(r.Chains)+1)
+	vals = append(vals, "ID|Type|Multisig Address|Fee Payer Address|Token Quantity")
+
+	for _, chain := range r.Chains {
+		vals = append(vals, fmt.Sprintf("%d|%s|%s|%s|%s",
+			chain.ID, chain.ChainType, chain.AddressMultisig, chain.AddressFeePayer, chain.TokenQuantity))
+	}
+
+	buffer.WriteString(helper.FormatList(vals))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,197 @@
+package helper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
+)
+
+const (
+	ChainIDFlag     = "chain-id"
+	ChainIDFlagDesc = "id of the chain registered on the apex bridge"
+
+	// apex JSON RPC endpoints
+	GetRegisteredChainsFn    = "apex_getRegisteredChains"
+	GetValidatorsChainDataFn = "apex_getValidatorsChainData"
+	GetChainTokenQuantityFn  = "apex_getChainTokenQuantity"
+	GetClaimVotesFn          = "apex_getClaimVotes"
+	GetConfirmedBatchFn      = "apex_getConfirmedBatch"
+	GetPendingBatchFn        = "apex_getPendingBatch"
+	GetBatchTransactionsFn   = "apex_getBatchTransactions"
+
+	// ChainTypeCardano is the type of the cardano chains registered on the apex bridge
+	ChainTypeCardano = 0
+	// ChainTypeEVM is the type of the evm chains registered on the apex bridge
+	ChainTypeEVM = 1
+
+	// latestBlock is the block against which apex contracts are queried
+	latestBlock = "latest"
+
+	// cardanoKeySize is the size of the cardano verifying key
+	cardanoKeySize = 32
+	// blsKeyElementSize is the size of the single element (uint256) of the BLS public key
+	blsKeyElementSize = 32
+)
+
+// RegisteredChain is the chain registered on the apex bridge, as returned by the apex JSON RPC endpoint
+type RegisteredChain struct {
+	ID              common.JSONNumber `json:"id"`
+	ChainType       common.JSONNumber `json:"chainType"`
+	AddressMultisig string            `json:"addressMultisig"`
+	AddressFeePayer string            `json:"addressFeePayer"`
+}
+
+// ValidatorChainData is the data (verifying keys) of a single validator for the given chain
+type ValidatorChainData struct {
+	Key [4]string `json:"key"`
+}
+
+// ClaimVotes is the number of validator votes for the given claim hash, along with the quorum
+type ClaimVotes struct {
+	Votes  common.JSONNumber `json:"votes"`
+	Quorum common.JSONNumber `json:"quorum"`
+}
+
+// ConfirmedBatch is the last batch of the given destination chain confirmed by the validators
+type ConfirmedBatch struct {
+	ID     common.JSONNumber `json:"id"`
+	Bitmap string            `json:"bitmap"`
+}
+
+// PendingBatch contains the confirmed transactions which are going to be included in the next batch
+type PendingBatch struct {
+	ID                common.JSONNumber   `json:"id"`
+	ShouldCreateBatch bool                `json:"shouldCreateBatch"`
+	Transactions      []*BatchTransaction `json:"transactions"`
+}
+
+// BatchTransaction identifies the bridging transaction included in a batch
+type BatchTransaction struct {
+	SourceChainID           common.JSONNumber `json:"sourceChainId"`
+	ObservedTransactionHash string            `json:"observedTransactionHash"`
+}
+
+// chainIDs are the ids of the known apex chains by their names
+var chainIDs = map[string]uint8{
+	"prime":  1,
+	"vector": 2,
+	"nexus":  3,
+}
+
+// ParseChainID parses the apex chain given either by its name (prime, vector, nexus) or by its numeric id
+func ParseChainID(chain string) (uint8, error) {
+	if id, exists := chainIDs[chain]; exists {
+		return id, nil
+	}
+
+	id, err := strconv.ParseUint(chain, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid chain %s, expected chain name (prime, vector, nexus) or numeric chain id", chain)
+	}
+
+	return uint8(id), nil
+}
+
+// ChainName returns the name of the known apex chain with the given id
+func ChainName(chainID uint8) (string, bool) {
+	for name, id := range chainIDs {
+		if id == chainID {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+// ChainTypeName returns the human readable name of the chain type
+func ChainTypeName(chainType uint64) string {
+	switch chainType {
+	case ChainTypeCardano:
+		return "cardano"
+	case ChainTypeEVM:
+		return "evm"
+	default:
+		return fmt.Sprintf("unknown (%d)", chainType)
+	}
+}
+
+// Call calls the apex JSON RPC endpoint against the latest block
+func Call(client *jsonrpc.EthClient, method string, out interface{}, params ...interface{}) error {
+	if err := client.EndpointCall(method, out, append(params, latestBlock)...); err != nil {
+		return fmt.Errorf("%s failed: %w", method, err)
+	}
+
+	return nil
+}
+
+// GetRegisteredChains returns the chains registered on the apex bridge
+func GetRegisteredChains(client *jsonrpc.EthClient) ([]*RegisteredChain, error) {
+	var chains []*RegisteredChain
+	if err := Call(client, GetRegisteredChainsFn, &chains); err != nil {
+		return nil, err
+	}
+
+	return chains, nil
+}
+
+// EncodeChainID encodes the chain id as the argument of the apex JSON RPC endpoint
+func EncodeChainID(chainID uint64) string {
+	return hex.EncodeUint64(chainID)
+}
+
+// FormatCardanoKey formats the key element of the validator chain data as the hex encoded cardano verifying key
+func FormatCardanoKey(key string) (string, error) {
+	value, err := hex.DecodeHexToBig(key)
+	if err != nil {
+		return "", fmt.Errorf("invalid key %s: %w", key, err)
+	}
+
+	return hex.EncodeToString(common.PadLeftOrTrim(value.Bytes(), cardanoKeySize)), nil
+}
+
+// FormatBLSKey formats the validator chain data as the hex encoded BLS public key
+func FormatBLSKey(key [4]string) (string, error) {
+	blsKey := make([]byte, 0, len(key)*blsKeyElementSize)
+
+	for _, k := range key {
+		value, err := hex.DecodeHexToBig(k)
+		if err != nil {
+			return "", fmt.Errorf("invalid key %s: %w", k, err)
+		}
+
+		blsKey = append(blsKey, common.PadLeftOrTrim(value.Bytes(), blsKeyElementSize)...)
+	}
+
+	return hex.EncodeToHex(blsKey), nil
+}
+
+// BitmapIndexes returns the indexes of the validators set in the hex encoded bitmap
+func BitmapIndexes(bitmap string) ([]uint64, error) {
+	value, err := hex.DecodeHexToBig(bitmap)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bitmap %s: %w", bitmap, err)
+	}
+
+	indexes := []uint64{}
+
+	for i := 0; i < value.BitLen(); i++ {
+		if value.Bit(i) == 1 {
+			indexes = append(indexes, uint64(i))
+		}
+	}
+
+	return indexes, nil
+}
+
+// FormatBig formats the hex encoded big number returned by the apex JSON RPC endpoint as decimal number
+func FormatBig(value string) (string, error) {
+	number, err := hex.DecodeHexToBig(value)
+	if err != nil {
+		return "", fmt.Errorf("invalid number %s: %w", value, err)
+	}
+
+	return number.String(), nil
+}
@@ -0,0 +1,78 @@
+package helper
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FormatKeys(t *testing.T) {
+	t.Parallel()
+
+	cardanoKey, err := FormatCardanoKey("0x1f2e")
+	require.NoError(t, err)
+	require.Equal(t, strings.Repeat("00", 30)+"1f2e", cardanoKey)
+
+	blsKey, err := FormatBLSKey([4]string{"0x1", "0x2", "0x3", "0x4"})
+	require.NoError(t, err)
+	require.Len(t, blsKey, 2+4*2*blsKeyElementSize)
+	require.True(t, strings.HasSuffix(blsKey, strings.Repeat("0", 63)+"4"))
+
+	_, err = FormatCardanoKey("0xzz")
+	require.Error(t, err)
+
+	indexes, err := BitmapIndexes("0x0")
+	require.NoError(t, err)
+	require.Empty(t, indexes)
+
+	indexes, err = BitmapIndexes("0x12")
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 4}, indexes)
+
+	value, err := FormatBig("0xde0b6b3a7640000")
+	require.NoError(t, err)
+	require.Equal(t, "1000000000000000000", value)
+
+	require.Equal(t, "cardano", ChainTypeName(ChainTypeCardano))
+	require.Equal(t, "evm", ChainTypeName(ChainTypeEVM))
+	require.Equal(t, "unknown (7)", ChainTypeName(7))
+}
+
+func Test_ParseChainID(t *testing.T) {
+	t.Parallel()
+
+	id, err := ParseChainID("nexus")
+	require.NoError(t, err)
+	require.Equal(t, uint8(3), id)
+
+	id, err = ParseChainID("7")
+	require.NoError(t, err)
+	require.Equal(t, uint8(7), id)
+
+	_, err = ParseChainID("256")
+	require.Error(t, err)
+
+	name, exists := ChainName(1)
+	require.True(t, exists)
+	require.Equal(t, "prime", name)
+
+	_, exists = ChainName(7)
+	require.False(t, exists)
+}
+
+func Test_TransferQueryMatchesAny(t *testing.T) {
+	t.Parallel()
+
+	query := &TransferQuery{SourceChainID: 3, DestinationChainID: 1, TxHash: "0xABCD"}
+	tx := func(chainID uint64, hash string) *BatchTransaction {
+		batchTx := &BatchTransaction{ObservedTransactionHash: hash}
+		batchTx.SourceChainID.Value = chainID
+
+		return batchTx
+	}
+
+	require.False(t, query.matchesAny(nil))
+	require.False(t, query.matchesAny([]*BatchTransaction{tx(1, "0xabcd"), tx(3, "0xabce")}))
+	require.True(t, query.matchesAny([]*BatchTransaction{tx(1, "0xabcd"), tx(3, "0xabcd")}))
+}
@@ -0,0 +1,165 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
+)
+
+const (
+	// ConfirmedStage is the stage in which the bridging request claim is confirmed by the quorum of validators
+	ConfirmedStage = "confirmed"
+	// BatchedStage is the stage in which the bridging request is included in a batch for the destination chain
+	BatchedStage = "batched"
+	// SignedStage is the stage in which the batch is signed by the quorum of validators,
+	// so that it can be submitted to the destination chain
+	SignedStage = "signed"
+
+	// DefaultBatchesLookback is the default number of the latest batches searched for the transfer
+	DefaultBatchesLookback = 100
+	// DefaultWaitTimeout is the default timeout for waiting until the batch of the transfer is signed
+	DefaultWaitTimeout = 10 * time.Minute
+	// DefaultPollInterval is the default interval at which the transfer status is polled while waiting
+	DefaultPollInterval = 5 * time.Second
+)
+
+// TransferStages are the stages of a transfer through the apex bridge, in order
+var TransferStages = []string{ConfirmedStage, BatchedStage, SignedStage}
+
+var errTransferNotSigned = errors.New("batch of the transfer is not signed")
+
+// TransferStage is a single stage of the transfer through the apex bridge
+type TransferStage struct {
+	// Name is the stage name
+	Name string `json:"name"`
+
+	// Completed indicates whether the transfer has reached the stage
+	Completed bool `json:"completed"`
+}
+
+// TransferStatus is the status of the transfer through the apex bridge, reported through all of its stages
+type TransferStatus struct {
+	SourceChainID      uint8  `json:"sourceChainId"`
+	DestinationChainID uint8  `json:"destinationChainId"`
+	TxHash             string `json:"txHash"`
+
+	// BatchID is the id of the batch which includes the transfer, if the transfer is batched
+	BatchID uint64 `json:"batchId,omitempty"`
+
+	Stages []*TransferStage `json:"stages"`
+}
+
+// IsSigned returns true if the batch of the transfer is signed
+func (s *TransferStatus) IsSigned() bool {
+	return s.Stages[len(s.Stages)-1].Completed
+}
+
+// TransferQuery identifies the transfer by the source chain, the destination chain
+// and the hash of the transaction observed on the source chain
+type TransferQuery struct {
+	SourceChainID      uint8
+	DestinationChainID uint8
+	TxHash             string
+
+	// BatchesLookback is the number of the latest batches of the destination chain searched for the transfer
+	BatchesLookback uint64
+}
+
+// GetTransferStatus returns the status of the transfer, as exposed by the apex bridge contracts
+func GetTransferStatus(client *jsonrpc.EthClient, query *TransferQuery) (*TransferStatus, error) {
+	var (
+		confirmed ConfirmedBatch
+		pending   PendingBatch
+		chainID   = EncodeChainID(uint64(query.DestinationChainID))
+	)
+
+	if err := Call(client, GetConfirmedBatchFn, &confirmed, chainID); err != nil {
+		return nil, fmt.Errorf("failed to get confirmed batch: %w", err)
+	}
+
+	if err := Call(client, GetPendingBatchFn, &pending, chainID); err != nil {
+		return nil, fmt.Errorf("failed to get pending batch: %w", err)
+	}
+
+	status := &TransferStatus{
+		SourceChainID:      query.SourceChainID,
+		DestinationChainID: query.DestinationChainID,
+		TxHash:             query.TxHash,
+		Stages:             make([]*TransferStage, len(TransferStages)),
+	}
+
+	for i, name := range TransferStages {
+		status.Stages[i] = &TransferStage{Name: name}
+	}
+
+	// confirmed transactions which are not batched yet
+	if query.matchesAny(pending.Transactions) {
+		status.Stages[0].Completed = true
+
+		return status, nil
+	}
+
+	// batch being signed, if any, follows the last confirmed batch
+	lastBatchID := confirmed.ID.Value + 1
+	if pending.ID.Value > lastBatchID {
+		lastBatchID = pending.ID.Value
+	}
+
+	for batchID := lastBatchID; batchID > 0 && lastBatchID-batchID < query.BatchesLookback; batchID-- {
+		var txs []*BatchTransaction
+		if err := Call(client, GetBatchTransactionsFn, &txs, chainID, EncodeChainID(batchID)); err != nil {
+			return nil, fmt.Errorf("failed to get transactions of batch %d: %w", batchID, err)
+		}
+
+		if query.matchesAny(txs) {
+			status.BatchID = batchID
+			status.Stages[0].Completed = true
+			status.Stages[1].Completed = true
+			status.Stages[2].Completed = batchID <= confirmed.ID.Value
+
+			break
+		}
+	}
+
+	return status, nil
+}
+
+// WaitForTransferSigned waits until the batch of the transfer is signed, or the context is done
+func WaitForTransferSigned(ctx context.Context, client *jsonrpc.EthClient, query *TransferQuery,
+	pollInterval time.Duration) (*TransferStatus, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := GetTransferStatus(client, query)
+		if err != nil {
+			return nil, err
+		}
+
+		if status.IsSigned() {
+			return status, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, fmt.Errorf("%w: %v", errTransferNotSigned, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// matchesAny returns true if any of the given batch transactions is the queried transfer
+func (q *TransferQuery) matchesAny(txs []*BatchTransaction) bool {
+	for _, tx := range txs {
+		if tx.SourceChainID.Value == uint64(q.SourceChainID) &&
+			strings.EqualFold(tx.ObservedTransactionHash, q.TxHash) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,55 @@
+package validators
+
+import (
+	"fmt"
+
+	apexHelper "github.com/0xPolygon/polygon-edge/command/apex/helper"
+)
+
+type validatorsParams struct {
+	chainID    uint64
+	chainIDSet bool
+}
+
+// filterChains returns the registered chains whose validator data are requested
+func (vp *validatorsParams) filterChains(
+	chains []*apexHelper.RegisteredChain) ([]*apexHelper.RegisteredChain, error) {
+	if !vp.chainIDSet {
+		return chains, nil
+	}
+
+	for _, chain := range chains {
+		if chain.ID.Value == vp.chainID {
+			return []*apexHelper.RegisteredChain{chain}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("chain %d is not registered on the apex bridge", vp.chainID)
+}
+
+// newValidatorChainKeys returns the keys of the validator for the chain of the given type
+func newValidatorChainKeys(
+	chain *apexHelper.RegisteredChain, data *apexHelper.ValidatorChainData) (*validatorChainKeys, error) {
+	keys := &validatorChainKeys{
+		ChainID:   chain.ID.Value,
+		ChainType: apexHelper.ChainTypeName(chain.ChainType.Value),
+	}
+
+	var err error
+
+	if chain.ChainType.Value == apexHelper.ChainTypeEVM {
+		keys.BLSKey, err = apexHelper.FormatBLSKey(data.Key)
+
+		return keys, err
+	}
+
+	if keys.VerifyingKey, err = apexHelper.FormatCardanoKey(data.Key[0]); err != nil {
+		return nil, err
+	}
+
+	if keys.FeeVerifyingKey, err = apexHelper.FormatCardanoKey(data.Key[1]); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,63 @@
+package validators
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+// validatorChainKeys are the keys of the validator for the single registered chain
+type validatorChainKeys struct {
+	ChainID         uint64 `json:"chainId"`
+	ChainType       string `json:"chainType"`
+	VerifyingKey    string `json:"verifyingKey,omitempty"`
+	FeeVerifyingKey string `json:"feeVerifyingKey,omitempty"`
+	BLSKey          string `json:"blsKey,omitempty"`
+}
+
+type validatorResult struct {
+	Index  uint64                `json:"index"`
+	Chains []*validatorChainKeys `json:"chains"`
+}
+
+type validatorsResult struct {
+	Validators []*validatorResult `json:"validators"`
+}
+
+func (r *validatorsResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[APEX VALIDATORS]\n")
+
+	if len(r.Validators) == 0 {
+		buffer.WriteString("No validator data found\n")
+
+		return buffer.String()
+	}
+
+	for i, validator := range r.Validators {
+		if i > 0 {
+			buffer.WriteString("\n")
+		}
+
+		vals := []string{fmt.Sprintf("Validator Index|%d", validator.Index)}
+
+		for _, keys := range validator.Chains {
+			if keys.BLSKey != "" {
+				vals = append(vals, fmt.Sprintf("Chain %d (%s) BLS Key|%s", keys.ChainID, keys.ChainType, keys.BLSKey))
+
+				continue
+			}
+
+			vals = append(vals,
+				fmt.Sprintf("Chain %d (%s) Verifying Key|%s", keys.ChainID, keys.ChainType, keys.VerifyingKey),
+				fmt.Sprintf("Chain %d (%s) Fee Verifying Key|%s", keys.ChainID, keys.ChainType, keys.FeeVerifyingKey))
+		}
+
+		buffer.WriteString(helper.FormatKV(vals))
+		buffer.WriteString("\n")
+	}
+
+	return buffer.String()
+}
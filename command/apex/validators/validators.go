@@ -0,0 +1,96 @@
+package validators
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	apexHelper "github.com/0xPolygon/polygon-edge/command/apex/helper"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
+)
+
+var (
+	params validatorsParams
+)
+
+// GetCommand returns the apex validators command
+func GetCommand() *cobra.Command {
+	validatorsCmd := &cobra.Command{
+		Use: "validators",
+		Short: "Shows cardano verifying keys and BLS keys of the apex validators " +
+			"for the registered chains, as stored in the Validators contract",
+		PreRun: preRunCommand,
+		Run:    runCommand,
+	}
+
+	validatorsCmd.Flags().Uint64Var(
+		&params.chainID,
+		apexHelper.ChainIDFlag,
+		0,
+		apexHelper.ChainIDFlagDesc+" (keys for all registered chains are shown if not set)",
+	)
+
+	return validatorsCmd
+}
+
+func preRunCommand(cmd *cobra.Command, _ []string) {
+	params.chainIDSet = cmd.Flags().Changed(apexHelper.ChainIDFlag)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	client, err := jsonrpc.NewEthClient(helper.GetJSONRPCAddress(cmd))
+	if err != nil {
+		outputter.SetError(fmt.Errorf("could not create JSON RPC client: %w", err))
+
+		return
+	}
+
+	registeredChains, err := apexHelper.GetRegisteredChains(client)
+	if err != nil {
+		outputter.SetError(fmt.Errorf("failed to get registered chains: %w", err))
+
+		return
+	}
+
+	chains, err := params.filterChains(registeredChains)
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	result := &validatorsResult{Validators: []*validatorResult{}}
+
+	for _, chain := range chains {
+		var chainData []*apexHelper.ValidatorChainData
+		if err := apexHelper.Call(client, apexHelper.GetValidatorsChainDataFn, &chainData,
+			apexHelper.EncodeChainID(chain.ID.Value)); err != nil {
+			outputter.SetError(fmt.Errorf("failed to get validators data of chain %d: %w", chain.ID.Value, err))
+
+			return
+		}
+
+		// chain data are ordered by the validator index
+		for i, data := range chainData {
+			if i == len(result.Validators) {
+				result.Validators = append(result.Validators, &validatorResult{Index: uint64(i)})
+			}
+
+			keys, err := newValidatorChainKeys(chain, data)
+			if err != nil {
+				outputter.SetError(fmt.Errorf("invalid data of validator %d for chain %d: %w", i, chain.ID.Value, err))
+
+				return
+			}
+
+			result.Validators[i].Chains = append(result.Validators[i].Chains, keys)
+		}
+	}
+
+	outputter.SetCommandResult(result)
+}
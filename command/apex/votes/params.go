@@ -0,0 +1,74 @@
+package votes
+
+import (
+	"errors"
+	"fmt"
+
+	apexHelper "github.com/0xPolygon/polygon-edge/command/apex/helper"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	claimHashFlag = "claim-hash"
+	batchIDFlag   = "batch-id"
+
+	batchStatusConfirmed  = "confirmed"
+	batchStatusPending    = "pending"
+	batchStatusNotCreated = "not created"
+)
+
+var (
+	errInvalidVoteFlags = fmt.Errorf("either %s flag or both %s and %s flags must be provided",
+		claimHashFlag, apexHelper.ChainIDFlag, batchIDFlag)
+	errInvalidClaimHash = errors.New("invalid claim hash provided")
+)
+
+type votesParams struct {
+	claimHashRaw string
+	chainID      uint64
+	batchID      uint64
+
+	chainIDSet bool
+	batchIDSet bool
+}
+
+func (vp *votesParams) validateFlags() error {
+	if vp.claimHashRaw != "" {
+		if vp.chainIDSet || vp.batchIDSet {
+			return errInvalidVoteFlags
+		}
+
+		raw, err := hex.DecodeHex(vp.claimHashRaw)
+		if err != nil || len(raw) != types.HashLength {
+			return errInvalidClaimHash
+		}
+
+		return nil
+	}
+
+	if !vp.chainIDSet || !vp.batchIDSet {
+		return errInvalidVoteFlags
+	}
+
+	return nil
+}
+
+// batchStatus returns the status of the requested batch, along with the indexes of the validators
+// which signed it, if the batch is the last confirmed batch of the chain
+func (vp *votesParams) batchStatus(
+	confirmed *apexHelper.ConfirmedBatch, pending *apexHelper.PendingBatch) (string, []uint64, error) {
+	switch {
+	case confirmed.ID.Value != 0 && vp.batchID == confirmed.ID.Value:
+		signers, err := apexHelper.BitmapIndexes(confirmed.Bitmap)
+
+		return batchStatusConfirmed, signers, err
+	case vp.batchID < confirmed.ID.Value:
+		// only signatures of the last confirmed batch are kept by the contracts
+		return batchStatusConfirmed, nil, nil
+	case vp.batchID == pending.ID.Value:
+		return batchStatusPending, nil, nil
+	default:
+		return batchStatusNotCreated, nil, nil
+	}
+}
@@ -0,0 +1,92 @@
+package votes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apexHelper "github.com/0xPolygon/polygon-edge/command/apex/helper"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+)
+
+func Test_validateFlags(t *testing.T) {
+	t.Parallel()
+
+	claimHash := "0x9e1dfc5cf3f1e1b0c1f8a2b8c5c0f1e1d7a6b5c4d3e2f1a0b9c8d7e6f5a4b3c2"
+
+	cases := []struct {
+		name   string
+		params *votesParams
+		err    error
+	}{
+		{
+			name:   "no claim or batch",
+			params: &votesParams{},
+			err:    errInvalidVoteFlags,
+		},
+		{
+			name:   "batch without chain id",
+			params: &votesParams{batchIDSet: true},
+			err:    errInvalidVoteFlags,
+		},
+		{
+			name:   "claim and batch",
+			params: &votesParams{claimHashRaw: claimHash, chainIDSet: true, batchIDSet: true},
+			err:    errInvalidVoteFlags,
+		},
+		{
+			name:   "invalid claim hash",
+			params: &votesParams{claimHashRaw: "0x1"},
+			err:    errInvalidClaimHash,
+		},
+		{
+			name:   "claim",
+			params: &votesParams{claimHashRaw: claimHash},
+		},
+		{
+			name:   "batch",
+			params: &votesParams{chainIDSet: true, batchIDSet: true},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.ErrorIs(t, c.params.validateFlags(), c.err)
+		})
+	}
+}
+
+func Test_batchStatus(t *testing.T) {
+	t.Parallel()
+
+	confirmed := &apexHelper.ConfirmedBatch{ID: common.JSONNumber{Value: 4}, Bitmap: "0xb"}
+	pending := &apexHelper.PendingBatch{ID: common.JSONNumber{Value: 5}}
+
+	cases := []struct {
+		batchID uint64
+		status  string
+		signers []uint64
+	}{
+		{batchID: 4, status: batchStatusConfirmed, signers: []uint64{0, 1, 3}},
+		{batchID: 2, status: batchStatusConfirmed},
+		{batchID: 5, status: batchStatusPending},
+		{batchID: 6, status: batchStatusNotCreated},
+	}
+
+	for _, c := range cases {
+		status, signers, err := (&votesParams{batchID: c.batchID}).batchStatus(confirmed, pending)
+		require.NoError(t, err)
+		require.Equal(t, c.status, status, c.batchID)
+		require.Equal(t, c.signers, signers, c.batchID)
+	}
+
+	// no batch confirmed yet
+	status, _, err := (&votesParams{batchID: 0}).batchStatus(
+		&apexHelper.ConfirmedBatch{Bitmap: "0x0"}, &apexHelper.PendingBatch{ID: common.JSONNumber{Value: 1}})
+	require.NoError(t, err)
+	require.Equal(t, batchStatusNotCreated, status)
+}
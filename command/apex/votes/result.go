@@ -0,0 +1,92 @@
+package votes
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type claimVotesResult struct {
+	ClaimHash string `json:"claimHash"`
+	Votes     uint64 `json:"votes"`
+	Quorum    uint64 `json:"quorum"`
+	Confirmed bool   `json:"confirmed"`
+}
+
+func (r *claimVotesResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[APEX CLAIM VOTES]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Claim Hash|%s", r.ClaimHash),
+		fmt.Sprintf("Votes|%d", r.Votes),
+		fmt.Sprintf("Quorum|%d", r.Quorum),
+		fmt.Sprintf("Confirmed|%t", r.Confirmed),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
+
+// batchTransaction identifies the bridging transaction included in the batch
+type batchTransaction struct {
+	SourceChainID           uint64 `json:"sourceChainId"`
+	ObservedTransactionHash string `json:"observedTransactionHash"`
+}
+
+type batchVotesResult struct {
+	ChainID uint64 `json:"chainId"`
+	BatchID uint64 `json:"batchId"`
+	Status  string `json:"status"`
+	// Signers are the indexes of the validators which signed the batch
+	Signers             []uint64            `json:"signers,omitempty"`
+	Transactions        []*batchTransaction `json:"transactions"`
+	PendingTransactions uint64              `json:"pendingTransactions,omitempty"`
+}
+
+func (r *batchVotesResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	vals := []string{
+		fmt.Sprintf("Chain ID|%d", r.ChainID),
+		fmt.Sprintf("Batch ID|%d", r.BatchID),
+		fmt.Sprintf("Status|%s", r.Status),
+	}
+
+	if r.Signers != nil {
+		signers := make([]string, len(r.Signers))
+		for i, signer := range r.Signers {
+			signers[i] = fmt.Sprintf("%d", signer)
+		}
+
+		vals = append(vals,
+			fmt.Sprintf("Signatures|%d", len(r.Signers)),
+			fmt.Sprintf("Signer Validator Indexes|%s", strings.Join(signers, ", ")))
+	}
+
+	if r.Status == batchStatusPending {
+		vals = append(vals, fmt.Sprintf("Pending Transactions|%d", r.PendingTransactions))
+	}
+
+	vals = append(vals, fmt.Sprintf("Batch Transactions|%d", len(r.Transactions)))
+
+	buffer.WriteString("\n[APEX BATCH VOTES]\n")
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n")
+
+	if len(r.Transactions) > 0 {
+		txs := make([]string, 0, len(r.Transactions)+1)
+		txs = append(txs, "Source Chain|Observed Transaction Hash")
+
+		for _, tx := range r.Transactions {
+			txs = append(txs, fmt.Sprintf("%d|%s", tx.SourceChainID, tx.ObservedTransactionHash))
+		}
+
+		buffer.WriteString(helper.FormatList(txs))
+		buffer.WriteString("\n")
+	}
+
+	return buffer.String()
+}
@@ -0,0 +1,160 @@
+package votes
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	apexHelper "github.com/0xPolygon/polygon-edge/command/apex/helper"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
+)
+
+var (
+	params votesParams
+)
+
+// GetCommand returns the apex votes command
+func GetCommand() *cobra.Command {
+	votesCmd := &cobra.Command{
+		Use: "votes",
+		Short: "Prints the vote status of the claim, identified by its hash, " +
+			"or of the batch, identified by the destination chain id and the batch id",
+		PreRunE: preRunCommand,
+		Run:     runCommand,
+	}
+
+	setFlags(votesCmd)
+
+	return votesCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.claimHashRaw,
+		claimHashFlag,
+		"",
+		"hash of the claim submitted by the apex validators",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.chainID,
+		apexHelper.ChainIDFlag,
+		0,
+		"id of the destination chain of the batch",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.batchID,
+		batchIDFlag,
+		0,
+		"id of the batch",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(claimHashFlag, apexHelper.ChainIDFlag)
+	cmd.MarkFlagsMutuallyExclusive(claimHashFlag, batchIDFlag)
+}
+
+func preRunCommand(cmd *cobra.Command, _ []string) error {
+	params.chainIDSet = cmd.Flags().Changed(apexHelper.ChainIDFlag)
+	params.batchIDSet = cmd.Flags().Changed(batchIDFlag)
+
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	client, err := jsonrpc.NewEthClient(helper.GetJSONRPCAddress(cmd))
+	if err != nil {
+		outputter.SetError(fmt.Errorf("could not create JSON RPC client: %w", err))
+
+		return
+	}
+
+	if params.claimHashRaw != "" {
+		result, err := getClaimVotes(client)
+		if err != nil {
+			outputter.SetError(err)
+
+			return
+		}
+
+		outputter.SetCommandResult(result)
+
+		return
+	}
+
+	result, err := getBatchVotes(client)
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(result)
+}
+
+func getClaimVotes(client *jsonrpc.EthClient) (*claimVotesResult, error) {
+	var votes apexHelper.ClaimVotes
+	if err := apexHelper.Call(client, apexHelper.GetClaimVotesFn, &votes, params.claimHashRaw); err != nil {
+		return nil, fmt.Errorf("failed to get claim votes: %w", err)
+	}
+
+	return &claimVotesResult{
+		ClaimHash: params.claimHashRaw,
+		Votes:     votes.Votes.Value,
+		Quorum:    votes.Quorum.Value,
+		Confirmed: votes.Quorum.Value > 0 && votes.Votes.Value >= votes.Quorum.Value,
+	}, nil
+}
+
+func getBatchVotes(client *jsonrpc.EthClient) (*batchVotesResult, error) {
+	var (
+		confirmed apexHelper.ConfirmedBatch
+		pending   apexHelper.PendingBatch
+		txs       []*apexHelper.BatchTransaction
+		chainID   = apexHelper.EncodeChainID(params.chainID)
+	)
+
+	if err := apexHelper.Call(client, apexHelper.GetConfirmedBatchFn, &confirmed, chainID); err != nil {
+		return nil, fmt.Errorf("failed to get confirmed batch: %w", err)
+	}
+
+	if err := apexHelper.Call(client, apexHelper.GetPendingBatchFn, &pending, chainID); err != nil {
+		return nil, fmt.Errorf("failed to get pending batch: %w", err)
+	}
+
+	if err := apexHelper.Call(client, apexHelper.GetBatchTransactionsFn, &txs,
+		chainID, apexHelper.EncodeChainID(params.batchID)); err != nil {
+		return nil, fmt.Errorf("failed to get batch transactions: %w", err)
+	}
+
+	status, signers, err := params.batchStatus(&confirmed, &pending)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &batchVotesResult{
+		ChainID:      params.chainID,
+		BatchID:      params.batchID,
+		Status:       status,
+		Signers:      signers,
+		Transactions: make([]*batchTransaction, len(txs)),
+	}
+
+	for i, tx := range txs {
+		result.Transactions[i] = &batchTransaction{
+			SourceChainID:           tx.SourceChainID.Value,
+			ObservedTransactionHash: tx.ObservedTransactionHash,
+		}
+	}
+
+	if status == batchStatusPending {
+		result.PendingTransactions = uint64(len(pending.Transactions))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,159 @@
+package metadata
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	apexHelper "github.com/0xPolygon/polygon-edge/command/apex/helper"
+	"github.com/0xPolygon/polygon-edge/command/bridge/common"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
+)
+
+var (
+	params metadataParams
+)
+
+// GetCommand returns the bridge apex-metadata command
+func GetCommand() *cobra.Command {
+	metadataCmd := &cobra.Command{
+		Use: "apex-metadata",
+		Short: "Builds the metadata of the bridging request which transfers native tokens " +
+			"from the cardano chain to the evm chain (nexus) through the apex bridge",
+		PreRunE: preRunCommand,
+		Run:     runCommand,
+	}
+
+	setFlags(metadataCmd)
+
+	return metadataCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.sender,
+		senderFlag,
+		"",
+		"cardano address which sends the bridging request",
+	)
+
+	cmd.Flags().StringVar(
+		&params.srcChain,
+		srcChainFlag,
+		"prime",
+		"source cardano chain name or id",
+	)
+
+	cmd.Flags().StringVar(
+		&params.dstChain,
+		dstChainFlag,
+		"nexus",
+		"destination chain name or id",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&params.receivers,
+		common.ReceiversFlag,
+		nil,
+		"receiving addresses on the destination chain",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&params.amounts,
+		common.AmountsFlag,
+		nil,
+		"amounts (in lovelace) to send to receiving addresses",
+	)
+
+	cmd.Flags().StringVar(
+		&params.fee,
+		feeFlag,
+		"0",
+		"bridging fee (in lovelace) paid to the apex bridge, on top of the amounts",
+	)
+
+	cmd.Flags().StringVar(
+		&params.output,
+		outputFlag,
+		"",
+		"path of the file the metadata is written to (usable as cardano-cli --metadata-json-file)",
+	)
+
+	cmd.Flags().StringVar(
+		&params.bridgeJSONRPC,
+		bridgeJSONRPCFlag,
+		"",
+		"the JSON RPC endpoint of the apex bridge chain, used to resolve the bridging multisig address",
+	)
+
+	_ = cmd.MarkFlagRequired(senderFlag)
+}
+
+func preRunCommand(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	metadata, total, err := params.buildMetadata()
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	result := &metadataResult{
+		Metadata:           metadata,
+		SourceChainID:      params.srcChainID,
+		DestinationChainID: params.dstChainID,
+		TotalAmount:        total.String(),
+	}
+
+	if params.bridgeJSONRPC != "" {
+		if result.MultisigAddress, err = getMultisigAddress(params.bridgeJSONRPC, params.srcChainID); err != nil {
+			outputter.SetError(err)
+
+			return
+		}
+	}
+
+	if params.output != "" {
+		if err := params.writeMetadata(metadata); err != nil {
+			outputter.SetError(fmt.Errorf("failed to write metadata to %s: %w", params.output, err))
+
+			return
+		}
+
+		result.Output = params.output
+	}
+
+	outputter.SetCommandResult(result)
+}
+
+// getMultisigAddress returns the bridging multisig address of the source chain registered on the apex bridge
+func getMultisigAddress(bridgeJSONRPC string, chainID uint8) (string, error) {
+	client, err := jsonrpc.NewEthClient(bridgeJSONRPC)
+	if err != nil {
+		return "", fmt.Errorf("could not create bridge chain JSON RPC client: %w", err)
+	}
+
+	chains, err := apexHelper.GetRegisteredChains(client)
+	if err != nil {
+		return "", err
+	}
+
+	for _, chain := range chains {
+		if chain.ID.Value == uint64(chainID) {
+			if chain.ChainType.Value != apexHelper.ChainTypeCardano {
+				return "", fmt.Errorf("source chain %d is not cardano chain", chainID)
+			}
+
+			return chain.AddressMultisig, nil
+		}
+	}
+
+	return "", fmt.Errorf("source chain %d is not registered on the apex bridge", chainID)
+}
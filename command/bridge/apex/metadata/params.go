@@ -0,0 +1,172 @@
+package metadata
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	apexHelper "github.com/0xPolygon/polygon-edge/command/apex/helper"
+	cmdHelper "github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+)
+
+const (
+	senderFlag        = "sender"
+	srcChainFlag      = "src-chain"
+	dstChainFlag      = "dst-chain"
+	feeFlag           = "fee"
+	outputFlag        = "output"
+	bridgeJSONRPCFlag = "bridge-json-rpc"
+
+	// metadataLabel is the label of the cardano transaction metadata which contains the bridging request
+	metadataLabel = "1"
+	// metadataBridgingRequestType is the type of the bridging request metadata
+	metadataBridgingRequestType = "bridge"
+	// metadataMaxStringLength is the maximal length of the metadata string chunk,
+	// longer strings (addresses) are split into multiple chunks
+	metadataMaxStringLength = 40
+)
+
+var (
+	errInconsistentAmounts = errors.New("receivers and amounts must be equal length")
+	errNoReceivers         = errors.New("at least one receiver must be provided")
+	errSameChains          = errors.New("source and destination chains must be different")
+)
+
+// bridgingRequestReceiver is the receiver of the bridging request metadata
+type bridgingRequestReceiver struct {
+	Address []string `json:"a"`
+	Amount  uint64   `json:"m"`
+}
+
+// bridgingRequestMetadata is the bridging request attached to the cardano transaction
+// sent to the bridging multisig address
+type bridgingRequestMetadata struct {
+	Type             string                     `json:"t"`
+	DestinationChain string                     `json:"d"`
+	Sender           []string                   `json:"s"`
+	Transactions     []*bridgingRequestReceiver `json:"tx"`
+	FeeAmount        uint64                     `json:"fa"`
+}
+
+type metadataParams struct {
+	sender        string
+	srcChain      string
+	dstChain      string
+	receivers     []string
+	amounts       []string
+	fee           string
+	output        string
+	bridgeJSONRPC string
+
+	srcChainID   uint8
+	dstChainID   uint8
+	dstChainName string
+}
+
+func (mp *metadataParams) validateFlags() (err error) {
+	if mp.bridgeJSONRPC != "" {
+		if _, err := cmdHelper.ParseJSONRPCAddress(mp.bridgeJSONRPC); err != nil {
+			return fmt.Errorf("failed to parse bridge json rpc address. Error: %w", err)
+		}
+	}
+
+	if mp.srcChainID, err = apexHelper.ParseChainID(mp.srcChain); err != nil {
+		return err
+	}
+
+	if mp.dstChainID, err = apexHelper.ParseChainID(mp.dstChain); err != nil {
+		return err
+	}
+
+	if mp.srcChainID == mp.dstChainID {
+		return errSameChains
+	}
+
+	// bridging request metadata references the destination chain by its name
+	name, exists := apexHelper.ChainName(mp.dstChainID)
+	if !exists {
+		return fmt.Errorf("destination chain %d is not known apex chain", mp.dstChainID)
+	}
+
+	mp.dstChainName = name
+
+	if len(mp.receivers) == 0 {
+		return errNoReceivers
+	}
+
+	if len(mp.receivers) != len(mp.amounts) {
+		return errInconsistentAmounts
+	}
+
+	return nil
+}
+
+// buildMetadata builds the transaction metadata of the bridging request, along with the total amount
+// which has to be sent to the bridging multisig address
+func (mp *metadataParams) buildMetadata() (map[string]*bridgingRequestMetadata, *big.Int, error) {
+	fee, err := parseLovelace(mp.fee)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode provided fee %s: %w", mp.fee, err)
+	}
+
+	metadata := &bridgingRequestMetadata{
+		Type:             metadataBridgingRequestType,
+		DestinationChain: mp.dstChainName,
+		Sender:           splitString(mp.sender, metadataMaxStringLength),
+		Transactions:     make([]*bridgingRequestReceiver, len(mp.receivers)),
+		FeeAmount:        fee,
+	}
+	total := new(big.Int).SetUint64(fee)
+
+	for i, receiver := range mp.receivers {
+		amount, err := parseLovelace(mp.amounts[i])
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode provided amount %s: %w", mp.amounts[i], err)
+		}
+
+		metadata.Transactions[i] = &bridgingRequestReceiver{
+			Address: splitString(receiver, metadataMaxStringLength),
+			Amount:  amount,
+		}
+
+		total.Add(total, new(big.Int).SetUint64(amount))
+	}
+
+	return map[string]*bridgingRequestMetadata{metadataLabel: metadata}, total, nil
+}
+
+// writeMetadata writes the metadata to the output file, in the format accepted by cardano-cli
+func (mp *metadataParams) writeMetadata(metadata map[string]*bridgingRequestMetadata) error {
+	raw, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return common.SaveFileSafe(mp.output, raw, 0660)
+}
+
+func parseLovelace(value string) (uint64, error) {
+	amount, err := common.ParseUint256orHex(&value)
+	if err != nil {
+		return 0, err
+	}
+
+	if !amount.IsUint64() {
+		return 0, fmt.Errorf("amount %s exceeds the maximal lovelace amount", value)
+	}
+
+	return amount.Uint64(), nil
+}
+
+func splitString(s string, maxLength int) []string {
+	result := make([]string, 0, (len(s)+maxLength-1)/maxLength)
+
+	for len(s) > maxLength {
+		result = append(result, s[:maxLength])
+		s = s[maxLength:]
+	}
+
+	return append(result, s)
+}
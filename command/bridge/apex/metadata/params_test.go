@@ -0,0 +1,105 @@
+package metadata
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_buildMetadata(t *testing.T) {
+	t.Parallel()
+
+	sender := "addr_test1vqeux7xwusdju9dvsj8h7mca9aup2k439kfmwy773xxc2hcu7zy99"
+	receiver := "0x1111111111111111111111111111111111111111"
+
+	p := &metadataParams{
+		sender:    sender,
+		srcChain:  "prime",
+		dstChain:  "3",
+		receivers: []string{receiver, receiver},
+		amounts:   []string{"1000000", "2000000"},
+		fee:       "1100000",
+	}
+
+	require.NoError(t, p.validateFlags())
+	require.Equal(t, "nexus", p.dstChainName)
+
+	metadata, total, err := p.buildMetadata()
+	require.NoError(t, err)
+	require.Equal(t, "4100000", total.String())
+
+	request := metadata[metadataLabel]
+	require.NotNil(t, request)
+	require.Equal(t, metadataBridgingRequestType, request.Type)
+	require.Equal(t, "nexus", request.DestinationChain)
+	require.Equal(t, uint64(1100000), request.FeeAmount)
+	require.Equal(t, sender, strings.Join(request.Sender, ""))
+	require.Len(t, request.Transactions, 2)
+	require.Equal(t, uint64(2000000), request.Transactions[1].Amount)
+
+	for _, chunk := range request.Sender {
+		require.LessOrEqual(t, len(chunk), metadataMaxStringLength)
+	}
+
+	raw, err := json.Marshal(metadata)
+	require.NoError(t, err)
+	require.Contains(t, string(raw), `{"1":{"t":"bridge","d":"nexus","s":[`)
+}
+
+func Test_validateFlags(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		params *metadataParams
+		err    string
+	}{
+		{
+			name:   "same chains",
+			params: &metadataParams{srcChain: "prime", dstChain: "1", receivers: []string{"a"}, amounts: []string{"1"}},
+			err:    errSameChains.Error(),
+		},
+		{
+			name:   "unknown destination chain",
+			params: &metadataParams{srcChain: "prime", dstChain: "10", receivers: []string{"a"}, amounts: []string{"1"}},
+			err:    "destination chain 10 is not known apex chain",
+		},
+		{
+			name:   "inconsistent amounts",
+			params: &metadataParams{srcChain: "prime", dstChain: "nexus", receivers: []string{"a"}},
+			err:    errInconsistentAmounts.Error(),
+		},
+		{
+			name:   "invalid amount",
+			params: &metadataParams{srcChain: "prime", dstChain: "nexus", receivers: []string{"a"}, amounts: []string{"x"}},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.params.validateFlags()
+			if c.err == "" {
+				require.NoError(t, err)
+
+				_, _, err = c.params.buildMetadata()
+				require.Error(t, err)
+			} else {
+				require.ErrorContains(t, err, c.err)
+			}
+		})
+	}
+}
+
+func Test_splitString(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []string{""}, splitString("", 3))
+	require.Equal(t, []string{"abc"}, splitString("abc", 3))
+	require.Equal(t, []string{"abc", "de"}, splitString("abcde", 3))
+}
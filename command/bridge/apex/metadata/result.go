@@ -0,0 +1,50 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type metadataResult struct {
+	Metadata           map[string]*bridgingRequestMetadata `json:"metadata"`
+	SourceChainID      uint8                               `json:"sourceChainId"`
+	DestinationChainID uint8                               `json:"destinationChainId"`
+	TotalAmount        string                              `json:"totalAmount"`
+	MultisigAddress    string                              `json:"multisigAddress,omitempty"`
+	Output             string                              `json:"output,omitempty"`
+}
+
+func (r *metadataResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	vals := []string{
+		fmt.Sprintf("Source Chain ID|%d", r.SourceChainID),
+		fmt.Sprintf("Destination Chain ID|%d", r.DestinationChainID),
+		fmt.Sprintf("Total Amount|%s", r.TotalAmount),
+	}
+
+	if r.MultisigAddress != "" {
+		vals = append(vals, fmt.Sprintf("Multisig Address|%s", r.MultisigAddress))
+	}
+
+	if r.Output != "" {
+		vals = append(vals, fmt.Sprintf("Metadata File|%s", r.Output))
+	}
+
+	buffer.WriteString("\n[APEX BRIDGING REQUEST METADATA]\n")
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n")
+
+	if r.Output == "" {
+		raw, _ := json.MarshalIndent(r.Metadata, "", "  ")
+
+		buffer.WriteString("\n")
+		buffer.Write(raw)
+		buffer.WriteString("\n")
+	}
+
+	return buffer.String()
+}
@@ -0,0 +1,145 @@
+package send
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	apexHelper "github.com/0xPolygon/polygon-edge/command/apex/helper"
+	cmdHelper "github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/Ethernal-Tech/ethgo/abi"
+)
+
+const (
+	gatewayAddrFlag     = "gateway-addr"
+	srcChainFlag        = "src-chain"
+	dstChainFlag        = "dst-chain"
+	feeFlag             = "fee"
+	estimateOnlyFlag    = "estimate-only"
+	waitFlag            = "wait"
+	bridgeJSONRPCFlag   = "bridge-json-rpc"
+	waitTimeoutFlag     = "wait-timeout"
+	batchesLookbackFlag = "batches-lookback"
+)
+
+var (
+	// gatewayWithdrawMethod is the method of the apex evm gateway which creates the bridging request
+	// from the evm chain, the transaction value must be equal to the sum of the amounts and the fee
+	gatewayWithdrawMethod = abi.MustNewMethod("function withdraw(uint8 destinationChainId, " +
+		"tuple(string receiver, uint256 amount)[] receivers, uint256 feeAmount)")
+
+	errInconsistentAmounts = errors.New("receivers and amounts must be equal length")
+	errNoReceivers         = errors.New("at least one receiver must be provided")
+	errSameChains          = errors.New("source and destination chains must be different")
+)
+
+type gatewayReceiver struct {
+	Receiver string   `abi:"receiver"`
+	Amount   *big.Int `abi:"amount"`
+}
+
+type gatewayWithdrawFn struct {
+	DestinationChainID uint8              `abi:"destinationChainId"`
+	Receivers          []*gatewayReceiver `abi:"receivers"`
+	FeeAmount          *big.Int           `abi:"feeAmount"`
+}
+
+func (g *gatewayWithdrawFn) EncodeAbi() ([]byte, error) {
+	return gatewayWithdrawMethod.Encode(g)
+}
+
+type sendParams struct {
+	senderKey       string
+	jsonRPCAddr     string
+	gatewayAddr     string
+	srcChain        string
+	dstChain        string
+	receivers       []string
+	amounts         []string
+	fee             string
+	estimateOnly    bool
+	txTimeout       time.Duration
+	wait            bool
+	bridgeJSONRPC   string
+	waitTimeout     time.Duration
+	batchesLookback uint64
+
+	srcChainID uint8
+	dstChainID uint8
+}
+
+func (sp *sendParams) validateFlags() (err error) {
+	if _, err := cmdHelper.ParseJSONRPCAddress(sp.jsonRPCAddr); err != nil {
+		return fmt.Errorf("failed to parse json rpc address. Error: %w", err)
+	}
+
+	if sp.wait {
+		if _, err := cmdHelper.ParseJSONRPCAddress(sp.bridgeJSONRPC); err != nil {
+			return fmt.Errorf("failed to parse bridge json rpc address. Error: %w", err)
+		}
+	}
+
+	if _, err := types.IsValidAddress(sp.gatewayAddr, false); err != nil {
+		return fmt.Errorf("invalid gateway address: %w", err)
+	}
+
+	if sp.srcChainID, err = apexHelper.ParseChainID(sp.srcChain); err != nil {
+		return err
+	}
+
+	if sp.dstChainID, err = apexHelper.ParseChainID(sp.dstChain); err != nil {
+		return err
+	}
+
+	if sp.srcChainID == sp.dstChainID {
+		return errSameChains
+	}
+
+	if len(sp.receivers) == 0 {
+		return errNoReceivers
+	}
+
+	if len(sp.receivers) != len(sp.amounts) {
+		return errInconsistentAmounts
+	}
+
+	return nil
+}
+
+// withdrawFn builds the gateway withdraw function, along with the value of the transaction
+func (sp *sendParams) withdrawFn() (*gatewayWithdrawFn, *big.Int, error) {
+	fee, err := common.ParseUint256orHex(&sp.fee)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode provided fee %s: %w", sp.fee, err)
+	}
+
+	fn := &gatewayWithdrawFn{
+		DestinationChainID: sp.dstChainID,
+		Receivers:          make([]*gatewayReceiver, len(sp.receivers)),
+		FeeAmount:          fee,
+	}
+	value := new(big.Int).Set(fee)
+
+	for i, receiver := range sp.receivers {
+		amount, err := common.ParseUint256orHex(&sp.amounts[i])
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode provided amount %s: %w", sp.amounts[i], err)
+		}
+
+		fn.Receivers[i] = &gatewayReceiver{Receiver: receiver, Amount: amount}
+		value.Add(value, amount)
+	}
+
+	return fn, value, nil
+}
+
+// estimateTotalCost returns the total cost of the bridging request on the source chain:
+// transaction value (amounts and the bridging fee) along with the gas cost
+func estimateTotalCost(gas, gasPrice uint64, value *big.Int) *big.Int {
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(gas), new(big.Int).SetUint64(gasPrice))
+
+	return cost.Add(cost, value)
+}
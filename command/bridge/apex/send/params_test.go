@@ -0,0 +1,108 @@
+package send
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_validateFlags(t *testing.T) {
+	t.Parallel()
+
+	validParams := func() *sendParams {
+		return &sendParams{
+			jsonRPCAddr: "http://127.0.0.1:8545",
+			gatewayAddr: "0x1111111111111111111111111111111111111111",
+			srcChain:    "nexus",
+			dstChain:    "prime",
+			receivers:   []string{"addr_test1vqeux7xwusdju9dvsj8h7mca9aup2k439kfmwy773xxc2hcu7zy99"},
+			amounts:     []string{"1000"},
+		}
+	}
+
+	cases := []struct {
+		name   string
+		modify func(p *sendParams)
+		err    string
+	}{
+		{
+			name:   "valid",
+			modify: func(p *sendParams) {},
+		},
+		{
+			name:   "unknown chain",
+			modify: func(p *sendParams) { p.dstChain = "unknown" },
+			err:    "invalid chain unknown",
+		},
+		{
+			name:   "same chains",
+			modify: func(p *sendParams) { p.dstChain = "3" },
+			err:    errSameChains.Error(),
+		},
+		{
+			name:   "no receivers",
+			modify: func(p *sendParams) { p.receivers, p.amounts = nil, nil },
+			err:    errNoReceivers.Error(),
+		},
+		{
+			name:   "inconsistent amounts",
+			modify: func(p *sendParams) { p.amounts = append(p.amounts, "1") },
+			err:    errInconsistentAmounts.Error(),
+		},
+		{
+			name:   "invalid bridge json rpc",
+			modify: func(p *sendParams) { p.wait, p.bridgeJSONRPC = true, "" },
+			err:    "failed to parse bridge json rpc address",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := validParams()
+			c.modify(p)
+
+			err := p.validateFlags()
+			if c.err == "" {
+				require.NoError(t, err)
+				require.Equal(t, uint8(3), p.srcChainID)
+				require.Equal(t, uint8(1), p.dstChainID)
+			} else {
+				require.ErrorContains(t, err, c.err)
+			}
+		})
+	}
+}
+
+func Test_withdrawFn(t *testing.T) {
+	t.Parallel()
+
+	p := &sendParams{
+		receivers:  []string{"addr1", "addr2"},
+		amounts:    []string{"100", "0x20"},
+		fee:        "10",
+		dstChainID: 2,
+	}
+
+	fn, value, err := p.withdrawFn()
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(142), value)
+	require.Equal(t, uint8(2), fn.DestinationChainID)
+	require.Equal(t, big.NewInt(10), fn.FeeAmount)
+	require.Len(t, fn.Receivers, 2)
+	require.Equal(t, big.NewInt(32), fn.Receivers[1].Amount)
+
+	input, err := fn.EncodeAbi()
+	require.NoError(t, err)
+	require.Equal(t, gatewayWithdrawMethod.ID(), input[:4])
+
+	p.amounts[0] = "invalid"
+	_, _, err = p.withdrawFn()
+	require.ErrorContains(t, err, "failed to decode provided amount invalid")
+
+	require.Equal(t, big.NewInt(1_000_142), estimateTotalCost(1000, 1000, value))
+}
@@ -0,0 +1,59 @@
+package send
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	apexHelper "github.com/0xPolygon/polygon-edge/command/apex/helper"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type sendResult struct {
+	Sender             string                     `json:"sender"`
+	SourceChainID      uint8                      `json:"sourceChainId"`
+	DestinationChainID uint8                      `json:"destinationChainId"`
+	Receivers          []string                   `json:"receivers"`
+	Amounts            []string                   `json:"amounts"`
+	Fee                string                     `json:"fee"`
+	Value              string                     `json:"value"`
+	EstimatedGas       uint64                     `json:"estimatedGas"`
+	GasPrice           uint64                     `json:"gasPrice"`
+	EstimatedTotalCost string                     `json:"estimatedTotalCost"`
+	TxHash             string                     `json:"txHash,omitempty"`
+	BlockNumber        uint64                     `json:"blockNumber,omitempty"`
+	Status             *apexHelper.TransferStatus `json:"status,omitempty"`
+}
+
+func (r *sendResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	vals := []string{
+		fmt.Sprintf("Sender|%s", r.Sender),
+		fmt.Sprintf("Source Chain ID|%d", r.SourceChainID),
+		fmt.Sprintf("Destination Chain ID|%d", r.DestinationChainID),
+		fmt.Sprintf("Receivers|%s", strings.Join(r.Receivers, ", ")),
+		fmt.Sprintf("Amounts|%s", strings.Join(r.Amounts, ", ")),
+		fmt.Sprintf("Bridging Fee|%s", r.Fee),
+		fmt.Sprintf("Transaction Value|%s", r.Value),
+		fmt.Sprintf("Estimated Gas|%d", r.EstimatedGas),
+		fmt.Sprintf("Gas Price|%d", r.GasPrice),
+		fmt.Sprintf("Estimated Total Cost|%s", r.EstimatedTotalCost),
+	}
+
+	if r.TxHash != "" {
+		vals = append(vals,
+			fmt.Sprintf("Tx Hash|%s", r.TxHash),
+			fmt.Sprintf("Block Number|%d", r.BlockNumber))
+	}
+
+	if r.Status != nil {
+		vals = append(vals, fmt.Sprintf("Batch ID|%d", r.Status.BatchID))
+	}
+
+	buffer.WriteString("\n[APEX BRIDGING REQUEST]\n")
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
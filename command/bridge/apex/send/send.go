@@ -0,0 +1,286 @@
+package send
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	apexHelper "github.com/0xPolygon/polygon-edge/command/apex/helper"
+	"github.com/0xPolygon/polygon-edge/command/bridge/common"
+	"github.com/0xPolygon/polygon-edge/command/bridge/helper"
+	cmdHelper "github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
+	"github.com/0xPolygon/polygon-edge/txrelayer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	params sendParams
+)
+
+// GetCommand returns the bridge apex-send command
+func GetCommand() *cobra.Command {
+	sendCmd := &cobra.Command{
+		Use: "apex-send",
+		Short: "Sends native tokens from the evm chain (nexus) to the cardano chain through the apex bridge, " +
+			"by creating the bridging request on the apex evm gateway",
+		PreRunE: preRunCommand,
+		Run:     runCommand,
+	}
+
+	setFlags(sendCmd)
+
+	return sendCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.senderKey,
+		common.SenderKeyFlag,
+		"",
+		"hex encoded private key of the account which sends the bridging request",
+	)
+
+	cmd.Flags().StringVar(
+		&params.jsonRPCAddr,
+		common.JSONRPCFlag,
+		txrelayer.DefaultRPCAddress,
+		"the JSON RPC endpoint of the source evm chain",
+	)
+
+	cmd.Flags().StringVar(
+		&params.gatewayAddr,
+		gatewayAddrFlag,
+		"",
+		"address of the apex evm gateway on the source chain",
+	)
+
+	cmd.Flags().StringVar(
+		&params.srcChain,
+		srcChainFlag,
+		"nexus",
+		"source chain name or id",
+	)
+
+	cmd.Flags().StringVar(
+		&params.dstChain,
+		dstChainFlag,
+		"prime",
+		"destination cardano chain name or id",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&params.receivers,
+		common.ReceiversFlag,
+		nil,
+		"receiving cardano addresses",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&params.amounts,
+		common.AmountsFlag,
+		nil,
+		"amounts (in wei) to send to receiving addresses",
+	)
+
+	cmd.Flags().StringVar(
+		&params.fee,
+		feeFlag,
+		"0",
+		"bridging fee (in wei) paid to the apex bridge, on top of the amounts",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.estimateOnly,
+		estimateOnlyFlag,
+		false,
+		"only estimate gas and fees of the bridging request, without sending it",
+	)
+
+	cmd.Flags().DurationVar(
+		&params.txTimeout,
+		cmdHelper.TxTimeoutFlag,
+		txrelayer.DefaultTimeoutTransactions,
+		cmdHelper.TxTimeoutDesc,
+	)
+
+	cmd.Flags().BoolVar(
+		&params.wait,
+		waitFlag,
+		false,
+		"wait until the batch which includes the bridging request is signed by the apex validators",
+	)
+
+	cmd.Flags().StringVar(
+		&params.bridgeJSONRPC,
+		bridgeJSONRPCFlag,
+		"",
+		"the JSON RPC endpoint of the apex bridge chain, used to track the bridging request",
+	)
+
+	cmd.Flags().DurationVar(
+		&params.waitTimeout,
+		waitTimeoutFlag,
+		apexHelper.DefaultWaitTimeout,
+		"timeout for waiting until the batch is signed",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.batchesLookback,
+		batchesLookbackFlag,
+		apexHelper.DefaultBatchesLookback,
+		"number of the latest batches searched for the bridging request",
+	)
+
+	_ = cmd.MarkFlagRequired(common.SenderKeyFlag)
+	_ = cmd.MarkFlagRequired(gatewayAddrFlag)
+	cmd.MarkFlagsRequiredTogether(waitFlag, bridgeJSONRPCFlag)
+	cmd.MarkFlagsMutuallyExclusive(estimateOnlyFlag, waitFlag)
+}
+
+func preRunCommand(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	senderKeyRaw, err := hex.DecodeString(params.senderKey)
+	if err != nil {
+		outputter.SetError(fmt.Errorf("failed to decode sender private key: %w", err))
+
+		return
+	}
+
+	senderAccount, err := crypto.NewECDSAKeyFromRawPrivECDSA(senderKeyRaw)
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	txRelayer, err := txrelayer.NewTxRelayer(
+		txrelayer.WithIPAddress(params.jsonRPCAddr),
+		txrelayer.WithReceiptsTimeout(params.txTimeout),
+		txrelayer.WithWriter(outputter),
+	)
+	if err != nil {
+		outputter.SetError(fmt.Errorf("could not create source chain tx relayer: %w", err))
+
+		return
+	}
+
+	withdrawFn, value, err := params.withdrawFn()
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	input, err := withdrawFn.EncodeAbi()
+	if err != nil {
+		outputter.SetError(fmt.Errorf("failed to encode bridging request: %w", err))
+
+		return
+	}
+
+	gatewayAddr := types.StringToAddress(params.gatewayAddr)
+	txn := helper.CreateTransaction(senderAccount.Address(), &gatewayAddr, input, value, false)
+
+	result := &sendResult{
+		Sender:             senderAccount.Address().String(),
+		SourceChainID:      params.srcChainID,
+		DestinationChainID: params.dstChainID,
+		Receivers:          params.receivers,
+		Amounts:            params.amounts,
+		Fee:                withdrawFn.FeeAmount.String(),
+		Value:              value.String(),
+	}
+
+	if err := estimateFees(txRelayer.Client(), txn, result); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	if params.estimateOnly {
+		outputter.SetCommandResult(result)
+
+		return
+	}
+
+	receipt, err := txRelayer.SendTransaction(txn, senderAccount)
+	if err != nil {
+		outputter.SetError(fmt.Errorf("failed to send bridging request: %w", err))
+
+		return
+	}
+
+	if receipt.Status == uint64(types.ReceiptFailed) {
+		outputter.SetError(fmt.Errorf("bridging request transaction %s failed", receipt.TransactionHash))
+
+		return
+	}
+
+	result.TxHash = types.Hash(receipt.TransactionHash).String()
+	result.BlockNumber = receipt.BlockNumber
+
+	if params.wait {
+		status, err := waitForBatchSigned(cmd.Context(), result.TxHash)
+		if err != nil {
+			outputter.SetError(err)
+
+			return
+		}
+
+		result.Status = status
+	}
+
+	outputter.SetCommandResult(result)
+}
+
+// estimateFees estimates the gas of the bridging request and its total cost on the source chain
+func estimateFees(client *jsonrpc.EthClient, txn *types.Transaction, result *sendResult) error {
+	gas, err := client.EstimateGas(txrelayer.ConvertTxnToCallMsg(txn))
+	if err != nil {
+		return fmt.Errorf("failed to estimate gas of bridging request: %w", err)
+	}
+
+	gasPrice, err := client.GasPrice()
+	if err != nil {
+		return fmt.Errorf("failed to get gas price: %w", err)
+	}
+
+	result.EstimatedGas = gas
+	result.GasPrice = gasPrice
+	result.EstimatedTotalCost = estimateTotalCost(gas, gasPrice, txn.Value()).String()
+
+	return nil
+}
+
+// waitForBatchSigned waits until the batch which includes the bridging request is signed
+func waitForBatchSigned(ctx context.Context, txHash string) (*apexHelper.TransferStatus, error) {
+	client, err := jsonrpc.NewEthClient(params.bridgeJSONRPC)
+	if err != nil {
+		return nil, fmt.Errorf("could not create bridge chain JSON RPC client: %w", err)
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, params.waitTimeout)
+	defer cancel()
+
+	return apexHelper.WaitForTransferSigned(ctx, client, &apexHelper.TransferQuery{
+		SourceChainID:      params.srcChainID,
+		DestinationChainID: params.dstChainID,
+		TxHash:             txHash,
+		BatchesLookback:    params.batchesLookback,
+	}, apexHelper.DefaultPollInterval)
+}
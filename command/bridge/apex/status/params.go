@@ -0,0 +1,75 @@
+package status
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	apexHelper "github.com/0xPolygon/polygon-edge/command/apex/helper"
+	cmdHelper "github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+)
+
+const (
+	srcChainFlag        = "src-chain"
+	dstChainFlag        = "dst-chain"
+	txHashFlag          = "tx-hash"
+	waitFlag            = "wait"
+	waitTimeoutFlag     = "wait-timeout"
+	batchesLookbackFlag = "batches-lookback"
+)
+
+type statusParams struct {
+	jsonRPCAddr     string
+	srcChain        string
+	dstChain        string
+	txHash          string
+	wait            bool
+	waitTimeout     time.Duration
+	batchesLookback uint64
+
+	srcChainID uint8
+	dstChainID uint8
+}
+
+func (sp *statusParams) validateFlags() (err error) {
+	if _, err := cmdHelper.ParseJSONRPCAddress(sp.jsonRPCAddr); err != nil {
+		return fmt.Errorf("failed to parse json rpc address. Error: %w", err)
+	}
+
+	if sp.srcChainID, err = apexHelper.ParseChainID(sp.srcChain); err != nil {
+		return err
+	}
+
+	if sp.dstChainID, err = apexHelper.ParseChainID(sp.dstChain); err != nil {
+		return err
+	}
+
+	if sp.srcChainID == sp.dstChainID {
+		return fmt.Errorf("source and destination chains must be different")
+	}
+
+	// observed transaction hashes are reported as 0x prefixed hex
+	if !strings.HasPrefix(sp.txHash, "0x") {
+		sp.txHash = "0x" + sp.txHash
+	}
+
+	if _, err := hex.DecodeHex(sp.txHash); err != nil {
+		return fmt.Errorf("invalid transaction hash %s: %w", sp.txHash, err)
+	}
+
+	if sp.batchesLookback == 0 {
+		return fmt.Errorf("%s must be greater than zero", batchesLookbackFlag)
+	}
+
+	return nil
+}
+
+func (sp *statusParams) query() *apexHelper.TransferQuery {
+	return &apexHelper.TransferQuery{
+		SourceChainID:      sp.srcChainID,
+		DestinationChainID: sp.dstChainID,
+		TxHash:             sp.txHash,
+		BatchesLookback:    sp.batchesLookback,
+	}
+}
@@ -0,0 +1,44 @@
+package status
+
+import (
+	"bytes"
+	"fmt"
+
+	apexHelper "github.com/0xPolygon/polygon-edge/command/apex/helper"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type statusResult struct {
+	*apexHelper.TransferStatus
+}
+
+func (r *statusResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	vals := []string{
+		fmt.Sprintf("Source Chain ID|%d", r.SourceChainID),
+		fmt.Sprintf("Destination Chain ID|%d", r.DestinationChainID),
+		fmt.Sprintf("Tx Hash|%s", r.TxHash),
+	}
+
+	if r.BatchID != 0 {
+		vals = append(vals, fmt.Sprintf("Batch ID|%d", r.BatchID))
+	}
+
+	buffer.WriteString("\n[APEX TRANSFER STATUS]\n")
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n")
+
+	stages := make([]string, 0, len(r.Stages)+1)
+	stages = append(stages, "Stage|Completed")
+
+	for _, stage := range r.Stages {
+		stages = append(stages, fmt.Sprintf("%s|%t", stage.Name, stage.Completed))
+	}
+
+	buffer.WriteString("\n[STAGES]\n")
+	buffer.WriteString(helper.FormatList(stages))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
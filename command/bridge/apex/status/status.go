@@ -0,0 +1,127 @@
+package status
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	apexHelper "github.com/0xPolygon/polygon-edge/command/apex/helper"
+	"github.com/0xPolygon/polygon-edge/command/bridge/common"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
+	"github.com/0xPolygon/polygon-edge/txrelayer"
+)
+
+var (
+	params statusParams
+)
+
+// GetCommand returns the bridge apex-status command
+func GetCommand() *cobra.Command {
+	statusCmd := &cobra.Command{
+		Use:     "apex-status",
+		Short:   "Tracks the transfer through the apex bridge, from the confirmed claim up to the signed batch",
+		PreRunE: preRunCommand,
+		Run:     runCommand,
+	}
+
+	setFlags(statusCmd)
+
+	return statusCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.jsonRPCAddr,
+		common.JSONRPCFlag,
+		txrelayer.DefaultRPCAddress,
+		"the JSON RPC endpoint of the apex bridge chain",
+	)
+
+	cmd.Flags().StringVar(
+		&params.srcChain,
+		srcChainFlag,
+		"",
+		"source chain name or id",
+	)
+
+	cmd.Flags().StringVar(
+		&params.dstChain,
+		dstChainFlag,
+		"",
+		"destination chain name or id",
+	)
+
+	cmd.Flags().StringVar(
+		&params.txHash,
+		txHashFlag,
+		"",
+		"hash of the bridging request transaction on the source chain",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.wait,
+		waitFlag,
+		false,
+		"wait until the batch which includes the transfer is signed by the apex validators",
+	)
+
+	cmd.Flags().DurationVar(
+		&params.waitTimeout,
+		waitTimeoutFlag,
+		apexHelper.DefaultWaitTimeout,
+		"timeout for waiting until the batch is signed",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.batchesLookback,
+		batchesLookbackFlag,
+		apexHelper.DefaultBatchesLookback,
+		"number of the latest batches searched for the transfer",
+	)
+
+	_ = cmd.MarkFlagRequired(srcChainFlag)
+	_ = cmd.MarkFlagRequired(dstChainFlag)
+	_ = cmd.MarkFlagRequired(txHashFlag)
+}
+
+func preRunCommand(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	client, err := jsonrpc.NewEthClient(params.jsonRPCAddr)
+	if err != nil {
+		outputter.SetError(fmt.Errorf("could not create bridge chain JSON RPC client: %w", err))
+
+		return
+	}
+
+	var status *apexHelper.TransferStatus
+
+	if params.wait {
+		ctx := cmd.Context()
+		if ctx == nil {
+			ctx = context.Background()
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, params.waitTimeout)
+		defer cancel()
+
+		status, err = apexHelper.WaitForTransferSigned(ctx, client, params.query(), apexHelper.DefaultPollInterval)
+	} else {
+		status, err = apexHelper.GetTransferStatus(client, params.query())
+	}
+
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(&statusResult{status})
+}
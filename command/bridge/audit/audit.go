@@ -0,0 +1,299 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/Ethernal-Tech/ethgo"
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	bridgeHelper "github.com/0xPolygon/polygon-edge/command/bridge/helper"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/txrelayer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	params auditParams
+)
+
+// GetCommand returns the bridge audit command
+func GetCommand() *cobra.Command {
+	auditCmd := &cobra.Command{
+		Use: "audit",
+		Short: "Reconciles the tokens locked in the root chain predicates with the tokens minted " +
+			"by the child chain predicates and reports discrepancies and in-flight transfers",
+		PreRunE: preRunCommand,
+		Run:     runCommand,
+	}
+
+	setFlags(auditCmd)
+
+	return auditCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.genesisPath,
+		bridgeHelper.GenesisPathFlag,
+		bridgeHelper.DefaultGenesisPath,
+		bridgeHelper.GenesisPathFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.rootJSONRPCAddr,
+		rootJSONRPCFlag,
+		txrelayer.DefaultRPCAddress,
+		"the JSON RPC root chain endpoint",
+	)
+
+	cmd.Flags().StringVar(
+		&params.childJSONRPCAddr,
+		childJSONRPCFlag,
+		"http://127.0.0.1:9545",
+		"the JSON RPC child chain endpoint",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rootTokenRaw,
+		"root-token",
+		"",
+		"root token address of the audited token mapping (all the mapped tokens are audited if omitted)",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rootFromBlock,
+		rootFromBlockFlag,
+		0,
+		"root chain block from which the predicate events are read "+
+			"(defaults to the bridge deployment block from the genesis)",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.childFromBlock,
+		childFromBlockFlag,
+		0,
+		"child chain block from which the predicate events are read",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.blockRange,
+		blockRangeFlag,
+		defaultBlockRange,
+		"maximum number of blocks queried by a single eth_getLogs request",
+	)
+}
+
+func preRunCommand(cmd *cobra.Command, _ []string) error {
+	params.rootFromBlockSet = cmd.Flags().Changed(rootFromBlockFlag)
+
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	result, err := runAudit()
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(result)
+}
+
+// predicates holds the addresses of the root and child predicates of a single token type
+type predicates struct {
+	tokenType tokenType
+	root      types.Address
+	child     types.Address
+}
+
+func runAudit() (*auditResult, error) {
+	consensusCfg, err := polybft.LoadPolyBFTConfig(params.genesisPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load genesis configuration: %w", err)
+	}
+
+	if !consensusCfg.IsBridgeEnabled() {
+		return nil, errors.New("bridge is not enabled in the provided genesis")
+	}
+
+	rootToken, err := params.rootToken()
+	if err != nil {
+		return nil, err
+	}
+
+	rootFromBlock := params.rootFromBlock
+	if !params.rootFromBlockSet {
+		rootFromBlock = consensusCfg.Bridge.EventTrackerStartBlocks[consensusCfg.Bridge.StateSenderAddr]
+	}
+
+	rootTxRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(params.rootJSONRPCAddr))
+	if err != nil {
+		return nil, fmt.Errorf("could not create root chain tx relayer: %w", err)
+	}
+
+	childTxRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(params.childJSONRPCAddr))
+	if err != nil {
+		return nil, fmt.Errorf("could not create child chain tx relayer: %w", err)
+	}
+
+	allPredicates := []predicates{
+		{erc20Token, consensusCfg.Bridge.RootERC20PredicateAddr, contracts.ChildERC20PredicateContract},
+		{erc721Token, consensusCfg.Bridge.RootERC721PredicateAddr, contracts.ChildERC721PredicateContract},
+		{erc1155Token, consensusCfg.Bridge.RootERC1155PredicateAddr, contracts.ChildERC1155PredicateContract},
+	}
+
+	// events of all the predicates are read up to the same block on each chain
+	rootToBlock, err := rootTxRelayer.Client().BlockNumber()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get root chain block number: %w", err)
+	}
+
+	childToBlock, err := childTxRelayer.Client().BlockNumber()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get child chain block number: %w", err)
+	}
+
+	ledger := newTokenLedger(rootToken)
+
+	for _, p := range allPredicates {
+		if p.root == types.ZeroAddress {
+			continue
+		}
+
+		err = processLogs(rootTxRelayer, p.root, rootFromBlock, rootToBlock, func(log *ethgo.Log) error {
+			return ledger.processRootLog(p.tokenType, log)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s root predicate events: %w", p.tokenType, err)
+		}
+
+		err = processLogs(childTxRelayer, p.child, params.childFromBlock, childToBlock, func(log *ethgo.Log) error {
+			return ledger.processChildLog(p.tokenType, log)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s child predicate events: %w", p.tokenType, err)
+		}
+	}
+
+	predicateByType := make(map[tokenType]types.Address, len(allPredicates))
+	for _, p := range allPredicates {
+		predicateByType[p.tokenType] = p.root
+	}
+
+	for _, token := range ledger.tokens {
+		if err := queryBalances(rootTxRelayer, childTxRelayer, predicateByType[token.TokenType], token); err != nil {
+			return nil, fmt.Errorf("failed to query balances of the %s token %s: %w",
+				token.TokenType, token.RootToken, err)
+		}
+	}
+
+	return &auditResult{
+		RootToBlock:  rootToBlock,
+		ChildToBlock: childToBlock,
+		Tokens:       ledger.audits(),
+	}, nil
+}
+
+// processLogs reads the logs emitted by given contract in the given block interval,
+// in chunks of the configured block range
+func processLogs(txRelayer txrelayer.TxRelayer, contract types.Address, fromBlock, toBlock uint64,
+	handler func(*ethgo.Log) error) error {
+	for from := fromBlock; from <= toBlock; from += params.blockRange {
+		to := from + params.blockRange - 1
+		if to > toBlock {
+			to = toBlock
+		}
+
+		filter := &ethgo.LogFilter{Address: []ethgo.Address{ethgo.Address(contract)}}
+		filter.SetFromUint64(from)
+		filter.SetToUint64(to)
+
+		logs, err := txRelayer.Client().GetLogs(filter)
+		if err != nil {
+			return err
+		}
+
+		for _, log := range logs {
+			if err := handler(log); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// queryBalances queries the amount locked in the root predicate and,
+// for the ERC20 tokens, the total supply of the child token
+func queryBalances(rootTxRelayer, childTxRelayer txrelayer.TxRelayer,
+	rootPredicate types.Address, token *tokenAudit) error {
+	switch token.TokenType {
+	case erc20Token:
+		locked, err := callUint256(rootTxRelayer, token.RootToken,
+			&contractsapi.BalanceOfRootERC20Fn{Account: rootPredicate})
+		if err != nil {
+			return err
+		}
+
+		token.RootLocked = locked
+
+		if token.isNativeToken() {
+			return nil
+		}
+
+		supply, err := callUint256(childTxRelayer, token.ChildToken, &contractsapi.TotalSupplyChildERC20Fn{})
+		if err != nil {
+			return err
+		}
+
+		token.ChildSupply = supply
+	case erc721Token:
+		locked, err := callUint256(rootTxRelayer, token.RootToken,
+			&contractsapi.BalanceOfRootERC721Fn{Owner: rootPredicate})
+		if err != nil {
+			return err
+		}
+
+		token.RootLocked = locked
+	case erc1155Token:
+		token.RootLocked = big.NewInt(0)
+
+		for _, id := range token.sortedTokenIDs() {
+			locked, err := callUint256(rootTxRelayer, token.RootToken,
+				&contractsapi.BalanceOfRootERC1155Fn{Account: rootPredicate, ID: id})
+			if err != nil {
+				return err
+			}
+
+			token.RootLocked.Add(token.RootLocked, locked)
+		}
+	}
+
+	return nil
+}
+
+// callUint256 invokes given view function on the provided contract and decodes the uint256 result
+func callUint256(txRelayer txrelayer.TxRelayer, contract types.Address,
+	fn contractsapi.StateTransactionInput) (*big.Int, error) {
+	input, err := fn.EncodeAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := txRelayer.Call(types.ZeroAddress, contract, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return common.ParseUint256orHex(&response)
+}
@@ -0,0 +1,435 @@
+package audit
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/Ethernal-Tech/ethgo"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type tokenType string
+
+const (
+	erc20Token   tokenType = "ERC20"
+	erc721Token  tokenType = "ERC721"
+	erc1155Token tokenType = "ERC1155"
+)
+
+var (
+	tokenMappedEventSig   = new(contractsapi.TokenMappedEvent).Sig()
+	l2TokenMappedEventSig = new(contractsapi.L2TokenMappedEvent).Sig()
+
+	erc20DepositEventSig           = new(contractsapi.ERC20DepositEvent).Sig()
+	erc20WithdrawEventSig          = new(contractsapi.ERC20WithdrawEvent).Sig()
+	erc721DepositEventSig          = new(contractsapi.ERC721DepositEvent).Sig()
+	erc721DepositBatchEventSig     = new(contractsapi.ERC721DepositBatchEvent).Sig()
+	erc721WithdrawEventSig         = new(contractsapi.ERC721WithdrawEvent).Sig()
+	erc721WithdrawBatchEventSig    = new(contractsapi.ERC721WithdrawBatchEvent).Sig()
+	erc1155DepositEventSig         = new(contractsapi.ERC1155DepositEvent).Sig()
+	erc1155DepositBatchEventSig    = new(contractsapi.ERC1155DepositBatchEvent).Sig()
+	erc1155WithdrawEventSig        = new(contractsapi.ERC1155WithdrawEvent).Sig()
+	erc1155WithdrawBatchEventSig   = new(contractsapi.ERC1155WithdrawBatchEvent).Sig()
+	l2ERC20DepositEventSig         = new(contractsapi.L2ERC20DepositEvent).Sig()
+	l2ERC20WithdrawEventSig        = new(contractsapi.L2ERC20WithdrawEvent).Sig()
+	l2ERC721DepositEventSig        = new(contractsapi.L2ERC721DepositEvent).Sig()
+	l2ERC721DepositBatchEventSig   = new(contractsapi.L2ERC721DepositBatchEvent).Sig()
+	l2ERC721WithdrawEventSig       = new(contractsapi.L2ERC721WithdrawEvent).Sig()
+	l2ERC721WithdrawBatchEventSig  = new(contractsapi.L2ERC721WithdrawBatchEvent).Sig()
+	l2ERC1155DepositEventSig       = new(contractsapi.L2ERC1155DepositEvent).Sig()
+	l2ERC1155DepositBatchEventSig  = new(contractsapi.L2ERC1155DepositBatchEvent).Sig()
+	l2ERC1155WithdrawEventSig      = new(contractsapi.L2ERC1155WithdrawEvent).Sig()
+	l2ERC1155WithdrawBatchEventSig = new(contractsapi.L2ERC1155WithdrawBatchEvent).Sig()
+)
+
+// transferTotals holds the number of transferred items (amounts or token ids) and their total amount
+type transferTotals struct {
+	Count  int64    `json:"count"`
+	Amount *big.Int `json:"amount"`
+}
+
+func newTransferTotals() transferTotals {
+	return transferTotals{Amount: big.NewInt(0)}
+}
+
+// add records a transfer of given amounts
+func (t *transferTotals) add(amounts ...*big.Int) {
+	for _, amount := range amounts {
+		t.Count++
+		t.Amount.Add(t.Amount, amount)
+	}
+}
+
+// sub returns the difference of given totals
+func (t transferTotals) sub(other transferTotals) transferTotals {
+	return transferTotals{
+		Count:  t.Count - other.Count,
+		Amount: new(big.Int).Sub(t.Amount, other.Amount),
+	}
+}
+
+// tokenAudit is the audit report of a single token mapping
+type tokenAudit struct {
+	TokenType  tokenType     `json:"tokenType"`
+	RootToken  types.Address `json:"rootToken"`
+	ChildToken types.Address `json:"childToken"`
+
+	RootDeposits     transferTotals `json:"rootDeposits"`
+	RootWithdrawals  transferTotals `json:"rootWithdrawals"`
+	ChildDeposits    transferTotals `json:"childDeposits"`
+	ChildWithdrawals transferTotals `json:"childWithdrawals"`
+
+	// PendingDeposits are deposited on the root chain, but not yet minted on the child chain
+	PendingDeposits transferTotals `json:"pendingDeposits"`
+	// PendingWithdrawals are burnt on the child chain, but not yet released on the root chain
+	PendingWithdrawals transferTotals `json:"pendingWithdrawals"`
+
+	// RootLocked is the amount held by the root predicate
+	RootLocked *big.Int `json:"rootLocked"`
+	// ChildSupply is the total supply of the child token (only available for ERC20 tokens)
+	ChildSupply *big.Int `json:"childSupply,omitempty"`
+
+	Discrepancies []string `json:"discrepancies"`
+
+	// tokenIDs are the ERC1155 token ids transferred through the bridge
+	tokenIDs map[string]*big.Int
+}
+
+// isNativeToken indicates whether the child token is the child chain native token, whose supply also
+// includes the genesis premine and minted rewards, so it can not be reconciled against the bridge transfers
+func (ta *tokenAudit) isNativeToken() bool {
+	return ta.ChildToken == contracts.NativeERC20TokenContract
+}
+
+// sortedTokenIDs returns the ERC1155 token ids transferred through the bridge in ascending order
+func (ta *tokenAudit) sortedTokenIDs() []*big.Int {
+	ids := make([]*big.Int, 0, len(ta.tokenIDs))
+	for _, id := range ta.tokenIDs {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].Cmp(ids[j]) < 0
+	})
+
+	return ids
+}
+
+// reconcile calculates the in-flight transfers and reports the discrepancies between the chains
+func (ta *tokenAudit) reconcile() {
+	ta.PendingDeposits = ta.RootDeposits.sub(ta.ChildDeposits)
+	ta.PendingWithdrawals = ta.ChildWithdrawals.sub(ta.RootWithdrawals)
+	ta.Discrepancies = []string{}
+
+	if ta.PendingDeposits.Amount.Sign() < 0 {
+		ta.Discrepancies = append(ta.Discrepancies, fmt.Sprintf(
+			"child chain deposits exceed root chain deposits by %s", new(big.Int).Neg(ta.PendingDeposits.Amount)))
+	}
+
+	if ta.PendingWithdrawals.Amount.Sign() < 0 {
+		ta.Discrepancies = append(ta.Discrepancies, fmt.Sprintf(
+			"root chain withdrawals exceed child chain withdrawals by %s",
+			new(big.Int).Neg(ta.PendingWithdrawals.Amount)))
+	}
+
+	if ta.RootLocked != nil {
+		netLocked := ta.RootDeposits.sub(ta.RootWithdrawals).Amount
+		if ta.RootLocked.Cmp(netLocked) != 0 {
+			ta.Discrepancies = append(ta.Discrepancies, fmt.Sprintf(
+				"root predicate holds %s, while net root chain deposits are %s", ta.RootLocked, netLocked))
+		}
+	}
+
+	if ta.ChildSupply != nil {
+		netMinted := ta.ChildDeposits.sub(ta.ChildWithdrawals).Amount
+		if ta.ChildSupply.Cmp(netMinted) != 0 {
+			ta.Discrepancies = append(ta.Discrepancies, fmt.Sprintf(
+				"child token supply is %s, while net child chain deposits are %s", ta.ChildSupply, netMinted))
+		}
+	}
+}
+
+// tokenLedger accumulates the bridge transfers of the audited token mappings from the predicate events
+type tokenLedger struct {
+	// rootToken, if set, restricts the audit to a single token mapping
+	rootToken *types.Address
+	tokens    map[types.Address]*tokenAudit
+}
+
+func newTokenLedger(rootToken *types.Address) *tokenLedger {
+	return &tokenLedger{
+		rootToken: rootToken,
+		tokens:    map[types.Address]*tokenAudit{},
+	}
+}
+
+// getToken returns the audit of given token mapping, creating it if it does not exist.
+// It returns nil if given token mapping is not audited
+func (l *tokenLedger) getToken(tokenType tokenType, rootToken, childToken types.Address) *tokenAudit {
+	if l.rootToken != nil && *l.rootToken != rootToken {
+		return nil
+	}
+
+	token, exists := l.tokens[rootToken]
+	if !exists {
+		token = &tokenAudit{
+			TokenType:        tokenType,
+			RootToken:        rootToken,
+			ChildToken:       childToken,
+			RootDeposits:     newTransferTotals(),
+			RootWithdrawals:  newTransferTotals(),
+			ChildDeposits:    newTransferTotals(),
+			ChildWithdrawals: newTransferTotals(),
+			tokenIDs:         map[string]*big.Int{},
+		}
+		l.tokens[rootToken] = token
+	}
+
+	return token
+}
+
+// recordTransfer records the transfer of given token ids and amounts (nil amounts denote ERC721 transfers)
+func (l *tokenLedger) recordTransfer(tokenType tokenType, rootToken, childToken types.Address,
+	selectTotals func(*tokenAudit) *transferTotals, tokenIDs []*big.Int, amounts []*big.Int) {
+	token := l.getToken(tokenType, rootToken, childToken)
+	if token == nil {
+		return
+	}
+
+	if amounts == nil {
+		amounts = make([]*big.Int, len(tokenIDs))
+		for i := range amounts {
+			amounts[i] = big.NewInt(1)
+		}
+	}
+
+	if tokenType == erc1155Token {
+		for _, id := range tokenIDs {
+			token.tokenIDs[id.String()] = id
+		}
+	}
+
+	selectTotals(token).add(amounts...)
+}
+
+// audits returns the reconciled audits of all the token mappings, sorted by the token type and root token
+func (l *tokenLedger) audits() []*tokenAudit {
+	audits := make([]*tokenAudit, 0, len(l.tokens))
+	for _, token := range l.tokens {
+		token.reconcile()
+		audits = append(audits, token)
+	}
+
+	sort.Slice(audits, func(i, j int) bool {
+		if audits[i].TokenType != audits[j].TokenType {
+			return audits[i].TokenType < audits[j].TokenType
+		}
+
+		return audits[i].RootToken.String() < audits[j].RootToken.String()
+	})
+
+	return audits
+}
+
+func rootDeposits(t *tokenAudit) *transferTotals     { return &t.RootDeposits }
+func rootWithdrawals(t *tokenAudit) *transferTotals  { return &t.RootWithdrawals }
+func childDeposits(t *tokenAudit) *transferTotals    { return &t.ChildDeposits }
+func childWithdrawals(t *tokenAudit) *transferTotals { return &t.ChildWithdrawals }
+
+// processRootLog records the token mapping or the transfer from given root predicate log
+func (l *tokenLedger) processRootLog(tokenType tokenType, log *ethgo.Log) error {
+	if len(log.Topics) == 0 {
+		return nil
+	}
+
+	switch log.Topics[0] {
+	case tokenMappedEventSig:
+		var event contractsapi.TokenMappedEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.getToken(tokenType, event.RootToken, event.ChildToken)
+	case erc20DepositEventSig:
+		var event contractsapi.ERC20DepositEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc20Token, event.RootToken, event.ChildToken, rootDeposits,
+			nil, []*big.Int{event.Amount})
+	case erc20WithdrawEventSig:
+		var event contractsapi.ERC20WithdrawEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc20Token, event.RootToken, event.ChildToken, rootWithdrawals,
+			nil, []*big.Int{event.Amount})
+	case erc721DepositEventSig:
+		var event contractsapi.ERC721DepositEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc721Token, event.RootToken, event.ChildToken, rootDeposits,
+			[]*big.Int{event.TokenID}, nil)
+	case erc721DepositBatchEventSig:
+		var event contractsapi.ERC721DepositBatchEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc721Token, event.RootToken, event.ChildToken, rootDeposits, event.TokenIDs, nil)
+	case erc721WithdrawEventSig:
+		var event contractsapi.ERC721WithdrawEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc721Token, event.RootToken, event.ChildToken, rootWithdrawals,
+			[]*big.Int{event.TokenID}, nil)
+	case erc721WithdrawBatchEventSig:
+		var event contractsapi.ERC721WithdrawBatchEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc721Token, event.RootToken, event.ChildToken, rootWithdrawals, event.TokenIDs, nil)
+	case erc1155DepositEventSig:
+		var event contractsapi.ERC1155DepositEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc1155Token, event.RootToken, event.ChildToken, rootDeposits,
+			[]*big.Int{event.TokenID}, []*big.Int{event.Amount})
+	case erc1155DepositBatchEventSig:
+		var event contractsapi.ERC1155DepositBatchEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc1155Token, event.RootToken, event.ChildToken, rootDeposits,
+			event.TokenIDs, event.Amounts)
+	case erc1155WithdrawEventSig:
+		var event contractsapi.ERC1155WithdrawEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc1155Token, event.RootToken, event.ChildToken, rootWithdrawals,
+			[]*big.Int{event.TokenID}, []*big.Int{event.Amount})
+	case erc1155WithdrawBatchEventSig:
+		var event contractsapi.ERC1155WithdrawBatchEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc1155Token, event.RootToken, event.ChildToken, rootWithdrawals,
+			event.TokenIDs, event.Amounts)
+	}
+
+	return nil
+}
+
+// processChildLog records the token mapping or the transfer from given child predicate log
+func (l *tokenLedger) processChildLog(tokenType tokenType, log *ethgo.Log) error {
+	if len(log.Topics) == 0 {
+		return nil
+	}
+
+	switch log.Topics[0] {
+	case l2TokenMappedEventSig:
+		var event contractsapi.L2TokenMappedEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.getToken(tokenType, event.RootToken, event.ChildToken)
+	case l2ERC20DepositEventSig:
+		var event contractsapi.L2ERC20DepositEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc20Token, event.RootToken, event.ChildToken, childDeposits,
+			nil, []*big.Int{event.Amount})
+	case l2ERC20WithdrawEventSig:
+		var event contractsapi.L2ERC20WithdrawEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc20Token, event.RootToken, event.ChildToken, childWithdrawals,
+			nil, []*big.Int{event.Amount})
+	case l2ERC721DepositEventSig:
+		var event contractsapi.L2ERC721DepositEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc721Token, event.RootToken, event.ChildToken, childDeposits,
+			[]*big.Int{event.TokenID}, nil)
+	case l2ERC721DepositBatchEventSig:
+		var event contractsapi.L2ERC721DepositBatchEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc721Token, event.RootToken, event.ChildToken, childDeposits, event.TokenIDs, nil)
+	case l2ERC721WithdrawEventSig:
+		var event contractsapi.L2ERC721WithdrawEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc721Token, event.RootToken, event.ChildToken, childWithdrawals,
+			[]*big.Int{event.TokenID}, nil)
+	case l2ERC721WithdrawBatchEventSig:
+		var event contractsapi.L2ERC721WithdrawBatchEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc721Token, event.RootToken, event.ChildToken, childWithdrawals, event.TokenIDs, nil)
+	case l2ERC1155DepositEventSig:
+		var event contractsapi.L2ERC1155DepositEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc1155Token, event.RootToken, event.ChildToken, childDeposits,
+			[]*big.Int{event.TokenID}, []*big.Int{event.Amount})
+	case l2ERC1155DepositBatchEventSig:
+		var event contractsapi.L2ERC1155DepositBatchEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc1155Token, event.RootToken, event.ChildToken, childDeposits,
+			event.TokenIDs, event.Amounts)
+	case l2ERC1155WithdrawEventSig:
+		var event contractsapi.L2ERC1155WithdrawEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc1155Token, event.RootToken, event.ChildToken, childWithdrawals,
+			[]*big.Int{event.TokenID}, []*big.Int{event.Amount})
+	case l2ERC1155WithdrawBatchEventSig:
+		var event contractsapi.L2ERC1155WithdrawBatchEvent
+		if _, err := event.ParseLog(log); err != nil {
+			return err
+		}
+
+		l.recordTransfer(erc1155Token, event.RootToken, event.ChildToken, childWithdrawals,
+			event.TokenIDs, event.Amounts)
+	}
+
+	return nil
+}
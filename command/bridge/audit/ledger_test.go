@@ -0,0 +1,198 @@
+package audit
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Ethernal-Tech/ethgo"
+	"github.com/Ethernal-Tech/ethgo/abi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	rootToken  = types.StringToAddress("0x1")
+	childToken = types.StringToAddress("0x2")
+	otherToken = types.StringToAddress("0x3")
+	sender     = types.StringToAddress("0x4")
+	receiver   = types.StringToAddress("0x5")
+)
+
+func TestTokenLedger_ERC20(t *testing.T) {
+	t.Parallel()
+
+	ledger := newTokenLedger(nil)
+
+	rootLogs := []*ethgo.Log{
+		createTestLog(t, contractsapi.RootERC20Predicate.Abi.Events["TokenMapped"], map[string]interface{}{
+			"rootToken": rootToken, "childToken": childToken,
+		}),
+		createTestERC20Log(t, contractsapi.RootERC20Predicate.Abi.Events["ERC20Deposit"], "depositor", 100),
+		createTestERC20Log(t, contractsapi.RootERC20Predicate.Abi.Events["ERC20Deposit"], "depositor", 50),
+		createTestERC20Log(t, contractsapi.RootERC20Predicate.Abi.Events["ERC20Withdraw"], "withdrawer", 10),
+		// unrelated logs are ignored
+		{Topics: []ethgo.Hash{ethgo.HexToHash("0x1")}},
+		{},
+	}
+
+	childLogs := []*ethgo.Log{
+		createTestERC20Log(t, contractsapi.ChildERC20Predicate.Abi.Events["L2ERC20Deposit"], "sender", 100),
+		createTestERC20Log(t, contractsapi.ChildERC20Predicate.Abi.Events["L2ERC20Withdraw"], "sender", 30),
+	}
+
+	for _, log := range rootLogs {
+		require.NoError(t, ledger.processRootLog(erc20Token, log))
+	}
+
+	for _, log := range childLogs {
+		require.NoError(t, ledger.processChildLog(erc20Token, log))
+	}
+
+	require.Len(t, ledger.tokens, 1)
+
+	token := ledger.tokens[rootToken]
+	token.RootLocked = big.NewInt(140)
+	token.ChildSupply = big.NewInt(70)
+
+	audits := ledger.audits()
+	require.Len(t, audits, 1)
+	require.Equal(t, erc20Token, audits[0].TokenType)
+	require.Equal(t, childToken, audits[0].ChildToken)
+	require.Equal(t, transferTotals{Count: 2, Amount: big.NewInt(150)}, audits[0].RootDeposits)
+	require.Equal(t, transferTotals{Count: 1, Amount: big.NewInt(10)}, audits[0].RootWithdrawals)
+	require.Equal(t, transferTotals{Count: 1, Amount: big.NewInt(50)}, audits[0].PendingDeposits)
+	require.Equal(t, transferTotals{Count: 0, Amount: big.NewInt(20)}, audits[0].PendingWithdrawals)
+	require.Empty(t, audits[0].Discrepancies)
+
+	// tokens held by the predicate and the child supply do not match the transfers
+	token.RootLocked = big.NewInt(145)
+	token.ChildSupply = big.NewInt(75)
+
+	audits = ledger.audits()
+	require.Equal(t, []string{
+		"root predicate holds 145, while net root chain deposits are 140",
+		"child token supply is 75, while net child chain deposits are 70",
+	}, audits[0].Discrepancies)
+}
+
+func TestTokenLedger_ERC721AndERC1155(t *testing.T) {
+	t.Parallel()
+
+	ledger := newTokenLedger(nil)
+
+	require.NoError(t, ledger.processRootLog(erc721Token, createTestLog(t,
+		contractsapi.RootERC721Predicate.Abi.Events["ERC721DepositBatch"], map[string]interface{}{
+			"rootToken":  otherToken,
+			"childToken": childToken,
+			"depositor":  sender,
+			"receivers":  []types.Address{receiver, receiver},
+			"tokenIds":   []*big.Int{big.NewInt(1), big.NewInt(2)},
+		})))
+	require.NoError(t, ledger.processRootLog(erc1155Token, createTestLog(t,
+		contractsapi.RootERC1155Predicate.Abi.Events["ERC1155DepositBatch"], map[string]interface{}{
+			"rootToken":  rootToken,
+			"childToken": childToken,
+			"depositor":  sender,
+			"receivers":  []types.Address{receiver, receiver},
+			"tokenIds":   []*big.Int{big.NewInt(7), big.NewInt(3)},
+			"amounts":    []*big.Int{big.NewInt(10), big.NewInt(5)},
+		})))
+	require.NoError(t, ledger.processChildLog(erc1155Token, createTestLog(t,
+		contractsapi.ChildERC1155Predicate.Abi.Events["L2ERC1155Deposit"], map[string]interface{}{
+			"rootToken":  rootToken,
+			"childToken": childToken,
+			"sender":     sender,
+			"receiver":   receiver,
+			"tokenId":    big.NewInt(7),
+			"amount":     big.NewInt(10),
+		})))
+
+	audits := ledger.audits()
+	require.Len(t, audits, 2)
+
+	require.Equal(t, erc1155Token, audits[0].TokenType)
+	require.Equal(t, transferTotals{Count: 2, Amount: big.NewInt(15)}, audits[0].RootDeposits)
+	require.Equal(t, transferTotals{Count: 1, Amount: big.NewInt(5)}, audits[0].PendingDeposits)
+	require.Equal(t, []*big.Int{big.NewInt(3), big.NewInt(7)}, audits[0].sortedTokenIDs())
+
+	require.Equal(t, erc721Token, audits[1].TokenType)
+	require.Equal(t, transferTotals{Count: 2, Amount: big.NewInt(2)}, audits[1].RootDeposits)
+	require.Empty(t, audits[1].Discrepancies)
+	require.Empty(t, audits[1].sortedTokenIDs())
+}
+
+func TestTokenLedger_RootTokenFilter(t *testing.T) {
+	t.Parallel()
+
+	ledger := newTokenLedger(&rootToken)
+
+	require.NoError(t, ledger.processRootLog(erc20Token, createTestLog(t,
+		contractsapi.RootERC20Predicate.Abi.Events["TokenMapped"], map[string]interface{}{
+			"rootToken": otherToken, "childToken": childToken,
+		})))
+	require.NoError(t, ledger.processChildLog(erc20Token, createTestLog(t,
+		contractsapi.ChildERC20Predicate.Abi.Events["L2TokenMapped"], map[string]interface{}{
+			"rootToken": rootToken, "childToken": childToken,
+		})))
+
+	require.Len(t, ledger.tokens, 1)
+	require.Contains(t, ledger.tokens, rootToken)
+}
+
+func TestTokenAudit_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	token := newTokenLedger(nil).getToken(erc20Token, rootToken, contracts.NativeERC20TokenContract)
+	token.RootDeposits.add(big.NewInt(5))
+	token.ChildDeposits.add(big.NewInt(8))
+	token.RootWithdrawals.add(big.NewInt(2))
+
+	require.True(t, token.isNativeToken())
+
+	token.reconcile()
+	require.Equal(t, []string{
+		"child chain deposits exceed root chain deposits by 3",
+		"root chain withdrawals exceed child chain withdrawals by 2",
+	}, token.Discrepancies)
+}
+
+func createTestERC20Log(t *testing.T, event *abi.Event, senderField string, amount int64) *ethgo.Log {
+	t.Helper()
+
+	return createTestLog(t, event, map[string]interface{}{
+		"rootToken":  rootToken,
+		"childToken": childToken,
+		senderField:  sender,
+		"receiver":   receiver,
+		"amount":     big.NewInt(amount),
+	})
+}
+
+// createTestLog creates the log of given event, encoding the indexed values as topics
+func createTestLog(t *testing.T, event *abi.Event, values map[string]interface{}) *ethgo.Log {
+	t.Helper()
+
+	topics := []ethgo.Hash{event.ID()}
+	nonIndexed := make([]*abi.TupleElem, 0, len(event.Inputs.TupleElems()))
+
+	for _, elem := range event.Inputs.TupleElems() {
+		if !elem.Indexed {
+			nonIndexed = append(nonIndexed, elem)
+
+			continue
+		}
+
+		topic, err := abi.EncodeTopic(elem.Elem, values[elem.Name])
+		require.NoError(t, err)
+
+		topics = append(topics, topic)
+	}
+
+	data, err := abi.NewTupleType(nonIndexed).Encode(values)
+	require.NoError(t, err)
+
+	return &ethgo.Log{Topics: topics, Data: data}
+}
@@ -0,0 +1,75 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	rootJSONRPCFlag    = "root-json-rpc"
+	childJSONRPCFlag   = "child-json-rpc"
+	rootFromBlockFlag  = "root-from-block"
+	childFromBlockFlag = "child-from-block"
+	blockRangeFlag     = "block-range"
+
+	defaultBlockRange = uint64(1000)
+)
+
+var (
+	errInvalidBlockRange = fmt.Errorf("%s flag must be greater than zero", blockRangeFlag)
+	errInvalidRootToken  = errors.New("invalid root token address provided")
+)
+
+type auditParams struct {
+	genesisPath      string
+	rootJSONRPCAddr  string
+	childJSONRPCAddr string
+	rootTokenRaw     string
+	rootFromBlock    uint64
+	childFromBlock   uint64
+	blockRange       uint64
+
+	rootFromBlockSet bool
+}
+
+func (ap *auditParams) validateFlags() error {
+	if _, err := os.Stat(ap.genesisPath); err != nil {
+		return fmt.Errorf("provided genesis path '%s' is invalid. Error: %w ", ap.genesisPath, err)
+	}
+
+	if _, err := helper.ParseJSONRPCAddress(ap.rootJSONRPCAddr); err != nil {
+		return fmt.Errorf("failed to parse root chain JSON RPC address: %w", err)
+	}
+
+	if _, err := helper.ParseJSONRPCAddress(ap.childJSONRPCAddr); err != nil {
+		return fmt.Errorf("failed to parse child chain JSON RPC address: %w", err)
+	}
+
+	if ap.blockRange == 0 {
+		return errInvalidBlockRange
+	}
+
+	if _, err := ap.rootToken(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// rootToken returns the audited root token, or nil if all the mapped tokens are audited
+func (ap *auditParams) rootToken() (*types.Address, error) {
+	if ap.rootTokenRaw == "" {
+		return nil, nil
+	}
+
+	rootToken, err := types.IsValidAddress(ap.rootTokenRaw, false)
+	if err != nil {
+		return nil, errInvalidRootToken
+	}
+
+	return &rootToken, nil
+}
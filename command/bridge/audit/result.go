@@ -0,0 +1,85 @@
+package audit
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type auditResult struct {
+	RootToBlock  uint64        `json:"rootToBlock"`
+	ChildToBlock uint64        `json:"childToBlock"`
+	Tokens       []*tokenAudit `json:"tokens"`
+}
+
+func (r *auditResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[BRIDGE AUDIT]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Root Chain Block|%d", r.RootToBlock),
+		fmt.Sprintf("Child Chain Block|%d", r.ChildToBlock),
+		fmt.Sprintf("Audited Tokens|%d", len(r.Tokens)),
+		fmt.Sprintf("Tokens With Discrepancies|%d", r.discrepanciesCount()),
+	}))
+	buffer.WriteString("\n")
+
+	for _, token := range r.Tokens {
+		buffer.WriteString(fmt.Sprintf("\n[%s TOKEN %s]\n", token.TokenType, token.RootToken))
+
+		vals := []string{
+			fmt.Sprintf("Child Token|%s", token.ChildToken),
+			fmt.Sprintf("Root Deposits|%s", formatTotals(token.RootDeposits)),
+			fmt.Sprintf("Child Deposits|%s", formatTotals(token.ChildDeposits)),
+			fmt.Sprintf("Child Withdrawals|%s", formatTotals(token.ChildWithdrawals)),
+			fmt.Sprintf("Root Withdrawals|%s", formatTotals(token.RootWithdrawals)),
+			fmt.Sprintf("Pending Deposits|%s", formatTotals(token.PendingDeposits)),
+			fmt.Sprintf("Pending Withdrawals|%s", formatTotals(token.PendingWithdrawals)),
+			fmt.Sprintf("Root Predicate Locked|%s", formatAmount(token.RootLocked)),
+		}
+
+		if token.ChildSupply != nil {
+			vals = append(vals, fmt.Sprintf("Child Token Supply|%s", token.ChildSupply))
+		}
+
+		if len(token.Discrepancies) == 0 {
+			vals = append(vals, "Discrepancies|none")
+		}
+
+		for _, discrepancy := range token.Discrepancies {
+			vals = append(vals, fmt.Sprintf("Discrepancy|%s", discrepancy))
+		}
+
+		buffer.WriteString(helper.FormatKV(vals))
+		buffer.WriteString("\n")
+	}
+
+	return buffer.String()
+}
+
+// discrepanciesCount returns the number of audited tokens which have at least one discrepancy
+func (r *auditResult) discrepanciesCount() int {
+	count := 0
+
+	for _, token := range r.Tokens {
+		if len(token.Discrepancies) > 0 {
+			count++
+		}
+	}
+
+	return count
+}
+
+func formatTotals(totals transferTotals) string {
+	return fmt.Sprintf("%s (%d transfers)", totals.Amount, totals.Count)
+}
+
+func formatAmount(amount *big.Int) string {
+	if amount == nil {
+		return "-"
+	}
+
+	return amount.String()
+}
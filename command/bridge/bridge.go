@@ -3,6 +3,11 @@ package bridge
 import (
 	"github.com/spf13/cobra"
 
+	apexMetadata "github.com/0xPolygon/polygon-edge/command/bridge/apex/metadata"
+	apexSend "github.com/0xPolygon/polygon-edge/command/bridge/apex/send"
+	apexStatus "github.com/0xPolygon/polygon-edge/command/bridge/apex/status"
+	"github.com/0xPolygon/polygon-edge/command/bridge/audit"
+	"github.com/0xPolygon/polygon-edge/command/bridge/deadletter"
 	deploy "github.com/0xPolygon/polygon-edge/command/bridge/deploy"
 	depositERC1155 "github.com/0xPolygon/polygon-edge/command/bridge/deposit/erc1155"
 	depositERC20 "github.com/0xPolygon/polygon-edge/command/bridge/deposit/erc20"
@@ -12,6 +17,8 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/bridge/fund"
 	"github.com/0xPolygon/polygon-edge/command/bridge/premine"
 	"github.com/0xPolygon/polygon-edge/command/bridge/server"
+	"github.com/0xPolygon/polygon-edge/command/bridge/status"
+	"github.com/0xPolygon/polygon-edge/command/bridge/tokenmappings"
 	withdrawERC1155 "github.com/0xPolygon/polygon-edge/command/bridge/withdraw/erc1155"
 	withdrawERC20 "github.com/0xPolygon/polygon-edge/command/bridge/withdraw/erc20"
 	withdrawERC721 "github.com/0xPolygon/polygon-edge/command/bridge/withdraw/erc721"
@@ -55,5 +62,19 @@ func registerSubcommands(baseCmd *cobra.Command) {
 		premine.GetCommand(),
 		// bridge finalize
 		finalize.GetCommand(),
+		// bridge status
+		status.GetCommand(),
+		// bridge dead-letter
+		deadletter.GetCommand(),
+		// bridge audit
+		audit.GetCommand(),
+		// bridge token-mappings
+		tokenmappings.GetCommand(),
+		// bridge apex-send
+		apexSend.GetCommand(),
+		// bridge apex-metadata
+		apexMetadata.GetCommand(),
+		// bridge apex-status
+		apexStatus.GetCommand(),
 	)
 }
@@ -0,0 +1,508 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+
+	cmdHelper "github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	helperCommon "github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
+	"github.com/0xPolygon/polygon-edge/txrelayer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	TransfersFileFlag = "transfers-file"
+	ResultFileFlag    = "result-file"
+	ConcurrencyFlag   = "concurrency"
+	DryRunFlag        = "dry-run"
+
+	defaultConcurrency = 4
+	resultFileSuffix   = "-result"
+
+	receiverColumn     = "receiver"
+	amountColumn       = "amount"
+	tokenIDColumn      = "tokenId"
+	statusColumn       = "status"
+	txHashColumn       = "txHash"
+	blockNumberColumn  = "blockNumber"
+	gasEstimateColumn  = "gasEstimate"
+	exitEventIDsColumn = "exitEventIds"
+	errorColumn        = "error"
+)
+
+var (
+	errNoTransfers         = errors.New("transfers file contains no pending transfers")
+	errInvalidConcurrency  = fmt.Errorf("%s flag must be greater than zero", ConcurrencyFlag)
+	errReceiversAndFile    = fmt.Errorf("%s flag can not be combined with %s flag", ReceiversFlag, TransfersFileFlag)
+	errMissingReceiverCell = errors.New("transfers file has no receiver column")
+)
+
+// TransferStatus is the status of a single transfer sent from the transfers file
+type TransferStatus string
+
+const (
+	// TransferSucceeded denotes the transfer whose transaction got executed
+	TransferSucceeded TransferStatus = "success"
+	// TransferFailed denotes the transfer whose transaction was not sent, or got reverted
+	TransferFailed TransferStatus = "failed"
+	// TransferEstimated denotes the transfer whose gas got estimated in the dry-run mode
+	TransferEstimated TransferStatus = "estimated"
+)
+
+// TransferRow is a single transfer from the transfers file
+type TransferRow struct {
+	Receiver string `json:"receiver"`
+	Amount   string `json:"amount,omitempty"`
+	TokenID  string `json:"tokenId,omitempty"`
+}
+
+// TransferResult is the outcome of a single transfer sent from the transfers file.
+// Result files can be used as transfers files, in which case the succeeded transfers are skipped
+type TransferResult struct {
+	TransferRow
+	Status       TransferStatus `json:"status"`
+	TxHash       string         `json:"txHash,omitempty"`
+	BlockNumber  uint64         `json:"blockNumber,omitempty"`
+	GasEstimate  uint64         `json:"gasEstimate,omitempty"`
+	ExitEventIDs []*big.Int     `json:"exitEventIds,omitempty"`
+	Error        string         `json:"error,omitempty"`
+}
+
+// BatchParams holds the parameters of the transfers which are read from the transfers file
+type BatchParams struct {
+	TransfersFile string
+	ResultFile    string
+	Concurrency   int
+	DryRun        bool
+
+	// fileResults are all the rows of the transfers file, including the already succeeded transfers
+	// (if the transfers file is the result file of the previous run), while transfers are the pending ones
+	fileResults []*TransferResult
+	transfers   []*TransferRow
+}
+
+// IsBatch indicates whether the transfers are read from the transfers file
+func (bp *BatchParams) IsBatch() bool {
+	return bp.TransfersFile != ""
+}
+
+// resultFilePath returns the path of the result file, which by default
+// is placed next to the transfers file, with the "-result" suffix
+func (bp *BatchParams) resultFilePath() string {
+	if bp.ResultFile != "" {
+		return bp.ResultFile
+	}
+
+	ext := filepath.Ext(bp.TransfersFile)
+	name := strings.TrimSuffix(bp.TransfersFile, ext)
+
+	if strings.HasSuffix(name, resultFileSuffix) {
+		// transfers file is the result file of the previous run, so it is updated in place
+		return bp.TransfersFile
+	}
+
+	return name + resultFileSuffix + ext
+}
+
+// loadTransfers reads the pending transfers from the transfers file and returns their receivers,
+// amounts and token ids, validating that the required columns are provided for each of the transfers
+func (bp *BatchParams) loadTransfers(requireAmounts, requireTokenIDs bool) (
+	receivers []string, amounts []string, tokenIDs []string, err error) {
+	if bp.Concurrency <= 0 {
+		return nil, nil, nil, errInvalidConcurrency
+	}
+
+	bp.fileResults, err = loadTransferResults(bp.TransfersFile)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	bp.transfers = pendingTransfers(bp.fileResults)
+
+	if len(bp.transfers) == 0 {
+		return nil, nil, nil, errNoTransfers
+	}
+
+	for i, transfer := range bp.transfers {
+		if _, err := types.IsValidAddress(transfer.Receiver, false); err != nil {
+			return nil, nil, nil, fmt.Errorf("transfer #%d has invalid receiver %q", i+1, transfer.Receiver)
+		}
+
+		if requireAmounts && transfer.Amount == "" {
+			return nil, nil, nil, fmt.Errorf("transfer #%d has no amount", i+1)
+		}
+
+		if requireTokenIDs && transfer.TokenID == "" {
+			return nil, nil, nil, fmt.Errorf("transfer #%d has no token id", i+1)
+		}
+
+		receivers = append(receivers, transfer.Receiver)
+		amounts = append(amounts, transfer.Amount)
+		tokenIDs = append(tokenIDs, transfer.TokenID)
+	}
+
+	return receivers, amounts, tokenIDs, nil
+}
+
+// LoadTransfersFile reads the transfers from given CSV or JSON file (determined by the file extension).
+// CSV files must have a header row naming the receiver, amount and tokenId columns.
+// Transfers which already succeeded (the result files have the status column) are skipped
+func LoadTransfersFile(path string) ([]*TransferRow, error) {
+	results, err := loadTransferResults(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return pendingTransfers(results), nil
+}
+
+// loadTransferResults reads all the rows of given transfers file, along with their results
+// (in case of the result file)
+func loadTransferResults(path string) ([]*TransferResult, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read transfers file: %w", err)
+	}
+
+	var results []*TransferResult
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		if err := json.Unmarshal(content, &results); err != nil {
+			return nil, fmt.Errorf("failed to decode transfers file: %w", err)
+		}
+	} else {
+		if results, err = readTransfersCSV(bytes.NewReader(content)); err != nil {
+			return nil, fmt.Errorf("failed to decode transfers file: %w", err)
+		}
+	}
+
+	return results, nil
+}
+
+// pendingTransfers returns the transfers which have not succeeded yet
+func pendingTransfers(results []*TransferResult) []*TransferRow {
+	transfers := make([]*TransferRow, 0, len(results))
+
+	for _, result := range results {
+		if result.Status == TransferSucceeded {
+			continue
+		}
+
+		transfers = append(transfers, &TransferRow{
+			Receiver: strings.TrimSpace(result.Receiver),
+			Amount:   strings.TrimSpace(result.Amount),
+			TokenID:  strings.TrimSpace(result.TokenID),
+		})
+	}
+
+	return transfers
+}
+
+func readTransfersCSV(reader io.Reader) ([]*TransferResult, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	columns := make(map[string]int, len(records[0]))
+	for i, column := range records[0] {
+		columns[strings.TrimSpace(column)] = i
+	}
+
+	if _, exists := columns[receiverColumn]; !exists {
+		return nil, errMissingReceiverCell
+	}
+
+	cell := func(record []string, column string) string {
+		if i, exists := columns[column]; exists && i < len(record) {
+			return record[i]
+		}
+
+		return ""
+	}
+
+	uintCell := func(record []string, column string) (uint64, error) {
+		value := strings.TrimSpace(cell(record, column))
+		if value == "" {
+			return 0, nil
+		}
+
+		return strconv.ParseUint(value, 10, 64)
+	}
+
+	results := make([]*TransferResult, 0, len(records)-1)
+
+	for i, record := range records[1:] {
+		result := &TransferResult{
+			TransferRow: TransferRow{
+				Receiver: cell(record, receiverColumn),
+				Amount:   cell(record, amountColumn),
+				TokenID:  cell(record, tokenIDColumn),
+			},
+			Status: TransferStatus(cell(record, statusColumn)),
+			TxHash: cell(record, txHashColumn),
+			Error:  cell(record, errorColumn),
+		}
+
+		if result.BlockNumber, err = uintCell(record, blockNumberColumn); err != nil {
+			return nil, fmt.Errorf("transfer #%d has invalid %s: %w", i+1, blockNumberColumn, err)
+		}
+
+		if result.GasEstimate, err = uintCell(record, gasEstimateColumn); err != nil {
+			return nil, fmt.Errorf("transfer #%d has invalid %s: %w", i+1, gasEstimateColumn, err)
+		}
+
+		for _, id := range strings.Fields(cell(record, exitEventIDsColumn)) {
+			exitEventID, ok := new(big.Int).SetString(id, 10)
+			if !ok {
+				return nil, fmt.Errorf("transfer #%d has invalid %s %q", i+1, exitEventIDsColumn, id)
+			}
+
+			result.ExitEventIDs = append(result.ExitEventIDs, exitEventID)
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// WriteTransferResults writes the transfer results to given CSV or JSON file (determined by the file extension)
+func WriteTransferResults(path string, results []*TransferResult) error {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		content, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		return helperCommon.SaveFileSafe(path, content, 0660)
+	}
+
+	var buf bytes.Buffer
+
+	writer := csv.NewWriter(&buf)
+	records := [][]string{{receiverColumn, amountColumn, tokenIDColumn, statusColumn,
+		txHashColumn, blockNumberColumn, gasEstimateColumn, exitEventIDsColumn, errorColumn}}
+
+	for _, result := range results {
+		exitEventIDs := make([]string, len(result.ExitEventIDs))
+		for i, id := range result.ExitEventIDs {
+			exitEventIDs[i] = id.String()
+		}
+
+		records = append(records, []string{
+			result.Receiver,
+			result.Amount,
+			result.TokenID,
+			string(result.Status),
+			result.TxHash,
+			strconv.FormatUint(result.BlockNumber, 10),
+			strconv.FormatUint(result.GasEstimate, 10),
+			strings.Join(exitEventIDs, " "),
+			result.Error,
+		})
+	}
+
+	if err := writer.WriteAll(records); err != nil {
+		return err
+	}
+
+	return helperCommon.SaveFileSafe(path, buf.Bytes(), 0660)
+}
+
+// SendBatchTransfers sends a transaction for each of the transfers read from the transfers file,
+// with bounded concurrency, and writes the result file. Failed transfers do not stop the batch.
+// In the dry-run mode, transactions are not sent and only their gas is estimated
+func (bp *BatchParams) SendBatchTransfers(ctx context.Context, txRelayer txrelayer.TxRelayer, sender crypto.Key,
+	title string, extractExitEvents bool, createTxn func(i int) (*types.Transaction, error)) (*BatchTxResult, error) {
+	results := make([]*TransferResult, len(bp.transfers))
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(bp.Concurrency)
+
+	for i, transfer := range bp.transfers {
+		i := i
+		results[i] = &TransferResult{TransferRow: *transfer}
+
+		g.Go(func() error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+
+			if err := bp.sendTransfer(txRelayer, sender, results[i], extractExitEvents,
+				func() (*types.Transaction, error) { return createTxn(i) }); err != nil {
+				results[i].Status = TransferFailed
+				results[i].Error = err.Error()
+			}
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	fileResults := bp.mergeResults(results)
+
+	resultFile := bp.resultFilePath()
+	if err := WriteTransferResults(resultFile, fileResults); err != nil {
+		return nil, fmt.Errorf("failed to write result file: %w", err)
+	}
+
+	return &BatchTxResult{
+		Title:      title,
+		Sender:     sender.Address().String(),
+		DryRun:     bp.DryRun,
+		ResultFile: resultFile,
+		Skipped:    len(fileResults) - len(results),
+		Transfers:  results,
+	}, nil
+}
+
+// mergeResults places the results of the sent transfers in between the already succeeded transfers
+// of the transfers file, which are carried through unchanged, so that the result file covers all of them
+func (bp *BatchParams) mergeResults(results []*TransferResult) []*TransferResult {
+	if len(bp.fileResults) == 0 {
+		return results
+	}
+
+	merged := make([]*TransferResult, 0, len(bp.fileResults))
+	next := 0
+
+	for _, fileResult := range bp.fileResults {
+		if fileResult.Status == TransferSucceeded {
+			merged = append(merged, fileResult)
+		} else if next < len(results) {
+			merged = append(merged, results[next])
+			next++
+		}
+	}
+
+	return merged
+}
+
+// sendTransfer sends (or estimates gas of) a single transfer and populates its result
+func (bp *BatchParams) sendTransfer(txRelayer txrelayer.TxRelayer, sender crypto.Key, result *TransferResult,
+	extractExitEvents bool, createTxn func() (*types.Transaction, error)) error {
+	txn, err := createTxn()
+	if err != nil {
+		return fmt.Errorf("failed to create transaction: %w", err)
+	}
+
+	if bp.DryRun {
+		result.GasEstimate, err = txRelayer.Client().EstimateGas(&jsonrpc.CallMsg{
+			From:  sender.Address(),
+			To:    txn.To(),
+			Data:  txn.Input(),
+			Value: txn.Value(),
+		})
+		if err != nil {
+			return fmt.Errorf("gas estimation failed: %w", err)
+		}
+
+		result.Status = TransferEstimated
+
+		return nil
+	}
+
+	receipt, err := txRelayer.SendTransaction(txn, sender)
+	if err != nil {
+		return err
+	}
+
+	result.TxHash = types.Hash(receipt.TransactionHash).String()
+	result.BlockNumber = receipt.BlockNumber
+
+	if receipt.Status == uint64(types.ReceiptFailed) {
+		return errors.New("transaction reverted")
+	}
+
+	if extractExitEvents {
+		if result.ExitEventIDs, err = ExtractExitEventIDs(receipt); err != nil {
+			return fmt.Errorf("failed to extract exit event: %w", err)
+		}
+	}
+
+	result.Status = TransferSucceeded
+
+	return nil
+}
+
+// BatchTxResult is the outcome of the transfers sent from the transfers file
+type BatchTxResult struct {
+	Title      string            `json:"title"`
+	Sender     string            `json:"sender"`
+	DryRun     bool              `json:"dryRun"`
+	Notes      []string          `json:"notes,omitempty"`
+	ResultFile string            `json:"resultFile"`
+	Skipped    int               `json:"skipped,omitempty"`
+	Transfers  []*TransferResult `json:"transfers"`
+}
+
+func (r *BatchTxResult) GetOutput() string {
+	var (
+		buffer    bytes.Buffer
+		succeeded int
+	)
+
+	for _, transfer := range r.Transfers {
+		if transfer.Status != TransferFailed {
+			succeeded++
+		}
+	}
+
+	vals := []string{
+		fmt.Sprintf("Sender|%s", r.Sender),
+		fmt.Sprintf("Dry Run|%t", r.DryRun),
+		fmt.Sprintf("Succeeded|%d", succeeded),
+		fmt.Sprintf("Failed|%d", len(r.Transfers)-succeeded),
+		fmt.Sprintf("Skipped|%d", r.Skipped),
+		fmt.Sprintf("Result File|%s", r.ResultFile),
+	}
+
+	for _, note := range r.Notes {
+		vals = append(vals, fmt.Sprintf("Note|%s", note))
+	}
+
+	_, _ = buffer.WriteString(fmt.Sprintf("\n[%s]\n", r.Title))
+	_, _ = buffer.WriteString(cmdHelper.FormatKV(vals))
+	_, _ = buffer.WriteString("\n\n")
+
+	rows := make([]string, 0, len(r.Transfers)+1)
+	rows = append(rows, "Receiver|Amount|Token ID|Status|Tx Hash|Gas Estimate|Error")
+
+	for _, transfer := range r.Transfers {
+		rows = append(rows, fmt.Sprintf("%s|%s|%s|%s|%s|%d|%s", transfer.Receiver, transfer.Amount,
+			transfer.TokenID, transfer.Status, transfer.TxHash, transfer.GasEstimate, transfer.Error))
+	}
+
+	_, _ = buffer.WriteString(cmdHelper.FormatList(rows))
+	_, _ = buffer.WriteString("\n")
+
+	return buffer.String()
+}
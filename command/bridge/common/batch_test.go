@@ -0,0 +1,306 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Ethernal-Tech/ethgo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
+	"github.com/0xPolygon/polygon-edge/txrelayer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	testReceiver1 = "0x0000000000000000000000000000000000000001"
+	testReceiver2 = "0x0000000000000000000000000000000000000002"
+)
+
+func TestLoadTransfersFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	cases := []struct {
+		name      string
+		file      string
+		content   string
+		transfers []*TransferRow
+		err       string
+	}{
+		{
+			name: "csv",
+			file: "transfers.csv",
+			content: "receiver,amount,tokenId\n" +
+				testReceiver1 + ",100,\n" +
+				testReceiver2 + ", 0x10 ,7\n",
+			transfers: []*TransferRow{
+				{Receiver: testReceiver1, Amount: "100"},
+				{Receiver: testReceiver2, Amount: "0x10", TokenID: "7"},
+			},
+		},
+		{
+			name: "csv result file skips succeeded transfers",
+			file: "result.csv",
+			content: "receiver,amount,tokenId,status,txHash\n" +
+				testReceiver1 + ",100,,success,0x1\n" +
+				testReceiver2 + ",200,,failed,\n",
+			transfers: []*TransferRow{{Receiver: testReceiver2, Amount: "200"}},
+		},
+		{
+			name:    "csv without receiver column",
+			file:    "invalid.csv",
+			content: "amount\n100\n",
+			err:     errMissingReceiverCell.Error(),
+		},
+		{
+			name: "json",
+			file: "transfers.json",
+			content: `[{"receiver":"` + testReceiver1 + `","tokenId":"1"},` +
+				`{"receiver":"` + testReceiver2 + `","tokenId":"2","status":"success"}]`,
+			transfers: []*TransferRow{{Receiver: testReceiver1, TokenID: "1"}},
+		},
+		{
+			name:    "invalid json",
+			file:    "invalid.json",
+			content: `{`,
+			err:     "failed to decode transfers file",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(dir, c.file)
+			require.NoError(t, os.WriteFile(path, []byte(c.content), 0600))
+
+			transfers, err := LoadTransfersFile(path)
+			if c.err != "" {
+				require.ErrorContains(t, err, c.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, c.transfers, transfers)
+		})
+	}
+}
+
+func TestWriteTransferResults_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	results := []*TransferResult{
+		{
+			TransferRow:  TransferRow{Receiver: testReceiver1, Amount: "100"},
+			Status:       TransferSucceeded,
+			TxHash:       types.StringToHash("0x1").String(),
+			BlockNumber:  5,
+			ExitEventIDs: []*big.Int{big.NewInt(1), big.NewInt(2)},
+		},
+		{
+			TransferRow: TransferRow{Receiver: testReceiver2, Amount: "200"},
+			Status:      TransferFailed,
+			Error:       "transaction reverted",
+		},
+	}
+
+	for _, file := range []string{"result.csv", "result.json"} {
+		path := filepath.Join(t.TempDir(), file)
+
+		require.NoError(t, WriteTransferResults(path, results))
+
+		loaded, err := loadTransferResults(path)
+		require.NoError(t, err)
+		require.Equal(t, results, loaded)
+
+		// only the failed transfer is retried when the result file is used as the transfers file
+		transfers, err := LoadTransfersFile(path)
+		require.NoError(t, err)
+		require.Equal(t, []*TransferRow{{Receiver: testReceiver2, Amount: "200"}}, transfers)
+	}
+}
+
+func TestBatchParams_LoadTransfers(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "transfers.csv")
+	require.NoError(t, os.WriteFile(path, []byte("receiver,amount,tokenId\n"+testReceiver1+",100,\n"), 0600))
+
+	bp := &ERC1155BridgeParams{BridgeParams: &BridgeParams{
+		JSONRPCAddr: txrelayer.DefaultRPCAddress,
+		BatchParams: BatchParams{TransfersFile: path, Concurrency: 1},
+	}}
+	require.ErrorContains(t, bp.Validate(), "transfer #1 has no token id")
+
+	erc20Params := &ERC20BridgeParams{BridgeParams: &BridgeParams{
+		JSONRPCAddr: txrelayer.DefaultRPCAddress,
+		BatchParams: BatchParams{TransfersFile: path, Concurrency: 1},
+	}}
+	require.NoError(t, erc20Params.Validate())
+	require.Equal(t, []string{testReceiver1}, erc20Params.Receivers)
+	require.Equal(t, []string{"100"}, erc20Params.Amounts)
+
+	// receivers are populated from the transfers file, hence they are reset before each validation
+	erc20Params.Receivers, erc20Params.Amounts = nil, nil
+	erc20Params.Concurrency = 0
+	require.ErrorIs(t, erc20Params.Validate(), errInvalidConcurrency)
+
+	erc20Params.Concurrency = 1
+	erc20Params.Receivers, erc20Params.Amounts = []string{testReceiver2}, nil
+	require.ErrorIs(t, erc20Params.Validate(), errReceiversAndFile)
+}
+
+func TestBatchParams_SendBatchTransfers(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	resultFile := filepath.Join(t.TempDir(), "result.json")
+	bp := &BatchParams{
+		ResultFile:  resultFile,
+		Concurrency: 2,
+		transfers: []*TransferRow{
+			{Receiver: testReceiver1, Amount: "1"},
+			{Receiver: testReceiver2, Amount: "2"},
+			{Receiver: testReceiver1, Amount: "3"},
+		},
+	}
+
+	receiver2 := types.StringToAddress(testReceiver2)
+	txRelayer := &batchTxRelayer{failTo: receiver2}
+
+	res, err := bp.SendBatchTransfers(context.Background(), txRelayer, key, "TEST", false,
+		func(i int) (*types.Transaction, error) {
+			if i == 2 {
+				return nil, errors.New("invalid amount")
+			}
+
+			to := types.StringToAddress(bp.transfers[i].Receiver)
+
+			return types.NewTx(types.NewLegacyTx(types.WithTo(&to))), nil
+		})
+	require.NoError(t, err)
+
+	require.Equal(t, resultFile, res.ResultFile)
+	require.Len(t, res.Transfers, 3)
+	require.Equal(t, TransferSucceeded, res.Transfers[0].Status)
+	require.Equal(t, types.StringToHash("0x1").String(), res.Transfers[0].TxHash)
+	require.Equal(t, TransferFailed, res.Transfers[1].Status)
+	require.Equal(t, "transaction reverted", res.Transfers[1].Error)
+	require.Equal(t, TransferFailed, res.Transfers[2].Status)
+	require.Equal(t, "failed to create transaction: invalid amount", res.Transfers[2].Error)
+
+	transfers, err := LoadTransfersFile(resultFile)
+	require.NoError(t, err)
+	require.Equal(t, []*TransferRow{
+		{Receiver: testReceiver2, Amount: "2"},
+		{Receiver: testReceiver1, Amount: "3"},
+	}, transfers)
+}
+
+func TestBatchParams_SendBatchTransfers_ResultFileAsTransfersFile(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	succeeded := &TransferResult{
+		TransferRow:  TransferRow{Receiver: testReceiver1, Amount: "1"},
+		Status:       TransferSucceeded,
+		TxHash:       types.StringToHash("0x5").String(),
+		BlockNumber:  7,
+		ExitEventIDs: []*big.Int{big.NewInt(3)},
+	}
+
+	for _, file := range []string{"transfers-result.csv", "transfers-result.json"} {
+		path := filepath.Join(t.TempDir(), file)
+		require.NoError(t, WriteTransferResults(path, []*TransferResult{
+			succeeded,
+			{TransferRow: TransferRow{Receiver: testReceiver2, Amount: "2"}, Status: TransferFailed, Error: "failed"},
+		}))
+
+		bp := &BatchParams{TransfersFile: path, Concurrency: 1}
+		receivers, _, _, err := bp.loadTransfers(true, false)
+		require.NoError(t, err)
+		require.Equal(t, []string{testReceiver2}, receivers)
+
+		res, err := bp.SendBatchTransfers(context.Background(), &batchTxRelayer{}, key, "TEST", false,
+			func(i int) (*types.Transaction, error) {
+				to := types.StringToAddress(receivers[i])
+
+				return types.NewTx(types.NewLegacyTx(types.WithTo(&to))), nil
+			})
+		require.NoError(t, err)
+
+		// result file is updated in place, keeping the succeeded transfer unchanged
+		require.Equal(t, path, res.ResultFile)
+		require.Equal(t, 1, res.Skipped)
+		require.Len(t, res.Transfers, 1)
+
+		results, err := loadTransferResults(path)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		require.Equal(t, succeeded, results[0])
+		require.Equal(t, testReceiver2, results[1].Receiver)
+		require.Equal(t, TransferSucceeded, results[1].Status)
+		require.Empty(t, results[1].Error)
+	}
+}
+
+func TestBatchParams_ResultFilePath(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "transfers-result.csv", (&BatchParams{TransfersFile: "transfers.csv"}).resultFilePath())
+	require.Equal(t, "transfers-result.csv", (&BatchParams{TransfersFile: "transfers-result.csv"}).resultFilePath())
+	require.Equal(t, "out.json",
+		(&BatchParams{TransfersFile: "transfers-result.csv", ResultFile: "out.json"}).resultFilePath())
+}
+
+var _ txrelayer.TxRelayer = (*batchTxRelayer)(nil)
+
+// batchTxRelayer is a test tx relayer whose transactions to the failTo address get reverted
+type batchTxRelayer struct {
+	failTo types.Address
+}
+
+func (b *batchTxRelayer) Call(types.Address, types.Address, []byte) (string, error) {
+	return "", nil
+}
+
+func (b *batchTxRelayer) SendTransaction(txn *types.Transaction, _ crypto.Key) (*ethgo.Receipt, error) {
+	status := uint64(types.ReceiptSuccess)
+	if *txn.To() == b.failTo {
+		status = uint64(types.ReceiptFailed)
+	}
+
+	return &ethgo.Receipt{Status: status, TransactionHash: ethgo.HexToHash("0x1"), BlockNumber: 1}, nil
+}
+
+func (b *batchTxRelayer) SendTransactionLocal(*types.Transaction) (*ethgo.Receipt, error) {
+	return nil, nil
+}
+
+func (b *batchTxRelayer) Client() *jsonrpc.EthClient {
+	return nil
+}
+
+func (b *batchTxRelayer) GetTxnHashes() []types.Hash {
+	return nil
+}
+
+func (b *batchTxRelayer) GetReplacedTxnHashes() map[types.Hash]types.Hash {
+	return nil
+}
+
+func (b *batchTxRelayer) Close() {}
@@ -55,6 +55,7 @@ type BridgeParams struct {
 	JSONRPCAddr        string
 	ChildChainMintable bool
 	TxTimeout          time.Duration
+	BatchParams
 }
 
 // RegisterCommonFlags registers common bridge flags to a given command
@@ -93,6 +94,40 @@ func (p *BridgeParams) RegisterCommonFlags(cmd *cobra.Command) {
 		txrelayer.DefaultTimeoutTransactions,
 		cmdHelper.TxTimeoutDesc,
 	)
+
+	cmd.Flags().StringVar(
+		&p.TransfersFile,
+		TransfersFileFlag,
+		"",
+		"CSV or JSON file with the transfers (receiver, amount and tokenId columns), "+
+			"each of which is sent in a separate transaction",
+	)
+
+	cmd.Flags().StringVar(
+		&p.ResultFile,
+		ResultFileFlag,
+		"",
+		"file to which the per transfer transaction hashes and statuses are written "+
+			"(defaults to the transfers file name with the -result suffix, or to the transfers file itself "+
+			"if it is a result file)",
+	)
+
+	cmd.Flags().IntVar(
+		&p.Concurrency,
+		ConcurrencyFlag,
+		defaultConcurrency,
+		"maximum number of the transfers from the transfers file sent concurrently",
+	)
+
+	cmd.Flags().BoolVar(
+		&p.DryRun,
+		DryRunFlag,
+		false,
+		"only estimate gas of the transfers from the transfers file and check allowances, without sending them",
+	)
+
+	cmd.MarkFlagsOneRequired(ReceiversFlag, TransfersFileFlag)
+	cmd.MarkFlagsMutuallyExclusive(ReceiversFlag, TransfersFileFlag)
 }
 
 func (p *BridgeParams) Validate() error {
@@ -105,6 +140,10 @@ func (p *BridgeParams) Validate() error {
 		return fmt.Errorf("failed to parse json rpc address. Error: %w", err)
 	}
 
+	if p.IsBatch() && len(p.Receivers) > 0 {
+		return errReceiversAndFile
+	}
+
 	return nil
 }
 
@@ -122,6 +161,13 @@ func (bp *ERC20BridgeParams) Validate() error {
 		return err
 	}
 
+	if bp.IsBatch() {
+		var err error
+		if bp.Receivers, bp.Amounts, _, err = bp.loadTransfers(true, false); err != nil {
+			return err
+		}
+	}
+
 	if len(bp.Receivers) != len(bp.Amounts) {
 		return errInconsistentAmounts
 	}
@@ -143,6 +189,13 @@ func (bp *ERC721BridgeParams) Validate() error {
 		return err
 	}
 
+	if bp.IsBatch() {
+		var err error
+		if bp.Receivers, _, bp.TokenIDs, err = bp.loadTransfers(false, true); err != nil {
+			return err
+		}
+	}
+
 	if len(bp.Receivers) != len(bp.TokenIDs) {
 		return errInconsistentTokenIds
 	}
@@ -165,6 +218,13 @@ func (bp *ERC1155BridgeParams) Validate() error {
 		return err
 	}
 
+	if bp.IsBatch() {
+		var err error
+		if bp.Receivers, bp.Amounts, bp.TokenIDs, err = bp.loadTransfers(true, true); err != nil {
+			return err
+		}
+	}
+
 	if len(bp.Receivers) != len(bp.Amounts) {
 		return errInconsistentAmounts
 	}
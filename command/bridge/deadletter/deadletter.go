@@ -0,0 +1,134 @@
+package deadletter
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// getDeadLetterEventsFn is JSON RPC endpoint which returns the dead-lettered relayer events
+	getDeadLetterEventsFn = "bridge_getDeadLetterEvents"
+	// retryDeadLetterEventsFn is JSON RPC endpoint which returns dead-lettered events to the relayer queue
+	retryDeadLetterEventsFn = "bridge_retryDeadLetterEvents"
+	// skipDeadLetterEventsFn is JSON RPC endpoint which removes dead-lettered events
+	skipDeadLetterEventsFn = "bridge_skipDeadLetterEvents"
+)
+
+var (
+	params deadLetterParams
+)
+
+// GetCommand returns the bridge dead-letter command
+func GetCommand() *cobra.Command {
+	deadLetterCmd := &cobra.Command{
+		Use: "dead-letter",
+		Short: "Inspects and manages bridge events which the relayer failed to execute " +
+			"on the destination chain after exhausting all the attempts. Only accepts subcommands.",
+	}
+
+	deadLetterCmd.PersistentFlags().StringVar(
+		&params.jsonRPCAddress,
+		jsonRPCFlag,
+		"http://127.0.0.1:9545",
+		"the JSON RPC child chain endpoint",
+	)
+
+	deadLetterCmd.PersistentFlags().StringVar(
+		&params.transferType,
+		transferTypeFlag,
+		string(types.StateSyncTransfer),
+		fmt.Sprintf("type of the relayed events (%s or %s)", types.StateSyncTransfer, types.ExitTransfer),
+	)
+
+	deadLetterCmd.AddCommand(
+		// bridge dead-letter list
+		&cobra.Command{
+			Use:   "list",
+			Short: "Lists the dead-lettered events along with the last execution error",
+			PreRunE: func(_ *cobra.Command, _ []string) error {
+				return params.validateFlags(false)
+			},
+			Run: runListCommand,
+		},
+		// bridge dead-letter retry
+		getUpdateCommand("retry", "Returns given dead-lettered events to the relayer queue",
+			retryDeadLetterEventsFn),
+		// bridge dead-letter skip
+		getUpdateCommand("skip", "Removes given dead-lettered events, so that they are never relayed",
+			skipDeadLetterEventsFn),
+	)
+
+	return deadLetterCmd
+}
+
+// getUpdateCommand returns the subcommand which invokes given JSON RPC function on the provided event ids
+func getUpdateCommand(use, short, endpointFn string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			return params.validateFlags(true)
+		},
+		Run: func(cmd *cobra.Command, _ []string) {
+			outputter := command.InitializeOutputter(cmd)
+			defer outputter.WriteOutput()
+
+			client, err := jsonrpc.NewEthClient(params.jsonRPCAddress)
+			if err != nil {
+				outputter.SetError(fmt.Errorf("could not create child chain JSON RPC client: %w", err))
+
+				return
+			}
+
+			var out interface{}
+			if err := client.EndpointCall(endpointFn, &out, params.transferType, params.eventIDsArg()); err != nil {
+				outputter.SetError(fmt.Errorf("failed to %s dead-lettered events: %w", use, err))
+
+				return
+			}
+
+			outputter.SetCommandResult(&updateResult{
+				Action:       use,
+				TransferType: params.transferType,
+				EventIDs:     params.eventIDs,
+			})
+		},
+	}
+
+	cmd.Flags().UintSliceVar(
+		&params.eventIDs,
+		eventIDsFlag,
+		nil,
+		"comma separated ids of the dead-lettered events (state sync ids or exit ids)",
+	)
+
+	_ = cmd.MarkFlagRequired(eventIDsFlag)
+
+	return cmd
+}
+
+func runListCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	client, err := jsonrpc.NewEthClient(params.jsonRPCAddress)
+	if err != nil {
+		outputter.SetError(fmt.Errorf("could not create child chain JSON RPC client: %w", err))
+
+		return
+	}
+
+	var events []*types.BridgeRelayerEvent
+	if err := client.EndpointCall(getDeadLetterEventsFn, &events, params.transferType); err != nil {
+		outputter.SetError(fmt.Errorf("failed to get dead-lettered events: %w", err))
+
+		return
+	}
+
+	outputter.SetCommandResult(&listResult{TransferType: params.transferType, Events: events})
+}
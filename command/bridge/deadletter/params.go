@@ -0,0 +1,50 @@
+package deadletter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	jsonRPCFlag      = "json-rpc"
+	transferTypeFlag = "type"
+	eventIDsFlag     = "ids"
+)
+
+var (
+	errInvalidTransferType = fmt.Errorf("invalid %s flag, expected either %s or %s",
+		transferTypeFlag, types.StateSyncTransfer, types.ExitTransfer)
+	errNoEventIDs = errors.New("at least one event id must be provided")
+)
+
+type deadLetterParams struct {
+	jsonRPCAddress string
+	transferType   string
+	eventIDs       []uint
+}
+
+func (dp *deadLetterParams) validateFlags(requireIDs bool) error {
+	switch types.BridgeTransferType(dp.transferType) {
+	case types.StateSyncTransfer, types.ExitTransfer:
+	default:
+		return errInvalidTransferType
+	}
+
+	if requireIDs && len(dp.eventIDs) == 0 {
+		return errNoEventIDs
+	}
+
+	return nil
+}
+
+// eventIDsArg returns the event ids as hex encoded quantities, expected by the JSON RPC endpoints
+func (dp *deadLetterParams) eventIDsArg() []string {
+	ids := make([]string, len(dp.eventIDs))
+	for i, id := range dp.eventIDs {
+		ids[i] = fmt.Sprintf("0x%x", id)
+	}
+
+	return ids
+}
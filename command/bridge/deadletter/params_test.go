@@ -0,0 +1,56 @@
+package deadletter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_validateFlags(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		params     *deadLetterParams
+		requireIDs bool
+		err        error
+	}{
+		{
+			name:   "invalid transfer type",
+			params: &deadLetterParams{transferType: "deposit"},
+			err:    errInvalidTransferType,
+		},
+		{
+			name:       "no event ids",
+			params:     &deadLetterParams{transferType: "exit"},
+			requireIDs: true,
+			err:        errNoEventIDs,
+		},
+		{
+			name:   "list",
+			params: &deadLetterParams{transferType: "stateSync"},
+		},
+		{
+			name:       "retry",
+			params:     &deadLetterParams{transferType: "exit", eventIDs: []uint{1, 26}},
+			requireIDs: true,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.ErrorIs(t, c.params.validateFlags(c.requireIDs), c.err)
+		})
+	}
+}
+
+func Test_eventIDsArg(t *testing.T) {
+	t.Parallel()
+
+	params := &deadLetterParams{eventIDs: []uint{0, 26}}
+	require.Equal(t, []string{"0x0", "0x1a"}, params.eventIDsArg())
+}
@@ -0,0 +1,58 @@
+package deadletter
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type listResult struct {
+	TransferType string                      `json:"type"`
+	Events       []*types.BridgeRelayerEvent `json:"events"`
+}
+
+func (r *listResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[BRIDGE DEAD-LETTER EVENTS]\n")
+
+	if len(r.Events) == 0 {
+		buffer.WriteString(fmt.Sprintf("No dead-lettered %s events\n", r.TransferType))
+
+		return buffer.String()
+	}
+
+	vals := make([]string, 0, len(r.Events)+1)
+	vals = append(vals, "Event ID|Tries|Last Sent Block|Last Error")
+
+	for _, event := range r.Events {
+		vals = append(vals, fmt.Sprintf("%d|%d|%d|%s",
+			event.EventID, event.CountTries, event.BlockNumber, event.LastError))
+	}
+
+	buffer.WriteString(helper.FormatList(vals))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
+
+type updateResult struct {
+	Action       string `json:"action"`
+	TransferType string `json:"type"`
+	EventIDs     []uint `json:"eventIds"`
+}
+
+func (r *updateResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString(fmt.Sprintf("\n[BRIDGE DEAD-LETTER %s]\n", r.Action))
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Type|%s", r.TransferType),
+		fmt.Sprintf("Event IDs|%v", r.EventIDs),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -1,6 +1,7 @@
 package erc1155
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/bridge/common"
 	"github.com/0xPolygon/polygon-edge/command/bridge/helper"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/crypto"
 	helperCommon "github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/txrelayer"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -72,9 +74,6 @@ func GetCommand() *cobra.Command {
 		common.MinterKeyFlagDesc,
 	)
 
-	_ = depositCmd.MarkFlagRequired(common.ReceiversFlag)
-	_ = depositCmd.MarkFlagRequired(common.AmountsFlag)
-	_ = depositCmd.MarkFlagRequired(common.TokenIDsFlag)
 	_ = depositCmd.MarkFlagRequired(common.RootTokenFlag)
 	_ = depositCmd.MarkFlagRequired(common.RootPredicateFlag)
 
@@ -97,7 +96,7 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	depositorAddr := depositorKey.Address()
 
 	txRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(dp.JSONRPCAddr),
-		txrelayer.WithReceiptsTimeout(dp.TxTimeout))
+		txrelayer.WithReceiptsTimeout(dp.TxTimeout), txrelayer.WithNonceTracker(txrelayer.NewNonceTracker()))
 	if err != nil {
 		outputter.SetError(fmt.Errorf("failed to initialize tx relayer: %w", err))
 
@@ -130,6 +129,27 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		tokenIDs[i] = tokenID
 	}
 
+	if dp.IsBatch() && dp.DryRun {
+		// neither mint nor approve transactions are sent in the dry-run mode
+		approved, err := helper.IsApprovedForAll(txRelayer, types.StringToAddress(dp.TokenAddr),
+			depositorAddr, types.StringToAddress(dp.PredicateAddr))
+		if err != nil {
+			outputter.SetError(fmt.Errorf("failed to check root erc 1155 predicate approval: %w", err))
+
+			return
+		}
+
+		var notes []string
+		if !approved {
+			notes = append(notes, "predicate is not approved to transfer the depositor tokens, "+
+				"approve transaction is sent prior to the deposits")
+		}
+
+		runBatch(cmd.Context(), outputter, txRelayer, depositorKey, amounts, tokenIDs, notes...)
+
+		return
+	}
+
 	if dp.minterKey != "" {
 		minterKey, err := helper.DecodePrivateKey(dp.minterKey)
 		if err != nil {
@@ -183,6 +203,12 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		return
 	}
 
+	if dp.IsBatch() {
+		runBatch(cmd.Context(), outputter, txRelayer, depositorKey, amounts, tokenIDs)
+
+		return
+	}
+
 	receivers := make([]types.Address, len(dp.Receivers))
 	for i, receiverRaw := range dp.Receivers {
 		receivers[i] = types.StringToAddress(receiverRaw)
@@ -244,6 +270,24 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	outputter.SetCommandResult(res)
 }
 
+// runBatch sends the deposits read from the transfers file, each in a separate transaction
+func runBatch(ctx context.Context, outputter command.OutputFormatter, txRelayer txrelayer.TxRelayer,
+	depositorKey crypto.Key, amounts, tokenIDs []*big.Int, notes ...string) {
+	res, err := dp.SendBatchTransfers(ctx, txRelayer, depositorKey, "DEPOSIT ERC 1155", dp.ChildChainMintable,
+		func(i int) (*types.Transaction, error) {
+			return createDepositTxn(depositorKey.Address(), []types.Address{types.StringToAddress(dp.Receivers[i])},
+				[]*big.Int{amounts[i]}, []*big.Int{tokenIDs[i]})
+		})
+	if err != nil {
+		outputter.SetError(fmt.Errorf("sending deposit transactions failed: %w", err))
+
+		return
+	}
+
+	res.Notes = notes
+	outputter.SetCommandResult(res)
+}
+
 // createDepositTxn encodes parameters for deposit function on rootchain predicate contract
 func createDepositTxn(sender types.Address, receivers []types.Address,
 	amounts, tokenIDs []*big.Int) (*types.Transaction, error) {
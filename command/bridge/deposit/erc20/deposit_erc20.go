@@ -1,6 +1,7 @@
 package erc20
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/bridge/common"
 	"github.com/0xPolygon/polygon-edge/command/bridge/helper"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/crypto"
 	helperCommon "github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/txrelayer"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -66,8 +68,6 @@ func GetCommand() *cobra.Command {
 		common.MinterKeyFlagDesc,
 	)
 
-	_ = depositCmd.MarkFlagRequired(common.ReceiversFlag)
-	_ = depositCmd.MarkFlagRequired(common.AmountsFlag)
 	_ = depositCmd.MarkFlagRequired(common.RootTokenFlag)
 	_ = depositCmd.MarkFlagRequired(common.RootPredicateFlag)
 
@@ -90,7 +90,7 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	depositorAddr := depositorKey.Address()
 
 	txRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(dp.JSONRPCAddr),
-		txrelayer.WithReceiptsTimeout(dp.TxTimeout))
+		txrelayer.WithReceiptsTimeout(dp.TxTimeout), txrelayer.WithNonceTracker(txrelayer.NewNonceTracker()))
 	if err != nil {
 		outputter.SetError(fmt.Errorf("failed to initialize tx relayer: %w", err))
 
@@ -114,6 +114,27 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		aggregateAmount.Add(aggregateAmount, amount)
 	}
 
+	if dp.IsBatch() && dp.DryRun {
+		// neither mint nor approve transactions are sent in the dry-run mode
+		allowance, err := helper.GetERC20Allowance(txRelayer, types.StringToAddress(dp.TokenAddr),
+			depositorAddr, types.StringToAddress(dp.PredicateAddr))
+		if err != nil {
+			outputter.SetError(fmt.Errorf("failed to get root erc 20 predicate allowance: %w", err))
+
+			return
+		}
+
+		var notes []string
+		if allowance.Cmp(aggregateAmount) < 0 {
+			notes = append(notes, fmt.Sprintf("predicate allowance %s is lower than the total amount %s, "+
+				"approve transaction is sent prior to the deposits", allowance, aggregateAmount))
+		}
+
+		runBatch(cmd.Context(), outputter, txRelayer, depositorKey, amounts, notes...)
+
+		return
+	}
+
 	if dp.minterKey != "" {
 		minterKey, err := helper.DecodePrivateKey(dp.minterKey)
 		if err != nil {
@@ -170,6 +191,12 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		return
 	}
 
+	if dp.IsBatch() {
+		runBatch(cmd.Context(), outputter, txRelayer, depositorKey, amounts)
+
+		return
+	}
+
 	type bridgeTxData struct {
 		exitEventIDs   []*big.Int
 		blockNumber    uint64
@@ -267,6 +294,23 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		})
 }
 
+// runBatch sends the deposits read from the transfers file, each in a separate transaction
+func runBatch(ctx context.Context, outputter command.OutputFormatter, txRelayer txrelayer.TxRelayer,
+	depositorKey crypto.Key, amounts []*big.Int, notes ...string) {
+	res, err := dp.SendBatchTransfers(ctx, txRelayer, depositorKey, "DEPOSIT ERC 20", dp.ChildChainMintable,
+		func(i int) (*types.Transaction, error) {
+			return createDepositTxn(depositorKey.Address(), types.StringToAddress(dp.Receivers[i]), amounts[i])
+		})
+	if err != nil {
+		outputter.SetError(fmt.Errorf("sending deposit transactions failed: %w", err))
+
+		return
+	}
+
+	res.Notes = notes
+	outputter.SetCommandResult(res)
+}
+
 // createDepositTxn encodes parameters for deposit function on rootchain predicate contract
 func createDepositTxn(sender, receiver types.Address, amount *big.Int) (*types.Transaction, error) {
 	depositToFn := &contractsapi.DepositToRootERC20PredicateFn{
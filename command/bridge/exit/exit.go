@@ -1,10 +1,8 @@
 package exit
 
 import (
-	"bytes"
 	"fmt"
 	"strconv"
-	"time"
 
 	"github.com/spf13/cobra"
 
@@ -14,31 +12,19 @@ import (
 	cmdHelper "github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/txrelayer"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
 const (
-	// flag names
-	exitHelperFlag   = "exit-helper"
-	exitEventIDFlag  = "exit-id"
-	rootJSONRPCFlag  = "root-json-rpc"
-	childJSONRPCFlag = "child-json-rpc"
-
 	// generateExitProofFn is JSON RPC endpoint which creates exit proof
 	generateExitProofFn = "bridge_generateExitProof"
+	// generateExitProofsFn is JSON RPC endpoint which creates exit proofs of multiple exits
+	generateExitProofsFn = "bridge_generateExitProofs"
 )
 
-type exitParams struct {
-	senderKey         string
-	exitHelperAddrRaw string
-	exitID            uint64
-	rootJSONRPCAddr   string
-	childJSONRPCAddr  string
-	txTimeout         time.Duration
-}
-
 var (
 	// ep represents exit command parameters
 	ep *exitParams = &exitParams{}
@@ -47,9 +33,11 @@ var (
 // GetCommand returns the bridge exit command
 func GetCommand() *cobra.Command {
 	exitCmd := &cobra.Command{
-		Use:   "exit",
-		Short: "Sends exit transaction to the Exit helper contract on the root chain",
-		Run:   run,
+		Use: "exit",
+		Short: "Sends exit transaction to the Exit helper contract on the root chain. " +
+			"Multiple exits can be sent in a single batch exit transaction",
+		PreRunE: preRunCommand,
+		Run:     run,
 	}
 
 	exitCmd.Flags().StringVar(
@@ -73,6 +61,21 @@ func GetCommand() *cobra.Command {
 		"child chain exit event ID",
 	)
 
+	exitCmd.Flags().UintSliceVar(
+		&ep.exitIDs,
+		exitIDsFlag,
+		nil,
+		"comma separated child chain exit event IDs, which are sent in a single batch exit transaction",
+	)
+
+	exitCmd.Flags().StringVar(
+		&ep.exitIDRange,
+		exitIDRangeFlag,
+		"",
+		"inclusive range of child chain exit event IDs in <from>:<to> format, "+
+			"which are sent in a single batch exit transaction",
+	)
+
 	exitCmd.Flags().StringVar(
 		&ep.rootJSONRPCAddr,
 		rootJSONRPCFlag,
@@ -96,9 +99,17 @@ func GetCommand() *cobra.Command {
 
 	_ = exitCmd.MarkFlagRequired(exitHelperFlag)
 
+	exitCmd.MarkFlagsMutuallyExclusive(exitEventIDFlag, exitIDsFlag, exitIDRangeFlag)
+
 	return exitCmd
 }
 
+func preRunCommand(cmd *cobra.Command, _ []string) error {
+	ep.exitIDsSet = cmd.Flags().Changed(exitIDsFlag)
+
+	return ep.validateFlags()
+}
+
 func run(cmd *cobra.Command, _ []string) {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
@@ -125,6 +136,19 @@ func run(cmd *cobra.Command, _ []string) {
 		return
 	}
 
+	if ep.isBatch() {
+		result, err := sendBatchExit(senderKey, rootTxRelayer, childClient)
+		if err != nil {
+			outputter.SetError(err)
+
+			return
+		}
+
+		outputter.SetCommandResult(result)
+
+		return
+	}
+
 	// acquire proof for given exit event
 	var proof types.Proof
 	if err = childClient.EndpointCall(generateExitProofFn, &proof, fmt.Sprintf("0x%x", ep.exitID)); err != nil {
@@ -162,6 +186,80 @@ func run(cmd *cobra.Command, _ []string) {
 	})
 }
 
+// sendBatchExit acquires the proofs of the provided exit events at once
+// and sends them to the root chain in a single batch exit transaction
+func sendBatchExit(senderKey crypto.Key, rootTxRelayer txrelayer.TxRelayer,
+	childClient *jsonrpc.EthClient) (*batchExitResult, error) {
+	var proofs []types.Proof
+	if err := childClient.EndpointCall(generateExitProofsFn, &proofs, ep.exitProofsArgs()); err != nil {
+		return nil, fmt.Errorf("failed to get exit proofs: %w", err)
+	}
+
+	txn, exitEvents, err := createBatchExitTxn(senderKey.Address(), proofs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tx input: %w", err)
+	}
+
+	receipt, err := rootTxRelayer.SendTransaction(txn, senderKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send batch exit transaction: %w", err)
+	}
+
+	if receipt.Status == uint64(types.ReceiptFailed) {
+		return nil, fmt.Errorf("failed to execute batch exit transaction (tx hash=%s)", receipt.TransactionHash)
+	}
+
+	result := &batchExitResult{
+		TxHash: receipt.TransactionHash.String(),
+		Exits:  make([]*exitResult, len(exitEvents)),
+	}
+
+	for i, exitEvent := range exitEvents {
+		result.Exits[i] = &exitResult{
+			ID:       strconv.FormatUint(exitEvent.ID.Uint64(), 10),
+			Sender:   exitEvent.Sender.String(),
+			Receiver: exitEvent.Receiver.String(),
+		}
+	}
+
+	return result, nil
+}
+
+// createBatchExitTxn encodes parameters for batch exit function on root chain ExitHelper contract
+func createBatchExitTxn(sender types.Address, proofs []types.Proof) (*types.Transaction,
+	[]*contractsapi.L2StateSyncedEvent, error) {
+	batchExitFn := &contractsapi.BatchExitExitHelperFn{
+		Inputs: make([]*contractsapi.BatchExitInput, len(proofs)),
+	}
+	exitEvents := make([]*contractsapi.L2StateSyncedEvent, len(proofs))
+
+	for i, proof := range proofs {
+		exitInput, err := polybft.GetExitInputFromProof(proof)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		exitEvent := new(contractsapi.L2StateSyncedEvent)
+		if err := exitEvent.Decode(exitInput.UnhashedLeaf); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode exit event: %w", err)
+		}
+
+		batchExitFn.Inputs[i] = exitInput
+		exitEvents[i] = exitEvent
+	}
+
+	input, err := batchExitFn.EncodeAbi()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode provided parameters: %w", err)
+	}
+
+	// gas limit is estimated, since it depends on the number of exits
+	exitHelperAddr := types.StringToAddress(ep.exitHelperAddrRaw)
+	txn := helper.CreateTransaction(sender, &exitHelperAddr, input, nil, true)
+
+	return txn, exitEvents, nil
+}
+
 // createExitTxn encodes parameters for exit function on root chain ExitHelper contract
 func createExitTxn(sender types.Address, proof types.Proof) (*types.Transaction,
 	*contractsapi.L2StateSyncedEvent, error) {
@@ -193,24 +291,3 @@ func createExitTxn(sender types.Address, proof types.Proof) (*types.Transaction,
 
 	return txn, exitEvent, err
 }
-
-type exitResult struct {
-	ID       string `json:"id"`
-	Sender   string `json:"sender"`
-	Receiver string `json:"receiver"`
-}
-
-func (r *exitResult) GetOutput() string {
-	var buffer bytes.Buffer
-
-	vals := make([]string, 0, 3)
-	vals = append(vals, fmt.Sprintf("Exit Event ID|%s", r.ID))
-	vals = append(vals, fmt.Sprintf("Sender|%s", r.Sender))
-	vals = append(vals, fmt.Sprintf("Receiver|%s", r.Receiver))
-
-	buffer.WriteString("\n[EXIT TRANSACTION RELAYER]\n")
-	buffer.WriteString(cmdHelper.FormatKV(vals))
-	buffer.WriteString("\n")
-
-	return buffer.String()
-}
@@ -0,0 +1,108 @@
+package exit
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// flag names
+	exitHelperFlag   = "exit-helper"
+	exitEventIDFlag  = "exit-id"
+	exitIDsFlag      = "exit-ids"
+	exitIDRangeFlag  = "exit-id-range"
+	rootJSONRPCFlag  = "root-json-rpc"
+	childJSONRPCFlag = "child-json-rpc"
+
+	// maxBatchExitsCount is the maximum number of exits sent in a single batch exit transaction,
+	// which is also the maximum number of proofs generated by a single bridge_generateExitProofs call
+	maxBatchExitsCount = uint64(256)
+)
+
+var (
+	errInvalidExitIDRange = fmt.Errorf("invalid %s flag, expected <from>:<to> where from is not greater than to",
+		exitIDRangeFlag)
+	errTooManyExits = fmt.Errorf("at most %d exits can be sent at once", maxBatchExitsCount)
+	errNoExitIDs    = errors.New("at least one exit id must be provided")
+)
+
+type exitParams struct {
+	senderKey         string
+	exitHelperAddrRaw string
+	exitID            uint64
+	exitIDs           []uint
+	exitIDRange       string
+	rootJSONRPCAddr   string
+	childJSONRPCAddr  string
+	txTimeout         time.Duration
+
+	exitIDsSet bool
+	rangeFrom  uint64
+	rangeTo    uint64
+}
+
+// isBatch returns true if multiple exits are sent in a single batch exit transaction
+func (ep *exitParams) isBatch() bool {
+	return ep.exitIDsSet || ep.exitIDRange != ""
+}
+
+func (ep *exitParams) validateFlags() error {
+	if ep.exitIDsSet {
+		if len(ep.exitIDs) == 0 {
+			return errNoExitIDs
+		}
+
+		if uint64(len(ep.exitIDs)) > maxBatchExitsCount {
+			return errTooManyExits
+		}
+	}
+
+	if ep.exitIDRange == "" {
+		return nil
+	}
+
+	from, to, found := strings.Cut(ep.exitIDRange, ":")
+	if !found {
+		return errInvalidExitIDRange
+	}
+
+	var err error
+
+	if ep.rangeFrom, err = strconv.ParseUint(strings.TrimSpace(from), 10, 64); err != nil {
+		return errInvalidExitIDRange
+	}
+
+	if ep.rangeTo, err = strconv.ParseUint(strings.TrimSpace(to), 10, 64); err != nil {
+		return errInvalidExitIDRange
+	}
+
+	if ep.rangeFrom > ep.rangeTo {
+		return errInvalidExitIDRange
+	}
+
+	if ep.rangeTo-ep.rangeFrom >= maxBatchExitsCount {
+		return errTooManyExits
+	}
+
+	return nil
+}
+
+// exitProofsArgs returns the arguments of the bridge_generateExitProofs JSON RPC call
+func (ep *exitParams) exitProofsArgs() map[string]interface{} {
+	if ep.exitIDRange != "" {
+		return map[string]interface{}{
+			"from": fmt.Sprintf("0x%x", ep.rangeFrom),
+			"to":   fmt.Sprintf("0x%x", ep.rangeTo),
+		}
+	}
+
+	ids := make([]string, len(ep.exitIDs))
+	for i, id := range ep.exitIDs {
+		ids[i] = fmt.Sprintf("0x%x", id)
+	}
+
+	return map[string]interface{}{"exitIds": ids}
+}
@@ -0,0 +1,94 @@
+package exit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExitParams_ValidateFlags(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		params  *exitParams
+		isBatch bool
+		args    map[string]interface{}
+		err     error
+	}{
+		{
+			name:   "single exit",
+			params: &exitParams{exitID: 5},
+		},
+		{
+			name:    "exit ids",
+			params:  &exitParams{exitIDs: []uint{3, 10, 26}, exitIDsSet: true},
+			isBatch: true,
+			args:    map[string]interface{}{"exitIds": []string{"0x3", "0xa", "0x1a"}},
+		},
+		{
+			name:   "empty exit ids",
+			params: &exitParams{exitIDsSet: true},
+			err:    errNoExitIDs,
+		},
+		{
+			name:   "too many exit ids",
+			params: &exitParams{exitIDs: make([]uint, maxBatchExitsCount+1), exitIDsSet: true},
+			err:    errTooManyExits,
+		},
+		{
+			name:    "exit id range",
+			params:  &exitParams{exitIDRange: "16:31"},
+			isBatch: true,
+			args:    map[string]interface{}{"from": "0x10", "to": "0x1f"},
+		},
+		{
+			name:    "single exit id range",
+			params:  &exitParams{exitIDRange: "7:7"},
+			isBatch: true,
+			args:    map[string]interface{}{"from": "0x7", "to": "0x7"},
+		},
+		{
+			name:   "exit id range without separator",
+			params: &exitParams{exitIDRange: "16"},
+			err:    errInvalidExitIDRange,
+		},
+		{
+			name:   "invalid exit id range bound",
+			params: &exitParams{exitIDRange: "16:x"},
+			err:    errInvalidExitIDRange,
+		},
+		{
+			name:   "reversed exit id range",
+			params: &exitParams{exitIDRange: "31:16"},
+			err:    errInvalidExitIDRange,
+		},
+		{
+			name:   "too wide exit id range",
+			params: &exitParams{exitIDRange: "0:256"},
+			err:    errTooManyExits,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.params.validateFlags()
+			if c.err != nil {
+				require.ErrorIs(t, err, c.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, c.isBatch, c.params.isBatch())
+
+			if c.isBatch {
+				require.Equal(t, c.args, c.params.exitProofsArgs())
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package exit
+
+import (
+	"bytes"
+	"fmt"
+
+	cmdHelper "github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type exitResult struct {
+	ID       string `json:"id"`
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+}
+
+func (r *exitResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	vals := make([]string, 0, 3)
+	vals = append(vals, fmt.Sprintf("Exit Event ID|%s", r.ID))
+	vals = append(vals, fmt.Sprintf("Sender|%s", r.Sender))
+	vals = append(vals, fmt.Sprintf("Receiver|%s", r.Receiver))
+
+	buffer.WriteString("\n[EXIT TRANSACTION RELAYER]\n")
+	buffer.WriteString(cmdHelper.FormatKV(vals))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
+
+type batchExitResult struct {
+	TxHash string        `json:"txHash"`
+	Exits  []*exitResult `json:"exits"`
+}
+
+func (r *batchExitResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[BATCH EXIT TRANSACTION RELAYER]\n")
+	buffer.WriteString(cmdHelper.FormatKV([]string{
+		fmt.Sprintf("Transaction Hash|%s", r.TxHash),
+		fmt.Sprintf("Exits Count|%d", len(r.Exits)),
+	}))
+	buffer.WriteString("\n\n")
+
+	vals := make([]string, 0, len(r.Exits)+1)
+	vals = append(vals, "Exit Event ID|Sender|Receiver")
+
+	for _, exit := range r.Exits {
+		vals = append(vals, fmt.Sprintf("%s|%s|%s", exit.ID, exit.Sender, exit.Receiver))
+	}
+
+	buffer.WriteString(cmdHelper.FormatList(vals))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -251,6 +251,41 @@ func CreateApproveERC20Txn(amount *big.Int,
 	return CreateTransaction(types.ZeroAddress, &erc20TokenAddr, input, nil, rootchainTx), nil
 }
 
+// GetERC20Allowance returns the amount of given ERC20 token which the spender may spend on behalf of the owner
+func GetERC20Allowance(txRelayer txrelayer.TxRelayer, erc20TokenAddr, owner, spender types.Address) (*big.Int, error) {
+	input, err := contractsapi.RootERC20.Abi.GetMethod("allowance").Encode([]interface{}{owner, spender})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode parameters for RootERC20.allowance. error: %w", err)
+	}
+
+	response, err := txRelayer.Call(types.ZeroAddress, erc20TokenAddr, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return common.ParseUint256orHex(&response)
+}
+
+// IsApprovedForAll checks whether the operator is approved to transfer all the ERC721 or ERC1155 tokens of the owner
+func IsApprovedForAll(txRelayer txrelayer.TxRelayer, tokenAddr, owner, operator types.Address) (bool, error) {
+	input, err := contractsapi.RootERC721.Abi.GetMethod("isApprovedForAll").Encode([]interface{}{owner, operator})
+	if err != nil {
+		return false, fmt.Errorf("failed to encode parameters for isApprovedForAll. error: %w", err)
+	}
+
+	response, err := txRelayer.Call(types.ZeroAddress, tokenAddr, input)
+	if err != nil {
+		return false, err
+	}
+
+	approved, err := common.ParseUint256orHex(&response)
+	if err != nil {
+		return false, err
+	}
+
+	return approved.Sign() != 0, nil
+}
+
 // SendTransaction sends provided transaction
 func SendTransaction(txRelayer txrelayer.TxRelayer, addr types.Address, input []byte, contractName string,
 	deployerKey crypto.Key) (*ethgo.Receipt, error) {
@@ -0,0 +1,175 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/bridge/helper"
+	cmdHelper "github.com/0xPolygon/polygon-edge/command/helper"
+	serverConfig "github.com/0xPolygon/polygon-edge/command/server/config"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/server"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	embeddedChainName = "rootchain"
+	// embeddedChainID is the same chain id as the one of the geth dev chain,
+	// so the embedded rootchain is interchangeable with the docker one
+	embeddedChainID = 1337
+)
+
+// runEmbeddedRootchain starts an in-process dev consensus chain which serves as the rootchain,
+// and keeps it running until the process receives a termination signal
+func runEmbeddedRootchain(outputter command.OutputFormatter) error {
+	config, err := params.embeddedServerConfig()
+	if err != nil {
+		return err
+	}
+
+	srv, err := server.NewServer(config)
+	if err != nil {
+		return fmt.Errorf("failed to start embedded rootchain: %w", err)
+	}
+
+	defer srv.Close()
+
+	closeCh := make(chan struct{})
+	if err := PingServer(closeCh); err != nil {
+		return fmt.Errorf("failed to ping rootchain server: %w", err)
+	}
+
+	signalCh := make(chan os.Signal, 4)
+	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+
+	<-signalCh
+
+	outputter.SetCommandResult(&embeddedStopResult{
+		ChainID:     embeddedChainID,
+		JSONRPCAddr: config.JSONRPC.JSONRPCAddr.String(),
+		DataDir:     config.DataDir,
+	})
+
+	return nil
+}
+
+// embeddedServerConfig returns the configuration of the embedded rootchain server.
+// Server exposes the JSON-RPC on the same address as the docker rootchain
+// and keeps the test account unlocked, so the rootchain test mode works the same way
+func (p *serverParams) embeddedServerConfig() (*server.Config, error) {
+	chainConfig, err := p.embeddedChainConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	testAccountKey, err := crypto.HexToECDSA(helper.TestAccountPrivKey)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonRPCAddr, err := net.ResolveTCPAddr("tcp", net.JoinHostPort(defaultHostIP, defaultHostPort))
+	if err != nil {
+		return nil, err
+	}
+
+	// the rest of the services are bound to any free port, since they are not used by the bridge commands
+	localAddr := func() *net.TCPAddr {
+		return &net.TCPAddr{IP: net.ParseIP(defaultHostIP), Port: 0}
+	}
+
+	defaults := serverConfig.DefaultConfig()
+	libp2pAddr := localAddr()
+
+	return &server.Config{
+		Chain: chainConfig,
+		JSONRPC: &server.JSONRPC{
+			JSONRPCAddr:              jsonRPCAddr,
+			AccessControlAllowOrigin: defaults.Headers.AccessControlAllowOrigins,
+			BatchLengthLimit:         defaults.JSONRPCBatchRequestLimit,
+			BlockRangeLimit:          defaults.JSONRPCBlockRangeLimit,
+			ConcurrentRequestsDebug:  defaults.ConcurrentRequestsDebug,
+			WebSocketReadLimit:       defaults.WebSocketReadLimit,
+		},
+		GRPCAddr:   localAddr(),
+		LibP2PAddr: libp2pAddr,
+		Telemetry:  &server.Telemetry{},
+		Network: &network.Config{
+			NoDiscover:        true,
+			Addr:              libp2pAddr,
+			DataDir:           p.dataDir,
+			MaxPeers:          defaults.Network.MaxPeers,
+			MaxInboundPeers:   defaults.Network.MaxInboundPeers,
+			MaxOutboundPeers:  defaults.Network.MaxOutboundPeers,
+			Chain:             chainConfig,
+			GossipMessageSize: defaults.Network.GossipMessageSize,
+		},
+		DataDir:            p.dataDir,
+		Seal:               true,
+		PriceLimit:         defaults.TxPool.PriceLimit,
+		MaxSlots:           defaults.TxPool.MaxSlots,
+		MaxAccountEnqueued: defaults.TxPool.MaxAccountEnqueued,
+		LogLevel:           hclog.LevelFromString(defaults.LogLevel),
+		MetricsInterval:    defaults.MetricsInterval,
+		EventTracker: &server.EventTracker{
+			SyncBatchSize:          defaults.EventTracker.SyncBatchSize,
+			NumBlockConfirmations:  defaults.EventTracker.NumBlockConfirmations,
+			NumOfBlocksToReconcile: defaults.EventTracker.NumOfBlocksToReconcile,
+		},
+		UnlockedAccounts: []*ecdsa.PrivateKey{testAccountKey},
+	}, nil
+}
+
+// embeddedChainConfig returns the chain configuration of the embedded rootchain,
+// with the test account and provided premine accounts funded in genesis
+func (p *serverParams) embeddedChainConfig() (*chain.Chain, error) {
+	testAccountKey, err := crypto.HexToECDSA(helper.TestAccountPrivKey)
+	if err != nil {
+		return nil, err
+	}
+
+	alloc := map[types.Address]*chain.GenesisAccount{
+		crypto.PubKeyToAddress(&testAccountKey.PublicKey): {Balance: command.DefaultPremineBalance},
+	}
+
+	for _, premine := range p.premine {
+		premineInfo, err := cmdHelper.ParsePremineInfo(premine)
+		if err != nil {
+			return nil, fmt.Errorf("invalid premine balance amount provided: %w", err)
+		}
+
+		alloc[premineInfo.Address] = &chain.GenesisAccount{Balance: premineInfo.Amount}
+	}
+
+	return &chain.Chain{
+		Name: embeddedChainName,
+		Genesis: &chain.Genesis{
+			GasLimit:   command.DefaultGenesisGasLimit,
+			Difficulty: 1,
+			Alloc:      alloc,
+			GasUsed:    command.DefaultGenesisGasUsed,
+			BaseFee:    command.DefaultGenesisBaseFee,
+		},
+		Params: &chain.Params{
+			ChainID: embeddedChainID,
+			Forks:   chain.AllForksEnabled.Copy(),
+			Engine: map[string]interface{}{
+				string(server.DevConsensus): map[string]interface{}{
+					"interval": p.blockTime,
+				},
+			},
+			BaseFeeEM:          command.DefaultGenesisBaseFeeEM,
+			BaseFeeChangeDenom: command.DefaultGenesisBaseFeeChangeDenom,
+			// base fee is burned, the same way as on the geth dev chain
+			BurnContract: map[uint64]types.Address{0: types.ZeroAddress},
+		},
+	}, nil
+}
@@ -1,11 +1,49 @@
 package server
 
+import (
+	"errors"
+	"fmt"
+
+	cmdHelper "github.com/0xPolygon/polygon-edge/command/helper"
+)
+
 const (
-	dataDirFlag = "data-dir"
-	noConsole   = "no-console"
+	dataDirFlag   = "data-dir"
+	noConsole     = "no-console"
+	embeddedFlag  = "embedded"
+	blockTimeFlag = "block-time"
+	premineFlag   = "premine"
+
+	defaultBlockTime = uint64(2)
+)
+
+var (
+	errInvalidBlockTime = errors.New("block time must be greater than 0")
 )
 
 type serverParams struct {
 	dataDir   string
 	noConsole bool
+
+	embedded  bool
+	blockTime uint64
+	premine   []string
+}
+
+func (p *serverParams) validateFlags() error {
+	if !p.embedded {
+		return nil
+	}
+
+	if p.blockTime == 0 {
+		return errInvalidBlockTime
+	}
+
+	for _, premine := range p.premine {
+		if _, err := cmdHelper.ParsePremineInfo(premine); err != nil {
+			return fmt.Errorf("invalid premine balance amount provided: %w", err)
+		}
+	}
+
+	return nil
 }
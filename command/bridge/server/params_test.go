@@ -0,0 +1,83 @@
+package server
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/bridge/helper"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/server"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestServerParams_ValidateFlags(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		params *serverParams
+		err    string
+	}{
+		{"docker rootchain", &serverParams{}, ""},
+		{"embedded rootchain", &serverParams{embedded: true, blockTime: 1, premine: []string{"0x1:0x10"}}, ""},
+		{"zero block time", &serverParams{embedded: true}, errInvalidBlockTime.Error()},
+		{
+			"invalid premine",
+			&serverParams{embedded: true, blockTime: 1, premine: []string{"0x1:abc"}},
+			"invalid premine balance amount provided",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.params.validateFlags()
+			if c.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, c.err)
+			}
+		})
+	}
+}
+
+func TestServerParams_EmbeddedServerConfig(t *testing.T) {
+	t.Parallel()
+
+	p := &serverParams{
+		dataDir:   t.TempDir(),
+		embedded:  true,
+		blockTime: 3,
+		premine:   []string{"0x1:100", "0x2"},
+	}
+
+	config, err := p.embeddedServerConfig()
+	require.NoError(t, err)
+
+	testAccountKey, err := crypto.HexToECDSA(helper.TestAccountPrivKey)
+	require.NoError(t, err)
+
+	testAccount := crypto.PubKeyToAddress(&testAccountKey.PublicKey)
+
+	require.Equal(t, "127.0.0.1:8545", config.JSONRPC.JSONRPCAddr.String())
+	require.Equal(t, p.dataDir, config.DataDir)
+	require.True(t, config.Seal)
+	require.Len(t, config.UnlockedAccounts, 1)
+	require.Equal(t, testAccount, crypto.PubKeyToAddress(&config.UnlockedAccounts[0].PublicKey))
+
+	require.Equal(t, int64(embeddedChainID), config.Chain.Params.ChainID)
+	require.Equal(t, map[string]interface{}{"interval": p.blockTime},
+		config.Chain.Params.Engine[string(server.DevConsensus)])
+
+	alloc := config.Chain.Genesis.Alloc
+	require.Len(t, alloc, 3)
+	require.Equal(t, command.DefaultPremineBalance, alloc[testAccount].Balance)
+	require.Equal(t, big.NewInt(100), alloc[types.StringToAddress("0x1")].Balance)
+	require.Equal(t, command.DefaultPremineBalance, alloc[types.StringToAddress("0x2")].Balance)
+}
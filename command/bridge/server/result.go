@@ -39,3 +39,24 @@ func (r containerStopResult) GetOutput() string {
 
 	return buffer.String()
 }
+
+type embeddedStopResult struct {
+	ChainID     int64  `json:"chainID"`
+	JSONRPCAddr string `json:"jsonRPCAddr"`
+	DataDir     string `json:"dataDir"`
+}
+
+func (r embeddedStopResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	vals := make([]string, 0, 3)
+	vals = append(vals, fmt.Sprintf("Chain ID|%d", r.ChainID))
+	vals = append(vals, fmt.Sprintf("JSON-RPC address|%s", r.JSONRPCAddr))
+	vals = append(vals, fmt.Sprintf("Data directory|%s", r.DataDir))
+
+	buffer.WriteString("\n[BRIDGE SERVER - STOP]\n")
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -67,10 +67,37 @@ func setFlags(cmd *cobra.Command) {
 		false,
 		"use the official geth image instead of the console fork",
 	)
+
+	cmd.Flags().BoolVar(
+		&params.embedded,
+		embeddedFlag,
+		false,
+		"start an in-process dev consensus chain as the rootchain instead of the geth docker container",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.blockTime,
+		blockTimeFlag,
+		defaultBlockTime,
+		"block time in seconds of the embedded rootchain",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.premine,
+		premineFlag,
+		[]string{},
+		fmt.Sprintf(
+			"the premined accounts and balances of the embedded rootchain (format: <address>[:<balance>]). "+
+				"Default premined balance: %d",
+			command.DefaultPremineBalance,
+		),
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(noConsole, embeddedFlag)
 }
 
 func runPreRun(_ *cobra.Command, _ []string) error {
-	return nil
+	return params.validateFlags()
 }
 
 func runCommand(cmd *cobra.Command, _ []string) {
@@ -79,6 +106,14 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
+	if params.embedded {
+		if err := runEmbeddedRootchain(outputter); err != nil {
+			outputter.SetError(fmt.Errorf("failed to run embedded rootchain: %w", err))
+		}
+
+		return
+	}
+
 	closeCh := make(chan struct{})
 
 	// Check if the client is already running
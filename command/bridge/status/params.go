@@ -0,0 +1,67 @@
+package status
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	jsonRPCFlag     = "json-rpc"
+	stateSyncIDFlag = "state-sync-id"
+	exitIDFlag      = "exit-id"
+	txHashFlag      = "tx-hash"
+)
+
+var (
+	errInvalidTransferFlags = fmt.Errorf("exactly one of %s, %s or %s flags must be provided",
+		stateSyncIDFlag, exitIDFlag, txHashFlag)
+	errInvalidTxHash = errors.New("invalid transaction hash provided")
+)
+
+type statusParams struct {
+	jsonRPCAddress string
+	stateSyncID    uint64
+	exitID         uint64
+	txHashRaw      string
+
+	stateSyncIDSet bool
+	exitIDSet      bool
+}
+
+func (sp *statusParams) validateFlags() error {
+	provided := 0
+
+	for _, isSet := range []bool{sp.stateSyncIDSet, sp.exitIDSet, sp.txHashRaw != ""} {
+		if isSet {
+			provided++
+		}
+	}
+
+	if provided != 1 {
+		return errInvalidTransferFlags
+	}
+
+	if sp.txHashRaw != "" {
+		raw, err := hex.DecodeHex(sp.txHashRaw)
+		if err != nil || len(raw) != types.HashLength {
+			return errInvalidTxHash
+		}
+	}
+
+	return nil
+}
+
+// transferStatusArgs returns the arguments of the bridge_getTransferStatus JSON RPC call
+func (sp *statusParams) transferStatusArgs() map[string]string {
+	switch {
+	case sp.stateSyncIDSet:
+		return map[string]string{"stateSyncId": fmt.Sprintf("0x%x", sp.stateSyncID)}
+	case sp.exitIDSet:
+		return map[string]string{"exitId": fmt.Sprintf("0x%x", sp.exitID)}
+	default:
+		return map[string]string{"txHash": sp.txHashRaw}
+	}
+}
@@ -0,0 +1,71 @@
+package status
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_validateFlags(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		params *statusParams
+		args   map[string]string
+		err    error
+	}{
+		{
+			name:   "no transfer identifier",
+			params: &statusParams{},
+			err:    errInvalidTransferFlags,
+		},
+		{
+			name:   "multiple transfer identifiers",
+			params: &statusParams{stateSyncIDSet: true, txHashRaw: "0x1"},
+			err:    errInvalidTransferFlags,
+		},
+		{
+			name:   "invalid tx hash",
+			params: &statusParams{txHashRaw: "0x1"},
+			err:    errInvalidTxHash,
+		},
+		{
+			name:   "state sync id",
+			params: &statusParams{stateSyncID: 0, stateSyncIDSet: true},
+			args:   map[string]string{"stateSyncId": "0x0"},
+		},
+		{
+			name:   "exit id",
+			params: &statusParams{exitID: 26, exitIDSet: true},
+			args:   map[string]string{"exitId": "0x1a"},
+		},
+		{
+			name: "tx hash",
+			params: &statusParams{
+				txHashRaw: "0x9e1dfc5cf3f1e1b0c1f8a2b8c5c0f1e1d7a6b5c4d3e2f1a0b9c8d7e6f5a4b3c2",
+			},
+			args: map[string]string{
+				"txHash": "0x9e1dfc5cf3f1e1b0c1f8a2b8c5c0f1e1d7a6b5c4d3e2f1a0b9c8d7e6f5a4b3c2",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.params.validateFlags()
+			if c.err != nil {
+				require.ErrorIs(t, err, c.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, c.args, c.params.transferStatusArgs())
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package status
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type statusResult struct {
+	Transfers []*types.BridgeTransferStatus `json:"transfers"`
+}
+
+func (r *statusResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[BRIDGE TRANSFER STATUS]\n")
+
+	for i, transfer := range r.Transfers {
+		if i > 0 {
+			buffer.WriteString("\n")
+		}
+
+		buffer.WriteString(helper.FormatKV([]string{
+			fmt.Sprintf("Type|%s", transfer.Type),
+			fmt.Sprintf("ID|%d", transfer.ID),
+		}))
+		buffer.WriteString("\n")
+
+		vals := make([]string, 0, len(transfer.Stages)+1)
+		vals = append(vals, "Stage|Chain|Completed|Block|Transaction|Error")
+
+		for _, stage := range transfer.Stages {
+			chain := "child"
+			if stage.Rootchain {
+				chain = "root"
+			}
+
+			block, txHash := "-", "-"
+			if stage.BlockNumber != 0 {
+				block = fmt.Sprintf("%d", stage.BlockNumber)
+			}
+
+			if stage.TxHash != types.ZeroHash {
+				txHash = stage.TxHash.String()
+			}
+
+			vals = append(vals, fmt.Sprintf("%s|%s|%t|%s|%s|%s",
+				stage.Name, chain, stage.Completed, block, txHash, stage.Error))
+		}
+
+		buffer.WriteString(helper.FormatList(vals))
+		buffer.WriteString("\n")
+	}
+
+	return buffer.String()
+}
@@ -0,0 +1,95 @@
+package status
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// getTransferStatusFn is JSON RPC endpoint which returns the bridge transfer status
+	getTransferStatusFn = "bridge_getTransferStatus"
+)
+
+var (
+	params statusParams
+)
+
+// GetCommand returns the bridge status command
+func GetCommand() *cobra.Command {
+	statusCmd := &cobra.Command{
+		Use: "status",
+		Short: "Reports the status of bridge transfers, identified either by the state sync id, " +
+			"the exit id or the hash of the originating transaction",
+		PreRunE: preRunCommand,
+		Run:     runCommand,
+	}
+
+	setFlags(statusCmd)
+
+	return statusCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.jsonRPCAddress,
+		jsonRPCFlag,
+		"http://127.0.0.1:9545",
+		"the JSON RPC child chain endpoint",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.stateSyncID,
+		stateSyncIDFlag,
+		0,
+		"id of the state sync (deposit) transfer",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.exitID,
+		exitIDFlag,
+		0,
+		"id of the exit (withdrawal) transfer",
+	)
+
+	cmd.Flags().StringVar(
+		&params.txHashRaw,
+		txHashFlag,
+		"",
+		"hash of the transaction which originated the transfers (rootchain deposit or child chain withdrawal)",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(stateSyncIDFlag, exitIDFlag, txHashFlag)
+}
+
+func preRunCommand(cmd *cobra.Command, _ []string) error {
+	params.stateSyncIDSet = cmd.Flags().Changed(stateSyncIDFlag)
+	params.exitIDSet = cmd.Flags().Changed(exitIDFlag)
+
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	client, err := jsonrpc.NewEthClient(params.jsonRPCAddress)
+	if err != nil {
+		outputter.SetError(fmt.Errorf("could not create child chain JSON RPC client: %w", err))
+
+		return
+	}
+
+	var transfers []*types.BridgeTransferStatus
+	if err := client.EndpointCall(getTransferStatusFn, &transfers, params.transferStatusArgs()); err != nil {
+		outputter.SetError(fmt.Errorf("failed to get bridge transfer status: %w", err))
+
+		return
+	}
+
+	outputter.SetCommandResult(&statusResult{Transfers: transfers})
+}
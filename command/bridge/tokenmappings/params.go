@@ -0,0 +1,62 @@
+package tokenmappings
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	jsonRPCFlag   = "json-rpc"
+	tokenTypeFlag = "type"
+	offsetFlag    = "offset"
+	limitFlag     = "limit"
+
+	// defaultLimit is the default number of token mappings listed
+	defaultLimit = uint64(100)
+	// maxLimit is the maximum number of token mappings returned by a single JSON RPC call
+	maxLimit = uint64(1000)
+)
+
+var (
+	errInvalidLimit = fmt.Errorf("%s flag must be between 1 and %d", limitFlag, maxLimit)
+)
+
+type tokenMappingsParams struct {
+	jsonRPCAddress string
+	tokenType      string
+	offset         uint64
+	limit          uint64
+}
+
+func (tp *tokenMappingsParams) validateFlags() error {
+	if tp.limit == 0 || tp.limit > maxLimit {
+		return errInvalidLimit
+	}
+
+	if tp.tokenType == "" {
+		return nil
+	}
+
+	for _, tokenType := range types.BridgeTokenTypes {
+		if types.BridgeTokenType(tp.tokenType) == tokenType {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid token type: %s (expected one of %v)", tp.tokenType, types.BridgeTokenTypes)
+}
+
+// tokenMappingsArgs returns the arguments of the bridge_getTokenMappings JSON RPC call
+func (tp *tokenMappingsParams) tokenMappingsArgs() map[string]string {
+	args := map[string]string{
+		"offset": fmt.Sprintf("0x%x", tp.offset),
+		"limit":  fmt.Sprintf("0x%x", tp.limit),
+	}
+
+	if tp.tokenType != "" {
+		args["tokenType"] = tp.tokenType
+	}
+
+	return args
+}
@@ -0,0 +1,62 @@
+package tokenmappings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_validateFlags(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		params *tokenMappingsParams
+		args   map[string]string
+		err    string
+	}{
+		{
+			name:   "zero limit",
+			params: &tokenMappingsParams{},
+			err:    errInvalidLimit.Error(),
+		},
+		{
+			name:   "limit too big",
+			params: &tokenMappingsParams{limit: maxLimit + 1},
+			err:    errInvalidLimit.Error(),
+		},
+		{
+			name:   "invalid token type",
+			params: &tokenMappingsParams{limit: 1, tokenType: "erc777"},
+			err:    "invalid token type: erc777",
+		},
+		{
+			name:   "all token types",
+			params: &tokenMappingsParams{limit: defaultLimit},
+			args:   map[string]string{"offset": "0x0", "limit": "0x64"},
+		},
+		{
+			name:   "single token type",
+			params: &tokenMappingsParams{tokenType: "erc1155", offset: 20, limit: 10},
+			args:   map[string]string{"tokenType": "erc1155", "offset": "0x14", "limit": "0xa"},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.params.validateFlags()
+			if c.err != "" {
+				require.ErrorContains(t, err, c.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, c.args, c.params.tokenMappingsArgs())
+		})
+	}
+}
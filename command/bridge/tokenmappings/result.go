@@ -0,0 +1,57 @@
+package tokenmappings
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type tokenMappingsResult struct {
+	Offset uint64 `json:"offset"`
+	*types.BridgeTokenMappings
+}
+
+func (r *tokenMappingsResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[BRIDGE TOKEN MAPPINGS]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Total|%d", r.Total),
+		fmt.Sprintf("Listed|%d-%d", r.Offset+1, r.Offset+uint64(len(r.Mappings))),
+	}))
+	buffer.WriteString("\n")
+
+	if len(r.Mappings) == 0 {
+		return buffer.String()
+	}
+
+	vals := make([]string, 0, len(r.Mappings)+1)
+	vals = append(vals, "Type|Root Token|Child Token|Origin|Root Block|Child Block")
+
+	for _, mapping := range r.Mappings {
+		origin := "root"
+		if mapping.ChildOrigin {
+			origin = "child"
+		}
+
+		vals = append(vals, fmt.Sprintf("%s|%s|%s|%s|%s|%s",
+			mapping.TokenType, mapping.RootToken, mapping.ChildToken, origin,
+			formatBlock(mapping.RootBlock), formatBlock(mapping.ChildBlock)))
+	}
+
+	buffer.WriteString(helper.FormatList(vals))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
+
+// formatBlock formats the block number, where zero denotes the mapping which has not happened yet on that chain
+func formatBlock(block uint64) string {
+	if block == 0 {
+		return "-"
+	}
+
+	return fmt.Sprintf("%d", block)
+}
@@ -0,0 +1,93 @@
+package tokenmappings
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// getTokenMappingsFn is JSON RPC endpoint which returns the token mappings of the bridge predicates
+	getTokenMappingsFn = "bridge_getTokenMappings"
+)
+
+var (
+	params tokenMappingsParams
+)
+
+// GetCommand returns the bridge token-mappings command
+func GetCommand() *cobra.Command {
+	tokenMappingsCmd := &cobra.Command{
+		Use: "token-mappings",
+		Short: "Lists the rootchain tokens mapped to the child chain tokens by the bridge predicates, " +
+			"along with the blocks in which the mappings happened",
+		PreRunE: preRunCommand,
+		Run:     runCommand,
+	}
+
+	setFlags(tokenMappingsCmd)
+
+	return tokenMappingsCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.jsonRPCAddress,
+		jsonRPCFlag,
+		"http://127.0.0.1:9545",
+		"the JSON RPC child chain endpoint",
+	)
+
+	cmd.Flags().StringVar(
+		&params.tokenType,
+		tokenTypeFlag,
+		"",
+		fmt.Sprintf("type of the listed tokens %v (all the types are listed if omitted)", types.BridgeTokenTypes),
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.offset,
+		offsetFlag,
+		0,
+		"number of token mappings to skip",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.limit,
+		limitFlag,
+		defaultLimit,
+		"maximum number of listed token mappings",
+	)
+}
+
+func preRunCommand(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	client, err := jsonrpc.NewEthClient(params.jsonRPCAddress)
+	if err != nil {
+		outputter.SetError(fmt.Errorf("could not create child chain JSON RPC client: %w", err))
+
+		return
+	}
+
+	var mappings *types.BridgeTokenMappings
+	if err := client.EndpointCall(getTokenMappingsFn, &mappings, params.tokenMappingsArgs()); err != nil {
+		outputter.SetError(fmt.Errorf("failed to get token mappings: %w", err))
+
+		return
+	}
+
+	outputter.SetCommandResult(&tokenMappingsResult{
+		Offset:              params.offset,
+		BridgeTokenMappings: mappings,
+	})
+}
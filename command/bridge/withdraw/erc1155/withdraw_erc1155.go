@@ -62,10 +62,6 @@ func GetCommand() *cobra.Command {
 		"ERC 1155 child chain token address",
 	)
 
-	_ = withdrawCmd.MarkFlagRequired(common.ReceiversFlag)
-	_ = withdrawCmd.MarkFlagRequired(common.AmountsFlag)
-	_ = withdrawCmd.MarkFlagRequired(common.TokenIDsFlag)
-
 	return withdrawCmd
 }
 
@@ -92,7 +88,8 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	}
 
 	txRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(wp.JSONRPCAddr),
-		txrelayer.WithReceiptsTimeout(wp.TxTimeout))
+		txrelayer.WithReceiptsTimeout(wp.TxTimeout),
+		txrelayer.WithNonceTracker(txrelayer.NewNonceTracker()))
 	if err != nil {
 		outputter.SetError(fmt.Errorf("could not create child chain tx relayer: %w", err))
 
@@ -126,6 +123,22 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		tokenIDs[i] = tokenID
 	}
 
+	if wp.IsBatch() {
+		res, err := wp.SendBatchTransfers(cmd.Context(), txRelayer, senderAccount, "WITHDRAW ERC 1155",
+			!wp.ChildChainMintable, func(i int) (*types.Transaction, error) {
+				return createWithdrawTxn([]types.Address{receivers[i]}, []*big.Int{amounts[i]}, []*big.Int{tokenIDs[i]})
+			})
+		if err != nil {
+			outputter.SetError(fmt.Errorf("sending withdrawal transactions failed: %w", err))
+
+			return
+		}
+
+		outputter.SetCommandResult(res)
+
+		return
+	}
+
 	// withdraw tokens transaction
 	txn, err := createWithdrawTxn(receivers, amounts, tokenIDs)
 	if err != nil {
@@ -54,9 +54,6 @@ func GetCommand() *cobra.Command {
 		"child ERC 20 token address",
 	)
 
-	_ = withdrawCmd.MarkFlagRequired(common.ReceiversFlag)
-	_ = withdrawCmd.MarkFlagRequired(common.AmountsFlag)
-
 	return withdrawCmd
 }
 
@@ -85,6 +82,7 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	txRelayer, err := txrelayer.NewTxRelayer(
 		txrelayer.WithIPAddress(wp.JSONRPCAddr),
 		txrelayer.WithReceiptsTimeout(wp.TxTimeout),
+		txrelayer.WithNonceTracker(txrelayer.NewNonceTracker()),
 		txrelayer.WithWriter(outputter),
 	)
 	if err != nil {
@@ -93,6 +91,27 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		return
 	}
 
+	if wp.IsBatch() {
+		res, err := wp.SendBatchTransfers(cmd.Context(), txRelayer, senderAccount, "WITHDRAW ERC 20",
+			!wp.ChildChainMintable, func(i int) (*types.Transaction, error) {
+				amount, err := helperCommon.ParseUint256orHex(&wp.Amounts[i])
+				if err != nil {
+					return nil, fmt.Errorf("failed to decode provided amount %s: %w", wp.Amounts[i], err)
+				}
+
+				return createWithdrawTxn(types.StringToAddress(wp.Receivers[i]), amount)
+			})
+		if err != nil {
+			outputter.SetError(fmt.Errorf("sending withdrawal transactions failed: %w", err))
+
+			return
+		}
+
+		outputter.SetCommandResult(res)
+
+		return
+	}
+
 	exitEventIDs := make([]*big.Int, 0, len(wp.Receivers))
 	blockNumbers := make([]uint64, len(wp.Receivers))
 
@@ -54,8 +54,6 @@ func GetCommand() *cobra.Command {
 	)
 
 	_ = withdrawCmd.MarkFlagRequired(common.SenderKeyFlag)
-	_ = withdrawCmd.MarkFlagRequired(common.ReceiversFlag)
-	_ = withdrawCmd.MarkFlagRequired(common.TokenIDsFlag)
 
 	return withdrawCmd
 }
@@ -83,7 +81,8 @@ func run(cmd *cobra.Command, _ []string) {
 	}
 
 	txRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(wp.JSONRPCAddr),
-		txrelayer.WithReceiptsTimeout(wp.TxTimeout))
+		txrelayer.WithReceiptsTimeout(wp.TxTimeout),
+		txrelayer.WithNonceTracker(txrelayer.NewNonceTracker()))
 	if err != nil {
 		outputter.SetError(fmt.Errorf("could not create child chain tx relayer: %w", err))
 
@@ -107,6 +106,22 @@ func run(cmd *cobra.Command, _ []string) {
 		tokenIDs[i] = tokenID
 	}
 
+	if wp.IsBatch() {
+		res, err := wp.SendBatchTransfers(cmd.Context(), txRelayer, senderAccount, "WITHDRAW ERC 721",
+			!wp.ChildChainMintable, func(i int) (*types.Transaction, error) {
+				return createWithdrawTxn([]types.Address{receivers[i]}, []*big.Int{tokenIDs[i]})
+			})
+		if err != nil {
+			outputter.SetError(fmt.Errorf("sending withdrawal transactions failed: %w", err))
+
+			return
+		}
+
+		outputter.SetCommandResult(res)
+
+		return
+	}
+
 	txn, err := createWithdrawTxn(receivers, tokenIDs)
 	if err != nil {
 		outputter.SetError(fmt.Errorf("failed to create tx input: %w", err))
@@ -0,0 +1,28 @@
+package contracts
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command/contracts/upgrade"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+// GetCommand creates "contracts" helper command
+func GetCommand() *cobra.Command {
+	contractsCmd := &cobra.Command{
+		Use:   "contracts",
+		Short: "Top level command for managing the genesis deployed (proxied) contracts",
+	}
+
+	helper.RegisterJSONRPCFlag(contractsCmd)
+	registerSubcommands(contractsCmd)
+
+	return contractsCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// contracts upgrade
+		upgrade.GetCommand(),
+	)
+}
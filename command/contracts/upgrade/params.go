@@ -0,0 +1,92 @@
+package upgrade
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Ethernal-Tech/ethgo/abi"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	validatorHelper "github.com/0xPolygon/polygon-edge/command/validator/helper"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	proxyAddrFlag              = "proxy-addr"
+	artifactFlag               = "artifact"
+	currentArtifactFlag        = "current-artifact"
+	dataFlag                   = "data"
+	unsafeSkipStorageCheckFlag = "unsafe-skip-storage-check"
+)
+
+var (
+	// implementationSlot is the EIP-1967 storage slot of the proxy which holds the implementation address
+	// (keccak256("eip1967.proxy.implementation") - 1)
+	implementationSlot = types.StringToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	// adminSlot is the EIP-1967 storage slot of the proxy which holds the admin address
+	// (keccak256("eip1967.proxy.admin") - 1)
+	adminSlot = types.StringToHash("0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103")
+
+	// upgradeToMethod and upgradeToAndCallMethod are dispatched by the transparent proxy (GenesisProxy)
+	// only when invoked by the proxy admin, so they are not part of the proxy artifact ABI
+	upgradeToMethod        = abi.MustNewMethod("function upgradeTo(address newImplementation)")
+	upgradeToAndCallMethod = abi.MustNewMethod("function upgradeToAndCall(address newImplementation, bytes data)")
+
+	errNoCurrentArtifact = errors.New("current implementation artifact is required to verify storage layout " +
+		"compatibility, unless the check is explicitly skipped")
+)
+
+type upgradeParams struct {
+	accountDir             string
+	accountConfig          string
+	privateKey             string
+	jsonRPC                string
+	proxyAddr              string
+	artifact               string
+	currentArtifact        string
+	data                   string
+	unsafeSkipStorageCheck bool
+	txTimeout              time.Duration
+
+	proxy    types.Address
+	callData []byte
+}
+
+func (up *upgradeParams) validateFlags() (err error) {
+	if up.proxy, err = types.IsValidAddress(up.proxyAddr, false); err != nil {
+		return fmt.Errorf("invalid proxy address is provided: %w", err)
+	}
+
+	if up.currentArtifact == "" && !up.unsafeSkipStorageCheck {
+		return errNoCurrentArtifact
+	}
+
+	if up.data != "" {
+		if up.callData, err = hex.DecodeHex(up.data); err != nil {
+			return fmt.Errorf("invalid upgrade call data is provided: %w", err)
+		}
+	}
+
+	// validate jsonrpc address
+	if _, err := helper.ParseJSONRPCAddress(up.jsonRPC); err != nil {
+		return fmt.Errorf("failed to parse json rpc address. Error: %w", err)
+	}
+
+	if up.privateKey == "" {
+		return validatorHelper.ValidateSecretFlags(up.accountDir, up.accountConfig)
+	}
+
+	return nil
+}
+
+// upgradeInput encodes the proxy upgrade call, which also calls the new implementation
+// with the provided data (e.g. reinitializer), if any
+func (up *upgradeParams) upgradeInput(implementation types.Address) ([]byte, error) {
+	if len(up.callData) == 0 {
+		return upgradeToMethod.Encode([]interface{}{implementation})
+	}
+
+	return upgradeToAndCallMethod.Encode([]interface{}{implementation, up.callData})
+}
@@ -0,0 +1,90 @@
+package upgrade
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func Test_validateFlags(t *testing.T) {
+	t.Parallel()
+
+	const (
+		proxy      = "0x0000000000000000000000000000000000001010"
+		privateKey = "aa75e9a7d427efc732f8e4f1a5b7646adcc61fd5bae40f80d13c8419c9f43d6d"
+		jsonRPC    = "http://127.0.0.1:8545"
+	)
+
+	cases := []struct {
+		name   string
+		params *upgradeParams
+		err    string
+	}{
+		{
+			name: "valid",
+			params: &upgradeParams{proxyAddr: proxy, currentArtifact: "Bridge.json", privateKey: privateKey,
+				jsonRPC: jsonRPC, data: "0x8129fc1c"},
+		},
+		{
+			name: "skipped storage check",
+			params: &upgradeParams{proxyAddr: proxy, unsafeSkipStorageCheck: true, privateKey: privateKey,
+				jsonRPC: jsonRPC},
+		},
+		{
+			name:   "invalid proxy",
+			params: &upgradeParams{proxyAddr: "0x12", currentArtifact: "Bridge.json", privateKey: privateKey},
+			err:    "invalid proxy address",
+		},
+		{
+			name:   "missing current artifact",
+			params: &upgradeParams{proxyAddr: proxy, privateKey: privateKey, jsonRPC: jsonRPC},
+			err:    errNoCurrentArtifact.Error(),
+		},
+		{
+			name: "invalid call data",
+			params: &upgradeParams{proxyAddr: proxy, currentArtifact: "Bridge.json", privateKey: privateKey,
+				jsonRPC: jsonRPC, data: "0xzz"},
+			err: "invalid upgrade call data",
+		},
+		{
+			name:   "missing secrets",
+			params: &upgradeParams{proxyAddr: proxy, currentArtifact: "Bridge.json", jsonRPC: jsonRPC},
+			err:    "no config file or data directory passed in",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.params.validateFlags()
+			if c.err == "" {
+				require.NoError(t, err)
+				require.Equal(t, types.StringToAddress(proxy), c.params.proxy)
+			} else {
+				require.ErrorContains(t, err, c.err)
+			}
+		})
+	}
+}
+
+func Test_upgradeInput(t *testing.T) {
+	t.Parallel()
+
+	implementation := types.StringToAddress("0x1234")
+
+	input, err := (&upgradeParams{}).upgradeInput(implementation)
+	require.NoError(t, err)
+	require.Equal(t, upgradeToMethod.ID(), input[:4])
+	require.Len(t, input, 4+32)
+	require.Equal(t, implementation.Bytes(), input[4+12:])
+
+	input, err = (&upgradeParams{callData: []byte{0x81, 0x29, 0xfc, 0x1c}}).upgradeInput(implementation)
+	require.NoError(t, err)
+	require.Equal(t, upgradeToAndCallMethod.ID(), input[:4])
+	require.Equal(t, implementation.Bytes(), input[4+12:4+32])
+}
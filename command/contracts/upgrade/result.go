@@ -0,0 +1,36 @@
+package upgrade
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type upgradeResult struct {
+	Proxy                  string `json:"proxy"`
+	PreviousImplementation string `json:"previousImplementation"`
+	NewImplementation      string `json:"newImplementation"`
+	DeployTxHash           string `json:"deployTxHash"`
+	UpgradeTxHash          string `json:"upgradeTxHash"`
+	StorageLayoutChecked   bool   `json:"storageLayoutChecked"`
+}
+
+func (r *upgradeResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	vals := []string{
+		fmt.Sprintf("Proxy|%s", r.Proxy),
+		fmt.Sprintf("Previous Implementation|%s", r.PreviousImplementation),
+		fmt.Sprintf("New Implementation|%s", r.NewImplementation),
+		fmt.Sprintf("Deploy Tx Hash|%s", r.DeployTxHash),
+		fmt.Sprintf("Upgrade Tx Hash|%s", r.UpgradeTxHash),
+		fmt.Sprintf("Storage Layout Checked|%t", r.StorageLayoutChecked),
+	}
+
+	buffer.WriteString("\n[CONTRACT UPGRADE]\n")
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
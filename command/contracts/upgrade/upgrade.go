@@ -0,0 +1,263 @@
+package upgrade
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	bridgeHelper "github.com/0xPolygon/polygon-edge/command/bridge/helper"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	polybftsecrets "github.com/0xPolygon/polygon-edge/command/secrets/init"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
+	"github.com/0xPolygon/polygon-edge/txrelayer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	params upgradeParams
+)
+
+// GetCommand returns the contracts upgrade command
+func GetCommand() *cobra.Command {
+	upgradeCmd := &cobra.Command{
+		Use: "upgrade",
+		Short: "Upgrades the implementation of the genesis deployed proxy contract. " +
+			"Deploys the new implementation, verifies its storage layout against the current one " +
+			"and submits the upgrade from the proxy admin account.",
+		PreRunE: runPreRun,
+		RunE:    runCommand,
+	}
+
+	setFlags(upgradeCmd)
+
+	return upgradeCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.accountDir,
+		polybftsecrets.AccountDirFlag,
+		"",
+		polybftsecrets.AccountDirFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.accountConfig,
+		polybftsecrets.AccountConfigFlag,
+		"",
+		polybftsecrets.AccountConfigFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.privateKey,
+		polybftsecrets.PrivateKeyFlag,
+		"",
+		polybftsecrets.PrivateKeyFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.proxyAddr,
+		proxyAddrFlag,
+		"",
+		"address of the proxy contract to upgrade",
+	)
+
+	cmd.Flags().StringVar(
+		&params.artifact,
+		artifactFlag,
+		"",
+		"path to the artifact (json) of the new implementation contract",
+	)
+
+	cmd.Flags().StringVar(
+		&params.currentArtifact,
+		currentArtifactFlag,
+		"",
+		"path to the artifact (json) of the current implementation contract, "+
+			"used to verify storage layout compatibility",
+	)
+
+	cmd.Flags().StringVar(
+		&params.data,
+		dataFlag,
+		"",
+		"hex encoded call data executed on the new implementation as part of the upgrade (upgradeToAndCall)",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.unsafeSkipStorageCheck,
+		unsafeSkipStorageCheckFlag,
+		false,
+		"skip the storage layout compatibility check of the new implementation (unsafe)",
+	)
+
+	cmd.Flags().DurationVar(
+		&params.txTimeout,
+		helper.TxTimeoutFlag,
+		150*time.Second,
+		helper.TxTimeoutDesc,
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountDirFlag, polybftsecrets.AccountConfigFlag)
+	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.PrivateKeyFlag, polybftsecrets.AccountConfigFlag)
+	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.PrivateKeyFlag, polybftsecrets.AccountDirFlag)
+	_ = cmd.MarkFlagRequired(proxyAddrFlag)
+	_ = cmd.MarkFlagRequired(artifactFlag)
+}
+
+func runPreRun(cmd *cobra.Command, _ []string) error {
+	params.jsonRPC = helper.GetJSONRPCAddress(cmd)
+
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) error {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	adminKey, err := bridgeHelper.GetECDSAKey(params.privateKey, params.accountDir, params.accountConfig)
+	if err != nil {
+		return fmt.Errorf("failed to initialize proxy admin key: %w", err)
+	}
+
+	newArtifact, err := contracts.LoadArtifactFromFile(params.artifact)
+	if err != nil {
+		return err
+	}
+
+	txRelayer, err := txrelayer.NewTxRelayer(
+		txrelayer.WithIPAddress(params.jsonRPC),
+		txrelayer.WithReceiptsTimeout(params.txTimeout),
+		txrelayer.WithWriter(outputter),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create tx relayer: %w", err)
+	}
+
+	client := txRelayer.Client()
+
+	currentImpl, err := getSlotAddress(client, params.proxy, implementationSlot)
+	if err != nil {
+		return err
+	}
+
+	if currentImpl == types.ZeroAddress {
+		return fmt.Errorf("contract %s is not a proxy, implementation address is not set", params.proxy)
+	}
+
+	proxyAdmin, err := getSlotAddress(client, params.proxy, adminSlot)
+	if err != nil {
+		return err
+	}
+
+	if proxyAdmin != adminKey.Address() {
+		return fmt.Errorf("account %s is not admin of the proxy %s (admin is %s)",
+			adminKey.Address(), params.proxy, proxyAdmin)
+	}
+
+	if !params.unsafeSkipStorageCheck {
+		if err := checkStorageLayout(client, currentImpl, newArtifact); err != nil {
+			return err
+		}
+	}
+
+	deployTxn := bridgeHelper.CreateTransaction(types.ZeroAddress, nil, newArtifact.Bytecode, nil, true)
+
+	receipt, err := txRelayer.SendTransaction(deployTxn, adminKey)
+	if err != nil {
+		return fmt.Errorf("failed sending new implementation deploy transaction: %w", err)
+	}
+
+	if receipt == nil || receipt.Status != uint64(types.ReceiptSuccess) {
+		return errors.New("deployment of new implementation contract failed")
+	}
+
+	newImpl := types.Address(receipt.ContractAddress)
+	deployTxHash := types.Hash(receipt.TransactionHash)
+
+	input, err := params.upgradeInput(newImpl)
+	if err != nil {
+		return fmt.Errorf("failed to encode proxy upgrade: %w", err)
+	}
+
+	receipt, err = bridgeHelper.SendTransaction(txRelayer, params.proxy, input, "GenesisProxy", adminKey)
+	if err != nil {
+		return err
+	}
+
+	upgradedImpl, err := getSlotAddress(client, params.proxy, implementationSlot)
+	if err != nil {
+		return err
+	}
+
+	if upgradedImpl != newImpl {
+		return fmt.Errorf("proxy %s implementation is %s after the upgrade, expected %s",
+			params.proxy, upgradedImpl, newImpl)
+	}
+
+	outputter.SetCommandResult(&upgradeResult{
+		Proxy:                  params.proxy.String(),
+		PreviousImplementation: currentImpl.String(),
+		NewImplementation:      newImpl.String(),
+		DeployTxHash:           deployTxHash.String(),
+		UpgradeTxHash:          types.Hash(receipt.TransactionHash).String(),
+		StorageLayoutChecked:   !params.unsafeSkipStorageCheck,
+	})
+
+	return nil
+}
+
+// checkStorageLayout verifies that the current implementation matches the provided current artifact
+// and that the storage layout of the new implementation is compatible with it
+func checkStorageLayout(client *jsonrpc.EthClient, currentImpl types.Address,
+	newArtifact *contracts.Artifact) error {
+	currentArtifact, err := contracts.LoadArtifactFromFile(params.currentArtifact)
+	if err != nil {
+		return err
+	}
+
+	code, err := client.GetCode(currentImpl, jsonrpc.LatestBlockNumberOrHash)
+	if err != nil {
+		return fmt.Errorf("failed to get code of current implementation %s: %w", currentImpl, err)
+	}
+
+	rawCode, err := hex.DecodeHex(code)
+	if err != nil {
+		return fmt.Errorf("invalid code of current implementation %s: %w", currentImpl, err)
+	}
+
+	if !bytes.Equal(rawCode, currentArtifact.DeployedBytecode) {
+		return fmt.Errorf("code of current implementation %s does not match the artifact %s",
+			currentImpl, params.currentArtifact)
+	}
+
+	if currentArtifact.StorageLayout == nil {
+		return fmt.Errorf("artifact %s does not contain storage layout", params.currentArtifact)
+	}
+
+	if newArtifact.StorageLayout == nil {
+		return fmt.Errorf("artifact %s does not contain storage layout", params.artifact)
+	}
+
+	if err := currentArtifact.StorageLayout.CheckUpgrade(newArtifact.StorageLayout); err != nil {
+		return fmt.Errorf("storage layout of the new implementation is not compatible: %w", err)
+	}
+
+	return nil
+}
+
+// getSlotAddress returns the address stored in the given storage slot of the contract
+func getSlotAddress(client *jsonrpc.EthClient, contract types.Address, slot types.Hash) (types.Address, error) {
+	value, err := client.GetStorageAt(contract, slot, jsonrpc.LatestBlockNumberOrHash)
+	if err != nil {
+		return types.ZeroAddress, fmt.Errorf("failed to get storage slot %s of %s: %w", slot, contract, err)
+	}
+
+	return types.BytesToAddress(value.Bytes()), nil
+}
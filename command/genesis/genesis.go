@@ -32,12 +32,11 @@ func GetCommand() *cobra.Command {
 }
 
 func setFlags(cmd *cobra.Command) {
-	// apex config flag
-	cmd.Flags().Uint8Var(
-		&params.apexConfig,
-		apexConfigFlag,
-		ApexConfigDefault,
-		apexConfigDescriptionFlag,
+	cmd.Flags().StringVar(
+		&params.profile,
+		profileFlag,
+		ProfileApexBridge,
+		profileDescriptionFlag,
 	)
 
 	cmd.Flags().Int64Var(
@@ -274,6 +273,13 @@ func setFlags(cmd *cobra.Command) {
 			contracts.NativeERC20TokenContract.String(),
 			"stake token address",
 		)
+
+		cmd.Flags().StringVar(
+			&params.txOrderingConfigPath,
+			txOrderingConfigFlag,
+			"",
+			"path to the JSON file defining the priority lanes used for transactions ordering in the block",
+		)
 	}
 
 	// Governance
@@ -395,7 +401,9 @@ func setFlags(cmd *cobra.Command) {
 	}
 }
 
-func preRunCommand(_ *cobra.Command, _ []string) error {
+func preRunCommand(cmd *cobra.Command, _ []string) error {
+	params.blockGasLimitSet = cmd.Flags().Changed(blockGasLimitFlag)
+
 	return params.validateFlags()
 }
 
@@ -409,7 +417,8 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		var chainConfig *chain.Chain
 
 		if chainConfig, err = params.generateChainConfig(outputter); err == nil {
-			params.processConfigApex(chainConfig)
+			params.genesisProfile.applyToChainConfig(chainConfig)
+			params.profileResult = newGenesisProfileResult(params.genesisProfile, chainConfig)
 
 			err = helper.WriteGenesisConfigToDisk(chainConfig, params.genesisPath)
 		}
@@ -39,6 +39,7 @@ const (
 	proposalQuorumFlag           = "proposal-quorum"
 	stakeTokenFlag               = "stake-token"
 	bootnodeStartingPortFlag     = "bootnode-port"
+	txOrderingConfigFlag         = "tx-ordering-config"
 )
 
 var (
@@ -72,7 +73,8 @@ type genesisParams struct {
 	chainID   uint64
 	epochSize uint64
 
-	blockGasLimit uint64
+	blockGasLimit    uint64
+	blockGasLimitSet bool
 
 	burnContract        string
 	baseFeeConfig       string
@@ -139,7 +141,12 @@ type genesisParams struct {
 
 	bootnodeStartingPort int64
 
-	apexConfig uint8
+	txOrderingConfigPath string
+	txOrderingConfig     *polybft.TxOrderingConfig
+
+	profile        string
+	genesisProfile *GenesisProfile
+	profileResult  *GenesisProfileResult
 }
 
 func (p *genesisParams) validateFlags() error {
@@ -205,6 +212,18 @@ func (p *genesisParams) validateFlags() error {
 		if err != nil {
 			return fmt.Errorf("stake token address is not a valid address: %w", err)
 		}
+
+		if p.txOrderingConfigPath != "" {
+			if p.txOrderingConfig, err = polybft.LoadTxOrderingConfig(p.txOrderingConfigPath); err != nil {
+				return err
+			}
+		}
+
+		if p.genesisProfile, err = LoadGenesisProfile(p.profile); err != nil {
+			return err
+		}
+
+		p.genesisProfile.applyToParams(p)
 	}
 
 	// Validate validatorsPath only if validators information were not provided via CLI flag
@@ -353,5 +372,6 @@ func (p *genesisParams) validateGenesisBaseFeeConfig() error {
 func (p *genesisParams) getResult() command.CommandResult {
 	return &GenesisResult{
 		Message: fmt.Sprintf("\nGenesis written to %s\n", p.genesisPath),
+		Profile: p.profileResult,
 	}
 }
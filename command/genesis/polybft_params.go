@@ -195,7 +195,9 @@ func (p *genesisParams) generateChainConfig(o command.OutputFormatter) (*chain.C
 			NetworkParamsAddr: contracts.NetworkParamsContract,
 			ForkParamsAddr:    contracts.ForkParamsContract,
 		},
-		StakeTokenAddr: p.stakeTokenAddr,
+		StakeTokenAddr:     p.stakeTokenAddr,
+		TxOrdering:         p.txOrderingConfig,
+		ApexBridgeDisabled: !p.genesisProfile.HasPredeploy(apexBridgePredeploy),
 	}
 
 	// Disable london hardfork if burn contract address is not provided
@@ -372,7 +374,9 @@ func (p *genesisParams) deployContracts(rewardTokenByteCode []byte,
 		proxyAddresses = append(proxyAddresses, proxyAddr)
 	}
 
-	proxyAddresses = append(proxyAddresses, getApexProxyAddresses()...)
+	if p.genesisProfile.HasPredeploy(apexBridgePredeploy) {
+		proxyAddresses = append(proxyAddresses, getApexProxyAddresses()...)
+	}
 
 	genesisContracts := []*contractInfo{
 		{
@@ -436,7 +440,9 @@ func (p *genesisParams) deployContracts(rewardTokenByteCode []byte,
 		},
 	}
 
-	genesisContracts = append(genesisContracts, getApexContracts()...)
+	if p.genesisProfile.HasPredeploy(apexBridgePredeploy) {
+		genesisContracts = append(genesisContracts, getApexContracts()...)
+	}
 
 	if !params.nativeTokenConfig.IsMintable {
 		genesisContracts = append(genesisContracts,
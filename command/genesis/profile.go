@@ -0,0 +1,319 @@
+package genesis
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	profileFlag            = "profile"
+	profileDescriptionFlag = "built-in genesis profile (apex-bridge | blade | nexus | custom) or path to the genesis " +
+		"profile JSON file, which declares forks, precompiles, predeploys, gas limit, burn contract " +
+		"and address lists of the chain. Burn contract of the profile overrides the flag, " +
+		"while block gas limit flag, if provided, overrides gas limit of the profile"
+
+	ProfileApexBridge = "apex-bridge"
+	ProfileBlade      = "blade"
+	ProfileNexus      = "nexus"
+	ProfileCustom     = "custom"
+
+	// apexBridgePredeploy is the predeploy of apex bridge contracts together with their proxies
+	apexBridgePredeploy = "apexBridge"
+
+	profilesDir = "profiles"
+)
+
+var (
+	//go:embed profiles/*.json
+	builtinProfiles embed.FS
+
+	// precompileForks are the forks which enable the precompiles
+	precompileForks = []string{chain.CardanoPrecompiles, chain.PrecompileGasSchedule}
+
+	// predeploys are the predeploys which can be declared by the profile
+	predeploys = []string{apexBridgePredeploy}
+
+	errProfileNameNotProvided = errors.New("genesis profile name must be set")
+)
+
+// GenesisProfile declares the kind of the chain generated by the genesis command
+type GenesisProfile struct {
+	// Name is the name of the profile
+	Name string `json:"name"`
+
+	// Description describes the chain generated by the profile
+	Description string `json:"description"`
+
+	// DisabledForks are the forks which are not enabled in genesis
+	DisabledForks []string `json:"disabledForks"`
+
+	// DisabledPrecompiles are the precompile forks which are not enabled in genesis
+	DisabledPrecompiles []string `json:"disabledPrecompiles"`
+
+	// Predeploys are the contract sets (implementations and proxies) deployed in genesis
+	Predeploys []string `json:"predeploys"`
+
+	// GasLimit is the block gas limit of genesis, block gas limit flag is used if not set
+	// and takes precedence if provided
+	GasLimit uint64 `json:"gasLimit,omitempty"`
+
+	// BurnContract are the burn contracts by the starting block, burn contract flag is used if not set,
+	// and burn contract is disabled if empty
+	BurnContract map[uint64]types.Address `json:"burnContract,omitempty"`
+
+	// AddressLists are the access lists enabled in genesis, address list flags are used for lists not set
+	AddressLists *GenesisProfileAddressLists `json:"addressLists,omitempty"`
+
+	// source is the built-in profile name or the path of the profile file
+	source string
+}
+
+// GenesisProfileAddressLists are the access lists declared by the genesis profile
+type GenesisProfileAddressLists struct {
+	ContractDeployerAllowList *chain.AddressListConfig `json:"contractDeployerAllowList,omitempty"`
+	ContractDeployerBlockList *chain.AddressListConfig `json:"contractDeployerBlockList,omitempty"`
+	TransactionsAllowList     *chain.AddressListConfig `json:"transactionsAllowList,omitempty"`
+	TransactionsBlockList     *chain.AddressListConfig `json:"transactionsBlockList,omitempty"`
+	BridgeAllowList           *chain.AddressListConfig `json:"bridgeAllowList,omitempty"`
+	BridgeBlockList           *chain.AddressListConfig `json:"bridgeBlockList,omitempty"`
+}
+
+// LoadGenesisProfile loads the built-in genesis profile by its name or the genesis profile file by its path
+func LoadGenesisProfile(nameOrPath string) (*GenesisProfile, error) {
+	source := fmt.Sprintf("built-in %s", nameOrPath)
+
+	raw, err := builtinProfiles.ReadFile(path.Join(profilesDir, nameOrPath+".json"))
+	if err != nil {
+		source = nameOrPath
+
+		if raw, err = os.ReadFile(nameOrPath); err != nil {
+			return nil, fmt.Errorf("genesis profile %s is neither built-in profile (%s) nor readable file: %w",
+				nameOrPath, strings.Join(GetBuiltinProfileNames(), ", "), err)
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+
+	var profile GenesisProfile
+	if err := decoder.Decode(&profile); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal genesis profile %s: %w", nameOrPath, err)
+	}
+
+	if err := profile.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid genesis profile %s: %w", nameOrPath, err)
+	}
+
+	profile.source = source
+
+	return &profile, nil
+}
+
+// GetBuiltinProfileNames returns names of the built-in genesis profiles
+func GetBuiltinProfileNames() []string {
+	entries, err := builtinProfiles.ReadDir(profilesDir)
+	if err != nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, strings.TrimSuffix(entry.Name(), path.Ext(entry.Name())))
+	}
+
+	return names
+}
+
+// Validate validates the genesis profile
+func (gp *GenesisProfile) Validate() error {
+	if gp.Name == "" {
+		return errProfileNameNotProvided
+	}
+
+	for _, fork := range gp.DisabledForks {
+		if _, exists := (*chain.AllForksEnabled)[fork]; !exists || slices.Contains(precompileForks, fork) {
+			return fmt.Errorf("unknown fork %s", fork)
+		}
+	}
+
+	for _, fork := range gp.DisabledPrecompiles {
+		if !slices.Contains(precompileForks, fork) {
+			return fmt.Errorf("unknown precompiles %s, expected one of: %s",
+				fork, strings.Join(precompileForks, ", "))
+		}
+	}
+
+	for _, predeploy := range gp.Predeploys {
+		if !slices.Contains(predeploys, predeploy) {
+			return fmt.Errorf("unknown predeploy %s, expected one of: %s", predeploy, strings.Join(predeploys, ", "))
+		}
+	}
+
+	if gp.AddressLists != nil {
+		for name, list := range gp.AddressLists.all() {
+			// list could never be updated without admin
+			if list != nil && len(list.AdminAddresses) == 0 {
+				return fmt.Errorf("address list %s must have at least one admin address", name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// HasPredeploy returns true if the profile declares the predeploy
+func (gp *GenesisProfile) HasPredeploy(predeploy string) bool {
+	return slices.Contains(gp.Predeploys, predeploy)
+}
+
+// applyToParams applies the values of the profile which are provided as genesis flags,
+// so that the genesis is generated by them
+func (gp *GenesisProfile) applyToParams(p *genesisParams) {
+	// block gas limit flag takes precedence over the profile
+	if gp.GasLimit != 0 && !p.blockGasLimitSet {
+		p.blockGasLimit = gp.GasLimit
+	}
+
+	if gp.AddressLists == nil {
+		return
+	}
+
+	applyList := func(list *chain.AddressListConfig, admins, enabled *[]string) {
+		// address list flags take precedence over the profile
+		if list == nil || len(*admins) != 0 {
+			return
+		}
+
+		*admins = addressSliceToStringSlice(list.AdminAddresses)
+		*enabled = addressSliceToStringSlice(list.EnabledAddresses)
+	}
+
+	lists := gp.AddressLists
+	applyList(lists.ContractDeployerAllowList, &p.contractDeployerAllowListAdmin, &p.contractDeployerAllowListEnabled)
+	applyList(lists.ContractDeployerBlockList, &p.contractDeployerBlockListAdmin, &p.contractDeployerBlockListEnabled)
+	applyList(lists.TransactionsAllowList, &p.transactionsAllowListAdmin, &p.transactionsAllowListEnabled)
+	applyList(lists.TransactionsBlockList, &p.transactionsBlockListAdmin, &p.transactionsBlockListEnabled)
+	applyList(lists.BridgeAllowList, &p.bridgeAllowListAdmin, &p.bridgeAllowListEnabled)
+	applyList(lists.BridgeBlockList, &p.bridgeBlockListAdmin, &p.bridgeBlockListEnabled)
+}
+
+// applyToChainConfig applies forks, precompiles and burn contract of the profile to the generated chain config
+func (gp *GenesisProfile) applyToChainConfig(chainConfig *chain.Chain) {
+	for _, fork := range gp.DisabledForks {
+		chainConfig.Params.Forks.RemoveFork(fork)
+	}
+
+	for _, fork := range gp.DisabledPrecompiles {
+		chainConfig.Params.Forks.RemoveFork(fork)
+	}
+
+	if gp.BurnContract != nil {
+		if len(gp.BurnContract) == 0 {
+			chainConfig.Params.BurnContract = nil
+		} else {
+			chainConfig.Params.BurnContract = make(map[uint64]types.Address, len(gp.BurnContract))
+			for block, address := range gp.BurnContract {
+				chainConfig.Params.BurnContract[block] = address
+			}
+		}
+	}
+}
+
+// all returns the address lists by their names
+func (l *GenesisProfileAddressLists) all() map[string]*chain.AddressListConfig {
+	return map[string]*chain.AddressListConfig{
+		"contractDeployerAllowList": l.ContractDeployerAllowList,
+		"contractDeployerBlockList": l.ContractDeployerBlockList,
+		"transactionsAllowList":     l.TransactionsAllowList,
+		"transactionsBlockList":     l.TransactionsBlockList,
+		"bridgeAllowList":           l.BridgeAllowList,
+		"bridgeBlockList":           l.BridgeBlockList,
+	}
+}
+
+// GenesisProfileResult is the genesis profile resolved against the generated chain config
+type GenesisProfileResult struct {
+	Name         string   `json:"name"`
+	Source       string   `json:"source"`
+	Description  string   `json:"description"`
+	Forks        []string `json:"forks"`
+	Precompiles  []string `json:"precompiles"`
+	Predeploys   []string `json:"predeploys"`
+	GasLimit     uint64   `json:"gasLimit"`
+	BurnContract []string `json:"burnContract"`
+	AddressLists []string `json:"addressLists"`
+	Warnings     []string `json:"warnings,omitempty"`
+}
+
+// newGenesisProfileResult resolves the genesis profile against the generated chain config
+func newGenesisProfileResult(profile *GenesisProfile, chainConfig *chain.Chain) *GenesisProfileResult {
+	result := &GenesisProfileResult{
+		Name:        profile.Name,
+		Source:      profile.source,
+		Description: profile.Description,
+		Predeploys:  profile.Predeploys,
+		GasLimit:    chainConfig.Genesis.GasLimit,
+	}
+
+	if profile.GasLimit != 0 && profile.GasLimit != chainConfig.Genesis.GasLimit {
+		result.Warnings = append(result.Warnings, fmt.Sprintf(
+			"gas limit %d of the profile is overridden by the --%s flag", profile.GasLimit, blockGasLimitFlag))
+	}
+
+	for fork := range *chainConfig.Params.Forks {
+		if slices.Contains(precompileForks, fork) {
+			result.Precompiles = append(result.Precompiles, fork)
+		} else {
+			result.Forks = append(result.Forks, fork)
+		}
+	}
+
+	sort.Strings(result.Forks)
+	sort.Strings(result.Precompiles)
+
+	for block, address := range chainConfig.Params.BurnContract {
+		result.BurnContract = append(result.BurnContract, fmt.Sprintf("%d:%s", block, address))
+	}
+
+	sort.Strings(result.BurnContract)
+
+	addressLists := map[string]*chain.AddressListConfig{
+		"contractDeployerAllowList": chainConfig.Params.ContractDeployerAllowList,
+		"contractDeployerBlockList": chainConfig.Params.ContractDeployerBlockList,
+		"transactionsAllowList":     chainConfig.Params.TransactionsAllowList,
+		"transactionsBlockList":     chainConfig.Params.TransactionsBlockList,
+		"bridgeAllowList":           chainConfig.Params.BridgeAllowList,
+		"bridgeBlockList":           chainConfig.Params.BridgeBlockList,
+	}
+
+	for name, list := range addressLists {
+		if list != nil {
+			result.AddressLists = append(result.AddressLists, fmt.Sprintf("%s (%d admins, %d enabled)",
+				name, len(list.AdminAddresses), len(list.EnabledAddresses)))
+		}
+	}
+
+	sort.Strings(result.AddressLists)
+
+	return result
+}
+
+func addressSliceToStringSlice(addrs []types.Address) []string {
+	res := make([]string, len(addrs))
+	for indx, addr := range addrs {
+		res[indx] = addr.String()
+	}
+
+	return res
+}
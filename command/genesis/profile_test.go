@@ -0,0 +1,198 @@
+package genesis
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func Test_LoadGenesisProfile(t *testing.T) {
+	t.Parallel()
+
+	require.ElementsMatch(t,
+		[]string{ProfileApexBridge, ProfileBlade, ProfileNexus, ProfileCustom}, GetBuiltinProfileNames())
+
+	for _, name := range GetBuiltinProfileNames() {
+		profile, err := LoadGenesisProfile(name)
+		require.NoError(t, err)
+		require.Equal(t, name, profile.Name)
+		require.Equal(t, "built-in "+name, profile.source)
+	}
+
+	nexus, err := LoadGenesisProfile(ProfileNexus)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0x500000), nexus.GasLimit)
+	require.Equal(t, map[uint64]types.Address{0: types.ZeroAddress}, nexus.BurnContract)
+	require.True(t, nexus.HasPredeploy(apexBridgePredeploy))
+
+	custom, err := LoadGenesisProfile(ProfileCustom)
+	require.NoError(t, err)
+	require.Nil(t, custom.BurnContract)
+	require.True(t, custom.HasPredeploy(apexBridgePredeploy))
+	require.Empty(t, custom.DisabledForks)
+	require.Empty(t, custom.DisabledPrecompiles)
+
+	dir := t.TempDir()
+
+	cases := []struct {
+		name      string
+		content   string
+		expectErr string
+	}{
+		{
+			name: "valid profile",
+			content: `{"name": "team", "disabledForks": ["EIP3607"], "disabledPrecompiles": ["cardanoPrecompiles"],
+				"predeploys": ["apexBridge"], "gasLimit": 1000000,
+				"addressLists": {"transactionsAllowList": {
+					"adminAddresses": ["0x0000000000000000000000000000000000000001"]}}}`,
+		},
+		{
+			name:      "unknown field",
+			content:   `{"name": "team", "apexConfig": 2}`,
+			expectErr: "unknown field",
+		},
+		{
+			name:      "missing name",
+			content:   `{"disabledForks": ["london"]}`,
+			expectErr: errProfileNameNotProvided.Error(),
+		},
+		{
+			name:      "unknown fork",
+			content:   `{"name": "team", "disabledForks": ["shanghai"]}`,
+			expectErr: "unknown fork shanghai",
+		},
+		{
+			name:      "precompiles fork as fork",
+			content:   `{"name": "team", "disabledForks": ["cardanoPrecompiles"]}`,
+			expectErr: "unknown fork cardanoPrecompiles",
+		},
+		{
+			name:      "unknown precompiles",
+			content:   `{"name": "team", "disabledPrecompiles": ["london"]}`,
+			expectErr: "unknown precompiles london",
+		},
+		{
+			name:      "unknown predeploy",
+			content:   `{"name": "team", "predeploys": ["governance"]}`,
+			expectErr: "unknown predeploy governance",
+		},
+		{
+			name: "address list without admin",
+			content: `{"name": "team", "addressLists": {"bridgeBlockList": {
+				"enabledAddresses": ["0x0000000000000000000000000000000000000001"]}}}`,
+			expectErr: "address list bridgeBlockList must have at least one admin address",
+		},
+	}
+
+	for i, c := range cases {
+		path := filepath.Join(dir, c.name+".json")
+		require.NoError(t, os.WriteFile(path, []byte(c.content), 0600), i)
+
+		profile, err := LoadGenesisProfile(path)
+		if c.expectErr != "" {
+			require.ErrorContains(t, err, c.expectErr, c.name)
+		} else {
+			require.NoError(t, err, c.name)
+			require.Equal(t, path, profile.source)
+		}
+	}
+
+	_, err = LoadGenesisProfile(filepath.Join(dir, "not-existing.json"))
+	require.ErrorContains(t, err, "is neither built-in profile")
+}
+
+func Test_GenesisProfile_Apply(t *testing.T) {
+	t.Parallel()
+
+	admin := types.StringToAddress("0x1")
+	flagAdmin := types.StringToAddress("0x2")
+
+	profile := &GenesisProfile{
+		Name:                "team",
+		DisabledForks:       []string{chain.Governance, chain.London},
+		DisabledPrecompiles: []string{chain.PrecompileGasSchedule},
+		GasLimit:            0x500000,
+		BurnContract:        map[uint64]types.Address{},
+		AddressLists: &GenesisProfileAddressLists{
+			TransactionsAllowList: &chain.AddressListConfig{AdminAddresses: []types.Address{admin}},
+			BridgeAllowList:       &chain.AddressListConfig{AdminAddresses: []types.Address{admin}},
+		},
+	}
+	require.NoError(t, profile.Validate())
+
+	p := &genesisParams{
+		blockGasLimit:        command.DefaultGenesisGasLimit,
+		bridgeAllowListAdmin: []string{flagAdmin.String()},
+	}
+	profile.applyToParams(p)
+
+	require.Equal(t, uint64(0x500000), p.blockGasLimit)
+	require.Equal(t, []string{admin.String()}, p.transactionsAllowListAdmin)
+	// address list flags take precedence over the profile
+	require.Equal(t, []string{flagAdmin.String()}, p.bridgeAllowListAdmin)
+
+	chainConfig := &chain.Chain{
+		Genesis: &chain.Genesis{GasLimit: p.blockGasLimit},
+		Params: &chain.Params{
+			Forks:                 chain.AllForksEnabled.Copy(),
+			BurnContract:          map[uint64]types.Address{0: types.StringToAddress("0x3")},
+			TransactionsAllowList: &chain.AddressListConfig{AdminAddresses: []types.Address{admin}},
+		},
+	}
+	profile.applyToChainConfig(chainConfig)
+
+	require.False(t, chainConfig.Params.Forks.IsActive(chain.Governance, 0))
+	require.False(t, chainConfig.Params.Forks.IsActive(chain.London, 0))
+	require.False(t, chainConfig.Params.Forks.IsActive(chain.PrecompileGasSchedule, 0))
+	require.True(t, chainConfig.Params.Forks.IsActive(chain.CardanoPrecompiles, 0))
+	require.Nil(t, chainConfig.Params.BurnContract)
+
+	result := newGenesisProfileResult(profile, chainConfig)
+	require.NotContains(t, result.Forks, chain.London)
+	require.Contains(t, result.Forks, chain.Berlin)
+	require.Equal(t, []string{chain.CardanoPrecompiles}, result.Precompiles)
+	require.Equal(t, uint64(0x500000), result.GasLimit)
+	require.Empty(t, result.BurnContract)
+	require.Equal(t, []string{"transactionsAllowList (1 admins, 0 enabled)"}, result.AddressLists)
+	require.Empty(t, result.Warnings)
+
+	profile.BurnContract = map[uint64]types.Address{0: types.ZeroAddress}
+	profile.applyToChainConfig(chainConfig)
+
+	require.Equal(t, map[uint64]types.Address{0: types.ZeroAddress}, chainConfig.Params.BurnContract)
+	require.Equal(t, []string{"0:" + types.ZeroAddress.String()},
+		newGenesisProfileResult(profile, chainConfig).BurnContract)
+}
+
+func Test_GenesisProfile_ApplyBlockGasLimitFlag(t *testing.T) {
+	t.Parallel()
+
+	profile := &GenesisProfile{Name: "team", GasLimit: 0x500000}
+
+	p := &genesisParams{
+		blockGasLimit:    command.DefaultGenesisGasLimit,
+		blockGasLimitSet: true,
+	}
+	profile.applyToParams(p)
+
+	// block gas limit flag takes precedence over the profile
+	require.Equal(t, uint64(command.DefaultGenesisGasLimit), p.blockGasLimit)
+
+	chainConfig := &chain.Chain{
+		Genesis: &chain.Genesis{GasLimit: p.blockGasLimit},
+		Params:  &chain.Params{Forks: chain.AllForksEnabled.Copy()},
+	}
+
+	result := newGenesisProfileResult(profile, chainConfig)
+	require.Equal(t, uint64(command.DefaultGenesisGasLimit), result.GasLimit)
+	require.Equal(t, []string{"gas limit 5242880 of the profile is overridden by the --block-gas-limit flag"},
+		result.Warnings)
+	require.Contains(t, (&GenesisResult{Profile: result}).GetOutput(),
+		"[WARNING: gas limit 5242880 of the profile is overridden by the --block-gas-limit flag]")
+}
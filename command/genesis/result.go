@@ -2,17 +2,50 @@ package genesis
 
 import (
 	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
 )
 
 type GenesisResult struct {
-	Message string `json:"message"`
+	Message string                `json:"message"`
+	Profile *GenesisProfileResult `json:"profile,omitempty"`
 }
 
 func (r *GenesisResult) GetOutput() string {
 	var buffer bytes.Buffer
 
+	if r.Profile != nil {
+		buffer.WriteString("\n[GENESIS PROFILE]\n")
+		buffer.WriteString(helper.FormatKV([]string{
+			fmt.Sprintf("Name|%s", r.Profile.Name),
+			fmt.Sprintf("Source|%s", r.Profile.Source),
+			fmt.Sprintf("Description|%s", r.Profile.Description),
+			fmt.Sprintf("Forks|%s", formatProfileList(r.Profile.Forks)),
+			fmt.Sprintf("Precompiles|%s", formatProfileList(r.Profile.Precompiles)),
+			fmt.Sprintf("Predeploys|%s", formatProfileList(r.Profile.Predeploys)),
+			fmt.Sprintf("Gas Limit|%d", r.Profile.GasLimit),
+			fmt.Sprintf("Burn Contract|%s", formatProfileList(r.Profile.BurnContract)),
+			fmt.Sprintf("Address Lists|%s", formatProfileList(r.Profile.AddressLists)),
+		}))
+		buffer.WriteString("\n")
+
+		for _, warning := range r.Profile.Warnings {
+			buffer.WriteString(fmt.Sprintf("\n[WARNING: %s]\n", warning))
+		}
+	}
+
 	buffer.WriteString("\n[GENESIS SUCCESS]\n")
 	buffer.WriteString(r.Message)
 
 	return buffer.String()
 }
+
+func formatProfileList(values []string) string {
+	if len(values) == 0 {
+		return "none"
+	}
+
+	return strings.Join(values, ", ")
+}
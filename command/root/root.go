@@ -7,8 +7,10 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/0xPolygon/polygon-edge/command/accounts"
+	"github.com/0xPolygon/polygon-edge/command/apex"
 	"github.com/0xPolygon/polygon-edge/command/backup"
 	"github.com/0xPolygon/polygon-edge/command/bridge"
+	"github.com/0xPolygon/polygon-edge/command/contracts"
 	"github.com/0xPolygon/polygon-edge/command/genesis"
 	"github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/command/loadtest"
@@ -60,6 +62,8 @@ func (rc *RootCommand) registerSubCommands() {
 		regenesis.GetCommand(),
 		mint.GetCommand(),
 		validator.GetCommand(),
+		apex.GetCommand(),
+		contracts.GetCommand(),
 		loadtest.GetCommand(),
 		sanitycheck.GetCommand(),
 		accounts.GetCommand(),
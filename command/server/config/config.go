@@ -31,6 +31,7 @@ type Config struct {
 	LogFilePath              string     `json:"log_to" yaml:"log_to"`
 	JSONRPCBatchRequestLimit uint64     `json:"json_rpc_batch_request_limit" yaml:"json_rpc_batch_request_limit"`
 	JSONRPCBlockRangeLimit   uint64     `json:"json_rpc_block_range_limit" yaml:"json_rpc_block_range_limit"`
+	JSONRPCPendingBlock      bool       `json:"json_rpc_pending_block" yaml:"json_rpc_pending_block"`
 	JSONLogFormat            bool       `json:"json_log_format" yaml:"json_log_format"`
 	CorsAllowedOrigins       []string   `json:"cors_allowed_origins" yaml:"cors_allowed_origins"`
 	UseTLS                   bool       `json:"use_tls" yaml:"use_tls"`
@@ -44,6 +45,8 @@ type Config struct {
 
 	MetricsInterval time.Duration `json:"metrics_interval" yaml:"metrics_interval"`
 
+	ParallelTxExecution bool `json:"parallel_tx_execution" yaml:"parallel_tx_execution"`
+
 	EventTracker *EventTracker `json:"event_tracker" yaml:"event_tracker"`
 }
 
@@ -155,10 +158,12 @@ func DefaultConfig() *Config {
 		TLSKeyFile:               "",
 		JSONRPCBatchRequestLimit: DefaultJSONRPCBatchRequestLimit,
 		JSONRPCBlockRangeLimit:   DefaultJSONRPCBlockRangeLimit,
+		JSONRPCPendingBlock:      false,
 		Relayer:                  false,
 		ConcurrentRequestsDebug:  DefaultConcurrentRequestsDebug,
 		WebSocketReadLimit:       DefaultWebSocketReadLimit,
 		MetricsInterval:          DefaultMetricsInterval,
+		ParallelTxExecution:      false,
 		EventTracker: &EventTracker{
 			SyncBatchSize:          DefaultSyncBatchSize,
 			NumBlockConfirmations:  DefaultNumBlockConfirmations,
@@ -28,6 +28,7 @@ const (
 	priceLimitFlag               = "price-limit"
 	jsonRPCBatchRequestLimitFlag = "json-rpc-batch-request-limit"
 	jsonRPCBlockRangeLimitFlag   = "json-rpc-block-range-limit"
+	jsonRPCPendingBlockFlag      = "json-rpc-pending-block"
 	maxSlotsFlag                 = "max-slots"
 	maxEnqueuedFlag              = "max-enqueued"
 	blockGasTargetFlag           = "block-gas-target"
@@ -49,6 +50,8 @@ const (
 
 	metricsIntervalFlag = "metrics-interval"
 
+	parallelTxExecutionFlag = "parallel-tx-execution"
+
 	// event tracker
 	trackerSyncBatchSizeFlag          = "sync-batch-size"
 	trackerNumBlockConfirmationsFlag  = "num-block-confirmations"
@@ -158,6 +161,7 @@ func (p *serverParams) generateConfig() *server.Config {
 			AccessControlAllowOrigin: p.rawConfig.CorsAllowedOrigins,
 			BatchLengthLimit:         p.rawConfig.JSONRPCBatchRequestLimit,
 			BlockRangeLimit:          p.rawConfig.JSONRPCBlockRangeLimit,
+			PendingBlock:             p.rawConfig.JSONRPCPendingBlock,
 			ConcurrentRequestsDebug:  p.rawConfig.ConcurrentRequestsDebug,
 			WebSocketReadLimit:       p.rawConfig.WebSocketReadLimit,
 		},
@@ -192,8 +196,9 @@ func (p *serverParams) generateConfig() *server.Config {
 		TLSCertFile:        p.rawConfig.TLSCertFile,
 		TLSKeyFile:         p.rawConfig.TLSKeyFile,
 
-		Relayer:         p.relayer,
-		MetricsInterval: p.rawConfig.MetricsInterval,
+		Relayer:             p.relayer,
+		MetricsInterval:     p.rawConfig.MetricsInterval,
+		ParallelTxExecution: p.rawConfig.ParallelTxExecution,
 		EventTracker: &server.EventTracker{
 			SyncBatchSize:          p.rawConfig.EventTracker.SyncBatchSize,
 			NumBlockConfirmations:  p.rawConfig.EventTracker.NumBlockConfirmations,
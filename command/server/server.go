@@ -215,6 +215,14 @@ func setFlags(cmd *cobra.Command) {
 			"that consider fromBlock/toBlock values (e.g. eth_getLogs), value of 0 disables it",
 	)
 
+	cmd.Flags().BoolVar(
+		&params.rawConfig.JSONRPCPendingBlock,
+		jsonRPCPendingBlockFlag,
+		defaultConfig.JSONRPCPendingBlock,
+		"continuously build the pending block from the tx pool executables and use it for json-rpc pending queries "+
+			"(intended for RPC nodes), otherwise pending queries are resolved against the latest block",
+	)
+
 	cmd.Flags().StringVar(
 		&params.rawConfig.LogFilePath,
 		logFileLocationFlag,
@@ -271,6 +279,14 @@ func setFlags(cmd *cobra.Command) {
 		"the interval (in seconds) at which special metrics are generated. a value of zero means the metrics are disabled",
 	)
 
+	cmd.Flags().BoolVar(
+		&params.rawConfig.ParallelTxExecution,
+		parallelTxExecutionFlag,
+		defaultConfig.ParallelTxExecution,
+		"execute the block transactions optimistically in parallel, "+
+			"re-executing the conflicting ones (produces the same state as the sequential execution)",
+	)
+
 	{ // event tracker
 		cmd.Flags().Uint64Var(
 			&params.rawConfig.EventTracker.SyncBatchSize,
@@ -35,12 +35,18 @@ type Consensus interface {
 	// GetSyncProgression retrieves the current sync progression, if any
 	GetSyncProgression() *progress.Progression
 
-	// GetLatestChainConfig retrieves the latest chain configuration
-	GetLatestChainConfig() (*chain.Params, error)
+	// GetChainConfigAt retrieves the chain configuration in effect at the given block
+	GetChainConfigAt(blockNumber uint64) (*chain.Params, error)
 
 	// GetBridgeProvider returns an instance of BridgeDataProvider
 	GetBridgeProvider() BridgeDataProvider
 
+	// GetFinalizedBlockNumber returns the number of the latest block which can not be reverted
+	GetFinalizedBlockNumber() (uint64, error)
+
+	// GetSafeBlockNumber returns the number of the latest block which is considered safe
+	GetSafeBlockNumber() (uint64, error)
+
 	// FilterExtra filters extra data in header that is not a part of block hash
 	FilterExtra(extra []byte) ([]byte, error)
 
@@ -101,8 +107,29 @@ type BridgeDataProvider interface {
 	// GenerateExit proof generates proof of exit for given exit event
 	GenerateExitProof(exitID uint64) (types.Proof, error)
 
+	// GenerateExitProofs generates proofs of exit for given exit events, in the order of provided ids
+	GenerateExitProofs(exitIDs []uint64) ([]types.Proof, error)
+
 	// GetStateSyncProof retrieves the StateSync proof
 	GetStateSyncProof(stateSyncID uint64) (types.Proof, error)
+
+	// GetTransferStatus retrieves the statuses of the bridge transfers matching the query
+	GetTransferStatus(query *types.BridgeTransferQuery) ([]*types.BridgeTransferStatus, error)
+
+	// GetDeadLetterEvents retrieves the events of given transfer type which the relayer failed to relay
+	GetDeadLetterEvents(transferType types.BridgeTransferType) ([]*types.BridgeRelayerEvent, error)
+
+	// RetryDeadLetterEvents returns given dead-lettered events back to the relayer queue
+	RetryDeadLetterEvents(transferType types.BridgeTransferType, eventIDs []uint64) error
+
+	// SkipDeadLetterEvents removes given dead-lettered events, so they are never relayed
+	SkipDeadLetterEvents(transferType types.BridgeTransferType, eventIDs []uint64) error
+
+	// GetTokenMappings retrieves the page of the token mappings done by the bridge predicates
+	GetTokenMappings(query *types.BridgeTokenMappingQuery) (*types.BridgeTokenMappings, error)
+
+	// GetCheckpointLag retrieves the number of child chain blocks which are not checkpointed on the rootchain
+	GetCheckpointLag() (*types.BridgeCheckpointLag, error)
 }
 
 type EventTracker struct {
@@ -241,8 +241,8 @@ func (d *Dev) PreCommitState(_ *types.Block, _ *state.Transition) error {
 	return nil
 }
 
-// GetLatestChainConfig returns the latest chain configuration
-func (d *Dev) GetLatestChainConfig() (*chain.Params, error) {
+// GetChainConfigAt returns the chain configuration in effect at the given block
+func (d *Dev) GetChainConfigAt(_ uint64) (*chain.Params, error) {
 	return nil, nil
 }
 
@@ -260,6 +260,16 @@ func (d *Dev) GetBridgeProvider() consensus.BridgeDataProvider {
 	return nil
 }
 
+// GetFinalizedBlockNumber returns the latest block number, since every written block is final
+func (d *Dev) GetFinalizedBlockNumber() (uint64, error) {
+	return d.blockchain.Header().Number, nil
+}
+
+// GetSafeBlockNumber returns the latest block number, since every written block is final
+func (d *Dev) GetSafeBlockNumber() (uint64, error) {
+	return d.blockchain.Header().Number, nil
+}
+
 func (d *Dev) FilterExtra(extra []byte) ([]byte, error) {
 	return extra, nil
 }
@@ -66,8 +66,8 @@ func (d *Dummy) PreCommitState(_ *types.Block, _ *state.Transition) error {
 	return nil
 }
 
-// GetLatestChainConfig returns the latest chain configuration
-func (d *Dummy) GetLatestChainConfig() (*chain.Params, error) {
+// GetChainConfigAt returns the chain configuration in effect at the given block
+func (d *Dummy) GetChainConfigAt(_ uint64) (*chain.Params, error) {
 	return nil, nil
 }
 
@@ -85,6 +85,16 @@ func (d *Dummy) GetBridgeProvider() consensus.BridgeDataProvider {
 	return nil
 }
 
+// GetFinalizedBlockNumber returns the latest block number, since every written block is final
+func (d *Dummy) GetFinalizedBlockNumber() (uint64, error) {
+	return d.blockchain.Header().Number, nil
+}
+
+// GetSafeBlockNumber returns the latest block number, since every written block is final
+func (d *Dummy) GetSafeBlockNumber() (uint64, error) {
+	return d.blockchain.Header().Number, nil
+}
+
 func (d *Dummy) FilterExtra(extra []byte) ([]byte, error) {
 	return extra, nil
 }
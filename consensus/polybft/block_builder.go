@@ -40,9 +40,17 @@ type BlockBuilderParams struct {
 
 	// BaseFee is the base fee
 	BaseFee uint64
+
+	// TxOrderingPolicy decides in which order the tx pool transactions are included
+	// (if not set, transactions are included by price)
+	TxOrderingPolicy TxOrderingPolicy
 }
 
 func NewBlockBuilder(params *BlockBuilderParams) *BlockBuilder {
+	if params.TxOrderingPolicy == nil {
+		params.TxOrderingPolicy = &priceOrderingPolicy{}
+	}
+
 	return &BlockBuilder{
 		params: params,
 	}
@@ -163,13 +171,14 @@ func (b *BlockBuilder) Fill() {
 	blockTimer := time.NewTimer(b.params.BlockTime)
 
 	b.params.TxPool.Prepare()
+	b.params.TxOrderingPolicy.Reset(b.params.TxPool, b.params.GasLimit, b.params.BaseFee)
 write:
 	for {
 		select {
 		case <-blockTimer.C:
 			return
 		default:
-			tx := b.params.TxPool.Peek()
+			tx := b.params.TxOrderingPolicy.Next()
 
 			if b.params.Logger.IsTrace() && tx != nil {
 				_, _ = buf.WriteString(tx.String())
@@ -187,6 +196,8 @@ write:
 		}
 	}
 
+	b.params.TxOrderingPolicy.Done()
+
 	if b.params.Logger.IsDebug() {
 		b.params.Logger.Debug("[BlockBuilder.Fill]", "block number", b.header.Number, "block txs", buf.String())
 	}
@@ -205,6 +216,8 @@ func (b *BlockBuilder) writeTxPoolTransaction(tx *types.Transaction) (bool, erro
 		return true, nil
 	}
 
+	gasUsed := b.state.TotalGas()
+
 	if err := b.WriteTx(tx); err != nil {
 		if _, ok := err.(*state.GasLimitReachedTransitionApplicationError); ok { //nolint:errorlint
 			// stop processing
@@ -222,6 +235,7 @@ func (b *BlockBuilder) writeTxPoolTransaction(tx *types.Transaction) (bool, erro
 
 	// remove tx from the pool and add it to the list of all block transactions
 	b.params.TxPool.Pop(tx)
+	b.params.TxOrderingPolicy.Included(tx, b.state.TotalGas()-gasUsed)
 
 	return false, nil
 }
@@ -35,7 +35,8 @@ type blockchainBackend interface {
 
 	// NewBlockBuilder is a factory method that returns a block builder on top of 'parent'.
 	NewBlockBuilder(parent *types.Header, coinbase types.Address,
-		txPool txPoolInterface, blockTime time.Duration, logger hclog.Logger) (blockBuilder, error)
+		txPool txPoolInterface, blockTime time.Duration, txOrdering TxOrderingPolicy,
+		logger hclog.Logger) (blockBuilder, error)
 
 	// ProcessBlock builds a final block from given 'block' on top of 'parent'.
 	ProcessBlock(parent *types.Header, block *types.Block) (*types.FullBlock, error)
@@ -153,7 +154,8 @@ func (p *blockchainWrapper) GetHeaderByHash(hash types.Hash) (*types.Header, boo
 // NewBlockBuilder is an implementation of blockchainBackend interface
 func (p *blockchainWrapper) NewBlockBuilder(
 	parent *types.Header, coinbase types.Address,
-	txPool txPoolInterface, blockTime time.Duration, logger hclog.Logger) (blockBuilder, error) {
+	txPool txPoolInterface, blockTime time.Duration, txOrdering TxOrderingPolicy,
+	logger hclog.Logger) (blockBuilder, error) {
 	gasLimit, err := p.blockchain.CalculateGasLimit(parent.Number + 1)
 	if err != nil {
 		return nil, err
@@ -168,6 +170,8 @@ func (p *blockchainWrapper) NewBlockBuilder(
 		BaseFee:   p.blockchain.CalculateBaseFee(parent),
 		TxPool:    txPool,
 		Logger:    logger,
+
+		TxOrderingPolicy: txOrdering,
 	}), nil
 }
 
@@ -1,19 +1,23 @@
 package polybft
 
 import (
+	"errors"
 	"fmt"
 	"path"
+	"sync"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/consensus"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/txrelayer"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/Ethernal-Tech/blockchain-event-tracker/store"
 	"github.com/Ethernal-Tech/blockchain-event-tracker/tracker"
 	"github.com/Ethernal-Tech/ethgo"
+	"github.com/Ethernal-Tech/ethgo/jsonrpc"
 	"github.com/hashicorp/go-hclog"
 	bolt "go.etcd.io/bbolt"
 )
@@ -33,6 +37,9 @@ const (
 	defaultMaxAttemptsToSend = uint64(15)
 	// defaultMaxEventsPerBatch specifies maximum events per one batchExecute tx
 	defaultMaxEventsPerBatch = uint64(10)
+	// defaultMaxBackoffBlocks specifies maximum number of blocks to wait before resending the event,
+	// since the waiting period grows exponentially with each attempt
+	defaultMaxBackoffBlocks = uint64(960)
 )
 
 var (
@@ -54,17 +61,29 @@ type RelayerEventMetaData struct {
 	CountTries  uint64 `json:"countTries"`
 	BlockNumber uint64 `json:"blockNumber"` // block when event is sent
 	SentStatus  bool   `json:"sentStatus"`
+	LastError   string `json:"lastError,omitempty"` // reason of the last sending or execution failure
 }
 
 func (ed RelayerEventMetaData) String() string {
 	return fmt.Sprintf("%d", ed.EventID)
 }
 
+// toBridgeRelayerEvent converts relayer event to its public representation
+func (ed *RelayerEventMetaData) toBridgeRelayerEvent() *types.BridgeRelayerEvent {
+	return &types.BridgeRelayerEvent{
+		EventID:     ed.EventID,
+		CountTries:  ed.CountTries,
+		BlockNumber: ed.BlockNumber,
+		LastError:   ed.LastError,
+	}
+}
+
 // relayerConfig is a struct that holds the relayer configuration
 type relayerConfig struct {
 	maxBlocksToWaitForResend uint64
 	maxAttemptsToSend        uint64
 	maxEventsPerBatch        uint64
+	maxBackoffBlocks         uint64
 	eventExecutionAddr       types.Address
 }
 
@@ -82,8 +101,14 @@ type eventTrackerConfig struct {
 
 // RelayerState is an interface that defines functions that a relayer store has to implement
 type RelayerState interface {
-	GetAllAvailableRelayerEvents(limit int) (result []*RelayerEventMetaData, err error)
+	GetAllAvailableRelayerEvents(limit int,
+		isAvailable func(*RelayerEventMetaData) bool) (result []*RelayerEventMetaData, err error)
 	UpdateRelayerEvents(events []*RelayerEventMetaData, removeIDs []uint64, dbTx *bolt.Tx) error
+	SetRelayerEventError(eventID uint64, reason string, dbTx *bolt.Tx) error
+	DeadLetterRelayerEvents(events []*RelayerEventMetaData, dbTx *bolt.Tx) error
+	GetDeadLetterRelayerEvents() ([]*RelayerEventMetaData, error)
+	RetryDeadLetterRelayerEvents(eventIDs []uint64) error
+	SkipDeadLetterRelayerEvents(eventIDs []uint64) error
 }
 
 // relayerEventsProcessor is a parent struct of both state sync and exit relayer
@@ -100,8 +125,20 @@ type relayerEventsProcessor struct {
 // ProcessEvents processes all relayer events that were either successfully or unsuccessfully executed
 // and executes all the events that can be executed in regards to relayerConfig
 func (r *relayerEventsProcessor) processEvents() {
-	// we need twice as batch size because events from first batch are possible already sent maxAttemptsToSend times
-	events, err := r.state.GetAllAvailableRelayerEvents(int(r.config.maxEventsPerBatch) * 2)
+	var currentHeader *types.Header
+
+	// events which are still waiting for their confirmation or backing off after the failure are skipped
+	// by the store, so they neither consume the window nor block the events behind them.
+	// Current header is only retrieved if there is at least one event in the store.
+	// We need twice as batch size because events from first batch are possible already sent maxAttemptsToSend times
+	events, err := r.state.GetAllAvailableRelayerEvents(int(r.config.maxEventsPerBatch)*2,
+		func(event *RelayerEventMetaData) bool {
+			if currentHeader == nil {
+				currentHeader = r.blockchain.CurrentHeader()
+			}
+
+			return event.CountTries == 0 || event.BlockNumber+r.backoffBlocks(event.CountTries) <= currentHeader.Number
+		})
 	if err != nil {
 		r.logger.Error("retrieving events failed", "err", err)
 
@@ -112,20 +149,15 @@ func (r *relayerEventsProcessor) processEvents() {
 		return
 	}
 
-	removedEventIDs := make([]uint64, 0, len(events))
+	deadLetterEvents := make([]*RelayerEventMetaData, 0, len(events))
 	sendingEvents := make([]*RelayerEventMetaData, 0, len(events))
-	currentBlockNumber := r.blockchain.CurrentHeader().Number
+	currentBlockNumber := currentHeader.Number
 
 	// check already processed events
 	for _, event := range events {
-		// quit if we are still waiting for some old event confirmation (there is no parallelization right now!)
-		if event.SentStatus && event.BlockNumber+r.config.maxBlocksToWaitForResend > currentBlockNumber {
-			return
-		}
-
-		// remove event if it is processed too many times
+		// move event to the dead-letter queue if it is processed too many times
 		if event.CountTries+1 > r.config.maxAttemptsToSend {
-			removedEventIDs = append(removedEventIDs, event.EventID)
+			deadLetterEvents = append(deadLetterEvents, event)
 		} else {
 			event.CountTries++
 			event.BlockNumber = currentBlockNumber
@@ -138,28 +170,89 @@ func (r *relayerEventsProcessor) processEvents() {
 		}
 	}
 
+	if len(deadLetterEvents) > 0 {
+		r.logger.Warn("moving relayer events to the dead-letter queue", "events", deadLetterEvents)
+
+		if err := r.state.DeadLetterRelayerEvents(deadLetterEvents, nil); err != nil {
+			r.logger.Error("moving relayer events to the dead-letter queue failed", "events", deadLetterEvents, "err", err)
+
+			return
+		}
+	}
+
 	// update state only if needed
-	if len(sendingEvents)+len(removedEventIDs) > 0 {
-		r.logger.Debug("updating relayer events storage", "events", sendingEvents, "removed", removedEventIDs)
+	if len(sendingEvents) > 0 {
+		r.logger.Debug("updating relayer events storage", "events", sendingEvents)
 
-		if err := r.state.UpdateRelayerEvents(sendingEvents, removedEventIDs, nil); err != nil {
-			r.logger.Error("updating relayer events storage failed",
-				"events", sendingEvents, "removed", removedEventIDs, "err", err)
+		if err := r.state.UpdateRelayerEvents(sendingEvents, nil, nil); err != nil {
+			r.logger.Error("updating relayer events storage failed", "events", sendingEvents, "err", err)
 
 			return
 		}
 	}
 
 	// send tx only if needed
-	if len(sendingEvents) > 0 {
-		if err := r.sendTx(sendingEvents); err != nil {
-			r.logger.Error("failed to send relayer tx", "block", currentBlockNumber, "events", sendingEvents, "err", err)
-		} else {
-			r.logger.Debug("relayer tx has been successfully sent", "block", currentBlockNumber, "events", sendingEvents)
+	if len(sendingEvents) == 0 {
+		return
+	}
+
+	if failedEvents := r.sendEvents(sendingEvents, currentBlockNumber); len(failedEvents) > 0 {
+		if err := r.state.UpdateRelayerEvents(failedEvents, nil, nil); err != nil {
+			r.logger.Error("updating failed relayer events failed", "events", failedEvents, "err", err)
 		}
 	}
 }
 
+// sendEvents sends given events in a single transaction. If the transaction can not be sent,
+// the batch is bisected and both halves are sent separately, so that a single failing event
+// does not prevent the rest of the batch from being relayed.
+// Events which can not be sent even on their own are marked as failed and returned
+func (r *relayerEventsProcessor) sendEvents(events []*RelayerEventMetaData,
+	currentBlockNumber uint64) []*RelayerEventMetaData {
+	err := r.sendTx(events)
+	if err == nil {
+		r.logger.Debug("relayer tx has been successfully sent", "block", currentBlockNumber, "events", events)
+
+		return nil
+	}
+
+	if len(events) == 1 {
+		r.logger.Error("failed to send relayer tx", "block", currentBlockNumber, "events", events, "err", err)
+
+		events[0].SentStatus = false
+		events[0].LastError = err.Error()
+
+		return []*RelayerEventMetaData{events[0]}
+	}
+
+	r.logger.Warn("failed to send relayer tx, bisecting the batch",
+		"block", currentBlockNumber, "events", events, "err", err)
+
+	middle := len(events) / 2
+	failedEvents := r.sendEvents(events[:middle], currentBlockNumber)
+
+	return append(failedEvents, r.sendEvents(events[middle:], currentBlockNumber)...)
+}
+
+// backoffBlocks returns the number of blocks to wait before resending the event which was sent
+// given number of times. Waiting period is doubled with each attempt, up to the configured maximum
+func (r *relayerEventsProcessor) backoffBlocks(countTries uint64) uint64 {
+	if countTries == 0 {
+		return 0
+	}
+
+	backoff := r.config.maxBlocksToWaitForResend
+	for i := uint64(1); i < countTries && backoff < r.config.maxBackoffBlocks; i++ {
+		backoff *= 2
+	}
+
+	if r.config.maxBackoffBlocks > 0 && backoff > r.config.maxBackoffBlocks {
+		backoff = r.config.maxBackoffBlocks
+	}
+
+	return backoff
+}
+
 // BridgeManager is an interface that defines functions that a bridge manager must implement
 type BridgeManager interface {
 	tracker.EventSubscriber
@@ -170,7 +263,15 @@ type BridgeManager interface {
 	PostEpoch(req *PostEpochRequest) error
 	BuildExitEventRoot(epoch uint64) (types.Hash, error)
 	GenerateProof(eventID uint64, pType proofType) (types.Proof, error)
+	GenerateExitProofs(exitIDs []uint64) ([]types.Proof, error)
 	Commitment(pendingBlockNumber uint64) (*CommitmentMessageSigned, error)
+	LastCheckpointBlock() (uint64, error)
+	GetTransferStatus(query *types.BridgeTransferQuery) ([]*types.BridgeTransferStatus, error)
+	GetDeadLetterEvents(transferType types.BridgeTransferType) ([]*types.BridgeRelayerEvent, error)
+	RetryDeadLetterEvents(transferType types.BridgeTransferType, eventIDs []uint64) error
+	SkipDeadLetterEvents(transferType types.BridgeTransferType, eventIDs []uint64) error
+	GetTokenMappings(query *types.BridgeTokenMappingQuery) (*types.BridgeTokenMappings, error)
+	GetCheckpointLag() (*types.BridgeCheckpointLag, error)
 }
 
 var _ BridgeManager = (*dummyBridgeManager)(nil)
@@ -191,6 +292,31 @@ func (d *dummyBridgeManager) Commitment(pendingBlockNumber uint64) (*CommitmentM
 func (d *dummyBridgeManager) GenerateProof(eventID uint64, pType proofType) (types.Proof, error) {
 	return types.Proof{}, nil
 }
+func (d *dummyBridgeManager) GenerateExitProofs(exitIDs []uint64) ([]types.Proof, error) {
+	return []types.Proof{}, nil
+}
+func (d *dummyBridgeManager) LastCheckpointBlock() (uint64, error) { return 0, nil }
+func (d *dummyBridgeManager) GetTransferStatus(
+	query *types.BridgeTransferQuery) ([]*types.BridgeTransferStatus, error) {
+	return nil, nil
+}
+func (d *dummyBridgeManager) GetDeadLetterEvents(
+	transferType types.BridgeTransferType) ([]*types.BridgeRelayerEvent, error) {
+	return nil, nil
+}
+func (d *dummyBridgeManager) RetryDeadLetterEvents(transferType types.BridgeTransferType, eventIDs []uint64) error {
+	return nil
+}
+func (d *dummyBridgeManager) SkipDeadLetterEvents(transferType types.BridgeTransferType, eventIDs []uint64) error {
+	return nil
+}
+func (d *dummyBridgeManager) GetTokenMappings(
+	query *types.BridgeTokenMappingQuery) (*types.BridgeTokenMappings, error) {
+	return &types.BridgeTokenMappings{Mappings: []*types.BridgeTokenMapping{}}, nil
+}
+func (d *dummyBridgeManager) GetCheckpointLag() (*types.BridgeCheckpointLag, error) {
+	return &types.BridgeCheckpointLag{}, nil
+}
 
 var _ BridgeManager = (*bridgeManager)(nil)
 
@@ -202,8 +328,96 @@ type bridgeManager struct {
 	stateSyncRelayer  StateSyncRelayer
 	exitEventRelayer  ExitRelayer
 
+	tokenMappingTracker *tokenMappingTracker
+
+	state *State
+	// rootchainNonceTracker is shared between the relayers sending transactions to the rootchain,
+	// since all of them are sending the transactions with the same key
+	rootchainNonceTracker *txrelayer.NonceTracker
+	// rootchainTxRelayers are the relayers of the checkpoint manager, closed along with the bridge manager
+	rootchainTxRelayers []txrelayer.TxRelayer
+
 	eventTrackerConfig *eventTrackerConfig
 	logger             hclog.Logger
+
+	// trackerLock guards the event tracker, which is restarted on the rootchain reorg,
+	// and serializes the processing of its logs with the rollback of the bridge state
+	trackerLock       sync.Mutex
+	eventTracker      *tracker.EventTracker
+	eventTrackerStore store.EventTrackerStore
+	trackerStore      *eventTrackerInstanceStore
+	trackerSubscriber *eventTrackerSubscriber
+	trackerLogFilter  map[ethgo.Address][]ethgo.Hash
+	rootBlockProvider tracker.BlockProvider
+	rootReorgDetector *rootchainReorgDetector
+	closeCh           chan struct{}
+}
+
+// eventTrackerSubscriber passes the logs of a single event tracker instance to the bridge manager,
+// and drops them once that tracker instance is closed
+type eventTrackerSubscriber struct {
+	bridgeManager *bridgeManager
+	closed        bool
+}
+
+// AddLog tracks the rootchain block of the given log and passes the log to the bridge manager
+func (s *eventTrackerSubscriber) AddLog(eventLog *ethgo.Log) error {
+	b := s.bridgeManager
+
+	b.trackerLock.Lock()
+	defer b.trackerLock.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	if err := b.rootReorgDetector.addLog(eventLog); err != nil {
+		b.logger.Error("failed to track rootchain block of the bridge event", "err", err)
+	}
+
+	return b.AddLog(eventLog)
+}
+
+// eventTrackerInstanceStore is the event tracker store handle of a single event tracker instance.
+// Closing the event tracker doesn't wait for its goroutine to stop, so once the instance is closed,
+// its writes are dropped and can't overwrite the last processed block reset on the rootchain reorg
+type eventTrackerInstanceStore struct {
+	store.EventTrackerStore
+
+	lock   sync.Mutex
+	closed bool
+}
+
+// InsertLastProcessedBlock saves the last processed block, unless the tracker instance is closed
+func (s *eventTrackerInstanceStore) InsertLastProcessedBlock(blockNumber uint64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	return s.EventTrackerStore.InsertLastProcessedBlock(blockNumber)
+}
+
+// InsertLogs saves the processed logs, unless the tracker instance is closed
+func (s *eventTrackerInstanceStore) InsertLogs(logs []*ethgo.Log) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	return s.EventTrackerStore.InsertLogs(logs)
+}
+
+// close drops all the subsequent writes, waiting for the ongoing one to finish
+func (s *eventTrackerInstanceStore) close() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.closed = true
 }
 
 // newBridgeManager creates a new instance of bridgeManager
@@ -218,7 +432,10 @@ func newBridgeManager(
 
 	stateSenderAddr := runtimeConfig.GenesisConfig.Bridge.StateSenderAddr
 	bridgeManager := &bridgeManager{
-		logger: logger.Named("bridge-manager"),
+		logger:                logger.Named("bridge-manager"),
+		state:                 runtimeConfig.State,
+		closeCh:               make(chan struct{}),
+		rootchainNonceTracker: txrelayer.NewNonceTracker(),
 		eventTrackerConfig: &eventTrackerConfig{
 			EventTracker:          *runtimeConfig.eventTracker,
 			stateSenderAddr:       stateSenderAddr,
@@ -230,6 +447,10 @@ func newBridgeManager(
 		},
 	}
 
+	bridgeManager.tokenMappingTracker = newTokenMappingTracker(runtimeConfig.State.TokenMappingStore,
+		runtimeConfig.GenesisConfig.Bridge, logger.Named("token-mapping-tracker"))
+	eventProvider.Subscribe(bridgeManager.tokenMappingTracker)
+
 	if err := bridgeManager.initStateSyncManager(bridgeBackend, runtimeConfig, logger); err != nil {
 		return nil, err
 	}
@@ -303,6 +524,136 @@ func (b *bridgeManager) GenerateProof(eventID uint64, pType proofType) (types.Pr
 	}
 }
 
+// GenerateExitProofs generates proofs of multiple exit events at once
+func (b *bridgeManager) GenerateExitProofs(exitIDs []uint64) ([]types.Proof, error) {
+	return b.checkpointManager.GenerateExitProofs(exitIDs)
+}
+
+// LastCheckpointBlock returns the latest child chain block checkpointed on the rootchain
+func (b *bridgeManager) LastCheckpointBlock() (uint64, error) {
+	return b.checkpointManager.LastCheckpointBlock()
+}
+
+// GetTransferStatus returns the statuses of the bridge transfers matching the given query.
+// If the query contains a transaction hash, both state sync and exit transfers originating
+// from the transaction are returned
+func (b *bridgeManager) GetTransferStatus(
+	query *types.BridgeTransferQuery) ([]*types.BridgeTransferStatus, error) {
+	var (
+		stateSyncIDs []uint64
+		exitIDs      []uint64
+	)
+
+	switch {
+	case query.StateSyncID != nil:
+		stateSyncIDs = []uint64{*query.StateSyncID}
+	case query.ExitID != nil:
+		exitIDs = []uint64{*query.ExitID}
+	case query.TxHash != nil:
+		var err error
+
+		if stateSyncIDs, err = b.state.StateSyncStore.getTransferIDsByTxHash(*query.TxHash); err != nil {
+			return nil, err
+		}
+
+		if exitIDs, err = b.state.ExitStore.getTransferIDsByTxHash(*query.TxHash); err != nil {
+			return nil, err
+		}
+
+		if len(stateSyncIDs) == 0 && len(exitIDs) == 0 {
+			return nil, fmt.Errorf("no bridge transfers found for transaction %s", *query.TxHash)
+		}
+	default:
+		return nil, errors.New("either state sync id, exit id or transaction hash must be provided")
+	}
+
+	statuses := make([]*types.BridgeTransferStatus, 0, len(stateSyncIDs)+len(exitIDs))
+
+	for _, id := range stateSyncIDs {
+		status, err := b.state.StateSyncStore.getTransferStatus(id)
+		if err != nil {
+			return nil, err
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	for _, id := range exitIDs {
+		status, err := b.state.ExitStore.getTransferStatus(id)
+		if err != nil {
+			return nil, err
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// GetDeadLetterEvents returns the events of given transfer type which the relayer failed to relay
+func (b *bridgeManager) GetDeadLetterEvents(
+	transferType types.BridgeTransferType) ([]*types.BridgeRelayerEvent, error) {
+	relayerState, err := b.relayerState(transferType)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := relayerState.GetDeadLetterRelayerEvents()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*types.BridgeRelayerEvent, len(events))
+	for i, event := range events {
+		result[i] = event.toBridgeRelayerEvent()
+	}
+
+	return result, nil
+}
+
+// RetryDeadLetterEvents returns given dead-lettered events back to the relayer queue
+func (b *bridgeManager) RetryDeadLetterEvents(transferType types.BridgeTransferType, eventIDs []uint64) error {
+	relayerState, err := b.relayerState(transferType)
+	if err != nil {
+		return err
+	}
+
+	return relayerState.RetryDeadLetterRelayerEvents(eventIDs)
+}
+
+// SkipDeadLetterEvents removes given dead-lettered events, so they are never relayed
+func (b *bridgeManager) SkipDeadLetterEvents(transferType types.BridgeTransferType, eventIDs []uint64) error {
+	relayerState, err := b.relayerState(transferType)
+	if err != nil {
+		return err
+	}
+
+	return relayerState.SkipDeadLetterRelayerEvents(eventIDs)
+}
+
+// GetTokenMappings returns the page of the token mappings done by the bridge predicates
+func (b *bridgeManager) GetTokenMappings(
+	query *types.BridgeTokenMappingQuery) (*types.BridgeTokenMappings, error) {
+	return b.state.TokenMappingStore.getTokenMappings(query)
+}
+
+// GetCheckpointLag returns the number of child chain blocks which are not checkpointed on the rootchain
+func (b *bridgeManager) GetCheckpointLag() (*types.BridgeCheckpointLag, error) {
+	return b.checkpointManager.CheckpointLag()
+}
+
+// relayerState returns the relayer store of given transfer type
+func (b *bridgeManager) relayerState(transferType types.BridgeTransferType) (RelayerState, error) {
+	switch transferType {
+	case types.StateSyncTransfer:
+		return b.state.StateSyncStore, nil
+	case types.ExitTransfer:
+		return b.state.ExitStore, nil
+	default:
+		return nil, fmt.Errorf("unknown bridge transfer type: %s", transferType)
+	}
+}
+
 // PostBlockAsync is called on finalization of each block (either from consensus or syncer)
 // but it doesn't require return of any kind, and is done asynchronously
 func (b *bridgeManager) PostBlockAsync(req *PostBlockRequest) {
@@ -313,8 +664,20 @@ func (b *bridgeManager) PostBlockAsync(req *PostBlockRequest) {
 
 // close stops ongoing go routines in the manager
 func (b *bridgeManager) Close() {
+	close(b.closeCh)
 	b.stateSyncRelayer.Close()
 	b.exitEventRelayer.Close()
+
+	for _, txRelayer := range b.rootchainTxRelayers {
+		txRelayer.Close()
+	}
+
+	b.trackerLock.Lock()
+	defer b.trackerLock.Unlock()
+
+	b.trackerSubscriber.closed = true
+	b.trackerStore.close()
+	b.eventTracker.Close()
 }
 
 // initStateSyncManager initializes state sync manager
@@ -350,19 +713,37 @@ func (b *bridgeManager) initCheckpointManager(
 
 	txRelayer, err := txrelayer.NewTxRelayer(
 		txrelayer.WithIPAddress(runtimeConfig.GenesisConfig.Bridge.JSONRPCEndpoint),
-		txrelayer.WithWriter(log.StandardWriter(&hclog.StandardLoggerOptions{})))
+		txrelayer.WithWriter(log.StandardWriter(&hclog.StandardLoggerOptions{})),
+		txrelayer.WithNonceTracker(b.rootchainNonceTracker),
+		txrelayer.WithTxReplacement(txrelayer.DefaultReplacementTimeout, txrelayer.DefaultFeeBumpPercentage))
+	if err != nil {
+		return err
+	}
+
+	// batch relayer shares the nonce tracker with the relayer above, so the pending checkpoints
+	// sent in the catch-up mode get consecutive nonces and are checkpointed in order
+	batchTxRelayer, err := txrelayer.NewTxRelayer(
+		txrelayer.WithIPAddress(runtimeConfig.GenesisConfig.Bridge.JSONRPCEndpoint),
+		txrelayer.WithWriter(log.StandardWriter(&hclog.StandardLoggerOptions{})),
+		txrelayer.WithNonceTracker(b.rootchainNonceTracker),
+		txrelayer.WithTxReplacement(txrelayer.DefaultReplacementTimeout, txrelayer.DefaultFeeBumpPercentage),
+		txrelayer.WithNoWaiting())
 	if err != nil {
 		return err
 	}
 
+	b.rootchainTxRelayers = []txrelayer.TxRelayer{txRelayer, batchTxRelayer}
+
 	b.checkpointManager = newCheckpointManager(
 		wallet.NewEcdsaSigner(runtimeConfig.Key),
 		runtimeConfig.GenesisConfig.Bridge.CheckpointManagerAddr,
 		txRelayer,
+		batchTxRelayer,
 		runtimeConfig.blockchain,
 		runtimeConfig.polybftBackend,
 		log,
-		runtimeConfig.State)
+		runtimeConfig.State,
+		runtimeConfig.GenesisConfig.Bridge.CheckpointLagAlertThreshold)
 
 	eventProvider.Subscribe(b.checkpointManager)
 
@@ -392,6 +773,7 @@ func (b *bridgeManager) initStateSyncRelayer(
 				maxBlocksToWaitForResend: defaultMaxBlocksToWaitForResend,
 				maxAttemptsToSend:        defaultMaxAttemptsToSend,
 				maxEventsPerBatch:        defaultMaxEventsPerBatch,
+				maxBackoffBlocks:         defaultMaxBackoffBlocks,
 				eventExecutionAddr:       contracts.StateReceiverContract,
 			},
 			logger.Named("state_sync_relayer"))
@@ -411,7 +793,8 @@ func (b *bridgeManager) initExitRelayer(
 	runtimeConfig *runtimeConfig,
 	logger hclog.Logger) error {
 	if runtimeConfig.consensusConfig.IsRelayer {
-		txRelayer, err := getBridgeTxRelayer(runtimeConfig.GenesisConfig.Bridge.JSONRPCEndpoint, logger)
+		txRelayer, err := getBridgeTxRelayer(runtimeConfig.GenesisConfig.Bridge.JSONRPCEndpoint, logger,
+			txrelayer.WithNonceTracker(b.rootchainNonceTracker))
 		if err != nil {
 			return err
 		}
@@ -426,6 +809,7 @@ func (b *bridgeManager) initExitRelayer(
 				maxBlocksToWaitForResend: defaultMaxBlocksToWaitForResend,
 				maxAttemptsToSend:        defaultMaxAttemptsToSend,
 				maxEventsPerBatch:        defaultMaxEventsPerBatch,
+				maxBackoffBlocks:         defaultMaxBackoffBlocks,
 				eventExecutionAddr:       runtimeConfig.GenesisConfig.Bridge.ExitHelperAddr,
 			},
 			logger.Named("exit_relayer"))
@@ -436,50 +820,205 @@ func (b *bridgeManager) initExitRelayer(
 	return b.exitEventRelayer.Init()
 }
 
-// initTracker starts a new event tracker (to receive bridge events)
+// initTracker starts a new event tracker (to receive bridge events),
+// along with the detector of the rootchain reorgs which the event tracker can not handle
 func (b *bridgeManager) initTracker(runtimeConfig *runtimeConfig) error {
 	store, err := store.NewBoltDBEventTrackerStore(path.Join(runtimeConfig.DataDir, "/bridge.db"))
 	if err != nil {
 		return err
 	}
 
+	client, err := jsonrpc.NewClient(b.eventTrackerConfig.jsonrpcAddr)
+	if err != nil {
+		return err
+	}
+
+	b.eventTrackerStore = store
+	b.rootBlockProvider = client.Eth()
+	b.trackerLogFilter = map[ethgo.Address][]ethgo.Hash{
+		ethgo.Address(b.eventTrackerConfig.stateSenderAddr):       {stateSyncEventSig},
+		ethgo.Address(b.eventTrackerConfig.checkpointManagerAddr): {checkpointSubmittedEventSig},
+		ethgo.Address(b.eventTrackerConfig.exitHelperAddr):        {exitProcessedEventSig},
+	}
+
+	for addr, sigs := range b.tokenMappingTracker.rootLogFilter() {
+		b.trackerLogFilter[addr] = sigs
+	}
+
+	b.rootReorgDetector = newRootchainReorgDetector(
+		b.state.RootchainStore,
+		b.rootBlockProvider,
+		store.GetLastProcessedBlock,
+		b.rollbackRootchainEvents,
+		b.eventTrackerConfig.EventTracker.NumBlockConfirmations,
+		b.logger.Named("rootchain-reorg-detector"),
+	)
+
+	b.trackerLock.Lock()
+	defer b.trackerLock.Unlock()
+
+	if err := b.startEventTracker(); err != nil {
+		return err
+	}
+
+	go b.rootReorgDetector.run(b.eventTrackerConfig.trackerPollInterval, b.closeCh)
+
+	return nil
+}
+
+// startEventTracker creates and starts a new event tracker instance,
+// which continues from the last processed block saved in the event tracker store
+func (b *bridgeManager) startEventTracker() error {
+	subscriber := &eventTrackerSubscriber{bridgeManager: b}
+	trackerStore := &eventTrackerInstanceStore{EventTrackerStore: b.eventTrackerStore}
+
 	eventTracker, err := tracker.NewEventTracker(
 		&tracker.EventTrackerConfig{
-			EventSubscriber:        b,
+			EventSubscriber:        subscriber,
 			Logger:                 b.logger,
 			RPCEndpoint:            b.eventTrackerConfig.jsonrpcAddr,
 			SyncBatchSize:          b.eventTrackerConfig.EventTracker.SyncBatchSize,
 			NumBlockConfirmations:  b.eventTrackerConfig.EventTracker.NumBlockConfirmations,
 			NumOfBlocksToReconcile: b.eventTrackerConfig.EventTracker.NumOfBlocksToReconcile,
 			PollInterval:           b.eventTrackerConfig.trackerPollInterval,
-			LogFilter: map[ethgo.Address][]ethgo.Hash{
-				ethgo.Address(b.eventTrackerConfig.stateSenderAddr):       {stateSyncEventSig},
-				ethgo.Address(b.eventTrackerConfig.checkpointManagerAddr): {checkpointSubmittedEventSig},
-				ethgo.Address(b.eventTrackerConfig.exitHelperAddr):        {exitProcessedEventSig},
-			},
+			LogFilter:              b.trackerLogFilter,
+			BlockProvider:          b.rootBlockProvider,
 		},
-		store, b.eventTrackerConfig.startBlock,
+		trackerStore, b.eventTrackerConfig.startBlock,
 	)
+	if err != nil {
+		return err
+	}
+
+	if err := eventTracker.Start(); err != nil {
+		return err
+	}
+
+	b.eventTracker = eventTracker
+	b.trackerStore = trackerStore
+	b.trackerSubscriber = subscriber
 
+	return nil
+}
+
+// rollbackRootchainEvents rolls back the bridge state derived from the rootchain blocks
+// starting from the given fork block, and restarts the event tracker from the fork block,
+// so the events of the new canonical rootchain blocks are processed
+func (b *bridgeManager) rollbackRootchainEvents(forkBlock uint64) error {
+	b.trackerLock.Lock()
+	defer b.trackerLock.Unlock()
+
+	b.trackerSubscriber.closed = true
+	b.trackerStore.close()
+	b.eventTracker.Close()
+
+	removedBlocks, err := b.state.RootchainStore.removeRootchainBlocks(forkBlock)
 	if err != nil {
 		return err
 	}
 
-	return eventTracker.Start()
+	var stateSyncIDs, processedExitIDs []uint64
+	for _, block := range removedBlocks {
+		stateSyncIDs = append(stateSyncIDs, block.StateSyncIDs...)
+		processedExitIDs = append(processedExitIDs, block.ProcessedExitIDs...)
+	}
+
+	if err := b.stateSyncManager.Rollback(stateSyncIDs); err != nil {
+		return fmt.Errorf("failed to roll back state sync events: %w", err)
+	}
+
+	if err := b.exitEventRelayer.Rollback(processedExitIDs); err != nil {
+		return fmt.Errorf("failed to roll back processed exit events: %w", err)
+	}
+
+	if err := b.state.TokenMappingStore.removeRootTokenMappings(forkBlock); err != nil {
+		return fmt.Errorf("failed to roll back root token mappings: %w", err)
+	}
+
+	b.checkpointManager.Rollback()
+
+	if err := b.eventTrackerStore.InsertLastProcessedBlock(forkBlock - 1); err != nil {
+		return err
+	}
+
+	b.logger.Warn("Restarting event tracker from the rootchain fork block", "forkBlock", forkBlock)
+
+	return b.startEventTracker()
 }
 
 // AddLog saves the received log from event tracker if it matches a state sync event ABI
 func (b *bridgeManager) AddLog(eventLog *ethgo.Log) error {
 	switch eventLog.Topics[0] {
 	case stateSyncEventSig:
-		return b.stateSyncManager.AddLog(eventLog)
+		return b.addRelayerLog(eventLog, b.stateSyncManager.AddLog)
 	case checkpointSubmittedEventSig:
-		return b.exitEventRelayer.AddLog(eventLog)
+		if err := b.checkpointManager.AddLog(eventLog); err != nil {
+			return err
+		}
+
+		return b.addRelayerLog(eventLog, b.exitEventRelayer.AddLog)
 	case exitProcessedEventSig:
-		return b.exitEventRelayer.AddLog(eventLog)
+		return b.addRelayerLog(eventLog, b.exitEventRelayer.AddLog)
+	case tokenMappedEventSig, mintableTokenMappedEventSig:
+		return b.tokenMappingTracker.AddLog(eventLog)
 	default:
 		b.logger.Error("Unknown event log receiver from event tracker")
 
 		return nil
 	}
 }
+
+// addRelayerLog passes the log to the given relayer handler and saves the bridge transfer stage
+// in the same db transaction, so the transfer status and the relayer state can't diverge
+func (b *bridgeManager) addRelayerLog(eventLog *ethgo.Log, addLog func(*ethgo.Log, *bolt.Tx) error) error {
+	return b.state.db.Update(func(dbTx *bolt.Tx) error {
+		if err := b.trackTransfer(eventLog, dbTx); err != nil {
+			b.logger.Error("failed to track bridge transfer stage", "err", err)
+		}
+
+		return addLog(eventLog, dbTx)
+	})
+}
+
+// trackTransfer saves the bridge transfer stages which are completed on the rootchain
+func (b *bridgeManager) trackTransfer(eventLog *ethgo.Log, dbTx *bolt.Tx) error {
+	stage := &types.BridgeTransferStage{
+		BlockNumber: eventLog.BlockNumber,
+		TxHash:      types.Hash(eventLog.TransactionHash),
+	}
+
+	switch eventLog.Topics[0] {
+	case stateSyncEventSig:
+		var stateSyncedEvent contractsapi.StateSyncedEvent
+		if _, err := stateSyncedEvent.ParseLog(eventLog); err != nil {
+			return err
+		}
+
+		stage.Name = types.StateSyncedStage
+
+		return b.state.StateSyncStore.insertTransferStage([]uint64{stateSyncedEvent.ID.Uint64()}, stage, dbTx)
+	case checkpointSubmittedEventSig:
+		var checkpointSubmittedEvent contractsapi.CheckpointSubmittedEvent
+		if _, err := checkpointSubmittedEvent.ParseLog(eventLog); err != nil {
+			return err
+		}
+
+		stage.Name = types.CheckpointedStage
+
+		return b.state.ExitStore.insertCheckpoint(checkpointSubmittedEvent.BlockNumber.Uint64(), stage, dbTx)
+	case exitProcessedEventSig:
+		var exitProcessedEvent contractsapi.ExitProcessedEvent
+		if _, err := exitProcessedEvent.ParseLog(eventLog); err != nil {
+			return err
+		}
+
+		stage.Name = types.ExitedStage
+		if !exitProcessedEvent.Success {
+			stage.Error = "exit execution failed: " + hex.EncodeToHex(exitProcessedEvent.ReturnData)
+		}
+
+		return b.state.ExitStore.insertTransferStage(exitProcessedEvent.ID.Uint64(), stage, dbTx)
+	default:
+		return nil
+	}
+}
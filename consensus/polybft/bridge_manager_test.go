@@ -0,0 +1,315 @@
+package polybft
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/Ethernal-Tech/blockchain-event-tracker/store"
+	"github.com/Ethernal-Tech/ethgo"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRelayerEventsProcessor(t *testing.T, state RelayerState, blockchain blockchainBackend,
+	sendTx func([]*RelayerEventMetaData) error) *relayerEventsProcessor {
+	t.Helper()
+
+	return &relayerEventsProcessor{
+		logger:     hclog.NewNullLogger(),
+		state:      state,
+		blockchain: blockchain,
+		config: &relayerConfig{
+			maxBlocksToWaitForResend: 2,
+			maxAttemptsToSend:        3,
+			maxEventsPerBatch:        4,
+			maxBackoffBlocks:         6,
+		},
+		sendTx: sendTx,
+	}
+}
+
+func TestRelayerEventsProcessor_BackoffBlocks(t *testing.T) {
+	t.Parallel()
+
+	processor := newTestRelayerEventsProcessor(t, nil, nil, nil)
+
+	require.Equal(t, uint64(0), processor.backoffBlocks(0))
+	require.Equal(t, uint64(2), processor.backoffBlocks(1))
+	require.Equal(t, uint64(4), processor.backoffBlocks(2))
+	require.Equal(t, uint64(6), processor.backoffBlocks(3))
+	require.Equal(t, uint64(6), processor.backoffBlocks(10))
+
+	// without the maximum backoff, waiting period does not grow
+	processor.config.maxBackoffBlocks = 0
+	require.Equal(t, uint64(2), processor.backoffBlocks(10))
+}
+
+func TestRelayerEventsProcessor_BisectionAndDeadLetter(t *testing.T) {
+	t.Parallel()
+
+	const poisonEventID = uint64(3)
+
+	var (
+		state      = newTestState(t)
+		blockchain = &blockchainMock{}
+		sentTxs    [][]uint64
+	)
+
+	processor := newTestRelayerEventsProcessor(t, state.ExitStore, blockchain,
+		func(events []*RelayerEventMetaData) error {
+			ids := make([]uint64, len(events))
+			for i, event := range events {
+				ids[i] = event.EventID
+			}
+
+			sentTxs = append(sentTxs, ids)
+
+			for _, id := range ids {
+				if id == poisonEventID {
+					return errors.New("execution reverted")
+				}
+			}
+
+			return nil
+		})
+
+	require.NoError(t, state.ExitStore.UpdateRelayerEvents([]*RelayerEventMetaData{
+		{EventID: 1}, {EventID: 2}, {EventID: 3}, {EventID: 4},
+	}, nil, nil))
+
+	// poison event is isolated by bisecting the batch
+	blockchain.On("CurrentHeader").Return(&types.Header{Number: 10}).Once()
+	processor.processEvents()
+
+	require.Equal(t, [][]uint64{{1, 2, 3, 4}, {1, 2}, {3, 4}, {3}, {4}}, sentTxs)
+
+	events, err := state.ExitStore.GetAllAvailableRelayerEvents(0, nil)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	require.True(t, events[0].SentStatus)
+	require.True(t, events[1].SentStatus)
+	require.False(t, events[2].SentStatus)
+	require.Equal(t, "execution reverted", events[2].LastError)
+	require.True(t, events[3].SentStatus)
+
+	// successfully sent events are confirmed
+	require.NoError(t, state.ExitStore.UpdateRelayerEvents(nil, []uint64{1, 2, 4}, nil))
+
+	// poison event backs off exponentially: sent at 10 (retry at 12), 12 (retry at 16)
+	for _, block := range []uint64{11, 12, 13, 16} {
+		blockchain.On("CurrentHeader").Return(&types.Header{Number: block}).Once()
+		processor.processEvents()
+	}
+
+	require.Equal(t, [][]uint64{{3}, {3}}, sentTxs[5:])
+
+	// attempts are exhausted, so the event is dead-lettered
+	blockchain.On("CurrentHeader").Return(&types.Header{Number: 22}).Once()
+	processor.processEvents()
+
+	require.Len(t, sentTxs, 7)
+
+	events, err = state.ExitStore.GetAllAvailableRelayerEvents(0, nil)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	deadLetterEvents, err := state.ExitStore.GetDeadLetterRelayerEvents()
+	require.NoError(t, err)
+	require.Len(t, deadLetterEvents, 1)
+	require.Equal(t, poisonEventID, deadLetterEvents[0].EventID)
+	require.Equal(t, uint64(3), deadLetterEvents[0].CountTries)
+	require.Equal(t, "execution reverted", deadLetterEvents[0].LastError)
+
+	blockchain.AssertExpectations(t)
+}
+
+func TestRelayerEventsProcessor_SentEventsDoNotBlock(t *testing.T) {
+	t.Parallel()
+
+	var (
+		state      = newTestState(t)
+		blockchain = &blockchainMock{}
+		sentTxs    [][]uint64
+	)
+
+	processor := newTestRelayerEventsProcessor(t, state.StateSyncStore, blockchain,
+		func(events []*RelayerEventMetaData) error {
+			ids := make([]uint64, len(events))
+			for i, event := range events {
+				ids[i] = event.EventID
+			}
+
+			sentTxs = append(sentTxs, ids)
+
+			return nil
+		})
+
+	// first event is sent and still waiting for its confirmation
+	require.NoError(t, state.StateSyncStore.UpdateRelayerEvents([]*RelayerEventMetaData{
+		{EventID: 1, CountTries: 1, BlockNumber: 10, SentStatus: true}, {EventID: 2}, {EventID: 3},
+	}, nil, nil))
+
+	blockchain.On("CurrentHeader").Return(&types.Header{Number: 11}).Once()
+	processor.processEvents()
+
+	require.Equal(t, [][]uint64{{2, 3}}, sentTxs)
+
+	// unconfirmed event is resent once its waiting period expires
+	blockchain.On("CurrentHeader").Return(&types.Header{Number: 12}).Once()
+	processor.processEvents()
+
+	require.Equal(t, [][]uint64{{2, 3}, {1}}, sentTxs)
+
+	blockchain.AssertExpectations(t)
+}
+
+func TestRelayerEventsProcessor_BackedOffEventsDoNotStarve(t *testing.T) {
+	t.Parallel()
+
+	var (
+		state      = newTestState(t)
+		blockchain = &blockchainMock{}
+		sentTxs    [][]uint64
+	)
+
+	processor := newTestRelayerEventsProcessor(t, state.ExitStore, blockchain,
+		func(events []*RelayerEventMetaData) error {
+			ids := make([]uint64, len(events))
+			for i, event := range events {
+				ids[i] = event.EventID
+			}
+
+			sentTxs = append(sentTxs, ids)
+
+			return nil
+		})
+
+	// more events than the retrieval window (2 * maxEventsPerBatch) are backing off
+	// in front of the events which have not been sent yet
+	backedOffCount := int(processor.config.maxEventsPerBatch)*2 + 2
+	events := make([]*RelayerEventMetaData, 0, backedOffCount+5)
+
+	for i := 1; i <= backedOffCount+5; i++ {
+		event := &RelayerEventMetaData{EventID: uint64(i)}
+		if i <= backedOffCount {
+			event.CountTries = 1
+			event.BlockNumber = 10
+		}
+
+		events = append(events, event)
+	}
+
+	require.NoError(t, state.ExitStore.UpdateRelayerEvents(events, nil, nil))
+
+	// events behind the backed off ones are sent in order
+	blockchain.On("CurrentHeader").Return(&types.Header{Number: 11}).Twice()
+	processor.processEvents()
+	processor.processEvents()
+
+	require.Equal(t, [][]uint64{{11, 12, 13, 14}, {15}}, sentTxs)
+
+	// once the backoff expires, backed off events are resent in order
+	blockchain.On("CurrentHeader").Return(&types.Header{Number: 12}).Times(3)
+	processor.processEvents()
+	processor.processEvents()
+	processor.processEvents()
+
+	require.Equal(t, [][]uint64{{1, 2, 3, 4}, {5, 6, 7, 8}, {9, 10}}, sentTxs[2:])
+
+	blockchain.AssertExpectations(t)
+}
+
+func TestBridgeManager_DeadLetterEvents(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+	manager := &bridgeManager{state: state}
+
+	require.NoError(t, state.StateSyncStore.UpdateRelayerEvents([]*RelayerEventMetaData{
+		{EventID: 1}, {EventID: 2},
+	}, nil, nil))
+	require.NoError(t, state.StateSyncStore.DeadLetterRelayerEvents([]*RelayerEventMetaData{
+		{EventID: 1, CountTries: 5, BlockNumber: 20, SentStatus: true, LastError: "failed"},
+		{EventID: 2, CountTries: 5, BlockNumber: 20, SentStatus: true},
+	}, nil))
+
+	_, err := manager.GetDeadLetterEvents("unknown")
+	require.ErrorContains(t, err, "unknown bridge transfer type")
+
+	events, err := manager.GetDeadLetterEvents(types.ExitTransfer)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	events, err = manager.GetDeadLetterEvents(types.StateSyncTransfer)
+	require.NoError(t, err)
+	require.Equal(t, []*types.BridgeRelayerEvent{
+		{EventID: 1, CountTries: 5, BlockNumber: 20, LastError: "failed"},
+		{EventID: 2, CountTries: 5, BlockNumber: 20},
+	}, events)
+
+	require.ErrorContains(t, manager.RetryDeadLetterEvents(types.StateSyncTransfer, []uint64{1, 3}),
+		"event 3 is not in the dead-letter queue")
+	require.NoError(t, manager.RetryDeadLetterEvents(types.StateSyncTransfer, []uint64{1}))
+	require.NoError(t, manager.SkipDeadLetterEvents(types.StateSyncTransfer, []uint64{2}))
+	require.Error(t, manager.SkipDeadLetterEvents(types.StateSyncTransfer, []uint64{2}))
+
+	events, err = manager.GetDeadLetterEvents(types.StateSyncTransfer)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	relayerEvents, err := state.StateSyncStore.GetAllAvailableRelayerEvents(0, nil)
+	require.NoError(t, err)
+	require.Equal(t, []*RelayerEventMetaData{{EventID: 1, LastError: "failed"}}, relayerEvents)
+}
+
+func TestRelayerState_SetRelayerEventError(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+
+	require.NoError(t, state.StateSyncStore.UpdateRelayerEvents([]*RelayerEventMetaData{
+		{EventID: 1, CountTries: 1, BlockNumber: 5, SentStatus: true},
+	}, nil, nil))
+
+	// unknown events are ignored
+	require.NoError(t, state.StateSyncStore.SetRelayerEventError(2, "failed", nil))
+	require.NoError(t, state.StateSyncStore.SetRelayerEventError(1, "failed", nil))
+
+	events, err := state.StateSyncStore.GetAllAvailableRelayerEvents(0, nil)
+	require.NoError(t, err)
+	require.Equal(t, []*RelayerEventMetaData{
+		{EventID: 1, CountTries: 1, BlockNumber: 5, LastError: "failed"},
+	}, events)
+}
+
+func TestEventTrackerInstanceStore_Close(t *testing.T) {
+	t.Parallel()
+
+	trackerStore := store.NewTestTrackerStore(t)
+	instanceStore := &eventTrackerInstanceStore{EventTrackerStore: trackerStore}
+
+	require.NoError(t, instanceStore.InsertLastProcessedBlock(5))
+	require.NoError(t, instanceStore.InsertLogs([]*ethgo.Log{store.CreateTestLogForStateSyncEvent(t, 5, 0)}))
+
+	instanceStore.close()
+
+	// writes of the closed tracker instance are dropped
+	require.NoError(t, instanceStore.InsertLastProcessedBlock(10))
+	require.NoError(t, instanceStore.InsertLogs([]*ethgo.Log{store.CreateTestLogForStateSyncEvent(t, 10, 0)}))
+
+	lastProcessedBlock, err := trackerStore.GetLastProcessedBlock()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), lastProcessedBlock)
+
+	logs, err := trackerStore.GetAllLogs()
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+
+	// store itself can still be written to by the next tracker instance
+	require.NoError(t, trackerStore.InsertLastProcessedBlock(3))
+
+	lastProcessedBlock, err = instanceStore.GetLastProcessedBlock()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), lastProcessedBlock)
+}
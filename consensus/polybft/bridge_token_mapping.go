@@ -0,0 +1,195 @@
+package polybft
+
+import (
+	"github.com/Ethernal-Tech/ethgo"
+	"github.com/hashicorp/go-hclog"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	tokenMappedEventSig           = new(contractsapi.TokenMappedEvent).Sig()
+	mintableTokenMappedEventSig   = new(contractsapi.MintableTokenMappedEvent).Sig()
+	l2TokenMappedEventSig         = new(contractsapi.L2TokenMappedEvent).Sig()
+	l2MintableTokenMappedEventSig = new(contractsapi.L2MintableTokenMappedEvent).Sig()
+)
+
+// tokenPredicate describes the tokens mapped by a single bridge predicate
+type tokenPredicate struct {
+	tokenType types.BridgeTokenType
+	// childOrigin is set for the mintable predicates, which map the tokens originating from the child chain
+	childOrigin bool
+}
+
+// childChainPredicates are the predicates deployed on the child chain, which emit the token mapping events
+var childChainPredicates = map[types.Address]tokenPredicate{
+	contracts.ChildERC20PredicateContract:          {tokenType: types.ERC20BridgeToken},
+	contracts.ChildERC721PredicateContract:         {tokenType: types.ERC721BridgeToken},
+	contracts.ChildERC1155PredicateContract:        {tokenType: types.ERC1155BridgeToken},
+	contracts.RootMintableERC20PredicateContract:   {tokenType: types.ERC20BridgeToken, childOrigin: true},
+	contracts.RootMintableERC721PredicateContract:  {tokenType: types.ERC721BridgeToken, childOrigin: true},
+	contracts.RootMintableERC1155PredicateContract: {tokenType: types.ERC1155BridgeToken, childOrigin: true},
+}
+
+var _ EventSubscriber = (*tokenMappingTracker)(nil)
+
+// tokenMappingTracker indexes the token mappings done by the bridge predicates.
+// Rootchain mapping events are received from the bridge event tracker,
+// while the child chain mapping events are received from the event provider
+type tokenMappingTracker struct {
+	state          *TokenMappingStore
+	rootPredicates map[types.Address]tokenPredicate
+	logger         hclog.Logger
+}
+
+// newTokenMappingTracker creates a new instance of tokenMappingTracker
+func newTokenMappingTracker(state *TokenMappingStore, bridgeCfg *BridgeConfig,
+	logger hclog.Logger) *tokenMappingTracker {
+	rootPredicates := make(map[types.Address]tokenPredicate, len(childChainPredicates))
+
+	for addr, predicate := range map[types.Address]tokenPredicate{
+		bridgeCfg.RootERC20PredicateAddr:            {tokenType: types.ERC20BridgeToken},
+		bridgeCfg.RootERC721PredicateAddr:           {tokenType: types.ERC721BridgeToken},
+		bridgeCfg.RootERC1155PredicateAddr:          {tokenType: types.ERC1155BridgeToken},
+		bridgeCfg.ChildMintableERC20PredicateAddr:   {tokenType: types.ERC20BridgeToken, childOrigin: true},
+		bridgeCfg.ChildMintableERC721PredicateAddr:  {tokenType: types.ERC721BridgeToken, childOrigin: true},
+		bridgeCfg.ChildMintableERC1155PredicateAddr: {tokenType: types.ERC1155BridgeToken, childOrigin: true},
+	} {
+		if addr != types.ZeroAddress {
+			rootPredicates[addr] = predicate
+		}
+	}
+
+	return &tokenMappingTracker{
+		state:          state,
+		rootPredicates: rootPredicates,
+		logger:         logger,
+	}
+}
+
+// rootLogFilter returns the log filter of the rootchain mapping events,
+// which is used by the bridge event tracker
+func (t *tokenMappingTracker) rootLogFilter() map[ethgo.Address][]ethgo.Hash {
+	filter := make(map[ethgo.Address][]ethgo.Hash, len(t.rootPredicates))
+
+	for addr, predicate := range t.rootPredicates {
+		if predicate.childOrigin {
+			filter[ethgo.Address(addr)] = []ethgo.Hash{mintableTokenMappedEventSig}
+		} else {
+			filter[ethgo.Address(addr)] = []ethgo.Hash{tokenMappedEventSig}
+		}
+	}
+
+	return filter
+}
+
+// AddLog saves the token mapping from the rootchain mapping event
+func (t *tokenMappingTracker) AddLog(log *ethgo.Log) error {
+	predicate, exists := t.rootPredicates[types.Address(log.Address)]
+	if !exists {
+		return nil
+	}
+
+	mapping, err := parseTokenMapping(predicate, log)
+	if err != nil || mapping == nil {
+		return err
+	}
+
+	mapping.RootBlock = log.BlockNumber
+
+	t.logger.Debug("token mapped on the rootchain", "type", mapping.TokenType,
+		"rootToken", mapping.RootToken, "childToken", mapping.ChildToken, "block", log.BlockNumber)
+
+	return t.state.insertTokenMapping(mapping, nil)
+}
+
+// EventSubscriber implementation
+
+// GetLogFilters returns a map of log filters for getting desired events,
+// where the key is the address of contract that emits desired events,
+// and the value is a slice of signatures of events we want to get.
+// This function is the implementation of EventSubscriber interface
+func (t *tokenMappingTracker) GetLogFilters() map[types.Address][]types.Hash {
+	filter := make(map[types.Address][]types.Hash, len(childChainPredicates))
+
+	for addr, predicate := range childChainPredicates {
+		if predicate.childOrigin {
+			filter[addr] = []types.Hash{types.Hash(l2MintableTokenMappedEventSig)}
+		} else {
+			filter[addr] = []types.Hash{types.Hash(l2TokenMappedEventSig)}
+		}
+	}
+
+	return filter
+}
+
+// ProcessLog is the implementation of EventSubscriber interface,
+// used to handle a log defined in GetLogFilters, provided by event provider
+func (t *tokenMappingTracker) ProcessLog(header *types.Header, log *ethgo.Log, dbTx *bolt.Tx) error {
+	predicate, exists := childChainPredicates[types.Address(log.Address)]
+	if !exists {
+		return nil
+	}
+
+	mapping, err := parseTokenMapping(predicate, log)
+	if err != nil || mapping == nil {
+		return err
+	}
+
+	mapping.ChildBlock = header.Number
+
+	t.logger.Debug("token mapped on the child chain", "type", mapping.TokenType,
+		"rootToken", mapping.RootToken, "childToken", mapping.ChildToken, "block", header.Number)
+
+	return t.state.insertTokenMapping(mapping, dbTx)
+}
+
+// parseTokenMapping parses any of the predicate mapping events. Returned mapping always holds
+// the rootchain token as the root token, even though the mintable predicates emit
+// the token of the origin (child) chain as the root token
+func parseTokenMapping(predicate tokenPredicate, log *ethgo.Log) (*types.BridgeTokenMapping, error) {
+	var (
+		originToken, mappedToken types.Address
+		matches                  bool
+		err                      error
+	)
+
+	switch log.Topics[0] {
+	case tokenMappedEventSig:
+		var event contractsapi.TokenMappedEvent
+		matches, err = event.ParseLog(log)
+		originToken, mappedToken = event.RootToken, event.ChildToken
+	case mintableTokenMappedEventSig:
+		var event contractsapi.MintableTokenMappedEvent
+		matches, err = event.ParseLog(log)
+		originToken, mappedToken = event.RootToken, event.ChildToken
+	case l2TokenMappedEventSig:
+		var event contractsapi.L2TokenMappedEvent
+		matches, err = event.ParseLog(log)
+		originToken, mappedToken = event.RootToken, event.ChildToken
+	case l2MintableTokenMappedEventSig:
+		var event contractsapi.L2MintableTokenMappedEvent
+		matches, err = event.ParseLog(log)
+		originToken, mappedToken = event.RootToken, event.ChildToken
+	}
+
+	if err != nil || !matches {
+		return nil, err
+	}
+
+	mapping := &types.BridgeTokenMapping{
+		TokenType:   predicate.tokenType,
+		RootToken:   originToken,
+		ChildToken:  mappedToken,
+		ChildOrigin: predicate.childOrigin,
+	}
+
+	if predicate.childOrigin {
+		mapping.RootToken, mapping.ChildToken = mappedToken, originToken
+	}
+
+	return mapping, nil
+}
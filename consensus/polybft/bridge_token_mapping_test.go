@@ -0,0 +1,97 @@
+package polybft
+
+import (
+	"testing"
+
+	"github.com/Ethernal-Tech/ethgo"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestTokenMappingTracker_TrackMappings(t *testing.T) {
+	t.Parallel()
+
+	var (
+		rootERC20Predicate           = types.StringToAddress("0x10")
+		childMintableERC721Predicate = types.StringToAddress("0x11")
+		rootToken                    = types.StringToAddress("0x20")
+		childToken                   = types.StringToAddress("0x21")
+		childOriginToken             = types.StringToAddress("0x22")
+		rootMintedToken              = types.StringToAddress("0x23")
+	)
+
+	state := newTestState(t)
+	tracker := newTokenMappingTracker(state.TokenMappingStore, &BridgeConfig{
+		RootERC20PredicateAddr:           rootERC20Predicate,
+		ChildMintableERC721PredicateAddr: childMintableERC721Predicate,
+	}, hclog.NewNullLogger())
+
+	require.Equal(t, map[ethgo.Address][]ethgo.Hash{
+		ethgo.Address(rootERC20Predicate):           {tokenMappedEventSig},
+		ethgo.Address(childMintableERC721Predicate): {mintableTokenMappedEventSig},
+	}, tracker.rootLogFilter())
+	require.Len(t, tracker.GetLogFilters(), len(childChainPredicates))
+
+	// ERC20 token mapped on the rootchain and then on the child chain
+	rootLog := createTestLogForTokenMappedEvent(t, rootERC20Predicate, tokenMappedEventSig, rootToken, childToken)
+	rootLog.BlockNumber = 5
+	require.NoError(t, tracker.AddLog(rootLog))
+
+	childLog := createTestLogForTokenMappedEvent(t, contracts.ChildERC20PredicateContract,
+		l2TokenMappedEventSig, rootToken, childToken)
+	require.NoError(t, tracker.ProcessLog(&types.Header{Number: 12}, childLog, nil))
+
+	// ERC721 token originating from the child chain mapped on the child chain and then on the rootchain
+	childLog = createTestLogForTokenMappedEvent(t, contracts.RootMintableERC721PredicateContract,
+		l2MintableTokenMappedEventSig, childOriginToken, rootMintedToken)
+	require.NoError(t, tracker.ProcessLog(&types.Header{Number: 20}, childLog, nil))
+
+	rootLog = createTestLogForTokenMappedEvent(t, childMintableERC721Predicate,
+		mintableTokenMappedEventSig, childOriginToken, rootMintedToken)
+	rootLog.BlockNumber = 8
+	require.NoError(t, tracker.AddLog(rootLog))
+
+	// logs of unknown contracts are ignored
+	require.NoError(t, tracker.AddLog(createTestLogForTokenMappedEvent(t, types.StringToAddress("0x99"),
+		tokenMappedEventSig, rootToken, childToken)))
+
+	mappings, err := state.TokenMappingStore.getTokenMappings(&types.BridgeTokenMappingQuery{})
+	require.NoError(t, err)
+	require.Equal(t, &types.BridgeTokenMappings{
+		Total: 2,
+		Mappings: []*types.BridgeTokenMapping{
+			{
+				TokenType:  types.ERC20BridgeToken,
+				RootToken:  rootToken,
+				ChildToken: childToken,
+				RootBlock:  5,
+				ChildBlock: 12,
+			},
+			{
+				TokenType:   types.ERC721BridgeToken,
+				RootToken:   rootMintedToken,
+				ChildToken:  childOriginToken,
+				ChildOrigin: true,
+				RootBlock:   8,
+				ChildBlock:  20,
+			},
+		},
+	}, mappings)
+}
+
+func createTestLogForTokenMappedEvent(t *testing.T, emitter types.Address, sig ethgo.Hash,
+	rootToken, childToken types.Address) *ethgo.Log {
+	t.Helper()
+
+	return &ethgo.Log{
+		Address: ethgo.Address(emitter),
+		Topics: []ethgo.Hash{
+			sig,
+			ethgo.BytesToHash(rootToken.Bytes()),
+			ethgo.BytesToHash(childToken.Bytes()),
+		},
+	}
+}
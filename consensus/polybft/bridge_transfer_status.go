@@ -0,0 +1,140 @@
+package polybft
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+// transferStages are the reached stages of a single bridge transfer, by the stage name
+type transferStages map[string]*types.BridgeTransferStage
+
+// insertTransferStage saves the reached stage of the transfers with given ids to the provided bucket.
+// If lookup bucket is provided, the stage transaction hash is saved as the originating transaction of the transfers
+func insertTransferStage(tx *bolt.Tx, bucket, lookupBucket []byte,
+	ids []uint64, stage *types.BridgeTransferStage) error {
+	transfersBucket := tx.Bucket(bucket)
+
+	for _, id := range ids {
+		key := common.EncodeUint64ToBytes(id)
+		stages := transferStages{}
+
+		if raw := transfersBucket.Get(key); raw != nil {
+			if err := json.Unmarshal(raw, &stages); err != nil {
+				return err
+			}
+		}
+
+		stages[stage.Name] = stage
+
+		raw, err := json.Marshal(stages)
+		if err != nil {
+			return err
+		}
+
+		if err := transfersBucket.Put(key, raw); err != nil {
+			return err
+		}
+	}
+
+	if lookupBucket == nil {
+		return nil
+	}
+
+	var lookupIDs []uint64
+
+	lookup := tx.Bucket(lookupBucket)
+	if raw := lookup.Get(stage.TxHash.Bytes()); raw != nil {
+		if err := json.Unmarshal(raw, &lookupIDs); err != nil {
+			return err
+		}
+	}
+
+	// the same transfer can be reported more than once (e.g. if the rootchain events are reprocessed)
+	for _, id := range ids {
+		if !slices.Contains(lookupIDs, id) {
+			lookupIDs = append(lookupIDs, id)
+		}
+	}
+
+	raw, err := json.Marshal(lookupIDs)
+	if err != nil {
+		return err
+	}
+
+	return lookup.Put(stage.TxHash.Bytes(), raw)
+}
+
+// getTransferStages returns the reached stages of the transfer with given id from the provided bucket
+func getTransferStages(tx *bolt.Tx, bucket []byte, id uint64) (transferStages, error) {
+	stages := transferStages{}
+
+	raw := tx.Bucket(bucket).Get(common.EncodeUint64ToBytes(id))
+	if raw == nil {
+		return stages, nil
+	}
+
+	return stages, json.Unmarshal(raw, &stages)
+}
+
+// getTransferIDsByTxHash returns ids of the transfers originating from the transaction with given hash
+func getTransferIDsByTxHash(tx *bolt.Tx, lookupBucket []byte, txHash types.Hash) ([]uint64, error) {
+	var ids []uint64
+
+	raw := tx.Bucket(lookupBucket).Get(txHash.Bytes())
+	if raw == nil {
+		return nil, nil
+	}
+
+	return ids, json.Unmarshal(raw, &ids)
+}
+
+// newTransferStatus creates the status of the transfer, reporting each of the given stages in order.
+// Stages which are not reached, but are followed by a reached one, are reported as completed
+// (e.g. if the node has not been tracking the transfer from the start)
+func newTransferStatus(transferType types.BridgeTransferType, id uint64,
+	stageNames []string, reached transferStages) (*types.BridgeTransferStatus, error) {
+	if len(reached) == 0 {
+		return nil, fmt.Errorf("%s transfer with id %d not found", transferType, id)
+	}
+
+	status := &types.BridgeTransferStatus{
+		Type:   transferType,
+		ID:     id,
+		Stages: make([]*types.BridgeTransferStage, len(stageNames)),
+	}
+
+	completed := false
+
+	for i := len(stageNames) - 1; i >= 0; i-- {
+		stage, exists := reached[stageNames[i]]
+		if !exists {
+			stage = &types.BridgeTransferStage{Name: stageNames[i], Completed: completed}
+		} else {
+			stage.Completed = true
+		}
+
+		completed = stage.Completed
+		status.Stages[i] = stage
+	}
+
+	for _, stage := range status.Stages {
+		stage.Rootchain = isRootchainTransferStage(stage.Name)
+	}
+
+	return status, nil
+}
+
+// isRootchainTransferStage returns true if the transfer stage happens on the rootchain
+func isRootchainTransferStage(name string) bool {
+	switch name {
+	case types.StateSyncedStage, types.CheckpointedStage, types.ExitedStage:
+		return true
+	default:
+		return false
+	}
+}
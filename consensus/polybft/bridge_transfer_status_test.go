@@ -0,0 +1,147 @@
+package polybft
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridgeTransferStatus_StateSync(t *testing.T) {
+	t.Parallel()
+
+	var (
+		state        = newTestState(t)
+		depositTx    = types.StringToHash("0x1")
+		commitmentTx = types.StringToHash("0x2")
+	)
+
+	_, err := state.StateSyncStore.getTransferStatus(1)
+	require.ErrorContains(t, err, "stateSync transfer with id 1 not found")
+
+	require.NoError(t, state.StateSyncStore.insertTransferStage([]uint64{1, 2},
+		&types.BridgeTransferStage{Name: types.StateSyncedStage, BlockNumber: 10, TxHash: depositTx}, nil))
+	require.NoError(t, state.StateSyncStore.insertTransferStage([]uint64{1, 2, 3},
+		&types.BridgeTransferStage{Name: types.CommittedStage, BlockNumber: 20, TxHash: commitmentTx}, nil))
+	// reprocessed rootchain event does not duplicate the transfers of the transaction
+	require.NoError(t, state.StateSyncStore.insertTransferStage([]uint64{2},
+		&types.BridgeTransferStage{Name: types.StateSyncedStage, BlockNumber: 10, TxHash: depositTx}, nil))
+
+	ids, err := state.StateSyncStore.getTransferIDsByTxHash(depositTx)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, ids)
+
+	ids, err = state.StateSyncStore.getTransferIDsByTxHash(commitmentTx)
+	require.NoError(t, err)
+	require.Empty(t, ids)
+
+	status, err := state.StateSyncStore.getTransferStatus(1)
+	require.NoError(t, err)
+	require.Equal(t, types.StateSyncTransfer, status.Type)
+	require.Len(t, status.Stages, 3)
+	require.Equal(t, &types.BridgeTransferStage{Name: types.StateSyncedStage, Completed: true,
+		Rootchain: true, BlockNumber: 10, TxHash: depositTx}, status.Stages[0])
+	require.Equal(t, &types.BridgeTransferStage{Name: types.CommittedStage, Completed: true,
+		BlockNumber: 20, TxHash: commitmentTx}, status.Stages[1])
+	require.Equal(t, &types.BridgeTransferStage{Name: types.ExecutedStage}, status.Stages[2])
+
+	// state synced before the node started tracking is reported as completed
+	require.NoError(t, state.StateSyncStore.insertTransferStage([]uint64{3},
+		&types.BridgeTransferStage{Name: types.ExecutedStage, BlockNumber: 21, Error: "failed"}, nil))
+
+	status, err = state.StateSyncStore.getTransferStatus(3)
+	require.NoError(t, err)
+	require.True(t, status.Stages[0].Completed)
+	require.Equal(t, uint64(0), status.Stages[0].BlockNumber)
+	require.True(t, status.Stages[2].Completed)
+	require.Equal(t, "failed", status.Stages[2].Error)
+}
+
+func TestBridgeTransferStatus_Exit(t *testing.T) {
+	t.Parallel()
+
+	var (
+		state        = newTestState(t)
+		withdrawTx   = types.StringToHash("0x1")
+		checkpointTx = types.StringToHash("0x2")
+	)
+
+	require.NoError(t, state.ExitStore.insertExitEvent(&ExitEvent{
+		L2StateSyncedEvent: &contractsapi.L2StateSyncedEvent{ID: big.NewInt(7)},
+		EpochNumber:        2,
+		BlockNumber:        15,
+	}, nil))
+	require.NoError(t, state.ExitStore.insertTransferStage(7,
+		&types.BridgeTransferStage{Name: types.ExitEventStage, BlockNumber: 15, TxHash: withdrawTx}, nil))
+
+	ids, err := state.ExitStore.getTransferIDsByTxHash(withdrawTx)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{7}, ids)
+
+	status, err := state.ExitStore.getTransferStatus(7)
+	require.NoError(t, err)
+	require.Equal(t, types.ExitTransfer, status.Type)
+	require.Len(t, status.Stages, 4)
+	require.True(t, status.Stages[0].Completed)
+	require.False(t, status.Stages[1].Completed)
+
+	// checkpoint which does not include the exit event block
+	require.NoError(t, state.ExitStore.insertCheckpoint(10,
+		&types.BridgeTransferStage{Name: types.CheckpointedStage, BlockNumber: 100}, nil))
+
+	status, err = state.ExitStore.getTransferStatus(7)
+	require.NoError(t, err)
+	require.False(t, status.Stages[1].Completed)
+
+	require.NoError(t, state.ExitStore.insertCheckpoint(20,
+		&types.BridgeTransferStage{Name: types.CheckpointedStage, BlockNumber: 110, TxHash: checkpointTx}, nil))
+	require.NoError(t, state.ExitStore.insertCheckpoint(30,
+		&types.BridgeTransferStage{Name: types.CheckpointedStage, BlockNumber: 120}, nil))
+
+	status, err = state.ExitStore.getTransferStatus(7)
+	require.NoError(t, err)
+	require.Equal(t, &types.BridgeTransferStage{Name: types.CheckpointedStage, Completed: true,
+		Rootchain: true, BlockNumber: 110, TxHash: checkpointTx}, status.Stages[1])
+	require.True(t, status.Stages[2].Completed)
+	require.False(t, status.Stages[3].Completed)
+
+	require.NoError(t, state.ExitStore.insertTransferStage(7,
+		&types.BridgeTransferStage{Name: types.ExitedStage, BlockNumber: 130}, nil))
+
+	status, err = state.ExitStore.getTransferStatus(7)
+	require.NoError(t, err)
+	require.True(t, status.Stages[3].Completed)
+	require.True(t, status.Stages[3].Rootchain)
+}
+
+func TestBridgeManager_GetTransferStatus(t *testing.T) {
+	t.Parallel()
+
+	var (
+		state  = newTestState(t)
+		txHash = types.StringToHash("0x1")
+	)
+
+	manager := &bridgeManager{state: state}
+
+	require.NoError(t, state.StateSyncStore.insertTransferStage([]uint64{1},
+		&types.BridgeTransferStage{Name: types.StateSyncedStage, TxHash: txHash}, nil))
+
+	_, err := manager.GetTransferStatus(&types.BridgeTransferQuery{})
+	require.Error(t, err)
+
+	statuses, err := manager.GetTransferStatus(&types.BridgeTransferQuery{TxHash: &txHash})
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	require.Equal(t, uint64(1), statuses[0].ID)
+
+	unknownHash := types.StringToHash("0x2")
+	_, err = manager.GetTransferStatus(&types.BridgeTransferQuery{TxHash: &unknownHash})
+	require.ErrorContains(t, err, "no bridge transfers found")
+
+	exitID := uint64(1)
+	_, err = manager.GetTransferStatus(&types.BridgeTransferQuery{ExitID: &exitID})
+	require.ErrorContains(t, err, "exit transfer with id 1 not found")
+}
@@ -2,9 +2,11 @@ package polybft
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"math/big"
 	"strconv"
+	"sync/atomic"
 
 	"github.com/0xPolygon/polygon-edge/bls"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
@@ -20,6 +22,15 @@ import (
 	bolt "go.etcd.io/bbolt"
 )
 
+const (
+	// defaultCheckpointCatchUpBatchSize is the maximum number of pending checkpoints which are sent
+	// to the rootchain at once, without waiting for the receipts of each of them
+	defaultCheckpointCatchUpBatchSize = uint64(10)
+	// defaultCheckpointLagAlertThreshold is the number of child chain blocks not checkpointed
+	// on the rootchain, after which the checkpoint lag alert is raised
+	defaultCheckpointLagAlertThreshold = uint64(3600)
+)
+
 var (
 	// currentCheckpointBlockNumMethod is an ABI method object representation for
 	// currentCheckpointBlockNumber getter function on CheckpointManager contract
@@ -28,22 +39,36 @@ var (
 
 type CheckpointManager interface {
 	EventSubscriber
+	AddLog(eventLog *ethgo.Log) error
 	PostBlock(req *PostBlockRequest)
 	BuildEventRoot(epoch uint64) (types.Hash, error)
 	GenerateExitProof(exitID uint64) (types.Proof, error)
+	GenerateExitProofs(exitIDs []uint64) ([]types.Proof, error)
+	LastCheckpointBlock() (uint64, error)
+	CheckpointLag() (*types.BridgeCheckpointLag, error)
+	Rollback()
 }
 
 var _ CheckpointManager = (*dummyCheckpointManager)(nil)
 
 type dummyCheckpointManager struct{}
 
-func (d *dummyCheckpointManager) PostBlock(req *PostBlockRequest) {}
+func (d *dummyCheckpointManager) AddLog(eventLog *ethgo.Log) error { return nil }
+func (d *dummyCheckpointManager) PostBlock(req *PostBlockRequest)  {}
 func (d *dummyCheckpointManager) BuildEventRoot(epoch uint64) (types.Hash, error) {
 	return types.ZeroHash, nil
 }
 func (d *dummyCheckpointManager) GenerateExitProof(exitID uint64) (types.Proof, error) {
 	return types.Proof{}, nil
 }
+func (d *dummyCheckpointManager) GenerateExitProofs(exitIDs []uint64) ([]types.Proof, error) {
+	return []types.Proof{}, nil
+}
+func (d *dummyCheckpointManager) LastCheckpointBlock() (uint64, error) { return 0, nil }
+func (d *dummyCheckpointManager) CheckpointLag() (*types.BridgeCheckpointLag, error) {
+	return &types.BridgeCheckpointLag{}, nil
+}
+func (d *dummyCheckpointManager) Rollback() {}
 
 // EventSubscriber implementation
 func (d *dummyCheckpointManager) GetLogFilters() map[types.Address][]types.Hash {
@@ -66,27 +91,52 @@ type checkpointManager struct {
 	consensusBackend polybftBackend
 	// rootChainRelayer abstracts rootchain interaction logic (Call and SendTransaction invocations to the rootchain)
 	rootChainRelayer txrelayer.TxRelayer
+	// rootChainBatchRelayer sends the pending checkpoints in the catch-up mode, without waiting for their receipts
+	rootChainBatchRelayer txrelayer.TxRelayer
+	// catchUpBatchSize is the maximum number of pending checkpoints sent in a single batch
+	catchUpBatchSize uint64
 	// checkpointManagerAddr is address of CheckpointManager smart contract
 	checkpointManagerAddr types.Address
 	// lastSentBlock represents the last block on which a checkpoint transaction was sent
 	lastSentBlock uint64
+	// lastCheckpointBlock represents the last block which is checkpointed on the rootchain
+	lastCheckpointBlock atomic.Uint64
+	// submitting is set while checkpoints are being submitted, so that the submission
+	// triggered by a following block does not send the same checkpoints again
+	submitting atomic.Bool
+	// childHeight is the latest finalized child chain block
+	childHeight atomic.Uint64
+	// lagAlertThreshold is the checkpoint lag after which the checkpoint lag alert is raised
+	lagAlertThreshold uint64
+	// lagAlert is set while the checkpoint lag exceeds the alert threshold
+	lagAlert atomic.Bool
+	// refreshingCheckpointBlock is set while the last checkpoint block is being fetched from the rootchain
+	refreshingCheckpointBlock atomic.Bool
 	// logger instance
 	logger hclog.Logger
 	// state boltDb instance
 	state *State
 }
 
-// newCheckpointManager creates a new instance of checkpointManager
+// newCheckpointManager creates a new instance of checkpointManager.
+// Batch relayer is optional, and if it is not provided, pending checkpoints are sent one by one
 func newCheckpointManager(key crypto.Key,
-	checkpointManagerSC types.Address, txRelayer txrelayer.TxRelayer,
+	checkpointManagerSC types.Address, txRelayer, batchTxRelayer txrelayer.TxRelayer,
 	blockchain blockchainBackend, backend polybftBackend, logger hclog.Logger,
-	state *State) *checkpointManager {
+	state *State, lagAlertThreshold uint64) *checkpointManager {
+	if lagAlertThreshold == 0 {
+		lagAlertThreshold = defaultCheckpointLagAlertThreshold
+	}
+
 	return &checkpointManager{
 		key:                   key,
 		blockchain:            blockchain,
 		consensusBackend:      backend,
 		rootChainRelayer:      txRelayer,
+		rootChainBatchRelayer: batchTxRelayer,
+		catchUpBatchSize:      defaultCheckpointCatchUpBatchSize,
 		checkpointManagerAddr: checkpointManagerSC,
+		lagAlertThreshold:     lagAlertThreshold,
 		logger:                logger,
 		state:                 state,
 	}
@@ -114,13 +164,25 @@ func getCurrentCheckpointBlock(relayer txrelayer.TxRelayer, checkpointManagerAdd
 	return currentCheckpointBlock, nil
 }
 
-// submitCheckpoint sends a transaction with checkpoint data to the rootchain
+// submitCheckpoint sends a transaction with checkpoint data to the rootchain.
+// Any pending epoch ending checkpoints (e.g. the ones which failed to be sent while the rootchain was down)
+// are sent before it, in the catch-up mode
 func (c *checkpointManager) submitCheckpoint(latestHeader *types.Header, isEndOfEpoch bool) error {
+	if !c.submitting.CompareAndSwap(false, true) {
+		c.logger.Debug("checkpoint submission is already in progress", "checkpoint block", latestHeader.Number)
+
+		return nil
+	}
+
+	defer c.submitting.Store(false)
+
 	lastCheckpointBlockNumber, err := getCurrentCheckpointBlock(c.rootChainRelayer, c.checkpointManagerAddr)
 	if err != nil {
 		return err
 	}
 
+	c.setLastCheckpointBlock(lastCheckpointBlockNumber)
+
 	if lastCheckpointBlockNumber > latestHeader.Number {
 		// node is out of sync (haven't reached the tip of the chain), so even though it is a proposer,
 		// it would checkpoint block that is already checkpointed and transaction would fail anyway
@@ -137,6 +199,7 @@ func (c *checkpointManager) submitCheckpoint(latestHeader *types.Header, isEndOf
 		parentHeader       *types.Header
 		currentExtra       *Extra
 		found              bool
+		checkpoints        []*pendingCheckpoint
 	)
 
 	if initialBlockNumber < latestHeader.Number {
@@ -173,9 +236,11 @@ func (c *checkpointManager) submitCheckpoint(latestHeader *types.Header, isEndOf
 			continue
 		}
 
-		if err = c.encodeAndSendCheckpoint(parentHeader, parentExtra, true); err != nil {
-			return err
-		}
+		checkpoints = append(checkpoints, &pendingCheckpoint{
+			header:       parentHeader,
+			extra:        parentExtra,
+			isEndOfEpoch: true,
+		})
 
 		parentHeader = currentHeader
 		parentExtra = currentExtra
@@ -191,12 +256,65 @@ func (c *checkpointManager) submitCheckpoint(latestHeader *types.Header, isEndOf
 		}
 	}
 
-	return c.encodeAndSendCheckpoint(latestHeader, currentExtra, isEndOfEpoch)
+	checkpoints = append(checkpoints, &pendingCheckpoint{
+		header:       latestHeader,
+		extra:        currentExtra,
+		isEndOfEpoch: isEndOfEpoch,
+	})
+
+	return c.sendCheckpoints(checkpoints)
 }
 
-// encodeAndSendCheckpoint encodes checkpoint data for the given block and
-// sends a transaction to the CheckpointManager rootchain contract
-func (c *checkpointManager) encodeAndSendCheckpoint(header *types.Header, extra *Extra, isEndOfEpoch bool) error {
+// pendingCheckpoint is a checkpoint which is about to be sent to the rootchain
+type pendingCheckpoint struct {
+	header       *types.Header
+	extra        *Extra
+	isEndOfEpoch bool
+}
+
+// sendCheckpoints sends given checkpoints to the rootchain in order.
+// When there is more than one checkpoint to send (catch-up mode), checkpoints are sent in batches
+// and only the receipt of the last checkpoint in a batch is awaited. Since the checkpoints of a batch
+// are sent with consecutive nonces, the successful receipt of the last one means that the whole batch is checkpointed
+func (c *checkpointManager) sendCheckpoints(checkpoints []*pendingCheckpoint) error {
+	batchSize := 1
+	if c.rootChainBatchRelayer != nil && c.catchUpBatchSize > 1 {
+		batchSize = int(c.catchUpBatchSize)
+	}
+
+	if len(checkpoints) > 1 {
+		c.logger.Info("catching up with the pending checkpoints",
+			"pending checkpoints", len(checkpoints),
+			"first checkpoint block", checkpoints[0].header.Number,
+			"batch size", batchSize)
+	}
+
+	for start := 0; start < len(checkpoints); start += batchSize {
+		end := min(start+batchSize, len(checkpoints))
+
+		for i := start; i < end; i++ {
+			relayer := c.rootChainRelayer
+			if i < end-1 {
+				relayer = c.rootChainBatchRelayer
+			}
+
+			checkpoint := checkpoints[i]
+			if err := c.encodeAndSendCheckpoint(relayer, checkpoint.header,
+				checkpoint.extra, checkpoint.isEndOfEpoch); err != nil {
+				return err
+			}
+		}
+
+		c.setLastCheckpointBlock(checkpoints[end-1].header.Number)
+	}
+
+	return nil
+}
+
+// encodeAndSendCheckpoint encodes checkpoint data for the given block and sends a transaction
+// to the CheckpointManager rootchain contract. Receipt is not checked if the relayer does not wait for it
+func (c *checkpointManager) encodeAndSendCheckpoint(relayer txrelayer.TxRelayer,
+	header *types.Header, extra *Extra, isEndOfEpoch bool) error {
 	c.logger.Debug("send checkpoint txn...", "block number", header.Number)
 
 	nextEpochValidators := validator.AccountSet{}
@@ -220,11 +338,17 @@ func (c *checkpointManager) encodeAndSendCheckpoint(header *types.Header, extra
 		types.WithInput(input),
 	))
 
-	receipt, err := c.rootChainRelayer.SendTransaction(txn, c.key)
+	receipt, err := relayer.SendTransaction(txn, c.key)
 	if err != nil {
 		return err
 	}
 
+	if receipt == nil {
+		c.logger.Debug("checkpoint txn sent without waiting for the receipt", "block number", header.Number)
+
+		return nil
+	}
+
 	if receipt.Status == uint64(types.ReceiptFailed) {
 		return fmt.Errorf("checkpoint submission transaction failed for block %d", header.Number)
 	}
@@ -290,6 +414,131 @@ func (c *checkpointManager) PostBlock(req *PostBlockRequest) {
 
 		c.lastSentBlock = req.FullBlock.Block.Number()
 	}
+
+	c.childHeight.Store(req.FullBlock.Block.Number())
+	c.updateCheckpointLag()
+}
+
+// AddLog handles the received log from event tracker if it matches a checkpoint submitted event ABI,
+// and keeps track of the latest block checkpointed on the rootchain
+func (c *checkpointManager) AddLog(eventLog *ethgo.Log) error {
+	var checkpointSubmittedEvent contractsapi.CheckpointSubmittedEvent
+
+	doesMatch, err := checkpointSubmittedEvent.ParseLog(eventLog)
+	if err != nil {
+		c.logger.Error("could not decode checkpoint submitted event", "err", err)
+
+		return err
+	}
+
+	if !doesMatch {
+		return nil
+	}
+
+	c.setLastCheckpointBlock(checkpointSubmittedEvent.BlockNumber.Uint64())
+	c.updateCheckpointLag()
+
+	return nil
+}
+
+// setLastCheckpointBlock sets the latest block checkpointed on the rootchain,
+// unless a later checkpointed block is already known
+func (c *checkpointManager) setLastCheckpointBlock(checkpointBlock uint64) {
+	for {
+		lastCheckpointBlock := c.lastCheckpointBlock.Load()
+		if checkpointBlock <= lastCheckpointBlock ||
+			c.lastCheckpointBlock.CompareAndSwap(lastCheckpointBlock, checkpointBlock) {
+			return
+		}
+	}
+}
+
+// updateCheckpointLag updates the checkpoint lag metric, and raises (or clears) the checkpoint lag alert
+// once the lag crosses the alert threshold. If the last checkpoint block is not known yet,
+// it is fetched from the rootchain in the background and the lag is updated on the following block
+func (c *checkpointManager) updateCheckpointLag() {
+	lastCheckpointBlock := c.lastCheckpointBlock.Load()
+	if lastCheckpointBlock == 0 {
+		if c.refreshingCheckpointBlock.CompareAndSwap(false, true) {
+			go func() {
+				defer c.refreshingCheckpointBlock.Store(false)
+
+				if _, err := c.LastCheckpointBlock(); err != nil {
+					c.logger.Debug("failed to fetch the last checkpoint block", "error", err)
+				}
+			}()
+		}
+
+		return
+	}
+
+	childHeight := c.childHeight.Load()
+	if childHeight < lastCheckpointBlock {
+		return
+	}
+
+	lag := childHeight - lastCheckpointBlock
+	updateCheckpointLagMetric(lag)
+
+	if lag > c.lagAlertThreshold {
+		if c.lagAlert.CompareAndSwap(false, true) {
+			incrCheckpointLagAlertMetric()
+			c.logger.Warn("checkpoint lag exceeds the alert threshold",
+				"lag", lag,
+				"threshold", c.lagAlertThreshold,
+				"child height", childHeight,
+				"last checkpoint block", lastCheckpointBlock)
+		}
+	} else if c.lagAlert.CompareAndSwap(true, false) {
+		c.logger.Info("checkpoint lag is back below the alert threshold",
+			"lag", lag,
+			"threshold", c.lagAlertThreshold)
+	}
+}
+
+// CheckpointLag returns the number of the child chain blocks which are not checkpointed on the rootchain
+func (c *checkpointManager) CheckpointLag() (*types.BridgeCheckpointLag, error) {
+	lastCheckpointBlock, err := c.LastCheckpointBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &types.BridgeCheckpointLag{
+		ChildHeight:         c.blockchain.CurrentHeader().Number,
+		LastCheckpointBlock: lastCheckpointBlock,
+		AlertThreshold:      c.lagAlertThreshold,
+	}
+
+	if result.ChildHeight > lastCheckpointBlock {
+		result.Lag = result.ChildHeight - lastCheckpointBlock
+	}
+
+	result.Alert = result.Lag > result.AlertThreshold
+
+	return result, nil
+}
+
+// LastCheckpointBlock returns the latest block checkpointed on the rootchain.
+// If no checkpoint submitted event has been received yet, CheckpointManager contract is queried
+func (c *checkpointManager) LastCheckpointBlock() (uint64, error) {
+	if lastCheckpointBlock := c.lastCheckpointBlock.Load(); lastCheckpointBlock != 0 {
+		return lastCheckpointBlock, nil
+	}
+
+	lastCheckpointBlock, err := getCurrentCheckpointBlock(c.rootChainRelayer, c.checkpointManagerAddr)
+	if err != nil {
+		return 0, err
+	}
+
+	c.lastCheckpointBlock.CompareAndSwap(0, lastCheckpointBlock)
+
+	return lastCheckpointBlock, nil
+}
+
+// Rollback forgets the last checkpointed block, since the checkpoint submitted event it is taken from
+// might be emitted in the reorged rootchain blocks, so it is queried from the CheckpointManager contract again
+func (c *checkpointManager) Rollback() {
+	c.lastCheckpointBlock.Store(0)
 }
 
 // BuildEventRoot returns an exit event root hash for exit tree of given epoch
@@ -303,110 +552,163 @@ func (c *checkpointManager) BuildEventRoot(epoch uint64) (types.Hash, error) {
 		return types.ZeroHash, nil
 	}
 
-	tree, err := createExitTree(exitEvents)
+	tree, err := newExitTree(exitEvents)
 	if err != nil {
 		return types.ZeroHash, err
 	}
 
-	return types.Hash(tree.Hash()), nil
+	return tree.root(), nil
 }
 
 // GenerateExitProof generates proof of exit event
 func (c *checkpointManager) GenerateExitProof(exitID uint64) (types.Proof, error) {
-	c.logger.Debug("Generating proof for exit", "exitID", exitID)
-
-	exitEvent, err := c.state.ExitStore.getExitEvent(exitID)
+	proofs, err := c.GenerateExitProofs([]uint64{exitID})
 	if err != nil {
 		return types.Proof{}, err
 	}
 
+	return proofs[0], nil
+}
+
+// GenerateExitProofs generates proofs of given exit events, in the same order as the provided exit ids.
+// Checkpoint block of each exit event block is retrieved from the rootchain only once,
+// and the exit trees are built only once per checkpoint, since they are cached in the exit store
+func (c *checkpointManager) GenerateExitProofs(exitIDs []uint64) ([]types.Proof, error) {
+	c.logger.Debug("Generating proofs for exits", "exitIDs", exitIDs)
+
+	proofs := make([]types.Proof, len(exitIDs))
+	checkpointBlocks := make(map[uint64]uint64)
+
+	for i, exitID := range exitIDs {
+		exitEvent, err := c.state.ExitStore.getExitEvent(exitID)
+		if err != nil {
+			return nil, err
+		}
+
+		checkpointBlock, ok := checkpointBlocks[exitEvent.BlockNumber]
+		if !ok {
+			checkpointBlock, ok, err = c.getCheckpointBlock(exitEvent.BlockNumber)
+			if err != nil {
+				return nil, fmt.Errorf("failed to retrieve checkpoint block for exit ID %d: %w", exitID, err)
+			}
+
+			if !ok {
+				return nil, fmt.Errorf("checkpoint block not found for exit ID %d", exitID)
+			}
+
+			checkpointBlocks[exitEvent.BlockNumber] = checkpointBlock
+		}
+
+		tree, err := c.getExitTree(exitEvent.EpochNumber, checkpointBlock)
+		if err != nil {
+			return nil, err
+		}
+
+		leafIndex, ok := tree.leafIndex(exitID)
+		if !ok {
+			return nil, fmt.Errorf("exit event %d is not in the exit tree of checkpoint block %d",
+				exitID, checkpointBlock)
+		}
+
+		exitEventEncoded, err := exitEvent.L2StateSyncedEvent.Encode()
+		if err != nil {
+			return nil, err
+		}
+
+		proof := tree.proof(leafIndex)
+
+		c.logger.Debug("Generated proof for exit", "exitID", exitID, "leafIndex", leafIndex, "proofLen", len(proof))
+
+		proofs[i] = types.Proof{
+			Data: proof,
+			Metadata: map[string]interface{}{
+				"LeafIndex":       leafIndex,
+				"ExitEvent":       hex.EncodeToString(exitEventEncoded),
+				"CheckpointBlock": new(big.Int).SetUint64(checkpointBlock),
+			},
+		}
+	}
+
+	return proofs, nil
+}
+
+// getCheckpointBlock returns the checkpoint block on the rootchain which checkpointed given child chain block,
+// and whether such checkpoint is submitted at all
+func (c *checkpointManager) getCheckpointBlock(blockNumber uint64) (uint64, bool, error) {
 	getCheckpointBlockFn := &contractsapi.GetCheckpointBlockCheckpointManagerFn{
-		BlockNumber: new(big.Int).SetUint64(exitEvent.BlockNumber),
+		BlockNumber: new(big.Int).SetUint64(blockNumber),
 	}
 
 	input, err := getCheckpointBlockFn.EncodeAbi()
 	if err != nil {
-		return types.Proof{}, fmt.Errorf("failed to encode get checkpoint block input: %w", err)
+		return 0, false, fmt.Errorf("failed to encode get checkpoint block input: %w", err)
 	}
 
-	getCheckpointBlockResp, err := c.rootChainRelayer.Call(types.ZeroAddress, c.checkpointManagerAddr,
-		input)
+	getCheckpointBlockResp, err := c.rootChainRelayer.Call(types.ZeroAddress, c.checkpointManagerAddr, input)
 	if err != nil {
-		return types.Proof{}, fmt.Errorf("failed to retrieve checkpoint block for exit ID %d: %w", exitID, err)
+		return 0, false, err
 	}
 
 	getCheckpointBlockRespRaw, err := hex.DecodeHex(getCheckpointBlockResp)
 	if err != nil {
-		return types.Proof{}, fmt.Errorf("failed to decode hex response for exit ID %d: %w", exitID, err)
+		return 0, false, fmt.Errorf("failed to decode hex response: %w", err)
 	}
 
 	getCheckpointBlockGeneric, err := contractsapi.GetCheckpointBlockABIResponse.Decode(getCheckpointBlockRespRaw)
 	if err != nil {
-		return types.Proof{}, fmt.Errorf("failed to decode checkpoint block response for exit ID %d: %w", exitID, err)
+		return 0, false, fmt.Errorf("failed to decode checkpoint block response: %w", err)
 	}
 
 	checkpointBlockMap, ok := getCheckpointBlockGeneric.(map[string]interface{})
 	if !ok {
-		return types.Proof{}, fmt.Errorf("failed to convert for checkpoint block response exit ID %d", exitID)
+		return 0, false, errors.New("failed to convert checkpoint block response")
 	}
 
 	isFoundGeneric, ok := checkpointBlockMap["isFound"]
 	if !ok {
-		return types.Proof{}, fmt.Errorf("invalid response for exit ID %d", exitID)
+		return 0, false, errors.New("invalid checkpoint block response")
 	}
 
 	isCheckpointFound, ok := isFoundGeneric.(bool)
 	if !ok || !isCheckpointFound {
-		return types.Proof{}, fmt.Errorf("checkpoint block not found for exit ID %d", exitID)
-	}
-
-	checkpointBlockGeneric, ok := checkpointBlockMap["checkpointBlock"]
-	if !ok {
-		return types.Proof{}, fmt.Errorf("checkpoint block not found for exit ID %d", exitID)
+		return 0, false, nil
 	}
 
-	checkpointBlock, ok := checkpointBlockGeneric.(*big.Int)
+	checkpointBlock, ok := checkpointBlockMap["checkpointBlock"].(*big.Int)
 	if !ok {
-		return types.Proof{}, fmt.Errorf("checkpoint block not found for exit ID %d", exitID)
+		return 0, false, nil
 	}
 
-	exitEventEncoded, err := exitEvent.L2StateSyncedEvent.Encode()
-	if err != nil {
-		return types.Proof{}, err
-	}
+	return checkpointBlock.Uint64(), true, nil
+}
 
-	exitEvents, err := c.state.ExitStore.getExitEventsForProof(exitEvent.EpochNumber, checkpointBlock.Uint64())
-	if err != nil {
-		return types.Proof{}, err
+// getExitTree returns the exit tree of given epoch, which contains the exit events
+// checkpointed by the given checkpoint block. Tree is read from the exit store if it is already cached,
+// otherwise it is built and cached, once the node has processed the checkpoint block itself
+// (prior to that, the node may not have all the exit events of the checkpoint)
+func (c *checkpointManager) getExitTree(epoch, checkpointBlock uint64) (*exitTree, error) {
+	tree, err := c.state.ExitStore.getExitTree(epoch, checkpointBlock)
+	if err != nil || tree != nil {
+		return tree, err
 	}
 
-	tree, err := createExitTree(exitEvents)
+	exitEvents, err := c.state.ExitStore.getExitEventsForProof(epoch, checkpointBlock, nil)
 	if err != nil {
-		return types.Proof{}, err
+		return nil, err
 	}
 
-	leafIndex, err := tree.LeafIndex(exitEventEncoded)
+	tree, err = newExitTree(exitEvents)
 	if err != nil {
-		return types.Proof{}, err
+		return nil, err
 	}
 
-	proof, err := tree.GenerateProof(exitEventEncoded)
-	if err != nil {
-		return types.Proof{}, err
+	if c.childHeight.Load() >= checkpointBlock {
+		if err := c.state.ExitStore.insertExitTree(epoch, checkpointBlock, tree); err != nil {
+			return nil, fmt.Errorf("failed to cache exit tree of checkpoint block %d: %w", checkpointBlock, err)
+		}
 	}
 
-	c.logger.Debug("Generated proof for exit", "exitID", exitID, "leafIndex", leafIndex, "proofLen", len(proof))
-
-	exitEventHex := hex.EncodeToString(exitEventEncoded)
-
-	return types.Proof{
-		Data: types.FromMerkleToTypesHash(proof),
-		Metadata: map[string]interface{}{
-			"LeafIndex":       leafIndex,
-			"ExitEvent":       exitEventHex,
-			"CheckpointBlock": checkpointBlock,
-		},
-	}, nil
+	return tree, nil
 }
 
 // EventSubscriber implementation
@@ -440,6 +742,14 @@ func (c *checkpointManager) ProcessLog(header *types.Header, log *ethgo.Log, dbT
 		"epoch", exitEvent.EpochNumber,
 		"blockNumber", exitEvent.BlockNumber)
 
+	if err := c.state.ExitStore.insertTransferStage(exitEvent.ID.Uint64(), &types.BridgeTransferStage{
+		Name:        types.ExitEventStage,
+		BlockNumber: header.Number,
+		TxHash:      types.Hash(log.TransactionHash),
+	}, dbTx); err != nil {
+		return err
+	}
+
 	return c.state.ExitStore.insertExitEvent(exitEvent, dbTx)
 }
 
@@ -460,6 +770,90 @@ func createExitTree(exitEvents []*ExitEvent) (*merkle.MerkleTree, error) {
 	return merkle.NewMerkleTree(data)
 }
 
+// exitTree is an exit event merkle tree, built the same way as the one created by createExitTree,
+// but kept as the node hashes of each tree layer, so it can be stored and used to generate proofs
+// without encoding and hashing the exit events again
+type exitTree struct {
+	// ExitIDs are the ids of the exit events, in the order of tree leaves
+	ExitIDs []uint64 `json:"exitIds"`
+	// Layers are the node hashes of tree layers, from the leaves up to the root
+	Layers [][]types.Hash `json:"layers"`
+}
+
+// newExitTree creates an exit tree from provided exit events
+func newExitTree(exitEvents []*ExitEvent) (*exitTree, error) {
+	if len(exitEvents) == 0 {
+		return nil, errors.New("exit tree must contain at least one exit event")
+	}
+
+	exitIDs := make([]uint64, len(exitEvents))
+	leaves := make([]types.Hash, len(exitEvents))
+
+	for i, exitEvent := range exitEvents {
+		encoded, err := exitEvent.L2StateSyncedEvent.Encode()
+		if err != nil {
+			return nil, err
+		}
+
+		exitIDs[i] = exitEvent.ID.Uint64()
+		leaves[i] = crypto.Keccak256Hash(encoded)
+	}
+
+	layers := [][]types.Hash{leaves}
+
+	for layer := leaves; len(layer) > 1; layer = layers[len(layers)-1] {
+		parents := make([]types.Hash, 0, (len(layer)+1)/2)
+
+		for i := 0; i < len(layer); i += 2 {
+			// last node of the layer with an odd number of nodes is hashed with itself
+			right := layer[i]
+			if i+1 < len(layer) {
+				right = layer[i+1]
+			}
+
+			parents = append(parents, crypto.Keccak256Hash(layer[i].Bytes(), right.Bytes()))
+		}
+
+		layers = append(layers, parents)
+	}
+
+	return &exitTree{ExitIDs: exitIDs, Layers: layers}, nil
+}
+
+// root returns the root hash of the exit tree
+func (t *exitTree) root() types.Hash {
+	return t.Layers[len(t.Layers)-1][0]
+}
+
+// leafIndex returns the index of the leaf of the given exit event
+func (t *exitTree) leafIndex(exitID uint64) (uint64, bool) {
+	for i, id := range t.ExitIDs {
+		if id == exitID {
+			return uint64(i), true
+		}
+	}
+
+	return 0, false
+}
+
+// proof returns the merkle proof of the leaf with the given index
+func (t *exitTree) proof(leafIndex uint64) []types.Hash {
+	proof := make([]types.Hash, 0, len(t.Layers)-1)
+	index := leafIndex
+
+	for _, layer := range t.Layers[:len(t.Layers)-1] {
+		sibling := index ^ 1
+		if sibling >= uint64(len(layer)) {
+			sibling = index
+		}
+
+		proof = append(proof, layer[sibling])
+		index /= 2
+	}
+
+	return proof
+}
+
 // parseExitEvent parses exit event from provided log
 func parseExitEvent(h *types.Header, l *ethgo.Log) (*ExitEvent, bool, error) {
 	extra, err := GetIbftExtra(h.ExtraData)
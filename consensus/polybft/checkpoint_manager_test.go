@@ -53,49 +53,7 @@ func TestCheckpointManager_SubmitCheckpoint(t *testing.T) {
 		backendMock := new(polybftBackendMock)
 		backendMock.On("GetValidators", mock.Anything, mock.Anything).Return(validatorsMetadata)
 
-		var (
-			headersMap  = &testHeadersMap{}
-			epochNumber = uint64(1)
-			dummyMsg    = []byte("checkpoint")
-			idx         = uint64(0)
-			header      *types.Header
-			bitmap      bitmap.Bitmap
-			signatures  bls.Signatures
-		)
-
-		validators.IterAcct(aliases, func(t *validator.TestValidator) {
-			bitmap.Set(idx)
-
-			signatures = append(signatures, t.MustSign(dummyMsg, signer.DomainCheckpointManager))
-			idx++
-		})
-
-		signature, err := signatures.Aggregate().Marshal()
-		require.NoError(t, err)
-
-		for i := uint64(1); i <= blocksCount; i++ {
-			if i%epochSize == 1 {
-				// epoch-beginning block
-				checkpoint := &CheckpointData{
-					BlockRound:  0,
-					EpochNumber: epochNumber,
-					EventRoot:   types.BytesToHash(generateRandomBytes(t)),
-				}
-				extra := createTestExtraObject(validatorsMetadata, validatorsMetadata, 3, 3, 3)
-				extra.Checkpoint = checkpoint
-				extra.Committed = &Signature{Bitmap: bitmap, AggregatedSignature: signature}
-				header = &types.Header{
-					ExtraData: extra.MarshalRLPTo(nil),
-				}
-				epochNumber++
-			} else {
-				header = header.Copy()
-			}
-
-			header.Number = i
-			header.ComputeHash()
-			headersMap.addHeader(header)
-		}
+		headersMap := createTestCheckpointHeaders(t, validators, aliases, blocksCount, epochSize)
 
 		// mock blockchain
 		blockchainMock := new(blockchainMock)
@@ -110,7 +68,7 @@ func TestCheckpointManager_SubmitCheckpoint(t *testing.T) {
 			logger:           hclog.NewNullLogger(),
 		}
 
-		err = c.submitCheckpoint(headersMap.getHeader(blocksCount), false)
+		err := c.submitCheckpoint(headersMap.getHeader(blocksCount), false)
 		require.NoError(t, err)
 		txRelayerMock.AssertExpectations(t)
 
@@ -122,6 +80,70 @@ func TestCheckpointManager_SubmitCheckpoint(t *testing.T) {
 		}
 	})
 
+	t.Run("submit pending checkpoints in batches", func(t *testing.T) {
+		t.Parallel()
+
+		aliases := []string{"A", "B", "C"}
+
+		validators := validator.NewTestValidatorsWithAliases(t, aliases)
+		validatorsMetadata := validators.GetPublicIdentities()
+
+		// only the receipts of the last checkpoints in the batches (blocks 6 and 10) are awaited
+		txRelayerMock := newDummyTxRelayer(t)
+		txRelayerMock.On("Call", mock.Anything, mock.Anything, mock.Anything).
+			Return("2", error(nil)).
+			Once()
+		txRelayerMock.On("SendTransaction", mock.Anything, mock.Anything).
+			Return(&ethgo.Receipt{Status: uint64(types.ReceiptSuccess)}, error(nil)).
+			Times(2)
+
+		batchTxRelayerMock := newDummyTxRelayer(t)
+		batchTxRelayerMock.On("SendTransaction", mock.Anything, mock.Anything).
+			Return((*ethgo.Receipt)(nil), error(nil)).
+			Times(2)
+
+		backendMock := new(polybftBackendMock)
+		backendMock.On("GetValidators", mock.Anything, mock.Anything).Return(validatorsMetadata)
+
+		headersMap := createTestCheckpointHeaders(t, validators, aliases, blocksCount, epochSize)
+
+		blockchainMock := new(blockchainMock)
+		blockchainMock.On("GetHeaderByNumber", mock.Anything).Return(headersMap.getHeader)
+
+		c := &checkpointManager{
+			key:                   wallet.NewEcdsaSigner(validators.GetValidator("A").Key()),
+			rootChainRelayer:      txRelayerMock,
+			rootChainBatchRelayer: batchTxRelayerMock,
+			catchUpBatchSize:      2,
+			consensusBackend:      backendMock,
+			blockchain:            blockchainMock,
+			logger:                hclog.NewNullLogger(),
+		}
+
+		require.NoError(t, c.submitCheckpoint(headersMap.getHeader(blocksCount), true))
+		txRelayerMock.AssertExpectations(t)
+		batchTxRelayerMock.AssertExpectations(t)
+
+		require.Equal(t, []uint64{4, 8}, batchTxRelayerMock.checkpointBlocks)
+		require.Equal(t, []uint64{6, 10}, txRelayerMock.checkpointBlocks)
+		require.Equal(t, uint64(blocksCount), c.lastCheckpointBlock.Load())
+	})
+
+	t.Run("checkpoint not submitted while another submission is in progress", func(t *testing.T) {
+		t.Parallel()
+
+		txRelayerMock := newDummyTxRelayer(t)
+
+		c := &checkpointManager{
+			rootChainRelayer: txRelayerMock,
+			logger:           hclog.NewNullLogger(),
+		}
+		c.submitting.Store(true)
+
+		require.NoError(t, c.submitCheckpoint(&types.Header{Number: 10}, true))
+		txRelayerMock.AssertNotCalled(t, "Call", mock.Anything, mock.Anything, mock.Anything)
+	})
+
 	t.Run("checkpoint not submitted when node is syncing", func(t *testing.T) {
 		t.Parallel()
 
@@ -148,6 +170,50 @@ func TestCheckpointManager_SubmitCheckpoint(t *testing.T) {
 	})
 }
 
+func TestCheckpointManager_CheckpointLag(t *testing.T) {
+	t.Parallel()
+
+	blockchainMock := new(blockchainMock)
+	blockchainMock.On("CurrentHeader").Return(&types.Header{Number: 150})
+
+	c := newCheckpointManager(wallet.NewEcdsaSigner(createTestKey(t)), types.ZeroAddress,
+		nil, nil, blockchainMock, nil, hclog.NewNullLogger(), nil, 100)
+
+	c.childHeight.Store(150)
+	c.setLastCheckpointBlock(40)
+	c.updateCheckpointLag()
+	require.True(t, c.lagAlert.Load())
+
+	lag, err := c.CheckpointLag()
+	require.NoError(t, err)
+	require.Equal(t, &types.BridgeCheckpointLag{
+		ChildHeight:         150,
+		LastCheckpointBlock: 40,
+		Lag:                 110,
+		AlertThreshold:      100,
+		Alert:               true,
+	}, lag)
+
+	// older checkpoint does not override the latest one
+	c.setLastCheckpointBlock(30)
+	require.Equal(t, uint64(40), c.lastCheckpointBlock.Load())
+
+	// alert is cleared once the checkpoints catch up
+	c.setLastCheckpointBlock(140)
+	c.updateCheckpointLag()
+	require.False(t, c.lagAlert.Load())
+
+	lag, err = c.CheckpointLag()
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), lag.Lag)
+	require.False(t, lag.Alert)
+
+	// checkpoint from the reorged rootchain block is forgotten, so an older one can be set again
+	c.Rollback()
+	c.setLastCheckpointBlock(120)
+	require.Equal(t, uint64(120), c.lastCheckpointBlock.Load())
+}
+
 func TestCheckpointManager_abiEncodeCheckpointBlock(t *testing.T) {
 	t.Parallel()
 
@@ -312,7 +378,7 @@ func TestCheckpointManager_IsCheckpointBlock(t *testing.T) {
 			t.Parallel()
 
 			checkpointMgr := newCheckpointManager(wallet.NewEcdsaSigner(createTestKey(t)),
-				types.ZeroAddress, nil, nil, nil, hclog.NewNullLogger(), nil)
+				types.ZeroAddress, nil, nil, nil, nil, hclog.NewNullLogger(), nil, 0)
 			require.Equal(t, c.isCheckpointBlock,
 				checkpointMgr.isCheckpointBlock(c.blockNumber, c.checkpointsOffset, c.isEpochEndingBlock))
 		})
@@ -389,8 +455,10 @@ func TestCheckpointManager_GenerateExitProof(t *testing.T) {
 		dummyTxRelayer,
 		nil,
 		nil,
+		nil,
 		hclog.NewNullLogger(),
-		state)
+		state,
+		0)
 
 	exitEvents := insertTestExitEvents(t, state, 1, numOfBlocks, numOfEventsPerBlock)
 	encodedEvents := encodeExitEvents(t, exitEvents)
@@ -454,6 +522,113 @@ func TestCheckpointManager_GenerateExitProof(t *testing.T) {
 	})
 }
 
+func TestCheckpointManager_GenerateExitProofs(t *testing.T) {
+	t.Parallel()
+
+	const (
+		numOfBlocks         = 10
+		numOfEventsPerBlock = 3
+		checkpointBlock     = 7
+	)
+
+	state := newTestState(t)
+
+	checkpointReturn, err := contractsapi.GetCheckpointBlockABIResponse.Encode(map[string]interface{}{
+		"isFound":         true,
+		"checkpointBlock": checkpointBlock,
+	})
+	require.NoError(t, err)
+
+	dummyTxRelayer := newDummyTxRelayer(t)
+	dummyTxRelayer.On("Call", types.ZeroAddress, types.ZeroAddress, mock.Anything).
+		Return(hex.EncodeToString(checkpointReturn), error(nil))
+
+	checkpointMgr := newCheckpointManager(wallet.NewEcdsaSigner(
+		createTestKey(t)),
+		types.ZeroAddress,
+		dummyTxRelayer,
+		nil,
+		nil,
+		nil,
+		hclog.NewNullLogger(),
+		state,
+		0)
+	checkpointMgr.childHeight.Store(numOfBlocks)
+
+	exitEvents := insertTestExitEvents(t, state, 1, numOfBlocks, numOfEventsPerBlock)
+	encodedEvents := encodeExitEvents(t, exitEvents)
+
+	// exit events up to the checkpoint block are checkpointed together
+	tree, err := merkle.NewMerkleTree(encodedEvents[:checkpointBlock*numOfEventsPerBlock])
+	require.NoError(t, err)
+
+	// exit events 20 and 19 are emitted in the same block
+	exitIDs := []uint64{20, 0, 5, 19}
+
+	proofs, err := checkpointMgr.GenerateExitProofs(exitIDs)
+	require.NoError(t, err)
+	require.Len(t, proofs, len(exitIDs))
+
+	// checkpoint block is retrieved once per exit event block
+	dummyTxRelayer.AssertNumberOfCalls(t, "Call", 3)
+
+	for i, exitID := range exitIDs {
+		leafIndex, ok := proofs[i].Metadata["LeafIndex"].(uint64)
+		require.True(t, ok)
+		require.Equal(t, exitID, leafIndex)
+		require.Equal(t, new(big.Int).SetUint64(checkpointBlock), proofs[i].Metadata["CheckpointBlock"])
+
+		expectedProof, err := tree.GenerateProof(encodedEvents[exitID])
+		require.NoError(t, err)
+		require.Equal(t, types.FromMerkleToTypesHash(expectedProof), proofs[i].Data)
+		require.NoError(t, merkle.VerifyProof(leafIndex, encodedEvents[exitID],
+			types.FromTypesToMerkleHash(proofs[i].Data), tree.Hash()))
+	}
+
+	// exit tree is cached, so the same proofs are generated from the exit store
+	cachedTree, err := state.ExitStore.getExitTree(1, checkpointBlock)
+	require.NoError(t, err)
+	require.NotNil(t, cachedTree)
+	require.Equal(t, types.Hash(tree.Hash()), cachedTree.root())
+
+	cachedProofs, err := checkpointMgr.GenerateExitProofs(exitIDs)
+	require.NoError(t, err)
+	require.Equal(t, proofs, cachedProofs)
+
+	_, err = checkpointMgr.GenerateExitProofs([]uint64{0, numOfBlocks * numOfEventsPerBlock})
+	require.ErrorContains(t, err, "could not find any exit event that has an id")
+}
+
+func TestCheckpointManager_ExitTree(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+	exitEvents := insertTestExitEvents(t, state, 1, 17, 1)
+	encodedEvents := encodeExitEvents(t, exitEvents)
+
+	for size := 1; size <= len(exitEvents); size++ {
+		expectedTree, err := merkle.NewMerkleTree(encodedEvents[:size])
+		require.NoError(t, err)
+
+		tree, err := newExitTree(exitEvents[:size])
+		require.NoError(t, err)
+		require.Equal(t, types.Hash(expectedTree.Hash()), tree.root())
+
+		for i := 0; i < size; i++ {
+			leafIndex, ok := tree.leafIndex(exitEvents[i].ID.Uint64())
+			require.True(t, ok)
+			require.Equal(t, uint64(i), leafIndex)
+
+			expectedProof, err := expectedTree.GenerateProof(encodedEvents[i])
+			require.NoError(t, err)
+			require.Equal(t, types.FromMerkleToTypesHash(expectedProof), tree.proof(leafIndex))
+		}
+	}
+
+	_, err := newExitTree(nil)
+	require.Error(t, err)
+}
+
 var _ txrelayer.TxRelayer = (*dummyTxRelayer)(nil)
 
 type dummyTxRelayer struct {
@@ -498,6 +673,12 @@ func (d *dummyTxRelayer) GetTxnHashes() []types.Hash {
 	return nil
 }
 
+func (d *dummyTxRelayer) GetReplacedTxnHashes() map[types.Hash]types.Hash {
+	return nil
+}
+
+func (d *dummyTxRelayer) Close() {}
+
 func getBlockNumberCheckpointSubmitInput(t *testing.T, input []byte) uint64 {
 	t.Helper()
 
@@ -506,3 +687,57 @@ func getBlockNumberCheckpointSubmitInput(t *testing.T, input []byte) uint64 {
 
 	return submit.Checkpoint.BlockNumber.Uint64()
 }
+
+// createTestCheckpointHeaders creates headers of given number of blocks, signed by given validators,
+// where the epoch ending blocks are the ones divisible by the epoch size
+func createTestCheckpointHeaders(t *testing.T, validators *validator.TestValidators, aliases []string,
+	blocksCount, epochSize uint64) *testHeadersMap {
+	t.Helper()
+
+	var (
+		validatorsMetadata = validators.GetPublicIdentities()
+		headersMap         = &testHeadersMap{}
+		epochNumber        = uint64(1)
+		dummyMsg           = []byte("checkpoint")
+		idx                = uint64(0)
+		header             *types.Header
+		bitmap             bitmap.Bitmap
+		signatures         bls.Signatures
+	)
+
+	validators.IterAcct(aliases, func(t *validator.TestValidator) {
+		bitmap.Set(idx)
+
+		signatures = append(signatures, t.MustSign(dummyMsg, signer.DomainCheckpointManager))
+		idx++
+	})
+
+	signature, err := signatures.Aggregate().Marshal()
+	require.NoError(t, err)
+
+	for i := uint64(1); i <= blocksCount; i++ {
+		if i%epochSize == 1 {
+			// epoch-beginning block
+			checkpoint := &CheckpointData{
+				BlockRound:  0,
+				EpochNumber: epochNumber,
+				EventRoot:   types.BytesToHash(generateRandomBytes(t)),
+			}
+			extra := createTestExtraObject(validatorsMetadata, validatorsMetadata, 3, 3, 3)
+			extra.Checkpoint = checkpoint
+			extra.Committed = &Signature{Bitmap: bitmap, AggregatedSignature: signature}
+			header = &types.Header{
+				ExtraData: extra.MarshalRLPTo(nil),
+			}
+			epochNumber++
+		} else {
+			header = header.Copy()
+		}
+
+		header.Number = i
+		header.ComputeHash()
+		headersMap.addHeader(header)
+	}
+
+	return headersMap
+}
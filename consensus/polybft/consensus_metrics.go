@@ -56,3 +56,20 @@ func updateBlockExecutionMetric(start time.Time) {
 	metrics.SetGauge([]string{consensusMetricsPrefix, "block_execution_time"},
 		float32(time.Now().UTC().Sub(start).Seconds()))
 }
+
+// updateCheckpointLagMetric updates the number of child chain blocks which are not checkpointed on the rootchain
+func updateCheckpointLagMetric(lag uint64) {
+	metrics.SetGauge([]string{consensusMetricsPrefix, "checkpoint_lag"}, float32(lag))
+}
+
+// incrCheckpointLagAlertMetric increments the number of times the checkpoint lag exceeded the alert threshold
+func incrCheckpointLagAlertMetric() {
+	metrics.IncrCounter([]string{consensusMetricsPrefix, "checkpoint_lag_alerts"}, float32(1))
+}
+
+// updateRootchainReorgMetrics increments the number of detected rootchain reorgs
+// deeper than the number of block confirmations, and updates the depth of the latest one
+func updateRootchainReorgMetrics(depth uint64) {
+	metrics.IncrCounter([]string{consensusMetricsPrefix, "rootchain_reorgs"}, float32(1))
+	metrics.SetGauge([]string{consensusMetricsPrefix, "rootchain_reorg_depth"}, float32(depth))
+}
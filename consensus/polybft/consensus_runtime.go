@@ -94,6 +94,8 @@ type runtimeConfig struct {
 	bridgeTopic     topic
 	consensusConfig *consensus.Config
 	eventTracker    *consensus.EventTracker
+	// onBlockInserted is invoked with the header of each inserted block, once its processing is finished
+	onBlockInserted func(header *types.Header) error
 }
 
 // consensusRuntime is a struct that provides consensus runtime features like epoch, state and event management
@@ -380,6 +382,12 @@ func (c *consensusRuntime) OnBlockInserted(fullBlock *types.FullBlock) {
 	c.epoch = epoch
 	c.lastBuiltBlock = fullBlock.Block.Header
 
+	if c.config.onBlockInserted != nil {
+		if err := c.config.onBlockInserted(fullBlock.Block.Header); err != nil {
+			c.logger.Error("on block inserted hook failed", "block", fullBlock.Block.Number(), "error", err)
+		}
+	}
+
 	endTime := time.Now().UTC()
 
 	c.logger.Debug("OnBlockInserted finished", "elapsedTime", endTime.Sub(startTime),
@@ -404,6 +412,7 @@ func (c *consensusRuntime) FSM() error {
 		c.config.Key.Address(),
 		c.config.txPool,
 		epoch.CurrentClientConfig.BlockTime.Duration,
+		NewTxOrderingPolicy(epoch.CurrentClientConfig.TxOrdering),
 		c.logger,
 	)
 
@@ -460,6 +469,13 @@ func (c *consensusRuntime) FSM() error {
 		}
 	}
 
+	if isFirstBlockOfEpoch && c.config.Forks.IsActive(chain.GovernanceAddressLists, pendingBlockNumber) {
+		ff.addressListRoles, err = c.governanceManager.GetAddressListRoles(epoch.Number)
+		if err != nil {
+			return fmt.Errorf("cannot get address list roles: %w", err)
+		}
+	}
+
 	ff.distributeRewardsInput, err = c.calculateDistributeRewardsInput(isFirstBlockOfEpoch,
 		pendingBlockNumber, parent, epoch.Number)
 	if err != nil {
@@ -712,11 +728,51 @@ func (c *consensusRuntime) GenerateExitProof(exitID uint64) (types.Proof, error)
 	return c.bridgeManager.GenerateProof(exitID, Exit)
 }
 
+// GenerateExitProofs generates proofs of multiple exits and is a bridge endpoint store function
+func (c *consensusRuntime) GenerateExitProofs(exitIDs []uint64) ([]types.Proof, error) {
+	return c.bridgeManager.GenerateExitProofs(exitIDs)
+}
+
 // GetStateSyncProof returns the proof for the state sync
 func (c *consensusRuntime) GetStateSyncProof(stateSyncID uint64) (types.Proof, error) {
 	return c.bridgeManager.GenerateProof(stateSyncID, StateSync)
 }
 
+// GetTransferStatus returns the statuses of the bridge transfers and is a bridge endpoint store function
+func (c *consensusRuntime) GetTransferStatus(
+	query *types.BridgeTransferQuery) ([]*types.BridgeTransferStatus, error) {
+	return c.bridgeManager.GetTransferStatus(query)
+}
+
+// GetDeadLetterEvents returns the dead-lettered relayer events and is a bridge endpoint store function
+func (c *consensusRuntime) GetDeadLetterEvents(
+	transferType types.BridgeTransferType) ([]*types.BridgeRelayerEvent, error) {
+	return c.bridgeManager.GetDeadLetterEvents(transferType)
+}
+
+// RetryDeadLetterEvents returns dead-lettered relayer events back to the relayer queue
+// and is a bridge endpoint store function
+func (c *consensusRuntime) RetryDeadLetterEvents(transferType types.BridgeTransferType, eventIDs []uint64) error {
+	return c.bridgeManager.RetryDeadLetterEvents(transferType, eventIDs)
+}
+
+// SkipDeadLetterEvents removes dead-lettered relayer events and is a bridge endpoint store function
+func (c *consensusRuntime) SkipDeadLetterEvents(transferType types.BridgeTransferType, eventIDs []uint64) error {
+	return c.bridgeManager.SkipDeadLetterEvents(transferType, eventIDs)
+}
+
+// GetTokenMappings returns the token mappings done by the bridge predicates and is a bridge endpoint store function
+func (c *consensusRuntime) GetTokenMappings(
+	query *types.BridgeTokenMappingQuery) (*types.BridgeTokenMappings, error) {
+	return c.bridgeManager.GetTokenMappings(query)
+}
+
+// GetCheckpointLag returns the number of child chain blocks which are not checkpointed on the rootchain
+// and is a bridge endpoint store function
+func (c *consensusRuntime) GetCheckpointLag() (*types.BridgeCheckpointLag, error) {
+	return c.bridgeManager.GetCheckpointLag()
+}
+
 // setIsActiveValidator updates the activeValidatorFlag field
 func (c *consensusRuntime) setIsActiveValidator(isActiveValidator bool) {
 	c.activeValidatorFlag.Store(isActiveValidator)
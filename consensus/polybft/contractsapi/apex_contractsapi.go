@@ -2,9 +2,320 @@
 package contractsapi
 
 import (
+	"math/big"
+
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/Ethernal-Tech/ethgo"
+	"github.com/Ethernal-Tech/ethgo/abi"
 )
 
+type GetAllRegisteredChainsApexBridgeContractsBridgeFn struct {
+}
+
+func (g *GetAllRegisteredChainsApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["getAllRegisteredChains"].ID()
+}
+
+func (g *GetAllRegisteredChainsApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["getAllRegisteredChains"].Encode(g)
+}
+
+func (g *GetAllRegisteredChainsApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["getAllRegisteredChains"], buf, g)
+}
+
+type Chain struct {
+	ID              uint8  `abi:"id"`
+	ChainType       uint8  `abi:"chainType"`
+	AddressMultisig string `abi:"addressMultisig"`
+	AddressFeePayer string `abi:"addressFeePayer"`
+}
+
+var ChainABIType = abi.MustNewType("tuple(uint8 id,uint8 chainType,string addressMultisig,string addressFeePayer)")
+
+func (c *Chain) EncodeAbi() ([]byte, error) {
+	return ChainABIType.Encode(c)
+}
+
+func (c *Chain) DecodeAbi(buf []byte) error {
+	return decodeStruct(ChainABIType, buf, &c)
+}
+
+type GetAllRegisteredChainsApexBridgeContractsBridgeOutput struct {
+	Chains []*Chain `abi:"_chains"`
+}
+
+func (g *GetAllRegisteredChainsApexBridgeContractsBridgeOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Bridge.Abi.Methods["getAllRegisteredChains"].Outputs, buf, &g)
+}
+
+type GetBatchTransactionsApexBridgeContractsBridgeFn struct {
+	ChainID uint8  `abi:"_chainId"`
+	BatchID uint64 `abi:"_batchId"`
+}
+
+func (g *GetBatchTransactionsApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["getBatchTransactions"].ID()
+}
+
+func (g *GetBatchTransactionsApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["getBatchTransactions"].Encode(g)
+}
+
+func (g *GetBatchTransactionsApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["getBatchTransactions"], buf, g)
+}
+
+type TxDataInfo struct {
+	SourceChainID           uint8      `abi:"sourceChainId"`
+	ObservedTransactionHash types.Hash `abi:"observedTransactionHash"`
+}
+
+var TxDataInfoABIType = abi.MustNewType("tuple(uint8 sourceChainId,bytes32 observedTransactionHash)")
+
+func (t *TxDataInfo) EncodeAbi() ([]byte, error) {
+	return TxDataInfoABIType.Encode(t)
+}
+
+func (t *TxDataInfo) DecodeAbi(buf []byte) error {
+	return decodeStruct(TxDataInfoABIType, buf, &t)
+}
+
+type GetBatchTransactionsApexBridgeContractsBridgeOutput struct {
+	Field0 []*TxDataInfo `abi:"0"`
+}
+
+func (g *GetBatchTransactionsApexBridgeContractsBridgeOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Bridge.Abi.Methods["getBatchTransactions"].Outputs, buf, &g)
+}
+
+type GetConfirmedBatchApexBridgeContractsBridgeFn struct {
+	DestinationChain uint8 `abi:"_destinationChain"`
+}
+
+func (g *GetConfirmedBatchApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["getConfirmedBatch"].ID()
+}
+
+func (g *GetConfirmedBatchApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["getConfirmedBatch"].Encode(g)
+}
+
+func (g *GetConfirmedBatchApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["getConfirmedBatch"], buf, g)
+}
+
+type ConfirmedBatch struct {
+	Signatures     [][]byte `abi:"signatures"`
+	FeeSignatures  [][]byte `abi:"feeSignatures"`
+	Bitmap         *big.Int `abi:"bitmap"`
+	RawTransaction []byte   `abi:"rawTransaction"`
+	ID             uint64   `abi:"id"`
+}
+
+var ConfirmedBatchABIType = abi.MustNewType("tuple(bytes[] signatures,bytes[] feeSignatures,uint256 bitmap,bytes rawTransaction,uint64 id)")
+
+func (c *ConfirmedBatch) EncodeAbi() ([]byte, error) {
+	return ConfirmedBatchABIType.Encode(c)
+}
+
+func (c *ConfirmedBatch) DecodeAbi(buf []byte) error {
+	return decodeStruct(ConfirmedBatchABIType, buf, &c)
+}
+
+type GetConfirmedBatchApexBridgeContractsBridgeOutput struct {
+	Batch *ConfirmedBatch `abi:"_batch"`
+}
+
+func (g *GetConfirmedBatchApexBridgeContractsBridgeOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Bridge.Abi.Methods["getConfirmedBatch"].Outputs, buf, &g)
+}
+
+type GetConfirmedTransactionsApexBridgeContractsBridgeFn struct {
+	DestinationChain uint8 `abi:"_destinationChain"`
+}
+
+func (g *GetConfirmedTransactionsApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["getConfirmedTransactions"].ID()
+}
+
+func (g *GetConfirmedTransactionsApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["getConfirmedTransactions"].Encode(g)
+}
+
+func (g *GetConfirmedTransactionsApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["getConfirmedTransactions"], buf, g)
+}
+
+type Receiver struct {
+	Amount             *big.Int `abi:"amount"`
+	DestinationAddress string   `abi:"destinationAddress"`
+}
+
+var ReceiverABIType = abi.MustNewType("tuple(uint256 amount,string destinationAddress)")
+
+func (r *Receiver) EncodeAbi() ([]byte, error) {
+	return ReceiverABIType.Encode(r)
+}
+
+func (r *Receiver) DecodeAbi(buf []byte) error {
+	return decodeStruct(ReceiverABIType, buf, &r)
+}
+
+type ConfirmedTransaction struct {
+	BlockHeight             *big.Int    `abi:"blockHeight"`
+	TotalAmount             *big.Int    `abi:"totalAmount"`
+	RetryCounter            *big.Int    `abi:"retryCounter"`
+	Nonce                   uint64      `abi:"nonce"`
+	SourceChainID           uint8       `abi:"sourceChainId"`
+	ObservedTransactionHash types.Hash  `abi:"observedTransactionHash"`
+	TransactionType         uint8       `abi:"transactionType"`
+	Receivers               []*Receiver `abi:"receivers"`
+}
+
+var ConfirmedTransactionABIType = abi.MustNewType("tuple(uint256 blockHeight,uint256 totalAmount,uint256 retryCounter,uint64 nonce,uint8 sourceChainId,bytes32 observedTransactionHash,uint8 transactionType,tuple(uint256 amount,string destinationAddress)[] receivers)")
+
+func (c *ConfirmedTransaction) EncodeAbi() ([]byte, error) {
+	return ConfirmedTransactionABIType.Encode(c)
+}
+
+func (c *ConfirmedTransaction) DecodeAbi(buf []byte) error {
+	return decodeStruct(ConfirmedTransactionABIType, buf, &c)
+}
+
+type GetConfirmedTransactionsApexBridgeContractsBridgeOutput struct {
+	ConfirmedTransactions []*ConfirmedTransaction `abi:"_confirmedTransactions"`
+}
+
+func (g *GetConfirmedTransactionsApexBridgeContractsBridgeOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Bridge.Abi.Methods["getConfirmedTransactions"].Outputs, buf, &g)
+}
+
+type GetLastObservedBlockApexBridgeContractsBridgeFn struct {
+	SourceChain uint8 `abi:"_sourceChain"`
+}
+
+func (g *GetLastObservedBlockApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["getLastObservedBlock"].ID()
+}
+
+func (g *GetLastObservedBlockApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["getLastObservedBlock"].Encode(g)
+}
+
+func (g *GetLastObservedBlockApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["getLastObservedBlock"], buf, g)
+}
+
+type CardanoBlock struct {
+	BlockSlot *big.Int   `abi:"blockSlot"`
+	BlockHash types.Hash `abi:"blockHash"`
+}
+
+var CardanoBlockABIType = abi.MustNewType("tuple(uint256 blockSlot,bytes32 blockHash)")
+
+func (c *CardanoBlock) EncodeAbi() ([]byte, error) {
+	return CardanoBlockABIType.Encode(c)
+}
+
+func (c *CardanoBlock) DecodeAbi(buf []byte) error {
+	return decodeStruct(CardanoBlockABIType, buf, &c)
+}
+
+type GetLastObservedBlockApexBridgeContractsBridgeOutput struct {
+	Cblock *CardanoBlock `abi:"_cblock"`
+}
+
+func (g *GetLastObservedBlockApexBridgeContractsBridgeOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Bridge.Abi.Methods["getLastObservedBlock"].Outputs, buf, &g)
+}
+
+type GetNextBatchIdApexBridgeContractsBridgeFn struct {
+	DestinationChain uint8 `abi:"_destinationChain"`
+}
+
+func (g *GetNextBatchIdApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["getNextBatchId"].ID()
+}
+
+func (g *GetNextBatchIdApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["getNextBatchId"].Encode(g)
+}
+
+func (g *GetNextBatchIdApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["getNextBatchId"], buf, g)
+}
+
+type GetNextBatchIdApexBridgeContractsBridgeOutput struct {
+	Result uint64 `abi:"_result"`
+}
+
+func (g *GetNextBatchIdApexBridgeContractsBridgeOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Bridge.Abi.Methods["getNextBatchId"].Outputs, buf, &g)
+}
+
+type GetRawTransactionFromLastBatchApexBridgeContractsBridgeFn struct {
+	DestinationChain uint8 `abi:"_destinationChain"`
+}
+
+func (g *GetRawTransactionFromLastBatchApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["getRawTransactionFromLastBatch"].ID()
+}
+
+func (g *GetRawTransactionFromLastBatchApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["getRawTransactionFromLastBatch"].Encode(g)
+}
+
+func (g *GetRawTransactionFromLastBatchApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["getRawTransactionFromLastBatch"], buf, g)
+}
+
+type GetRawTransactionFromLastBatchApexBridgeContractsBridgeOutput struct {
+	Field0 []byte `abi:"0"`
+}
+
+func (g *GetRawTransactionFromLastBatchApexBridgeContractsBridgeOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Bridge.Abi.Methods["getRawTransactionFromLastBatch"].Outputs, buf, &g)
+}
+
+type GetValidatorsChainDataApexBridgeContractsBridgeFn struct {
+	ChainID uint8 `abi:"_chainId"`
+}
+
+func (g *GetValidatorsChainDataApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["getValidatorsChainData"].ID()
+}
+
+func (g *GetValidatorsChainDataApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["getValidatorsChainData"].Encode(g)
+}
+
+func (g *GetValidatorsChainDataApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["getValidatorsChainData"], buf, g)
+}
+
+type ValidatorChainData struct {
+	Key [4]*big.Int `abi:"key"`
+}
+
+var ValidatorChainDataABIType = abi.MustNewType("tuple(uint256[4] key)")
+
+func (v *ValidatorChainData) EncodeAbi() ([]byte, error) {
+	return ValidatorChainDataABIType.Encode(v)
+}
+
+func (v *ValidatorChainData) DecodeAbi(buf []byte) error {
+	return decodeStruct(ValidatorChainDataABIType, buf, &v)
+}
+
+type GetValidatorsChainDataApexBridgeContractsBridgeOutput struct {
+	Field0 []*ValidatorChainData `abi:"0"`
+}
+
+func (g *GetValidatorsChainDataApexBridgeContractsBridgeOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Bridge.Abi.Methods["getValidatorsChainData"].Outputs, buf, &g)
+}
+
 type InitializeApexBridgeContractsBridgeFn struct {
 	Owner        types.Address `abi:"_owner"`
 	UpgradeAdmin types.Address `abi:"_upgradeAdmin"`
@@ -14,190 +325,2835 @@ func (i *InitializeApexBridgeContractsBridgeFn) Sig() []byte {
 	return ApexBridgeContracts.Bridge.Abi.Methods["initialize"].ID()
 }
 
-func (i *InitializeApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
-	return ApexBridgeContracts.Bridge.Abi.Methods["initialize"].Encode(i)
+func (i *InitializeApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["initialize"].Encode(i)
+}
+
+func (i *InitializeApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["initialize"], buf, i)
+}
+
+type OwnerApexBridgeContractsBridgeFn struct {
+}
+
+func (o *OwnerApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["owner"].ID()
+}
+
+func (o *OwnerApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["owner"].Encode(o)
+}
+
+func (o *OwnerApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["owner"], buf, o)
+}
+
+type OwnerApexBridgeContractsBridgeOutput struct {
+	Field0 types.Address `abi:"0"`
+}
+
+func (o *OwnerApexBridgeContractsBridgeOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Bridge.Abi.Methods["owner"].Outputs, buf, &o)
+}
+
+type ProxiableUUIDApexBridgeContractsBridgeFn struct {
+}
+
+func (p *ProxiableUUIDApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["proxiableUUID"].ID()
+}
+
+func (p *ProxiableUUIDApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["proxiableUUID"].Encode(p)
+}
+
+func (p *ProxiableUUIDApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["proxiableUUID"], buf, p)
+}
+
+type ProxiableUUIDApexBridgeContractsBridgeOutput struct {
+	Field0 types.Hash `abi:"0"`
+}
+
+func (p *ProxiableUUIDApexBridgeContractsBridgeOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Bridge.Abi.Methods["proxiableUUID"].Outputs, buf, &p)
+}
+
+type ValidatorAddressChainData struct {
+	Addr types.Address       `abi:"addr"`
+	Data *ValidatorChainData `abi:"data"`
+}
+
+var ValidatorAddressChainDataABIType = abi.MustNewType("tuple(address addr,tuple(uint256[4] key) data)")
+
+func (v *ValidatorAddressChainData) EncodeAbi() ([]byte, error) {
+	return ValidatorAddressChainDataABIType.Encode(v)
+}
+
+func (v *ValidatorAddressChainData) DecodeAbi(buf []byte) error {
+	return decodeStruct(ValidatorAddressChainDataABIType, buf, &v)
+}
+
+type RegisterChainApexBridgeContractsBridgeFn struct {
+	Chain         *Chain                       `abi:"_chain"`
+	TokenQuantity *big.Int                     `abi:"_tokenQuantity"`
+	ChainDatas    []*ValidatorAddressChainData `abi:"_chainDatas"`
+}
+
+func (r *RegisterChainApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["registerChain"].ID()
+}
+
+func (r *RegisterChainApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["registerChain"].Encode(r)
+}
+
+func (r *RegisterChainApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["registerChain"], buf, r)
+}
+
+type RegisterChainGovernanceApexBridgeContractsBridgeFn struct {
+	ChainID            uint8               `abi:"_chainId"`
+	ChainType          uint8               `abi:"_chainType"`
+	TokenQuantity      *big.Int            `abi:"_tokenQuantity"`
+	ValidatorChainData *ValidatorChainData `abi:"_validatorChainData"`
+}
+
+func (r *RegisterChainGovernanceApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["registerChainGovernance"].ID()
+}
+
+func (r *RegisterChainGovernanceApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["registerChainGovernance"].Encode(r)
+}
+
+func (r *RegisterChainGovernanceApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["registerChainGovernance"], buf, r)
+}
+
+type RenounceOwnershipApexBridgeContractsBridgeFn struct {
+}
+
+func (r *RenounceOwnershipApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["renounceOwnership"].ID()
+}
+
+func (r *RenounceOwnershipApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["renounceOwnership"].Encode(r)
+}
+
+func (r *RenounceOwnershipApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["renounceOwnership"], buf, r)
+}
+
+type SetChainAdditionalDataApexBridgeContractsBridgeFn struct {
+	ChainID         uint8  `abi:"_chainId"`
+	AddressMultisig string `abi:"addressMultisig"`
+	AddressFeePayer string `abi:"addressFeePayer"`
+}
+
+func (s *SetChainAdditionalDataApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["setChainAdditionalData"].ID()
+}
+
+func (s *SetChainAdditionalDataApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["setChainAdditionalData"].Encode(s)
+}
+
+func (s *SetChainAdditionalDataApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["setChainAdditionalData"], buf, s)
+}
+
+type SetDependenciesApexBridgeContractsBridgeFn struct {
+	ClaimsAddress        types.Address `abi:"_claimsAddress"`
+	SignedBatchesAddress types.Address `abi:"_signedBatchesAddress"`
+	SlotsAddress         types.Address `abi:"_slotsAddress"`
+	ValidatorsAddress    types.Address `abi:"_validatorsAddress"`
+}
+
+func (s *SetDependenciesApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["setDependencies"].ID()
+}
+
+func (s *SetDependenciesApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["setDependencies"].Encode(s)
+}
+
+func (s *SetDependenciesApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["setDependencies"], buf, s)
+}
+
+type ShouldCreateBatchApexBridgeContractsBridgeFn struct {
+	DestinationChain uint8 `abi:"_destinationChain"`
+}
+
+func (s *ShouldCreateBatchApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["shouldCreateBatch"].ID()
+}
+
+func (s *ShouldCreateBatchApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["shouldCreateBatch"].Encode(s)
+}
+
+func (s *ShouldCreateBatchApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["shouldCreateBatch"], buf, s)
+}
+
+type ShouldCreateBatchApexBridgeContractsBridgeOutput struct {
+	Batch bool `abi:"_batch"`
+}
+
+func (s *ShouldCreateBatchApexBridgeContractsBridgeOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Bridge.Abi.Methods["shouldCreateBatch"].Outputs, buf, &s)
+}
+
+type BridgingRequestClaim struct {
+	ObservedTransactionHash types.Hash  `abi:"observedTransactionHash"`
+	Receivers               []*Receiver `abi:"receivers"`
+	TotalAmount             *big.Int    `abi:"totalAmount"`
+	RetryCounter            *big.Int    `abi:"retryCounter"`
+	SourceChainID           uint8       `abi:"sourceChainId"`
+	DestinationChainID      uint8       `abi:"destinationChainId"`
+}
+
+var BridgingRequestClaimABIType = abi.MustNewType("tuple(bytes32 observedTransactionHash,tuple(uint256 amount,string destinationAddress)[] receivers,uint256 totalAmount,uint256 retryCounter,uint8 sourceChainId,uint8 destinationChainId)")
+
+func (b *BridgingRequestClaim) EncodeAbi() ([]byte, error) {
+	return BridgingRequestClaimABIType.Encode(b)
+}
+
+func (b *BridgingRequestClaim) DecodeAbi(buf []byte) error {
+	return decodeStruct(BridgingRequestClaimABIType, buf, &b)
+}
+
+type BatchExecutedClaim struct {
+	ObservedTransactionHash types.Hash `abi:"observedTransactionHash"`
+	BatchNonceID            uint64     `abi:"batchNonceId"`
+	ChainID                 uint8      `abi:"chainId"`
+}
+
+var BatchExecutedClaimABIType = abi.MustNewType("tuple(bytes32 observedTransactionHash,uint64 batchNonceId,uint8 chainId)")
+
+func (b *BatchExecutedClaim) EncodeAbi() ([]byte, error) {
+	return BatchExecutedClaimABIType.Encode(b)
+}
+
+func (b *BatchExecutedClaim) DecodeAbi(buf []byte) error {
+	return decodeStruct(BatchExecutedClaimABIType, buf, &b)
+}
+
+type BatchExecutionFailedClaim struct {
+	ObservedTransactionHash types.Hash `abi:"observedTransactionHash"`
+	BatchNonceID            uint64     `abi:"batchNonceId"`
+	ChainID                 uint8      `abi:"chainId"`
+}
+
+var BatchExecutionFailedClaimABIType = abi.MustNewType("tuple(bytes32 observedTransactionHash,uint64 batchNonceId,uint8 chainId)")
+
+func (b *BatchExecutionFailedClaim) EncodeAbi() ([]byte, error) {
+	return BatchExecutionFailedClaimABIType.Encode(b)
+}
+
+func (b *BatchExecutionFailedClaim) DecodeAbi(buf []byte) error {
+	return decodeStruct(BatchExecutionFailedClaimABIType, buf, &b)
+}
+
+type RefundRequestClaim struct {
+	ObservedTransactionHash types.Hash `abi:"observedTransactionHash"`
+	PreviousRefundTxHash    types.Hash `abi:"previousRefundTxHash"`
+	Signature               []byte     `abi:"signature"`
+	RawTransaction          []byte     `abi:"rawTransaction"`
+	RetryCounter            uint64     `abi:"retryCounter"`
+	ChainID                 uint8      `abi:"chainId"`
+	Receiver                string     `abi:"receiver"`
+}
+
+var RefundRequestClaimABIType = abi.MustNewType("tuple(bytes32 observedTransactionHash,bytes32 previousRefundTxHash,bytes signature,bytes rawTransaction,uint64 retryCounter,uint8 chainId,string receiver)")
+
+func (r *RefundRequestClaim) EncodeAbi() ([]byte, error) {
+	return RefundRequestClaimABIType.Encode(r)
+}
+
+func (r *RefundRequestClaim) DecodeAbi(buf []byte) error {
+	return decodeStruct(RefundRequestClaimABIType, buf, &r)
+}
+
+type RefundExecutedClaim struct {
+	ObservedTransactionHash types.Hash `abi:"observedTransactionHash"`
+	RefundTxHash            types.Hash `abi:"refundTxHash"`
+	ChainID                 uint8      `abi:"chainId"`
+}
+
+var RefundExecutedClaimABIType = abi.MustNewType("tuple(bytes32 observedTransactionHash,bytes32 refundTxHash,uint8 chainId)")
+
+func (r *RefundExecutedClaim) EncodeAbi() ([]byte, error) {
+	return RefundExecutedClaimABIType.Encode(r)
+}
+
+func (r *RefundExecutedClaim) DecodeAbi(buf []byte) error {
+	return decodeStruct(RefundExecutedClaimABIType, buf, &r)
+}
+
+type HotWalletIncrementClaim struct {
+	ChainID     uint8    `abi:"chainId"`
+	Amount      *big.Int `abi:"amount"`
+	IsIncrement bool     `abi:"isIncrement"`
+}
+
+var HotWalletIncrementClaimABIType = abi.MustNewType("tuple(uint8 chainId,uint256 amount,bool isIncrement)")
+
+func (h *HotWalletIncrementClaim) EncodeAbi() ([]byte, error) {
+	return HotWalletIncrementClaimABIType.Encode(h)
+}
+
+func (h *HotWalletIncrementClaim) DecodeAbi(buf []byte) error {
+	return decodeStruct(HotWalletIncrementClaimABIType, buf, &h)
+}
+
+type ValidatorClaims struct {
+	BridgingRequestClaims      []*BridgingRequestClaim      `abi:"bridgingRequestClaims"`
+	BatchExecutedClaims        []*BatchExecutedClaim        `abi:"batchExecutedClaims"`
+	BatchExecutionFailedClaims []*BatchExecutionFailedClaim `abi:"batchExecutionFailedClaims"`
+	RefundRequestClaims        []*RefundRequestClaim        `abi:"refundRequestClaims"`
+	RefundExecutedClaims       []*RefundExecutedClaim       `abi:"refundExecutedClaims"`
+	HotWalletIncrementClaims   []*HotWalletIncrementClaim   `abi:"hotWalletIncrementClaims"`
+}
+
+var ValidatorClaimsABIType = abi.MustNewType("tuple(tuple(bytes32 observedTransactionHash,tuple(uint256 amount,string destinationAddress)[] receivers,uint256 totalAmount,uint256 retryCounter,uint8 sourceChainId,uint8 destinationChainId)[] bridgingRequestClaims,tuple(bytes32 observedTransactionHash,uint64 batchNonceId,uint8 chainId)[] batchExecutedClaims,tuple(bytes32 observedTransactionHash,uint64 batchNonceId,uint8 chainId)[] batchExecutionFailedClaims,tuple(bytes32 observedTransactionHash,bytes32 previousRefundTxHash,bytes signature,bytes rawTransaction,uint64 retryCounter,uint8 chainId,string receiver)[] refundRequestClaims,tuple(bytes32 observedTransactionHash,bytes32 refundTxHash,uint8 chainId)[] refundExecutedClaims,tuple(uint8 chainId,uint256 amount,bool isIncrement)[] hotWalletIncrementClaims)")
+
+func (v *ValidatorClaims) EncodeAbi() ([]byte, error) {
+	return ValidatorClaimsABIType.Encode(v)
+}
+
+func (v *ValidatorClaims) DecodeAbi(buf []byte) error {
+	return decodeStruct(ValidatorClaimsABIType, buf, &v)
+}
+
+type SubmitClaimsApexBridgeContractsBridgeFn struct {
+	Claims *ValidatorClaims `abi:"_claims"`
+}
+
+func (s *SubmitClaimsApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["submitClaims"].ID()
+}
+
+func (s *SubmitClaimsApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["submitClaims"].Encode(s)
+}
+
+func (s *SubmitClaimsApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["submitClaims"], buf, s)
+}
+
+type SubmitLastObservedBlocksApexBridgeContractsBridgeFn struct {
+	ChainID uint8           `abi:"_chainId"`
+	Blocks  []*CardanoBlock `abi:"_blocks"`
+}
+
+func (s *SubmitLastObservedBlocksApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["submitLastObservedBlocks"].ID()
+}
+
+func (s *SubmitLastObservedBlocksApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["submitLastObservedBlocks"].Encode(s)
+}
+
+func (s *SubmitLastObservedBlocksApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["submitLastObservedBlocks"], buf, s)
+}
+
+type SignedBatch struct {
+	ID                 uint64 `abi:"id"`
+	FirstTxNonceID     uint64 `abi:"firstTxNonceId"`
+	LastTxNonceID      uint64 `abi:"lastTxNonceId"`
+	DestinationChainID uint8  `abi:"destinationChainId"`
+	Signature          []byte `abi:"signature"`
+	FeeSignature       []byte `abi:"feeSignature"`
+	RawTransaction     []byte `abi:"rawTransaction"`
+}
+
+var SignedBatchABIType = abi.MustNewType("tuple(uint64 id,uint64 firstTxNonceId,uint64 lastTxNonceId,uint8 destinationChainId,bytes signature,bytes feeSignature,bytes rawTransaction)")
+
+func (s *SignedBatch) EncodeAbi() ([]byte, error) {
+	return SignedBatchABIType.Encode(s)
+}
+
+func (s *SignedBatch) DecodeAbi(buf []byte) error {
+	return decodeStruct(SignedBatchABIType, buf, &s)
+}
+
+type SubmitSignedBatchApexBridgeContractsBridgeFn struct {
+	SignedBatch *SignedBatch `abi:"_signedBatch"`
+}
+
+func (s *SubmitSignedBatchApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["submitSignedBatch"].ID()
+}
+
+func (s *SubmitSignedBatchApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["submitSignedBatch"].Encode(s)
+}
+
+func (s *SubmitSignedBatchApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["submitSignedBatch"], buf, s)
+}
+
+type SubmitSignedBatchEVMApexBridgeContractsBridgeFn struct {
+	SignedBatch *SignedBatch `abi:"_signedBatch"`
+}
+
+func (s *SubmitSignedBatchEVMApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["submitSignedBatchEVM"].ID()
+}
+
+func (s *SubmitSignedBatchEVMApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["submitSignedBatchEVM"].Encode(s)
+}
+
+func (s *SubmitSignedBatchEVMApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["submitSignedBatchEVM"], buf, s)
+}
+
+type TransferOwnershipApexBridgeContractsBridgeFn struct {
+	NewOwner types.Address `abi:"newOwner"`
+}
+
+func (t *TransferOwnershipApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["transferOwnership"].ID()
+}
+
+func (t *TransferOwnershipApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["transferOwnership"].Encode(t)
+}
+
+func (t *TransferOwnershipApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["transferOwnership"], buf, t)
+}
+
+type UpgradeToApexBridgeContractsBridgeFn struct {
+	NewImplementation types.Address `abi:"newImplementation"`
+}
+
+func (u *UpgradeToApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["upgradeTo"].ID()
+}
+
+func (u *UpgradeToApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["upgradeTo"].Encode(u)
+}
+
+func (u *UpgradeToApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["upgradeTo"], buf, u)
+}
+
+type UpgradeToAndCallApexBridgeContractsBridgeFn struct {
+	NewImplementation types.Address `abi:"newImplementation"`
+	Data              []byte        `abi:"data"`
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsBridgeFn) Sig() []byte {
+	return ApexBridgeContracts.Bridge.Abi.Methods["upgradeToAndCall"].ID()
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Methods["upgradeToAndCall"].Encode(u)
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["upgradeToAndCall"], buf, u)
+}
+
+type AdminChangedEvent struct {
+	PreviousAdmin types.Address `abi:"previousAdmin"`
+	NewAdmin      types.Address `abi:"newAdmin"`
+}
+
+func (*AdminChangedEvent) Sig() ethgo.Hash {
+	return ApexBridgeContracts.Bridge.Abi.Events["AdminChanged"].ID()
+}
+
+func (a *AdminChangedEvent) Encode() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Events["AdminChanged"].Inputs.Encode(a)
+}
+
+func (a *AdminChangedEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ApexBridgeContracts.Bridge.Abi.Events["AdminChanged"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ApexBridgeContracts.Bridge.Abi.Events["AdminChanged"], log, a)
+}
+
+func (a *AdminChangedEvent) Decode(input []byte) error {
+	return ApexBridgeContracts.Bridge.Abi.Events["AdminChanged"].Inputs.DecodeStruct(input, &a)
+}
+
+type BeaconUpgradedEvent struct {
+	Beacon types.Address `abi:"beacon"`
+}
+
+func (*BeaconUpgradedEvent) Sig() ethgo.Hash {
+	return ApexBridgeContracts.Bridge.Abi.Events["BeaconUpgraded"].ID()
+}
+
+func (b *BeaconUpgradedEvent) Encode() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Events["BeaconUpgraded"].Inputs.Encode(b)
+}
+
+func (b *BeaconUpgradedEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ApexBridgeContracts.Bridge.Abi.Events["BeaconUpgraded"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ApexBridgeContracts.Bridge.Abi.Events["BeaconUpgraded"], log, b)
+}
+
+func (b *BeaconUpgradedEvent) Decode(input []byte) error {
+	return ApexBridgeContracts.Bridge.Abi.Events["BeaconUpgraded"].Inputs.DecodeStruct(input, &b)
+}
+
+type ChainDefundedEvent struct {
+	ChainID uint8    `abi:"_chainId"`
+	Amount  *big.Int `abi:"_amount"`
+}
+
+func (*ChainDefundedEvent) Sig() ethgo.Hash {
+	return ApexBridgeContracts.Bridge.Abi.Events["ChainDefunded"].ID()
+}
+
+func (c *ChainDefundedEvent) Encode() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Events["ChainDefunded"].Inputs.Encode(c)
+}
+
+func (c *ChainDefundedEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ApexBridgeContracts.Bridge.Abi.Events["ChainDefunded"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ApexBridgeContracts.Bridge.Abi.Events["ChainDefunded"], log, c)
+}
+
+func (c *ChainDefundedEvent) Decode(input []byte) error {
+	return ApexBridgeContracts.Bridge.Abi.Events["ChainDefunded"].Inputs.DecodeStruct(input, &c)
+}
+
+type DefundFailedAfterMultipleRetriesEvent struct {
+}
+
+func (*DefundFailedAfterMultipleRetriesEvent) Sig() ethgo.Hash {
+	return ApexBridgeContracts.Bridge.Abi.Events["DefundFailedAfterMultipleRetries"].ID()
+}
+
+func (d *DefundFailedAfterMultipleRetriesEvent) Encode() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Events["DefundFailedAfterMultipleRetries"].Inputs.Encode(d)
+}
+
+func (d *DefundFailedAfterMultipleRetriesEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ApexBridgeContracts.Bridge.Abi.Events["DefundFailedAfterMultipleRetries"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ApexBridgeContracts.Bridge.Abi.Events["DefundFailedAfterMultipleRetries"], log, d)
+}
+
+func (d *DefundFailedAfterMultipleRetriesEvent) Decode(input []byte) error {
+	return ApexBridgeContracts.Bridge.Abi.Events["DefundFailedAfterMultipleRetries"].Inputs.DecodeStruct(input, &d)
+}
+
+type FundAdminChangedEvent struct {
+	NewFundAdmin types.Address `abi:"_newFundAdmin"`
+}
+
+func (*FundAdminChangedEvent) Sig() ethgo.Hash {
+	return ApexBridgeContracts.Bridge.Abi.Events["FundAdminChanged"].ID()
+}
+
+func (f *FundAdminChangedEvent) Encode() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Events["FundAdminChanged"].Inputs.Encode(f)
+}
+
+func (f *FundAdminChangedEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ApexBridgeContracts.Bridge.Abi.Events["FundAdminChanged"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ApexBridgeContracts.Bridge.Abi.Events["FundAdminChanged"], log, f)
+}
+
+func (f *FundAdminChangedEvent) Decode(input []byte) error {
+	return ApexBridgeContracts.Bridge.Abi.Events["FundAdminChanged"].Inputs.DecodeStruct(input, &f)
+}
+
+type InitializedEvent struct {
+	Version uint8 `abi:"version"`
+}
+
+func (*InitializedEvent) Sig() ethgo.Hash {
+	return ApexBridgeContracts.Bridge.Abi.Events["Initialized"].ID()
+}
+
+func (i *InitializedEvent) Encode() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Events["Initialized"].Inputs.Encode(i)
+}
+
+func (i *InitializedEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ApexBridgeContracts.Bridge.Abi.Events["Initialized"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ApexBridgeContracts.Bridge.Abi.Events["Initialized"], log, i)
+}
+
+func (i *InitializedEvent) Decode(input []byte) error {
+	return ApexBridgeContracts.Bridge.Abi.Events["Initialized"].Inputs.DecodeStruct(input, &i)
+}
+
+type InsufficientFundsEvent struct {
+	AvailableAmount  *big.Int `abi:"availableAmount"`
+	WithdrawalAmount *big.Int `abi:"withdrawalAmount"`
+}
+
+func (*InsufficientFundsEvent) Sig() ethgo.Hash {
+	return ApexBridgeContracts.Bridge.Abi.Events["InsufficientFunds"].ID()
+}
+
+func (i *InsufficientFundsEvent) Encode() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Events["InsufficientFunds"].Inputs.Encode(i)
+}
+
+func (i *InsufficientFundsEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ApexBridgeContracts.Bridge.Abi.Events["InsufficientFunds"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ApexBridgeContracts.Bridge.Abi.Events["InsufficientFunds"], log, i)
+}
+
+func (i *InsufficientFundsEvent) Decode(input []byte) error {
+	return ApexBridgeContracts.Bridge.Abi.Events["InsufficientFunds"].Inputs.DecodeStruct(input, &i)
+}
+
+type NotEnoughFundsEvent struct {
+	ClaimeType      string   `abi:"claimeType"`
+	Index           *big.Int `abi:"index"`
+	AvailableAmount *big.Int `abi:"availableAmount"`
+}
+
+func (*NotEnoughFundsEvent) Sig() ethgo.Hash {
+	return ApexBridgeContracts.Bridge.Abi.Events["NotEnoughFunds"].ID()
+}
+
+func (n *NotEnoughFundsEvent) Encode() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Events["NotEnoughFunds"].Inputs.Encode(n)
+}
+
+func (n *NotEnoughFundsEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ApexBridgeContracts.Bridge.Abi.Events["NotEnoughFunds"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ApexBridgeContracts.Bridge.Abi.Events["NotEnoughFunds"], log, n)
+}
+
+func (n *NotEnoughFundsEvent) Decode(input []byte) error {
+	return ApexBridgeContracts.Bridge.Abi.Events["NotEnoughFunds"].Inputs.DecodeStruct(input, &n)
+}
+
+type OwnershipTransferredEvent struct {
+	PreviousOwner types.Address `abi:"previousOwner"`
+	NewOwner      types.Address `abi:"newOwner"`
+}
+
+func (*OwnershipTransferredEvent) Sig() ethgo.Hash {
+	return ApexBridgeContracts.Bridge.Abi.Events["OwnershipTransferred"].ID()
+}
+
+func (o *OwnershipTransferredEvent) Encode() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Events["OwnershipTransferred"].Inputs.Encode(o)
+}
+
+func (o *OwnershipTransferredEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ApexBridgeContracts.Bridge.Abi.Events["OwnershipTransferred"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ApexBridgeContracts.Bridge.Abi.Events["OwnershipTransferred"], log, o)
+}
+
+func (o *OwnershipTransferredEvent) Decode(input []byte) error {
+	return ApexBridgeContracts.Bridge.Abi.Events["OwnershipTransferred"].Inputs.DecodeStruct(input, &o)
+}
+
+type UpdatedChainTokenQuantityEvent struct {
+	ChainID       *big.Int `abi:"chainId"`
+	IsIncrement   bool     `abi:"isIncrement"`
+	TokenQuantity *big.Int `abi:"tokenQuantity"`
+}
+
+func (*UpdatedChainTokenQuantityEvent) Sig() ethgo.Hash {
+	return ApexBridgeContracts.Bridge.Abi.Events["UpdatedChainTokenQuantity"].ID()
+}
+
+func (u *UpdatedChainTokenQuantityEvent) Encode() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Events["UpdatedChainTokenQuantity"].Inputs.Encode(u)
+}
+
+func (u *UpdatedChainTokenQuantityEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ApexBridgeContracts.Bridge.Abi.Events["UpdatedChainTokenQuantity"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ApexBridgeContracts.Bridge.Abi.Events["UpdatedChainTokenQuantity"], log, u)
+}
+
+func (u *UpdatedChainTokenQuantityEvent) Decode(input []byte) error {
+	return ApexBridgeContracts.Bridge.Abi.Events["UpdatedChainTokenQuantity"].Inputs.DecodeStruct(input, &u)
+}
+
+type UpgradedEvent struct {
+	Implementation types.Address `abi:"implementation"`
+}
+
+func (*UpgradedEvent) Sig() ethgo.Hash {
+	return ApexBridgeContracts.Bridge.Abi.Events["Upgraded"].ID()
+}
+
+func (u *UpgradedEvent) Encode() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Events["Upgraded"].Inputs.Encode(u)
+}
+
+func (u *UpgradedEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ApexBridgeContracts.Bridge.Abi.Events["Upgraded"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ApexBridgeContracts.Bridge.Abi.Events["Upgraded"], log, u)
+}
+
+func (u *UpgradedEvent) Decode(input []byte) error {
+	return ApexBridgeContracts.Bridge.Abi.Events["Upgraded"].Inputs.DecodeStruct(input, &u)
+}
+
+type NewChainProposalEvent struct {
+	ChainID uint8         `abi:"_chainId"`
+	Sender  types.Address `abi:"sender"`
+}
+
+func (*NewChainProposalEvent) Sig() ethgo.Hash {
+	return ApexBridgeContracts.Bridge.Abi.Events["newChainProposal"].ID()
+}
+
+func (n *NewChainProposalEvent) Encode() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Events["newChainProposal"].Inputs.Encode(n)
+}
+
+func (n *NewChainProposalEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ApexBridgeContracts.Bridge.Abi.Events["newChainProposal"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ApexBridgeContracts.Bridge.Abi.Events["newChainProposal"], log, n)
+}
+
+func (n *NewChainProposalEvent) Decode(input []byte) error {
+	return ApexBridgeContracts.Bridge.Abi.Events["newChainProposal"].Inputs.DecodeStruct(input, &n)
+}
+
+type NewChainRegisteredEvent struct {
+	ChainID uint8 `abi:"_chainId"`
+}
+
+func (*NewChainRegisteredEvent) Sig() ethgo.Hash {
+	return ApexBridgeContracts.Bridge.Abi.Events["newChainRegistered"].ID()
+}
+
+func (n *NewChainRegisteredEvent) Encode() ([]byte, error) {
+	return ApexBridgeContracts.Bridge.Abi.Events["newChainRegistered"].Inputs.Encode(n)
+}
+
+func (n *NewChainRegisteredEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ApexBridgeContracts.Bridge.Abi.Events["newChainRegistered"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ApexBridgeContracts.Bridge.Abi.Events["newChainRegistered"], log, n)
+}
+
+func (n *NewChainRegisteredEvent) Decode(input []byte) error {
+	return ApexBridgeContracts.Bridge.Abi.Events["newChainRegistered"].Inputs.DecodeStruct(input, &n)
+}
+
+type AlreadyConfirmedError struct {
+	ClaimTransactionHash types.Hash `abi:"_claimTransactionHash"`
+}
+
+func (*AlreadyConfirmedError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["AlreadyConfirmed"])
+}
+
+func (a *AlreadyConfirmedError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["AlreadyConfirmed"], buf, a)
+}
+
+type AlreadyProposedError struct {
+	ClaimTransactionHash uint8 `abi:"_claimTransactionHash"`
+}
+
+func (*AlreadyProposedError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["AlreadyProposed"])
+}
+
+func (a *AlreadyProposedError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["AlreadyProposed"], buf, a)
+}
+
+type CanNotCreateBatchYetError struct {
+	ChainID uint8 `abi:"_chainId"`
+}
+
+func (*CanNotCreateBatchYetError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["CanNotCreateBatchYet"])
+}
+
+func (c *CanNotCreateBatchYetError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["CanNotCreateBatchYet"], buf, c)
+}
+
+type ChainAlreadyRegisteredError struct {
+	ChainID uint8 `abi:"_chainId"`
+}
+
+func (*ChainAlreadyRegisteredError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["ChainAlreadyRegistered"])
+}
+
+func (c *ChainAlreadyRegisteredError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["ChainAlreadyRegistered"], buf, c)
+}
+
+type ChainIsNotRegisteredError struct {
+	ChainID uint8 `abi:"_chainId"`
+}
+
+func (*ChainIsNotRegisteredError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["ChainIsNotRegistered"])
+}
+
+func (c *ChainIsNotRegisteredError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["ChainIsNotRegistered"], buf, c)
+}
+
+type DefundRequestTooHighError struct {
+	ChainID         uint8    `abi:"_chainId"`
+	AvailableAmount *big.Int `abi:"_availableAmount"`
+	RequestedAmount *big.Int `abi:"_requestedAmount"`
+}
+
+func (*DefundRequestTooHighError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["DefundRequestTooHigh"])
+}
+
+func (d *DefundRequestTooHighError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["DefundRequestTooHigh"], buf, d)
+}
+
+type InvalidDataError struct {
+	Data string `abi:"data"`
+}
+
+func (*InvalidDataError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["InvalidData"])
+}
+
+func (i *InvalidDataError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["InvalidData"], buf, i)
+}
+
+type InvalidSignatureError struct {
+}
+
+func (*InvalidSignatureError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["InvalidSignature"])
+}
+
+func (i *InvalidSignatureError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["InvalidSignature"], buf, i)
+}
+
+type NegativeChainTokenAmountError struct {
+	AvailableAmount *big.Int `abi:"_availableAmount"`
+	DecreaseAmount  *big.Int `abi:"_decreaseAmount"`
+}
+
+func (*NegativeChainTokenAmountError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["NegativeChainTokenAmount"])
+}
+
+func (n *NegativeChainTokenAmountError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["NegativeChainTokenAmount"], buf, n)
+}
+
+type NotAdminContractError struct {
+}
+
+func (*NotAdminContractError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["NotAdminContract"])
+}
+
+func (n *NotAdminContractError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["NotAdminContract"], buf, n)
+}
+
+type NotBridgeError struct {
+}
+
+func (*NotBridgeError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["NotBridge"])
+}
+
+func (n *NotBridgeError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["NotBridge"], buf, n)
+}
+
+type NotClaimsError struct {
+}
+
+func (*NotClaimsError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["NotClaims"])
+}
+
+func (n *NotClaimsError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["NotClaims"], buf, n)
+}
+
+type NotEnoughBridgingTokensAvailableError struct {
+	ClaimTransactionHash types.Hash `abi:"_claimTransactionHash"`
+}
+
+func (*NotEnoughBridgingTokensAvailableError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["NotEnoughBridgingTokensAvailable"])
+}
+
+func (n *NotEnoughBridgingTokensAvailableError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["NotEnoughBridgingTokensAvailable"], buf, n)
+}
+
+type NotFundAdminError struct {
+}
+
+func (*NotFundAdminError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["NotFundAdmin"])
+}
+
+func (n *NotFundAdminError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["NotFundAdmin"], buf, n)
+}
+
+type NotOwnerError struct {
+}
+
+func (*NotOwnerError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["NotOwner"])
+}
+
+func (n *NotOwnerError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["NotOwner"], buf, n)
+}
+
+type NotSignedBatchesError struct {
+}
+
+func (*NotSignedBatchesError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["NotSignedBatches"])
+}
+
+func (n *NotSignedBatchesError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["NotSignedBatches"], buf, n)
+}
+
+type NotSignedBatchesOrBridgeError struct {
+}
+
+func (*NotSignedBatchesOrBridgeError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["NotSignedBatchesOrBridge"])
+}
+
+func (n *NotSignedBatchesOrBridgeError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["NotSignedBatchesOrBridge"], buf, n)
+}
+
+type NotSignedBatchesOrClaimsError struct {
+}
+
+func (*NotSignedBatchesOrClaimsError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["NotSignedBatchesOrClaims"])
+}
+
+func (n *NotSignedBatchesOrClaimsError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["NotSignedBatchesOrClaims"], buf, n)
+}
+
+type NotValidatorError struct {
+}
+
+func (*NotValidatorError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["NotValidator"])
+}
+
+func (n *NotValidatorError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["NotValidator"], buf, n)
+}
+
+type WrongBatchNonceError struct {
+	ChainID uint8  `abi:"_chainId"`
+	Nonce   uint64 `abi:"_nonce"`
+}
+
+func (*WrongBatchNonceError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["WrongBatchNonce"])
+}
+
+func (w *WrongBatchNonceError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["WrongBatchNonce"], buf, w)
+}
+
+type ZeroAddressError struct {
+}
+
+func (*ZeroAddressError) Sig() []byte {
+	return errorID(ApexBridgeContracts.Bridge.Abi.Errors["ZeroAddress"])
+}
+
+func (z *ZeroAddressError) DecodeAbi(buf []byte) error {
+	return decodeError(ApexBridgeContracts.Bridge.Abi.Errors["ZeroAddress"], buf, z)
+}
+
+type ConfirmedSignedBatchesApexBridgeContractsClaimsHelperFn struct {
+	Field0 uint8  `abi:"0"`
+	Field1 uint64 `abi:"1"`
+}
+
+func (c *ConfirmedSignedBatchesApexBridgeContractsClaimsHelperFn) Sig() []byte {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["confirmedSignedBatches"].ID()
+}
+
+func (c *ConfirmedSignedBatchesApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["confirmedSignedBatches"].Encode(c)
+}
+
+func (c *ConfirmedSignedBatchesApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["confirmedSignedBatches"], buf, c)
+}
+
+type ConfirmedSignedBatchesApexBridgeContractsClaimsHelperOutput struct {
+	FirstTxNonceID uint64 `abi:"firstTxNonceId"`
+	LastTxNonceID  uint64 `abi:"lastTxNonceId"`
+}
+
+func (c *ConfirmedSignedBatchesApexBridgeContractsClaimsHelperOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.ClaimsHelper.Abi.Methods["confirmedSignedBatches"].Outputs, buf, &c)
+}
+
+type CurrentBatchBlockApexBridgeContractsClaimsHelperFn struct {
+	Field0 uint8 `abi:"0"`
+}
+
+func (c *CurrentBatchBlockApexBridgeContractsClaimsHelperFn) Sig() []byte {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["currentBatchBlock"].ID()
+}
+
+func (c *CurrentBatchBlockApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["currentBatchBlock"].Encode(c)
+}
+
+func (c *CurrentBatchBlockApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["currentBatchBlock"], buf, c)
+}
+
+type CurrentBatchBlockApexBridgeContractsClaimsHelperOutput struct {
+	Field0 *big.Int `abi:"0"`
+}
+
+func (c *CurrentBatchBlockApexBridgeContractsClaimsHelperOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.ClaimsHelper.Abi.Methods["currentBatchBlock"].Outputs, buf, &c)
+}
+
+type GetConfirmedSignedBatchDataApexBridgeContractsClaimsHelperFn struct {
+	ChainID uint8  `abi:"_chainId"`
+	BatchID uint64 `abi:"_batchId"`
+}
+
+func (g *GetConfirmedSignedBatchDataApexBridgeContractsClaimsHelperFn) Sig() []byte {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["getConfirmedSignedBatchData"].ID()
+}
+
+func (g *GetConfirmedSignedBatchDataApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["getConfirmedSignedBatchData"].Encode(g)
+}
+
+func (g *GetConfirmedSignedBatchDataApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["getConfirmedSignedBatchData"], buf, g)
+}
+
+type ConfirmedSignedBatchData struct {
+	FirstTxNonceID uint64 `abi:"firstTxNonceId"`
+	LastTxNonceID  uint64 `abi:"lastTxNonceId"`
+}
+
+var ConfirmedSignedBatchDataABIType = abi.MustNewType("tuple(uint64 firstTxNonceId,uint64 lastTxNonceId)")
+
+func (c *ConfirmedSignedBatchData) EncodeAbi() ([]byte, error) {
+	return ConfirmedSignedBatchDataABIType.Encode(c)
+}
+
+func (c *ConfirmedSignedBatchData) DecodeAbi(buf []byte) error {
+	return decodeStruct(ConfirmedSignedBatchDataABIType, buf, &c)
+}
+
+type GetConfirmedSignedBatchDataApexBridgeContractsClaimsHelperOutput struct {
+	ConfirmedSignedBatchData *ConfirmedSignedBatchData `abi:"_confirmedSignedBatchData"`
+}
+
+func (g *GetConfirmedSignedBatchDataApexBridgeContractsClaimsHelperOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.ClaimsHelper.Abi.Methods["getConfirmedSignedBatchData"].Outputs, buf, &g)
+}
+
+type HasVotedApexBridgeContractsClaimsHelperFn struct {
+	Field0 types.Hash    `abi:"0"`
+	Field1 types.Address `abi:"1"`
+}
+
+func (h *HasVotedApexBridgeContractsClaimsHelperFn) Sig() []byte {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["hasVoted"].ID()
+}
+
+func (h *HasVotedApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["hasVoted"].Encode(h)
+}
+
+func (h *HasVotedApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["hasVoted"], buf, h)
+}
+
+type HasVotedApexBridgeContractsClaimsHelperOutput struct {
+	Field0 bool `abi:"0"`
+}
+
+func (h *HasVotedApexBridgeContractsClaimsHelperOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.ClaimsHelper.Abi.Methods["hasVoted"].Outputs, buf, &h)
+}
+
+type InitializeApexBridgeContractsClaimsHelperFn struct {
+	Owner        types.Address `abi:"_owner"`
+	UpgradeAdmin types.Address `abi:"_upgradeAdmin"`
+}
+
+func (i *InitializeApexBridgeContractsClaimsHelperFn) Sig() []byte {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["initialize"].ID()
+}
+
+func (i *InitializeApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["initialize"].Encode(i)
+}
+
+func (i *InitializeApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["initialize"], buf, i)
+}
+
+type IsVoteRestrictedApexBridgeContractsClaimsHelperFn struct {
+	Voter     types.Address `abi:"_voter"`
+	Hash      types.Hash    `abi:"_hash"`
+	QuorumCnt *big.Int      `abi:"_quorumCnt"`
+}
+
+func (i *IsVoteRestrictedApexBridgeContractsClaimsHelperFn) Sig() []byte {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["isVoteRestricted"].ID()
+}
+
+func (i *IsVoteRestrictedApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["isVoteRestricted"].Encode(i)
+}
+
+func (i *IsVoteRestrictedApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["isVoteRestricted"], buf, i)
+}
+
+type IsVoteRestrictedApexBridgeContractsClaimsHelperOutput struct {
+	Field0 bool `abi:"0"`
+}
+
+func (i *IsVoteRestrictedApexBridgeContractsClaimsHelperOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.ClaimsHelper.Abi.Methods["isVoteRestricted"].Outputs, buf, &i)
+}
+
+type NumberOfVotesApexBridgeContractsClaimsHelperFn struct {
+	Field0 types.Hash `abi:"0"`
+}
+
+func (n *NumberOfVotesApexBridgeContractsClaimsHelperFn) Sig() []byte {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["numberOfVotes"].ID()
+}
+
+func (n *NumberOfVotesApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["numberOfVotes"].Encode(n)
+}
+
+func (n *NumberOfVotesApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["numberOfVotes"], buf, n)
+}
+
+type NumberOfVotesApexBridgeContractsClaimsHelperOutput struct {
+	Field0 uint8 `abi:"0"`
+}
+
+func (n *NumberOfVotesApexBridgeContractsClaimsHelperOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.ClaimsHelper.Abi.Methods["numberOfVotes"].Outputs, buf, &n)
+}
+
+type OwnerApexBridgeContractsClaimsHelperFn struct {
+}
+
+func (o *OwnerApexBridgeContractsClaimsHelperFn) Sig() []byte {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["owner"].ID()
+}
+
+func (o *OwnerApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["owner"].Encode(o)
+}
+
+func (o *OwnerApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["owner"], buf, o)
+}
+
+type OwnerApexBridgeContractsClaimsHelperOutput struct {
+	Field0 types.Address `abi:"0"`
+}
+
+func (o *OwnerApexBridgeContractsClaimsHelperOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.ClaimsHelper.Abi.Methods["owner"].Outputs, buf, &o)
+}
+
+type ProxiableUUIDApexBridgeContractsClaimsHelperFn struct {
+}
+
+func (p *ProxiableUUIDApexBridgeContractsClaimsHelperFn) Sig() []byte {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["proxiableUUID"].ID()
+}
+
+func (p *ProxiableUUIDApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["proxiableUUID"].Encode(p)
+}
+
+func (p *ProxiableUUIDApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["proxiableUUID"], buf, p)
+}
+
+type ProxiableUUIDApexBridgeContractsClaimsHelperOutput struct {
+	Field0 types.Hash `abi:"0"`
+}
+
+func (p *ProxiableUUIDApexBridgeContractsClaimsHelperOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.ClaimsHelper.Abi.Methods["proxiableUUID"].Outputs, buf, &p)
+}
+
+type RenounceOwnershipApexBridgeContractsClaimsHelperFn struct {
+}
+
+func (r *RenounceOwnershipApexBridgeContractsClaimsHelperFn) Sig() []byte {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["renounceOwnership"].ID()
+}
+
+func (r *RenounceOwnershipApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["renounceOwnership"].Encode(r)
+}
+
+func (r *RenounceOwnershipApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["renounceOwnership"], buf, r)
+}
+
+type ResetCurrentBatchBlockApexBridgeContractsClaimsHelperFn struct {
+	ChainID uint8 `abi:"_chainId"`
+}
+
+func (r *ResetCurrentBatchBlockApexBridgeContractsClaimsHelperFn) Sig() []byte {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["resetCurrentBatchBlock"].ID()
+}
+
+func (r *ResetCurrentBatchBlockApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["resetCurrentBatchBlock"].Encode(r)
+}
+
+func (r *ResetCurrentBatchBlockApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["resetCurrentBatchBlock"], buf, r)
+}
+
+type SetConfirmedSignedBatchDataApexBridgeContractsClaimsHelperFn struct {
+	SignedBatch *SignedBatch `abi:"_signedBatch"`
+}
+
+func (s *SetConfirmedSignedBatchDataApexBridgeContractsClaimsHelperFn) Sig() []byte {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["setConfirmedSignedBatchData"].ID()
+}
+
+func (s *SetConfirmedSignedBatchDataApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["setConfirmedSignedBatchData"].Encode(s)
+}
+
+func (s *SetConfirmedSignedBatchDataApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["setConfirmedSignedBatchData"], buf, s)
+}
+
+type SetDependenciesApexBridgeContractsClaimsHelperFn struct {
+	ClaimsAddress        types.Address `abi:"_claimsAddress"`
+	SignedBatchesAddress types.Address `abi:"_signedBatchesAddress"`
+}
+
+func (s *SetDependenciesApexBridgeContractsClaimsHelperFn) Sig() []byte {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["setDependencies"].ID()
+}
+
+func (s *SetDependenciesApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["setDependencies"].Encode(s)
+}
+
+func (s *SetDependenciesApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["setDependencies"], buf, s)
+}
+
+type SetVotedApexBridgeContractsClaimsHelperFn struct {
+	Voter types.Address `abi:"_voter"`
+	Hash  types.Hash    `abi:"_hash"`
+}
+
+func (s *SetVotedApexBridgeContractsClaimsHelperFn) Sig() []byte {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["setVoted"].ID()
+}
+
+func (s *SetVotedApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["setVoted"].Encode(s)
+}
+
+func (s *SetVotedApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["setVoted"], buf, s)
+}
+
+type SetVotedApexBridgeContractsClaimsHelperOutput struct {
+	Field0 *big.Int `abi:"0"`
+}
+
+func (s *SetVotedApexBridgeContractsClaimsHelperOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.ClaimsHelper.Abi.Methods["setVoted"].Outputs, buf, &s)
+}
+
+type SetVotedOnlyIfNeededApexBridgeContractsClaimsHelperFn struct {
+	Voter     types.Address `abi:"_voter"`
+	Hash      types.Hash    `abi:"_hash"`
+	QuorumCnt *big.Int      `abi:"_quorumCnt"`
+}
+
+func (s *SetVotedOnlyIfNeededApexBridgeContractsClaimsHelperFn) Sig() []byte {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["setVotedOnlyIfNeeded"].ID()
+}
+
+func (s *SetVotedOnlyIfNeededApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["setVotedOnlyIfNeeded"].Encode(s)
+}
+
+func (s *SetVotedOnlyIfNeededApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["setVotedOnlyIfNeeded"], buf, s)
+}
+
+type SetVotedOnlyIfNeededApexBridgeContractsClaimsHelperOutput struct {
+	Field0 bool `abi:"0"`
+}
+
+func (s *SetVotedOnlyIfNeededApexBridgeContractsClaimsHelperOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.ClaimsHelper.Abi.Methods["setVotedOnlyIfNeeded"].Outputs, buf, &s)
+}
+
+type TransferOwnershipApexBridgeContractsClaimsHelperFn struct {
+	NewOwner types.Address `abi:"newOwner"`
+}
+
+func (t *TransferOwnershipApexBridgeContractsClaimsHelperFn) Sig() []byte {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["transferOwnership"].ID()
+}
+
+func (t *TransferOwnershipApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["transferOwnership"].Encode(t)
+}
+
+func (t *TransferOwnershipApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["transferOwnership"], buf, t)
+}
+
+type UpgradeToApexBridgeContractsClaimsHelperFn struct {
+	NewImplementation types.Address `abi:"newImplementation"`
+}
+
+func (u *UpgradeToApexBridgeContractsClaimsHelperFn) Sig() []byte {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["upgradeTo"].ID()
+}
+
+func (u *UpgradeToApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["upgradeTo"].Encode(u)
+}
+
+func (u *UpgradeToApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["upgradeTo"], buf, u)
+}
+
+type UpgradeToAndCallApexBridgeContractsClaimsHelperFn struct {
+	NewImplementation types.Address `abi:"newImplementation"`
+	Data              []byte        `abi:"data"`
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsClaimsHelperFn) Sig() []byte {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["upgradeToAndCall"].ID()
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["upgradeToAndCall"].Encode(u)
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["upgradeToAndCall"], buf, u)
+}
+
+type MAX_NUMBER_OF_DEFUND_RETRIESApexBridgeContractsClaimsFn struct {
+}
+
+func (m *MAX_NUMBER_OF_DEFUND_RETRIESApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["MAX_NUMBER_OF_DEFUND_RETRIES"].ID()
+}
+
+func (m *MAX_NUMBER_OF_DEFUND_RETRIESApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["MAX_NUMBER_OF_DEFUND_RETRIES"].Encode(m)
+}
+
+func (m *MAX_NUMBER_OF_DEFUND_RETRIESApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["MAX_NUMBER_OF_DEFUND_RETRIES"], buf, m)
+}
+
+type MAX_NUMBER_OF_DEFUND_RETRIESApexBridgeContractsClaimsOutput struct {
+	Field0 uint8 `abi:"0"`
+}
+
+func (m *MAX_NUMBER_OF_DEFUND_RETRIESApexBridgeContractsClaimsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Claims.Abi.Methods["MAX_NUMBER_OF_DEFUND_RETRIES"].Outputs, buf, &m)
+}
+
+type ChainTokenQuantityApexBridgeContractsClaimsFn struct {
+	Field0 uint8 `abi:"0"`
+}
+
+func (c *ChainTokenQuantityApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["chainTokenQuantity"].ID()
+}
+
+func (c *ChainTokenQuantityApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["chainTokenQuantity"].Encode(c)
+}
+
+func (c *ChainTokenQuantityApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["chainTokenQuantity"], buf, c)
+}
+
+type ChainTokenQuantityApexBridgeContractsClaimsOutput struct {
+	Field0 *big.Int `abi:"0"`
+}
+
+func (c *ChainTokenQuantityApexBridgeContractsClaimsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Claims.Abi.Methods["chainTokenQuantity"].Outputs, buf, &c)
+}
+
+type ConfirmedTransactionsApexBridgeContractsClaimsFn struct {
+	Field0 uint8  `abi:"0"`
+	Field1 uint64 `abi:"1"`
+}
+
+func (c *ConfirmedTransactionsApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["confirmedTransactions"].ID()
+}
+
+func (c *ConfirmedTransactionsApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["confirmedTransactions"].Encode(c)
+}
+
+func (c *ConfirmedTransactionsApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["confirmedTransactions"], buf, c)
+}
+
+type ConfirmedTransactionsApexBridgeContractsClaimsOutput struct {
+	BlockHeight             *big.Int   `abi:"blockHeight"`
+	TotalAmount             *big.Int   `abi:"totalAmount"`
+	RetryCounter            *big.Int   `abi:"retryCounter"`
+	Nonce                   uint64     `abi:"nonce"`
+	SourceChainID           uint8      `abi:"sourceChainId"`
+	ObservedTransactionHash types.Hash `abi:"observedTransactionHash"`
+	TransactionType         uint8      `abi:"transactionType"`
+}
+
+func (c *ConfirmedTransactionsApexBridgeContractsClaimsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Claims.Abi.Methods["confirmedTransactions"].Outputs, buf, &c)
+}
+
+type DefundApexBridgeContractsClaimsFn struct {
+	ChainID       uint8    `abi:"_chainId"`
+	Amount        *big.Int `abi:"_amount"`
+	DefundAddress string   `abi:"_defundAddress"`
+}
+
+func (d *DefundApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["defund"].ID()
+}
+
+func (d *DefundApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["defund"].Encode(d)
+}
+
+func (d *DefundApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["defund"], buf, d)
+}
+
+type DefundHashApexBridgeContractsClaimsFn struct {
+}
+
+func (d *DefundHashApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["defundHash"].ID()
+}
+
+func (d *DefundHashApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["defundHash"].Encode(d)
+}
+
+func (d *DefundHashApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["defundHash"], buf, d)
+}
+
+type DefundHashApexBridgeContractsClaimsOutput struct {
+	Field0 types.Hash `abi:"0"`
+}
+
+func (d *DefundHashApexBridgeContractsClaimsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Claims.Abi.Methods["defundHash"].Outputs, buf, &d)
+}
+
+type GetBatchTransactionsApexBridgeContractsClaimsFn struct {
+	ChainID uint8  `abi:"_chainId"`
+	BatchID uint64 `abi:"_batchId"`
+}
+
+func (g *GetBatchTransactionsApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["getBatchTransactions"].ID()
+}
+
+func (g *GetBatchTransactionsApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["getBatchTransactions"].Encode(g)
+}
+
+func (g *GetBatchTransactionsApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["getBatchTransactions"], buf, g)
+}
+
+type GetBatchTransactionsApexBridgeContractsClaimsOutput struct {
+	Field0 []*TxDataInfo `abi:"0"`
+}
+
+func (g *GetBatchTransactionsApexBridgeContractsClaimsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Claims.Abi.Methods["getBatchTransactions"].Outputs, buf, &g)
+}
+
+type GetBatchingTxsCountApexBridgeContractsClaimsFn struct {
+	ChainID uint8 `abi:"_chainId"`
+}
+
+func (g *GetBatchingTxsCountApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["getBatchingTxsCount"].ID()
+}
+
+func (g *GetBatchingTxsCountApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["getBatchingTxsCount"].Encode(g)
+}
+
+func (g *GetBatchingTxsCountApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["getBatchingTxsCount"], buf, g)
+}
+
+type GetBatchingTxsCountApexBridgeContractsClaimsOutput struct {
+	CounterConfirmedTransactions uint64 `abi:"counterConfirmedTransactions"`
+}
+
+func (g *GetBatchingTxsCountApexBridgeContractsClaimsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Claims.Abi.Methods["getBatchingTxsCount"].Outputs, buf, &g)
+}
+
+type GetChainTokenQuantityApexBridgeContractsClaimsFn struct {
+	ChainID uint8 `abi:"_chainId"`
+}
+
+func (g *GetChainTokenQuantityApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["getChainTokenQuantity"].ID()
+}
+
+func (g *GetChainTokenQuantityApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["getChainTokenQuantity"].Encode(g)
+}
+
+func (g *GetChainTokenQuantityApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["getChainTokenQuantity"], buf, g)
+}
+
+type GetChainTokenQuantityApexBridgeContractsClaimsOutput struct {
+	Field0 *big.Int `abi:"0"`
+}
+
+func (g *GetChainTokenQuantityApexBridgeContractsClaimsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Claims.Abi.Methods["getChainTokenQuantity"].Outputs, buf, &g)
+}
+
+type GetConfirmedTransactionApexBridgeContractsClaimsFn struct {
+	DestinationChain uint8  `abi:"_destinationChain"`
+	Nonce            uint64 `abi:"_nonce"`
+}
+
+func (g *GetConfirmedTransactionApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["getConfirmedTransaction"].ID()
+}
+
+func (g *GetConfirmedTransactionApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["getConfirmedTransaction"].Encode(g)
+}
+
+func (g *GetConfirmedTransactionApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["getConfirmedTransaction"], buf, g)
+}
+
+type GetConfirmedTransactionApexBridgeContractsClaimsOutput struct {
+	ConfirmedTransaction *ConfirmedTransaction `abi:"_confirmedTransaction"`
+}
+
+func (g *GetConfirmedTransactionApexBridgeContractsClaimsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Claims.Abi.Methods["getConfirmedTransaction"].Outputs, buf, &g)
+}
+
+type HasVotedApexBridgeContractsClaimsFn struct {
+	Hash  types.Hash    `abi:"_hash"`
+	Voter types.Address `abi:"_voter"`
+}
+
+func (h *HasVotedApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["hasVoted"].ID()
+}
+
+func (h *HasVotedApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["hasVoted"].Encode(h)
+}
+
+func (h *HasVotedApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["hasVoted"], buf, h)
+}
+
+type HasVotedApexBridgeContractsClaimsOutput struct {
+	Field0 bool `abi:"0"`
+}
+
+func (h *HasVotedApexBridgeContractsClaimsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Claims.Abi.Methods["hasVoted"].Outputs, buf, &h)
+}
+
+type InitializeApexBridgeContractsClaimsFn struct {
+	Owner                   types.Address `abi:"_owner"`
+	UpgradeAdmin            types.Address `abi:"_upgradeAdmin"`
+	MaxNumberOfTransactions uint16        `abi:"_maxNumberOfTransactions"`
+	TimeoutBlocksNumber     uint8         `abi:"_timeoutBlocksNumber"`
+}
+
+func (i *InitializeApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["initialize"].ID()
+}
+
+func (i *InitializeApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["initialize"].Encode(i)
+}
+
+func (i *InitializeApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["initialize"], buf, i)
+}
+
+type IsChainRegisteredApexBridgeContractsClaimsFn struct {
+	Field0 uint8 `abi:"0"`
+}
+
+func (i *IsChainRegisteredApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["isChainRegistered"].ID()
+}
+
+func (i *IsChainRegisteredApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["isChainRegistered"].Encode(i)
+}
+
+func (i *IsChainRegisteredApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["isChainRegistered"], buf, i)
+}
+
+type IsChainRegisteredApexBridgeContractsClaimsOutput struct {
+	Field0 bool `abi:"0"`
+}
+
+func (i *IsChainRegisteredApexBridgeContractsClaimsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Claims.Abi.Methods["isChainRegistered"].Outputs, buf, &i)
+}
+
+type LastBatchedTxNonceApexBridgeContractsClaimsFn struct {
+	Field0 uint8 `abi:"0"`
+}
+
+func (l *LastBatchedTxNonceApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["lastBatchedTxNonce"].ID()
+}
+
+func (l *LastBatchedTxNonceApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["lastBatchedTxNonce"].Encode(l)
+}
+
+func (l *LastBatchedTxNonceApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["lastBatchedTxNonce"], buf, l)
+}
+
+type LastBatchedTxNonceApexBridgeContractsClaimsOutput struct {
+	Field0 uint64 `abi:"0"`
+}
+
+func (l *LastBatchedTxNonceApexBridgeContractsClaimsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Claims.Abi.Methods["lastBatchedTxNonce"].Outputs, buf, &l)
+}
+
+type LastConfirmedTxNonceApexBridgeContractsClaimsFn struct {
+	Field0 uint8 `abi:"0"`
+}
+
+func (l *LastConfirmedTxNonceApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["lastConfirmedTxNonce"].ID()
+}
+
+func (l *LastConfirmedTxNonceApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["lastConfirmedTxNonce"].Encode(l)
+}
+
+func (l *LastConfirmedTxNonceApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["lastConfirmedTxNonce"], buf, l)
+}
+
+type LastConfirmedTxNonceApexBridgeContractsClaimsOutput struct {
+	Field0 uint64 `abi:"0"`
+}
+
+func (l *LastConfirmedTxNonceApexBridgeContractsClaimsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Claims.Abi.Methods["lastConfirmedTxNonce"].Outputs, buf, &l)
+}
+
+type MaxNumberOfTransactionsApexBridgeContractsClaimsFn struct {
+}
+
+func (m *MaxNumberOfTransactionsApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["maxNumberOfTransactions"].ID()
+}
+
+func (m *MaxNumberOfTransactionsApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["maxNumberOfTransactions"].Encode(m)
+}
+
+func (m *MaxNumberOfTransactionsApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["maxNumberOfTransactions"], buf, m)
+}
+
+type MaxNumberOfTransactionsApexBridgeContractsClaimsOutput struct {
+	Field0 uint16 `abi:"0"`
+}
+
+func (m *MaxNumberOfTransactionsApexBridgeContractsClaimsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Claims.Abi.Methods["maxNumberOfTransactions"].Outputs, buf, &m)
+}
+
+type NextTimeoutBlockApexBridgeContractsClaimsFn struct {
+	Field0 uint8 `abi:"0"`
+}
+
+func (n *NextTimeoutBlockApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["nextTimeoutBlock"].ID()
+}
+
+func (n *NextTimeoutBlockApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["nextTimeoutBlock"].Encode(n)
+}
+
+func (n *NextTimeoutBlockApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["nextTimeoutBlock"], buf, n)
+}
+
+type NextTimeoutBlockApexBridgeContractsClaimsOutput struct {
+	Field0 *big.Int `abi:"0"`
+}
+
+func (n *NextTimeoutBlockApexBridgeContractsClaimsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Claims.Abi.Methods["nextTimeoutBlock"].Outputs, buf, &n)
+}
+
+type OwnerApexBridgeContractsClaimsFn struct {
+}
+
+func (o *OwnerApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["owner"].ID()
+}
+
+func (o *OwnerApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["owner"].Encode(o)
+}
+
+func (o *OwnerApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["owner"], buf, o)
+}
+
+type OwnerApexBridgeContractsClaimsOutput struct {
+	Field0 types.Address `abi:"0"`
+}
+
+func (o *OwnerApexBridgeContractsClaimsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Claims.Abi.Methods["owner"].Outputs, buf, &o)
+}
+
+type ProxiableUUIDApexBridgeContractsClaimsFn struct {
+}
+
+func (p *ProxiableUUIDApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["proxiableUUID"].ID()
+}
+
+func (p *ProxiableUUIDApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["proxiableUUID"].Encode(p)
+}
+
+func (p *ProxiableUUIDApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["proxiableUUID"], buf, p)
+}
+
+type ProxiableUUIDApexBridgeContractsClaimsOutput struct {
+	Field0 types.Hash `abi:"0"`
+}
+
+func (p *ProxiableUUIDApexBridgeContractsClaimsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Claims.Abi.Methods["proxiableUUID"].Outputs, buf, &p)
+}
+
+type RenounceOwnershipApexBridgeContractsClaimsFn struct {
+}
+
+func (r *RenounceOwnershipApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["renounceOwnership"].ID()
+}
+
+func (r *RenounceOwnershipApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["renounceOwnership"].Encode(r)
+}
+
+func (r *RenounceOwnershipApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["renounceOwnership"], buf, r)
+}
+
+type ResetCurrentBatchBlockApexBridgeContractsClaimsFn struct {
+	ChainID uint8 `abi:"_chainId"`
+}
+
+func (r *ResetCurrentBatchBlockApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["resetCurrentBatchBlock"].ID()
+}
+
+func (r *ResetCurrentBatchBlockApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["resetCurrentBatchBlock"].Encode(r)
+}
+
+func (r *ResetCurrentBatchBlockApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["resetCurrentBatchBlock"], buf, r)
+}
+
+type SetChainRegisteredApexBridgeContractsClaimsFn struct {
+	ChainID            uint8    `abi:"_chainId"`
+	InitialTokenSupply *big.Int `abi:"_initialTokenSupply"`
+}
+
+func (s *SetChainRegisteredApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["setChainRegistered"].ID()
+}
+
+func (s *SetChainRegisteredApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["setChainRegistered"].Encode(s)
+}
+
+func (s *SetChainRegisteredApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["setChainRegistered"], buf, s)
+}
+
+type SetDependenciesApexBridgeContractsClaimsFn struct {
+	BridgeAddress        types.Address `abi:"_bridgeAddress"`
+	ClaimsHelperAddress  types.Address `abi:"_claimsHelperAddress"`
+	ValidatorsAddress    types.Address `abi:"_validatorsAddress"`
+	AdminContractAddress types.Address `abi:"_adminContractAddress"`
+}
+
+func (s *SetDependenciesApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["setDependencies"].ID()
+}
+
+func (s *SetDependenciesApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["setDependencies"].Encode(s)
+}
+
+func (s *SetDependenciesApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["setDependencies"], buf, s)
+}
+
+type SetNextTimeoutBlockApexBridgeContractsClaimsFn struct {
+	ChainID     uint8    `abi:"_chainId"`
+	BlockNumber *big.Int `abi:"_blockNumber"`
+}
+
+func (s *SetNextTimeoutBlockApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["setNextTimeoutBlock"].ID()
+}
+
+func (s *SetNextTimeoutBlockApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["setNextTimeoutBlock"].Encode(s)
+}
+
+func (s *SetNextTimeoutBlockApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["setNextTimeoutBlock"], buf, s)
+}
+
+type SetVotedApexBridgeContractsClaimsFn struct {
+	Voter types.Address `abi:"_voter"`
+	Hash  types.Hash    `abi:"_hash"`
+}
+
+func (s *SetVotedApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["setVoted"].ID()
+}
+
+func (s *SetVotedApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["setVoted"].Encode(s)
+}
+
+func (s *SetVotedApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["setVoted"], buf, s)
+}
+
+type SetVotedApexBridgeContractsClaimsOutput struct {
+	Field0 *big.Int `abi:"0"`
+}
+
+func (s *SetVotedApexBridgeContractsClaimsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Claims.Abi.Methods["setVoted"].Outputs, buf, &s)
+}
+
+type ShouldCreateBatchApexBridgeContractsClaimsFn struct {
+	DestinationChain uint8 `abi:"_destinationChain"`
+}
+
+func (s *ShouldCreateBatchApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["shouldCreateBatch"].ID()
+}
+
+func (s *ShouldCreateBatchApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["shouldCreateBatch"].Encode(s)
+}
+
+func (s *ShouldCreateBatchApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["shouldCreateBatch"], buf, s)
+}
+
+type ShouldCreateBatchApexBridgeContractsClaimsOutput struct {
+	Field0 bool `abi:"0"`
+}
+
+func (s *ShouldCreateBatchApexBridgeContractsClaimsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Claims.Abi.Methods["shouldCreateBatch"].Outputs, buf, &s)
+}
+
+type SubmitClaimsApexBridgeContractsClaimsFn struct {
+	Claims *ValidatorClaims `abi:"_claims"`
+	Caller types.Address    `abi:"_caller"`
+}
+
+func (s *SubmitClaimsApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["submitClaims"].ID()
+}
+
+func (s *SubmitClaimsApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["submitClaims"].Encode(s)
+}
+
+func (s *SubmitClaimsApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["submitClaims"], buf, s)
+}
+
+type TimeoutBlocksNumberApexBridgeContractsClaimsFn struct {
+}
+
+func (t *TimeoutBlocksNumberApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["timeoutBlocksNumber"].ID()
+}
+
+func (t *TimeoutBlocksNumberApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["timeoutBlocksNumber"].Encode(t)
+}
+
+func (t *TimeoutBlocksNumberApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["timeoutBlocksNumber"], buf, t)
+}
+
+type TimeoutBlocksNumberApexBridgeContractsClaimsOutput struct {
+	Field0 uint8 `abi:"0"`
+}
+
+func (t *TimeoutBlocksNumberApexBridgeContractsClaimsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Claims.Abi.Methods["timeoutBlocksNumber"].Outputs, buf, &t)
+}
+
+type TransferOwnershipApexBridgeContractsClaimsFn struct {
+	NewOwner types.Address `abi:"newOwner"`
+}
+
+func (t *TransferOwnershipApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["transferOwnership"].ID()
+}
+
+func (t *TransferOwnershipApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["transferOwnership"].Encode(t)
+}
+
+func (t *TransferOwnershipApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["transferOwnership"], buf, t)
+}
+
+type UpdateChainTokenQuantityApexBridgeContractsClaimsFn struct {
+	ChainID     uint8    `abi:"_chainId"`
+	IsIncrease  bool     `abi:"_isIncrease"`
+	TokenAmount *big.Int `abi:"_tokenAmount"`
+}
+
+func (u *UpdateChainTokenQuantityApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["updateChainTokenQuantity"].ID()
+}
+
+func (u *UpdateChainTokenQuantityApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["updateChainTokenQuantity"].Encode(u)
+}
+
+func (u *UpdateChainTokenQuantityApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["updateChainTokenQuantity"], buf, u)
+}
+
+type UpgradeToApexBridgeContractsClaimsFn struct {
+	NewImplementation types.Address `abi:"newImplementation"`
+}
+
+func (u *UpgradeToApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["upgradeTo"].ID()
+}
+
+func (u *UpgradeToApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["upgradeTo"].Encode(u)
+}
+
+func (u *UpgradeToApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["upgradeTo"], buf, u)
+}
+
+type UpgradeToAndCallApexBridgeContractsClaimsFn struct {
+	NewImplementation types.Address `abi:"newImplementation"`
+	Data              []byte        `abi:"data"`
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsClaimsFn) Sig() []byte {
+	return ApexBridgeContracts.Claims.Abi.Methods["upgradeToAndCall"].ID()
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Claims.Abi.Methods["upgradeToAndCall"].Encode(u)
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["upgradeToAndCall"], buf, u)
+}
+
+type GetConfirmedBatchApexBridgeContractsSignedBatchesFn struct {
+	DestinationChain uint8 `abi:"_destinationChain"`
+}
+
+func (g *GetConfirmedBatchApexBridgeContractsSignedBatchesFn) Sig() []byte {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["getConfirmedBatch"].ID()
+}
+
+func (g *GetConfirmedBatchApexBridgeContractsSignedBatchesFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["getConfirmedBatch"].Encode(g)
+}
+
+func (g *GetConfirmedBatchApexBridgeContractsSignedBatchesFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.SignedBatches.Abi.Methods["getConfirmedBatch"], buf, g)
+}
+
+type GetConfirmedBatchApexBridgeContractsSignedBatchesOutput struct {
+	Batch *ConfirmedBatch `abi:"_batch"`
+}
+
+func (g *GetConfirmedBatchApexBridgeContractsSignedBatchesOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.SignedBatches.Abi.Methods["getConfirmedBatch"].Outputs, buf, &g)
+}
+
+type GetConfirmedBatchIdApexBridgeContractsSignedBatchesFn struct {
+	DestinationChain uint8 `abi:"_destinationChain"`
+}
+
+func (g *GetConfirmedBatchIdApexBridgeContractsSignedBatchesFn) Sig() []byte {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["getConfirmedBatchId"].ID()
+}
+
+func (g *GetConfirmedBatchIdApexBridgeContractsSignedBatchesFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["getConfirmedBatchId"].Encode(g)
+}
+
+func (g *GetConfirmedBatchIdApexBridgeContractsSignedBatchesFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.SignedBatches.Abi.Methods["getConfirmedBatchId"], buf, g)
+}
+
+type GetConfirmedBatchIdApexBridgeContractsSignedBatchesOutput struct {
+	Field0 uint64 `abi:"0"`
+}
+
+func (g *GetConfirmedBatchIdApexBridgeContractsSignedBatchesOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.SignedBatches.Abi.Methods["getConfirmedBatchId"].Outputs, buf, &g)
+}
+
+type GetConfirmedBatchTransactionApexBridgeContractsSignedBatchesFn struct {
+	DestinationChain uint8 `abi:"_destinationChain"`
+}
+
+func (g *GetConfirmedBatchTransactionApexBridgeContractsSignedBatchesFn) Sig() []byte {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["getConfirmedBatchTransaction"].ID()
+}
+
+func (g *GetConfirmedBatchTransactionApexBridgeContractsSignedBatchesFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["getConfirmedBatchTransaction"].Encode(g)
+}
+
+func (g *GetConfirmedBatchTransactionApexBridgeContractsSignedBatchesFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.SignedBatches.Abi.Methods["getConfirmedBatchTransaction"], buf, g)
+}
+
+type GetConfirmedBatchTransactionApexBridgeContractsSignedBatchesOutput struct {
+	Field0 []byte `abi:"0"`
+}
+
+func (g *GetConfirmedBatchTransactionApexBridgeContractsSignedBatchesOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.SignedBatches.Abi.Methods["getConfirmedBatchTransaction"].Outputs, buf, &g)
+}
+
+type GetNumberOfSignaturesApexBridgeContractsSignedBatchesFn struct {
+	Hash types.Hash `abi:"_hash"`
+}
+
+func (g *GetNumberOfSignaturesApexBridgeContractsSignedBatchesFn) Sig() []byte {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["getNumberOfSignatures"].ID()
+}
+
+func (g *GetNumberOfSignaturesApexBridgeContractsSignedBatchesFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["getNumberOfSignatures"].Encode(g)
+}
+
+func (g *GetNumberOfSignaturesApexBridgeContractsSignedBatchesFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.SignedBatches.Abi.Methods["getNumberOfSignatures"], buf, g)
+}
+
+type GetNumberOfSignaturesApexBridgeContractsSignedBatchesOutput struct {
+	Field0 *big.Int `abi:"0"`
+	Field1 *big.Int `abi:"1"`
+}
+
+func (g *GetNumberOfSignaturesApexBridgeContractsSignedBatchesOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.SignedBatches.Abi.Methods["getNumberOfSignatures"].Outputs, buf, &g)
+}
+
+type HasVotedApexBridgeContractsSignedBatchesFn struct {
+	Field0 types.Hash    `abi:"0"`
+	Field1 types.Address `abi:"1"`
+}
+
+func (h *HasVotedApexBridgeContractsSignedBatchesFn) Sig() []byte {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["hasVoted"].ID()
+}
+
+func (h *HasVotedApexBridgeContractsSignedBatchesFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["hasVoted"].Encode(h)
+}
+
+func (h *HasVotedApexBridgeContractsSignedBatchesFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.SignedBatches.Abi.Methods["hasVoted"], buf, h)
+}
+
+type HasVotedApexBridgeContractsSignedBatchesOutput struct {
+	Field0 bool `abi:"0"`
+}
+
+func (h *HasVotedApexBridgeContractsSignedBatchesOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.SignedBatches.Abi.Methods["hasVoted"].Outputs, buf, &h)
+}
+
+type InitializeApexBridgeContractsSignedBatchesFn struct {
+	Owner        types.Address `abi:"_owner"`
+	UpgradeAdmin types.Address `abi:"_upgradeAdmin"`
+}
+
+func (i *InitializeApexBridgeContractsSignedBatchesFn) Sig() []byte {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["initialize"].ID()
+}
+
+func (i *InitializeApexBridgeContractsSignedBatchesFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["initialize"].Encode(i)
+}
+
+func (i *InitializeApexBridgeContractsSignedBatchesFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.SignedBatches.Abi.Methods["initialize"], buf, i)
+}
+
+type OwnerApexBridgeContractsSignedBatchesFn struct {
+}
+
+func (o *OwnerApexBridgeContractsSignedBatchesFn) Sig() []byte {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["owner"].ID()
+}
+
+func (o *OwnerApexBridgeContractsSignedBatchesFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["owner"].Encode(o)
+}
+
+func (o *OwnerApexBridgeContractsSignedBatchesFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.SignedBatches.Abi.Methods["owner"], buf, o)
+}
+
+type OwnerApexBridgeContractsSignedBatchesOutput struct {
+	Field0 types.Address `abi:"0"`
+}
+
+func (o *OwnerApexBridgeContractsSignedBatchesOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.SignedBatches.Abi.Methods["owner"].Outputs, buf, &o)
+}
+
+type ProxiableUUIDApexBridgeContractsSignedBatchesFn struct {
+}
+
+func (p *ProxiableUUIDApexBridgeContractsSignedBatchesFn) Sig() []byte {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["proxiableUUID"].ID()
+}
+
+func (p *ProxiableUUIDApexBridgeContractsSignedBatchesFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["proxiableUUID"].Encode(p)
+}
+
+func (p *ProxiableUUIDApexBridgeContractsSignedBatchesFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.SignedBatches.Abi.Methods["proxiableUUID"], buf, p)
+}
+
+type ProxiableUUIDApexBridgeContractsSignedBatchesOutput struct {
+	Field0 types.Hash `abi:"0"`
+}
+
+func (p *ProxiableUUIDApexBridgeContractsSignedBatchesOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.SignedBatches.Abi.Methods["proxiableUUID"].Outputs, buf, &p)
+}
+
+type RenounceOwnershipApexBridgeContractsSignedBatchesFn struct {
+}
+
+func (r *RenounceOwnershipApexBridgeContractsSignedBatchesFn) Sig() []byte {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["renounceOwnership"].ID()
+}
+
+func (r *RenounceOwnershipApexBridgeContractsSignedBatchesFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["renounceOwnership"].Encode(r)
+}
+
+func (r *RenounceOwnershipApexBridgeContractsSignedBatchesFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.SignedBatches.Abi.Methods["renounceOwnership"], buf, r)
+}
+
+type SetDependenciesApexBridgeContractsSignedBatchesFn struct {
+	BridgeAddress       types.Address `abi:"_bridgeAddress"`
+	ClaimsHelperAddress types.Address `abi:"_claimsHelperAddress"`
+	ValidatorsAddress   types.Address `abi:"_validatorsAddress"`
+}
+
+func (s *SetDependenciesApexBridgeContractsSignedBatchesFn) Sig() []byte {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["setDependencies"].ID()
+}
+
+func (s *SetDependenciesApexBridgeContractsSignedBatchesFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["setDependencies"].Encode(s)
+}
+
+func (s *SetDependenciesApexBridgeContractsSignedBatchesFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.SignedBatches.Abi.Methods["setDependencies"], buf, s)
+}
+
+type SubmitSignedBatchApexBridgeContractsSignedBatchesFn struct {
+	SignedBatch *SignedBatch  `abi:"_signedBatch"`
+	Caller      types.Address `abi:"_caller"`
+}
+
+func (s *SubmitSignedBatchApexBridgeContractsSignedBatchesFn) Sig() []byte {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["submitSignedBatch"].ID()
+}
+
+func (s *SubmitSignedBatchApexBridgeContractsSignedBatchesFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["submitSignedBatch"].Encode(s)
+}
+
+func (s *SubmitSignedBatchApexBridgeContractsSignedBatchesFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.SignedBatches.Abi.Methods["submitSignedBatch"], buf, s)
+}
+
+type TransferOwnershipApexBridgeContractsSignedBatchesFn struct {
+	NewOwner types.Address `abi:"newOwner"`
+}
+
+func (t *TransferOwnershipApexBridgeContractsSignedBatchesFn) Sig() []byte {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["transferOwnership"].ID()
+}
+
+func (t *TransferOwnershipApexBridgeContractsSignedBatchesFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["transferOwnership"].Encode(t)
+}
+
+func (t *TransferOwnershipApexBridgeContractsSignedBatchesFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.SignedBatches.Abi.Methods["transferOwnership"], buf, t)
+}
+
+type UpgradeToApexBridgeContractsSignedBatchesFn struct {
+	NewImplementation types.Address `abi:"newImplementation"`
+}
+
+func (u *UpgradeToApexBridgeContractsSignedBatchesFn) Sig() []byte {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["upgradeTo"].ID()
+}
+
+func (u *UpgradeToApexBridgeContractsSignedBatchesFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["upgradeTo"].Encode(u)
+}
+
+func (u *UpgradeToApexBridgeContractsSignedBatchesFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.SignedBatches.Abi.Methods["upgradeTo"], buf, u)
+}
+
+type UpgradeToAndCallApexBridgeContractsSignedBatchesFn struct {
+	NewImplementation types.Address `abi:"newImplementation"`
+	Data              []byte        `abi:"data"`
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsSignedBatchesFn) Sig() []byte {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["upgradeToAndCall"].ID()
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsSignedBatchesFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.SignedBatches.Abi.Methods["upgradeToAndCall"].Encode(u)
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsSignedBatchesFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.SignedBatches.Abi.Methods["upgradeToAndCall"], buf, u)
+}
+
+type GetLastObservedBlockApexBridgeContractsSlotsFn struct {
+	ChainID uint8 `abi:"_chainId"`
+}
+
+func (g *GetLastObservedBlockApexBridgeContractsSlotsFn) Sig() []byte {
+	return ApexBridgeContracts.Slots.Abi.Methods["getLastObservedBlock"].ID()
+}
+
+func (g *GetLastObservedBlockApexBridgeContractsSlotsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Slots.Abi.Methods["getLastObservedBlock"].Encode(g)
+}
+
+func (g *GetLastObservedBlockApexBridgeContractsSlotsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Slots.Abi.Methods["getLastObservedBlock"], buf, g)
+}
+
+type GetLastObservedBlockApexBridgeContractsSlotsOutput struct {
+	Cb *CardanoBlock `abi:"_cb"`
+}
+
+func (g *GetLastObservedBlockApexBridgeContractsSlotsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Slots.Abi.Methods["getLastObservedBlock"].Outputs, buf, &g)
+}
+
+type InitializeApexBridgeContractsSlotsFn struct {
+	Owner        types.Address `abi:"_owner"`
+	UpgradeAdmin types.Address `abi:"_upgradeAdmin"`
+}
+
+func (i *InitializeApexBridgeContractsSlotsFn) Sig() []byte {
+	return ApexBridgeContracts.Slots.Abi.Methods["initialize"].ID()
+}
+
+func (i *InitializeApexBridgeContractsSlotsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Slots.Abi.Methods["initialize"].Encode(i)
+}
+
+func (i *InitializeApexBridgeContractsSlotsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Slots.Abi.Methods["initialize"], buf, i)
+}
+
+type OwnerApexBridgeContractsSlotsFn struct {
+}
+
+func (o *OwnerApexBridgeContractsSlotsFn) Sig() []byte {
+	return ApexBridgeContracts.Slots.Abi.Methods["owner"].ID()
+}
+
+func (o *OwnerApexBridgeContractsSlotsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Slots.Abi.Methods["owner"].Encode(o)
+}
+
+func (o *OwnerApexBridgeContractsSlotsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Slots.Abi.Methods["owner"], buf, o)
+}
+
+type OwnerApexBridgeContractsSlotsOutput struct {
+	Field0 types.Address `abi:"0"`
+}
+
+func (o *OwnerApexBridgeContractsSlotsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Slots.Abi.Methods["owner"].Outputs, buf, &o)
+}
+
+type ProxiableUUIDApexBridgeContractsSlotsFn struct {
+}
+
+func (p *ProxiableUUIDApexBridgeContractsSlotsFn) Sig() []byte {
+	return ApexBridgeContracts.Slots.Abi.Methods["proxiableUUID"].ID()
+}
+
+func (p *ProxiableUUIDApexBridgeContractsSlotsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Slots.Abi.Methods["proxiableUUID"].Encode(p)
+}
+
+func (p *ProxiableUUIDApexBridgeContractsSlotsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Slots.Abi.Methods["proxiableUUID"], buf, p)
+}
+
+type ProxiableUUIDApexBridgeContractsSlotsOutput struct {
+	Field0 types.Hash `abi:"0"`
+}
+
+func (p *ProxiableUUIDApexBridgeContractsSlotsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Slots.Abi.Methods["proxiableUUID"].Outputs, buf, &p)
+}
+
+type RenounceOwnershipApexBridgeContractsSlotsFn struct {
+}
+
+func (r *RenounceOwnershipApexBridgeContractsSlotsFn) Sig() []byte {
+	return ApexBridgeContracts.Slots.Abi.Methods["renounceOwnership"].ID()
+}
+
+func (r *RenounceOwnershipApexBridgeContractsSlotsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Slots.Abi.Methods["renounceOwnership"].Encode(r)
+}
+
+func (r *RenounceOwnershipApexBridgeContractsSlotsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Slots.Abi.Methods["renounceOwnership"], buf, r)
+}
+
+type SetDependenciesApexBridgeContractsSlotsFn struct {
+	BridgeAddress     types.Address `abi:"_bridgeAddress"`
+	ValidatorsAddress types.Address `abi:"_validatorsAddress"`
+}
+
+func (s *SetDependenciesApexBridgeContractsSlotsFn) Sig() []byte {
+	return ApexBridgeContracts.Slots.Abi.Methods["setDependencies"].ID()
+}
+
+func (s *SetDependenciesApexBridgeContractsSlotsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Slots.Abi.Methods["setDependencies"].Encode(s)
+}
+
+func (s *SetDependenciesApexBridgeContractsSlotsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Slots.Abi.Methods["setDependencies"], buf, s)
+}
+
+type TransferOwnershipApexBridgeContractsSlotsFn struct {
+	NewOwner types.Address `abi:"newOwner"`
+}
+
+func (t *TransferOwnershipApexBridgeContractsSlotsFn) Sig() []byte {
+	return ApexBridgeContracts.Slots.Abi.Methods["transferOwnership"].ID()
+}
+
+func (t *TransferOwnershipApexBridgeContractsSlotsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Slots.Abi.Methods["transferOwnership"].Encode(t)
+}
+
+func (t *TransferOwnershipApexBridgeContractsSlotsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Slots.Abi.Methods["transferOwnership"], buf, t)
+}
+
+type UpdateBlocksApexBridgeContractsSlotsFn struct {
+	ChainID uint8           `abi:"_chainId"`
+	Blocks  []*CardanoBlock `abi:"_blocks"`
+	Caller  types.Address   `abi:"_caller"`
+}
+
+func (u *UpdateBlocksApexBridgeContractsSlotsFn) Sig() []byte {
+	return ApexBridgeContracts.Slots.Abi.Methods["updateBlocks"].ID()
+}
+
+func (u *UpdateBlocksApexBridgeContractsSlotsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Slots.Abi.Methods["updateBlocks"].Encode(u)
+}
+
+func (u *UpdateBlocksApexBridgeContractsSlotsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Slots.Abi.Methods["updateBlocks"], buf, u)
+}
+
+type UpgradeToApexBridgeContractsSlotsFn struct {
+	NewImplementation types.Address `abi:"newImplementation"`
+}
+
+func (u *UpgradeToApexBridgeContractsSlotsFn) Sig() []byte {
+	return ApexBridgeContracts.Slots.Abi.Methods["upgradeTo"].ID()
+}
+
+func (u *UpgradeToApexBridgeContractsSlotsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Slots.Abi.Methods["upgradeTo"].Encode(u)
+}
+
+func (u *UpgradeToApexBridgeContractsSlotsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Slots.Abi.Methods["upgradeTo"], buf, u)
+}
+
+type UpgradeToAndCallApexBridgeContractsSlotsFn struct {
+	NewImplementation types.Address `abi:"newImplementation"`
+	Data              []byte        `abi:"data"`
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsSlotsFn) Sig() []byte {
+	return ApexBridgeContracts.Slots.Abi.Methods["upgradeToAndCall"].ID()
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsSlotsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Slots.Abi.Methods["upgradeToAndCall"].Encode(u)
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsSlotsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Slots.Abi.Methods["upgradeToAndCall"], buf, u)
+}
+
+type AddValidatorChainDataApexBridgeContractsValidatorsFn struct {
+	ChainID uint8               `abi:"_chainId"`
+	Addr    types.Address       `abi:"_addr"`
+	Data    *ValidatorChainData `abi:"_data"`
+}
+
+func (a *AddValidatorChainDataApexBridgeContractsValidatorsFn) Sig() []byte {
+	return ApexBridgeContracts.Validators.Abi.Methods["addValidatorChainData"].ID()
+}
+
+func (a *AddValidatorChainDataApexBridgeContractsValidatorsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Validators.Abi.Methods["addValidatorChainData"].Encode(a)
+}
+
+func (a *AddValidatorChainDataApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["addValidatorChainData"], buf, a)
+}
+
+type AreSignaturesValidApexBridgeContractsValidatorsFn struct {
+	ChainID       uint8         `abi:"_chainId"`
+	TxRaw         []byte        `abi:"_txRaw"`
+	Signature     []byte        `abi:"_signature"`
+	SignatureFee  []byte        `abi:"_signatureFee"`
+	ValidatorAddr types.Address `abi:"_validatorAddr"`
+}
+
+func (a *AreSignaturesValidApexBridgeContractsValidatorsFn) Sig() []byte {
+	return ApexBridgeContracts.Validators.Abi.Methods["areSignaturesValid"].ID()
+}
+
+func (a *AreSignaturesValidApexBridgeContractsValidatorsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Validators.Abi.Methods["areSignaturesValid"].Encode(a)
+}
+
+func (a *AreSignaturesValidApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["areSignaturesValid"], buf, a)
+}
+
+type AreSignaturesValidApexBridgeContractsValidatorsOutput struct {
+	Field0 bool `abi:"0"`
+}
+
+func (a *AreSignaturesValidApexBridgeContractsValidatorsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Validators.Abi.Methods["areSignaturesValid"].Outputs, buf, &a)
+}
+
+type GetQuorumNumberOfValidatorsApexBridgeContractsValidatorsFn struct {
+}
+
+func (g *GetQuorumNumberOfValidatorsApexBridgeContractsValidatorsFn) Sig() []byte {
+	return ApexBridgeContracts.Validators.Abi.Methods["getQuorumNumberOfValidators"].ID()
+}
+
+func (g *GetQuorumNumberOfValidatorsApexBridgeContractsValidatorsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Validators.Abi.Methods["getQuorumNumberOfValidators"].Encode(g)
+}
+
+func (g *GetQuorumNumberOfValidatorsApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["getQuorumNumberOfValidators"], buf, g)
+}
+
+type GetQuorumNumberOfValidatorsApexBridgeContractsValidatorsOutput struct {
+	Quorum uint8 `abi:"_quorum"`
+}
+
+func (g *GetQuorumNumberOfValidatorsApexBridgeContractsValidatorsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Validators.Abi.Methods["getQuorumNumberOfValidators"].Outputs, buf, &g)
+}
+
+type GetValidatorIndexApexBridgeContractsValidatorsFn struct {
+	Addr types.Address `abi:"_addr"`
+}
+
+func (g *GetValidatorIndexApexBridgeContractsValidatorsFn) Sig() []byte {
+	return ApexBridgeContracts.Validators.Abi.Methods["getValidatorIndex"].ID()
+}
+
+func (g *GetValidatorIndexApexBridgeContractsValidatorsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Validators.Abi.Methods["getValidatorIndex"].Encode(g)
+}
+
+func (g *GetValidatorIndexApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["getValidatorIndex"], buf, g)
+}
+
+type GetValidatorIndexApexBridgeContractsValidatorsOutput struct {
+	Field0 uint8 `abi:"0"`
+}
+
+func (g *GetValidatorIndexApexBridgeContractsValidatorsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Validators.Abi.Methods["getValidatorIndex"].Outputs, buf, &g)
+}
+
+type GetValidatorsChainDataApexBridgeContractsValidatorsFn struct {
+	ChainID uint8 `abi:"_chainId"`
+}
+
+func (g *GetValidatorsChainDataApexBridgeContractsValidatorsFn) Sig() []byte {
+	return ApexBridgeContracts.Validators.Abi.Methods["getValidatorsChainData"].ID()
+}
+
+func (g *GetValidatorsChainDataApexBridgeContractsValidatorsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Validators.Abi.Methods["getValidatorsChainData"].Encode(g)
+}
+
+func (g *GetValidatorsChainDataApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["getValidatorsChainData"], buf, g)
+}
+
+type GetValidatorsChainDataApexBridgeContractsValidatorsOutput struct {
+	Field0 []*ValidatorChainData `abi:"0"`
+}
+
+func (g *GetValidatorsChainDataApexBridgeContractsValidatorsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Validators.Abi.Methods["getValidatorsChainData"].Outputs, buf, &g)
+}
+
+type InitializeApexBridgeContractsValidatorsFn struct {
+	Owner        types.Address   `abi:"_owner"`
+	UpgradeAdmin types.Address   `abi:"_upgradeAdmin"`
+	Validators   []types.Address `abi:"_validators"`
+}
+
+func (i *InitializeApexBridgeContractsValidatorsFn) Sig() []byte {
+	return ApexBridgeContracts.Validators.Abi.Methods["initialize"].ID()
+}
+
+func (i *InitializeApexBridgeContractsValidatorsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Validators.Abi.Methods["initialize"].Encode(i)
 }
 
-func (i *InitializeApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
-	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["initialize"], buf, i)
+func (i *InitializeApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["initialize"], buf, i)
 }
 
-type SetDependenciesApexBridgeContractsBridgeFn struct {
-	ClaimsAddress        types.Address `abi:"_claimsAddress"`
-	SignedBatchesAddress types.Address `abi:"_signedBatchesAddress"`
-	SlotsAddress         types.Address `abi:"_slotsAddress"`
-	ValidatorsAddress    types.Address `abi:"_validatorsAddress"`
+type IsBlsSignatureValidApexBridgeContractsValidatorsFn struct {
+	Hash         types.Hash  `abi:"_hash"`
+	Signature    []byte      `abi:"_signature"`
+	VerifyingKey [4]*big.Int `abi:"_verifyingKey"`
 }
 
-func (s *SetDependenciesApexBridgeContractsBridgeFn) Sig() []byte {
-	return ApexBridgeContracts.Bridge.Abi.Methods["setDependencies"].ID()
+func (i *IsBlsSignatureValidApexBridgeContractsValidatorsFn) Sig() []byte {
+	return ApexBridgeContracts.Validators.Abi.Methods["isBlsSignatureValid"].ID()
 }
 
-func (s *SetDependenciesApexBridgeContractsBridgeFn) EncodeAbi() ([]byte, error) {
-	return ApexBridgeContracts.Bridge.Abi.Methods["setDependencies"].Encode(s)
+func (i *IsBlsSignatureValidApexBridgeContractsValidatorsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Validators.Abi.Methods["isBlsSignatureValid"].Encode(i)
 }
 
-func (s *SetDependenciesApexBridgeContractsBridgeFn) DecodeAbi(buf []byte) error {
-	return decodeMethod(ApexBridgeContracts.Bridge.Abi.Methods["setDependencies"], buf, s)
+func (i *IsBlsSignatureValidApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["isBlsSignatureValid"], buf, i)
 }
 
-type InitializeApexBridgeContractsClaimsHelperFn struct {
-	Owner        types.Address `abi:"_owner"`
-	UpgradeAdmin types.Address `abi:"_upgradeAdmin"`
+type IsBlsSignatureValidApexBridgeContractsValidatorsOutput struct {
+	Field0 bool `abi:"0"`
 }
 
-func (i *InitializeApexBridgeContractsClaimsHelperFn) Sig() []byte {
-	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["initialize"].ID()
+func (i *IsBlsSignatureValidApexBridgeContractsValidatorsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Validators.Abi.Methods["isBlsSignatureValid"].Outputs, buf, &i)
 }
 
-func (i *InitializeApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
-	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["initialize"].Encode(i)
+type IsBlsSignatureValidByValidatorAddressApexBridgeContractsValidatorsFn struct {
+	ChainID       uint8         `abi:"_chainId"`
+	Hash          types.Hash    `abi:"_hash"`
+	Signature     []byte        `abi:"_signature"`
+	ValidatorAddr types.Address `abi:"_validatorAddr"`
 }
 
-func (i *InitializeApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
-	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["initialize"], buf, i)
+func (i *IsBlsSignatureValidByValidatorAddressApexBridgeContractsValidatorsFn) Sig() []byte {
+	return ApexBridgeContracts.Validators.Abi.Methods["isBlsSignatureValidByValidatorAddress"].ID()
 }
 
-type SetDependenciesApexBridgeContractsClaimsHelperFn struct {
-	ClaimsAddress        types.Address `abi:"_claimsAddress"`
-	SignedBatchesAddress types.Address `abi:"_signedBatchesAddress"`
+func (i *IsBlsSignatureValidByValidatorAddressApexBridgeContractsValidatorsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Validators.Abi.Methods["isBlsSignatureValidByValidatorAddress"].Encode(i)
 }
 
-func (s *SetDependenciesApexBridgeContractsClaimsHelperFn) Sig() []byte {
-	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["setDependencies"].ID()
+func (i *IsBlsSignatureValidByValidatorAddressApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["isBlsSignatureValidByValidatorAddress"], buf, i)
 }
 
-func (s *SetDependenciesApexBridgeContractsClaimsHelperFn) EncodeAbi() ([]byte, error) {
-	return ApexBridgeContracts.ClaimsHelper.Abi.Methods["setDependencies"].Encode(s)
+type IsBlsSignatureValidByValidatorAddressApexBridgeContractsValidatorsOutput struct {
+	Field0 bool `abi:"0"`
 }
 
-func (s *SetDependenciesApexBridgeContractsClaimsHelperFn) DecodeAbi(buf []byte) error {
-	return decodeMethod(ApexBridgeContracts.ClaimsHelper.Abi.Methods["setDependencies"], buf, s)
+func (i *IsBlsSignatureValidByValidatorAddressApexBridgeContractsValidatorsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Validators.Abi.Methods["isBlsSignatureValidByValidatorAddress"].Outputs, buf, &i)
 }
 
-type InitializeApexBridgeContractsClaimsFn struct {
-	Owner                   types.Address `abi:"_owner"`
-	UpgradeAdmin            types.Address `abi:"_upgradeAdmin"`
-	MaxNumberOfTransactions uint16        `abi:"_maxNumberOfTransactions"`
-	TimeoutBlocksNumber     uint8         `abi:"_timeoutBlocksNumber"`
+type IsSignatureValidApexBridgeContractsValidatorsFn struct {
+	Data         []byte   `abi:"_data"`
+	Signature    []byte   `abi:"_signature"`
+	VerifyingKey *big.Int `abi:"_verifyingKey"`
+	IsTx         bool     `abi:"_isTx"`
 }
 
-func (i *InitializeApexBridgeContractsClaimsFn) Sig() []byte {
-	return ApexBridgeContracts.Claims.Abi.Methods["initialize"].ID()
+func (i *IsSignatureValidApexBridgeContractsValidatorsFn) Sig() []byte {
+	return ApexBridgeContracts.Validators.Abi.Methods["isSignatureValid"].ID()
 }
 
-func (i *InitializeApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
-	return ApexBridgeContracts.Claims.Abi.Methods["initialize"].Encode(i)
+func (i *IsSignatureValidApexBridgeContractsValidatorsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Validators.Abi.Methods["isSignatureValid"].Encode(i)
 }
 
-func (i *InitializeApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
-	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["initialize"], buf, i)
+func (i *IsSignatureValidApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["isSignatureValid"], buf, i)
 }
 
-type SetDependenciesApexBridgeContractsClaimsFn struct {
-	BridgeAddress        types.Address `abi:"_bridgeAddress"`
-	ClaimsHelperAddress  types.Address `abi:"_claimsHelperAddress"`
-	ValidatorsAddress    types.Address `abi:"_validatorsAddress"`
-	AdminContractAddress types.Address `abi:"_adminContractAddress"`
+type IsSignatureValidApexBridgeContractsValidatorsOutput struct {
+	Field0 bool `abi:"0"`
 }
 
-func (s *SetDependenciesApexBridgeContractsClaimsFn) Sig() []byte {
-	return ApexBridgeContracts.Claims.Abi.Methods["setDependencies"].ID()
+func (i *IsSignatureValidApexBridgeContractsValidatorsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Validators.Abi.Methods["isSignatureValid"].Outputs, buf, &i)
 }
 
-func (s *SetDependenciesApexBridgeContractsClaimsFn) EncodeAbi() ([]byte, error) {
-	return ApexBridgeContracts.Claims.Abi.Methods["setDependencies"].Encode(s)
+type IsValidatorApexBridgeContractsValidatorsFn struct {
+	Addr types.Address `abi:"_addr"`
 }
 
-func (s *SetDependenciesApexBridgeContractsClaimsFn) DecodeAbi(buf []byte) error {
-	return decodeMethod(ApexBridgeContracts.Claims.Abi.Methods["setDependencies"], buf, s)
+func (i *IsValidatorApexBridgeContractsValidatorsFn) Sig() []byte {
+	return ApexBridgeContracts.Validators.Abi.Methods["isValidator"].ID()
 }
 
-type InitializeApexBridgeContractsSignedBatchesFn struct {
-	Owner        types.Address `abi:"_owner"`
-	UpgradeAdmin types.Address `abi:"_upgradeAdmin"`
+func (i *IsValidatorApexBridgeContractsValidatorsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Validators.Abi.Methods["isValidator"].Encode(i)
 }
 
-func (i *InitializeApexBridgeContractsSignedBatchesFn) Sig() []byte {
-	return ApexBridgeContracts.SignedBatches.Abi.Methods["initialize"].ID()
+func (i *IsValidatorApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["isValidator"], buf, i)
 }
 
-func (i *InitializeApexBridgeContractsSignedBatchesFn) EncodeAbi() ([]byte, error) {
-	return ApexBridgeContracts.SignedBatches.Abi.Methods["initialize"].Encode(i)
+type IsValidatorApexBridgeContractsValidatorsOutput struct {
+	Field0 bool `abi:"0"`
 }
 
-func (i *InitializeApexBridgeContractsSignedBatchesFn) DecodeAbi(buf []byte) error {
-	return decodeMethod(ApexBridgeContracts.SignedBatches.Abi.Methods["initialize"], buf, i)
+func (i *IsValidatorApexBridgeContractsValidatorsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Validators.Abi.Methods["isValidator"].Outputs, buf, &i)
 }
 
-type SetDependenciesApexBridgeContractsSignedBatchesFn struct {
-	BridgeAddress       types.Address `abi:"_bridgeAddress"`
-	ClaimsHelperAddress types.Address `abi:"_claimsHelperAddress"`
-	ValidatorsAddress   types.Address `abi:"_validatorsAddress"`
+type OwnerApexBridgeContractsValidatorsFn struct {
 }
 
-func (s *SetDependenciesApexBridgeContractsSignedBatchesFn) Sig() []byte {
-	return ApexBridgeContracts.SignedBatches.Abi.Methods["setDependencies"].ID()
+func (o *OwnerApexBridgeContractsValidatorsFn) Sig() []byte {
+	return ApexBridgeContracts.Validators.Abi.Methods["owner"].ID()
 }
 
-func (s *SetDependenciesApexBridgeContractsSignedBatchesFn) EncodeAbi() ([]byte, error) {
-	return ApexBridgeContracts.SignedBatches.Abi.Methods["setDependencies"].Encode(s)
+func (o *OwnerApexBridgeContractsValidatorsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Validators.Abi.Methods["owner"].Encode(o)
 }
 
-func (s *SetDependenciesApexBridgeContractsSignedBatchesFn) DecodeAbi(buf []byte) error {
-	return decodeMethod(ApexBridgeContracts.SignedBatches.Abi.Methods["setDependencies"], buf, s)
+func (o *OwnerApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["owner"], buf, o)
 }
 
-type InitializeApexBridgeContractsSlotsFn struct {
-	Owner        types.Address `abi:"_owner"`
-	UpgradeAdmin types.Address `abi:"_upgradeAdmin"`
+type OwnerApexBridgeContractsValidatorsOutput struct {
+	Field0 types.Address `abi:"0"`
 }
 
-func (i *InitializeApexBridgeContractsSlotsFn) Sig() []byte {
-	return ApexBridgeContracts.Slots.Abi.Methods["initialize"].ID()
+func (o *OwnerApexBridgeContractsValidatorsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Validators.Abi.Methods["owner"].Outputs, buf, &o)
 }
 
-func (i *InitializeApexBridgeContractsSlotsFn) EncodeAbi() ([]byte, error) {
-	return ApexBridgeContracts.Slots.Abi.Methods["initialize"].Encode(i)
+type ProxiableUUIDApexBridgeContractsValidatorsFn struct {
 }
 
-func (i *InitializeApexBridgeContractsSlotsFn) DecodeAbi(buf []byte) error {
-	return decodeMethod(ApexBridgeContracts.Slots.Abi.Methods["initialize"], buf, i)
+func (p *ProxiableUUIDApexBridgeContractsValidatorsFn) Sig() []byte {
+	return ApexBridgeContracts.Validators.Abi.Methods["proxiableUUID"].ID()
 }
 
-type SetDependenciesApexBridgeContractsSlotsFn struct {
-	BridgeAddress     types.Address `abi:"_bridgeAddress"`
-	ValidatorsAddress types.Address `abi:"_validatorsAddress"`
+func (p *ProxiableUUIDApexBridgeContractsValidatorsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Validators.Abi.Methods["proxiableUUID"].Encode(p)
 }
 
-func (s *SetDependenciesApexBridgeContractsSlotsFn) Sig() []byte {
-	return ApexBridgeContracts.Slots.Abi.Methods["setDependencies"].ID()
+func (p *ProxiableUUIDApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["proxiableUUID"], buf, p)
 }
 
-func (s *SetDependenciesApexBridgeContractsSlotsFn) EncodeAbi() ([]byte, error) {
-	return ApexBridgeContracts.Slots.Abi.Methods["setDependencies"].Encode(s)
+type ProxiableUUIDApexBridgeContractsValidatorsOutput struct {
+	Field0 types.Hash `abi:"0"`
 }
 
-func (s *SetDependenciesApexBridgeContractsSlotsFn) DecodeAbi(buf []byte) error {
-	return decodeMethod(ApexBridgeContracts.Slots.Abi.Methods["setDependencies"], buf, s)
+func (p *ProxiableUUIDApexBridgeContractsValidatorsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Validators.Abi.Methods["proxiableUUID"].Outputs, buf, &p)
 }
 
-type InitializeApexBridgeContractsValidatorsFn struct {
-	Owner        types.Address   `abi:"_owner"`
-	UpgradeAdmin types.Address   `abi:"_upgradeAdmin"`
-	Validators   []types.Address `abi:"_validators"`
+type RenounceOwnershipApexBridgeContractsValidatorsFn struct {
 }
 
-func (i *InitializeApexBridgeContractsValidatorsFn) Sig() []byte {
-	return ApexBridgeContracts.Validators.Abi.Methods["initialize"].ID()
+func (r *RenounceOwnershipApexBridgeContractsValidatorsFn) Sig() []byte {
+	return ApexBridgeContracts.Validators.Abi.Methods["renounceOwnership"].ID()
 }
 
-func (i *InitializeApexBridgeContractsValidatorsFn) EncodeAbi() ([]byte, error) {
-	return ApexBridgeContracts.Validators.Abi.Methods["initialize"].Encode(i)
+func (r *RenounceOwnershipApexBridgeContractsValidatorsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Validators.Abi.Methods["renounceOwnership"].Encode(r)
 }
 
-func (i *InitializeApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) error {
-	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["initialize"], buf, i)
+func (r *RenounceOwnershipApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["renounceOwnership"], buf, r)
 }
 
 type SetDependenciesApexBridgeContractsValidatorsFn struct {
@@ -216,6 +3172,160 @@ func (s *SetDependenciesApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) e
 	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["setDependencies"], buf, s)
 }
 
+type SetValidatorsChainDataApexBridgeContractsValidatorsFn struct {
+	ChainID    uint8                        `abi:"_chainId"`
+	ChainDatas []*ValidatorAddressChainData `abi:"_chainDatas"`
+}
+
+func (s *SetValidatorsChainDataApexBridgeContractsValidatorsFn) Sig() []byte {
+	return ApexBridgeContracts.Validators.Abi.Methods["setValidatorsChainData"].ID()
+}
+
+func (s *SetValidatorsChainDataApexBridgeContractsValidatorsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Validators.Abi.Methods["setValidatorsChainData"].Encode(s)
+}
+
+func (s *SetValidatorsChainDataApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["setValidatorsChainData"], buf, s)
+}
+
+type TransferOwnershipApexBridgeContractsValidatorsFn struct {
+	NewOwner types.Address `abi:"newOwner"`
+}
+
+func (t *TransferOwnershipApexBridgeContractsValidatorsFn) Sig() []byte {
+	return ApexBridgeContracts.Validators.Abi.Methods["transferOwnership"].ID()
+}
+
+func (t *TransferOwnershipApexBridgeContractsValidatorsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Validators.Abi.Methods["transferOwnership"].Encode(t)
+}
+
+func (t *TransferOwnershipApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["transferOwnership"], buf, t)
+}
+
+type UpgradeToApexBridgeContractsValidatorsFn struct {
+	NewImplementation types.Address `abi:"newImplementation"`
+}
+
+func (u *UpgradeToApexBridgeContractsValidatorsFn) Sig() []byte {
+	return ApexBridgeContracts.Validators.Abi.Methods["upgradeTo"].ID()
+}
+
+func (u *UpgradeToApexBridgeContractsValidatorsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Validators.Abi.Methods["upgradeTo"].Encode(u)
+}
+
+func (u *UpgradeToApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["upgradeTo"], buf, u)
+}
+
+type UpgradeToAndCallApexBridgeContractsValidatorsFn struct {
+	NewImplementation types.Address `abi:"newImplementation"`
+	Data              []byte        `abi:"data"`
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsValidatorsFn) Sig() []byte {
+	return ApexBridgeContracts.Validators.Abi.Methods["upgradeToAndCall"].ID()
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsValidatorsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Validators.Abi.Methods["upgradeToAndCall"].Encode(u)
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["upgradeToAndCall"], buf, u)
+}
+
+type ValidatorsCountApexBridgeContractsValidatorsFn struct {
+}
+
+func (v *ValidatorsCountApexBridgeContractsValidatorsFn) Sig() []byte {
+	return ApexBridgeContracts.Validators.Abi.Methods["validatorsCount"].ID()
+}
+
+func (v *ValidatorsCountApexBridgeContractsValidatorsFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Validators.Abi.Methods["validatorsCount"].Encode(v)
+}
+
+func (v *ValidatorsCountApexBridgeContractsValidatorsFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Validators.Abi.Methods["validatorsCount"], buf, v)
+}
+
+type ValidatorsCountApexBridgeContractsValidatorsOutput struct {
+	Field0 uint8 `abi:"0"`
+}
+
+func (v *ValidatorsCountApexBridgeContractsValidatorsOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Validators.Abi.Methods["validatorsCount"].Outputs, buf, &v)
+}
+
+type DefundApexBridgeContractsAdminFn struct {
+	ChainID       uint8    `abi:"_chainId"`
+	DefundAddress string   `abi:"_defundAddress"`
+	Amount        *big.Int `abi:"_amount"`
+}
+
+func (d *DefundApexBridgeContractsAdminFn) Sig() []byte {
+	return ApexBridgeContracts.Admin.Abi.Methods["defund"].ID()
+}
+
+func (d *DefundApexBridgeContractsAdminFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Admin.Abi.Methods["defund"].Encode(d)
+}
+
+func (d *DefundApexBridgeContractsAdminFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Admin.Abi.Methods["defund"], buf, d)
+}
+
+type FundAdminApexBridgeContractsAdminFn struct {
+}
+
+func (f *FundAdminApexBridgeContractsAdminFn) Sig() []byte {
+	return ApexBridgeContracts.Admin.Abi.Methods["fundAdmin"].ID()
+}
+
+func (f *FundAdminApexBridgeContractsAdminFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Admin.Abi.Methods["fundAdmin"].Encode(f)
+}
+
+func (f *FundAdminApexBridgeContractsAdminFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Admin.Abi.Methods["fundAdmin"], buf, f)
+}
+
+type FundAdminApexBridgeContractsAdminOutput struct {
+	Field0 types.Address `abi:"0"`
+}
+
+func (f *FundAdminApexBridgeContractsAdminOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Admin.Abi.Methods["fundAdmin"].Outputs, buf, &f)
+}
+
+type GetChainTokenQuantityApexBridgeContractsAdminFn struct {
+	ChainID uint8 `abi:"_chainId"`
+}
+
+func (g *GetChainTokenQuantityApexBridgeContractsAdminFn) Sig() []byte {
+	return ApexBridgeContracts.Admin.Abi.Methods["getChainTokenQuantity"].ID()
+}
+
+func (g *GetChainTokenQuantityApexBridgeContractsAdminFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Admin.Abi.Methods["getChainTokenQuantity"].Encode(g)
+}
+
+func (g *GetChainTokenQuantityApexBridgeContractsAdminFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Admin.Abi.Methods["getChainTokenQuantity"], buf, g)
+}
+
+type GetChainTokenQuantityApexBridgeContractsAdminOutput struct {
+	Field0 *big.Int `abi:"0"`
+}
+
+func (g *GetChainTokenQuantityApexBridgeContractsAdminOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Admin.Abi.Methods["getChainTokenQuantity"].Outputs, buf, &g)
+}
+
 type InitializeApexBridgeContractsAdminFn struct {
 	Owner        types.Address `abi:"_owner"`
 	UpgradeAdmin types.Address `abi:"_upgradeAdmin"`
@@ -233,6 +3343,67 @@ func (i *InitializeApexBridgeContractsAdminFn) DecodeAbi(buf []byte) error {
 	return decodeMethod(ApexBridgeContracts.Admin.Abi.Methods["initialize"], buf, i)
 }
 
+type OwnerApexBridgeContractsAdminFn struct {
+}
+
+func (o *OwnerApexBridgeContractsAdminFn) Sig() []byte {
+	return ApexBridgeContracts.Admin.Abi.Methods["owner"].ID()
+}
+
+func (o *OwnerApexBridgeContractsAdminFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Admin.Abi.Methods["owner"].Encode(o)
+}
+
+func (o *OwnerApexBridgeContractsAdminFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Admin.Abi.Methods["owner"], buf, o)
+}
+
+type OwnerApexBridgeContractsAdminOutput struct {
+	Field0 types.Address `abi:"0"`
+}
+
+func (o *OwnerApexBridgeContractsAdminOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Admin.Abi.Methods["owner"].Outputs, buf, &o)
+}
+
+type ProxiableUUIDApexBridgeContractsAdminFn struct {
+}
+
+func (p *ProxiableUUIDApexBridgeContractsAdminFn) Sig() []byte {
+	return ApexBridgeContracts.Admin.Abi.Methods["proxiableUUID"].ID()
+}
+
+func (p *ProxiableUUIDApexBridgeContractsAdminFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Admin.Abi.Methods["proxiableUUID"].Encode(p)
+}
+
+func (p *ProxiableUUIDApexBridgeContractsAdminFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Admin.Abi.Methods["proxiableUUID"], buf, p)
+}
+
+type ProxiableUUIDApexBridgeContractsAdminOutput struct {
+	Field0 types.Hash `abi:"0"`
+}
+
+func (p *ProxiableUUIDApexBridgeContractsAdminOutput) DecodeAbi(buf []byte) error {
+	return decodeStruct(ApexBridgeContracts.Admin.Abi.Methods["proxiableUUID"].Outputs, buf, &p)
+}
+
+type RenounceOwnershipApexBridgeContractsAdminFn struct {
+}
+
+func (r *RenounceOwnershipApexBridgeContractsAdminFn) Sig() []byte {
+	return ApexBridgeContracts.Admin.Abi.Methods["renounceOwnership"].ID()
+}
+
+func (r *RenounceOwnershipApexBridgeContractsAdminFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Admin.Abi.Methods["renounceOwnership"].Encode(r)
+}
+
+func (r *RenounceOwnershipApexBridgeContractsAdminFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Admin.Abi.Methods["renounceOwnership"], buf, r)
+}
+
 type SetDependenciesApexBridgeContractsAdminFn struct {
 	ClaimsAddress types.Address `abi:"_claimsAddress"`
 }
@@ -248,3 +3419,86 @@ func (s *SetDependenciesApexBridgeContractsAdminFn) EncodeAbi() ([]byte, error)
 func (s *SetDependenciesApexBridgeContractsAdminFn) DecodeAbi(buf []byte) error {
 	return decodeMethod(ApexBridgeContracts.Admin.Abi.Methods["setDependencies"], buf, s)
 }
+
+type SetFundAdminApexBridgeContractsAdminFn struct {
+	FundAdmin types.Address `abi:"_fundAdmin"`
+}
+
+func (s *SetFundAdminApexBridgeContractsAdminFn) Sig() []byte {
+	return ApexBridgeContracts.Admin.Abi.Methods["setFundAdmin"].ID()
+}
+
+func (s *SetFundAdminApexBridgeContractsAdminFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Admin.Abi.Methods["setFundAdmin"].Encode(s)
+}
+
+func (s *SetFundAdminApexBridgeContractsAdminFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Admin.Abi.Methods["setFundAdmin"], buf, s)
+}
+
+type TransferOwnershipApexBridgeContractsAdminFn struct {
+	NewOwner types.Address `abi:"newOwner"`
+}
+
+func (t *TransferOwnershipApexBridgeContractsAdminFn) Sig() []byte {
+	return ApexBridgeContracts.Admin.Abi.Methods["transferOwnership"].ID()
+}
+
+func (t *TransferOwnershipApexBridgeContractsAdminFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Admin.Abi.Methods["transferOwnership"].Encode(t)
+}
+
+func (t *TransferOwnershipApexBridgeContractsAdminFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Admin.Abi.Methods["transferOwnership"], buf, t)
+}
+
+type UpdateChainTokenQuantityApexBridgeContractsAdminFn struct {
+	ChainID    uint8    `abi:"_chainId"`
+	IsIncrease bool     `abi:"_isIncrease"`
+	Quantity   *big.Int `abi:"_quantity"`
+}
+
+func (u *UpdateChainTokenQuantityApexBridgeContractsAdminFn) Sig() []byte {
+	return ApexBridgeContracts.Admin.Abi.Methods["updateChainTokenQuantity"].ID()
+}
+
+func (u *UpdateChainTokenQuantityApexBridgeContractsAdminFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Admin.Abi.Methods["updateChainTokenQuantity"].Encode(u)
+}
+
+func (u *UpdateChainTokenQuantityApexBridgeContractsAdminFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Admin.Abi.Methods["updateChainTokenQuantity"], buf, u)
+}
+
+type UpgradeToApexBridgeContractsAdminFn struct {
+	NewImplementation types.Address `abi:"newImplementation"`
+}
+
+func (u *UpgradeToApexBridgeContractsAdminFn) Sig() []byte {
+	return ApexBridgeContracts.Admin.Abi.Methods["upgradeTo"].ID()
+}
+
+func (u *UpgradeToApexBridgeContractsAdminFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Admin.Abi.Methods["upgradeTo"].Encode(u)
+}
+
+func (u *UpgradeToApexBridgeContractsAdminFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Admin.Abi.Methods["upgradeTo"], buf, u)
+}
+
+type UpgradeToAndCallApexBridgeContractsAdminFn struct {
+	NewImplementation types.Address `abi:"newImplementation"`
+	Data              []byte        `abi:"data"`
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsAdminFn) Sig() []byte {
+	return ApexBridgeContracts.Admin.Abi.Methods["upgradeToAndCall"].ID()
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsAdminFn) EncodeAbi() ([]byte, error) {
+	return ApexBridgeContracts.Admin.Abi.Methods["upgradeToAndCall"].Encode(u)
+}
+
+func (u *UpgradeToAndCallApexBridgeContractsAdminFn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ApexBridgeContracts.Admin.Abi.Methods["upgradeToAndCall"], buf, u)
+}
@@ -7,6 +7,7 @@ import (
 	"go/format"
 	"log"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"text/template"
@@ -19,9 +20,15 @@ import (
 )
 
 const (
-	abiTypeNameFormat  = "var %sABIType = abi.MustNewType(\"%s\")"
-	eventNameFormat    = "%sEvent"
-	functionNameFormat = "%sFn"
+	abiTypeNameFormat        = "var %sABIType = abi.MustNewType(\"%s\")"
+	eventNameFormat          = "%sEvent"
+	errorNameFormat          = "%sError"
+	functionNameFormat       = "%sFn"
+	functionOutputNameFormat = "%sOutput"
+
+	// allAbiItems can be provided as the only function or event of a contract, in order to generate
+	// the bindings for all the functions (along with their outputs and the custom errors) or events of the contract
+	allAbiItems = "*"
 )
 
 var (
@@ -31,6 +38,9 @@ var (
 type generatedData struct {
 	resultString []string
 	structs      []string
+	// signatures of the generated events and errors, used to generate
+	// the events and errors shared by multiple contracts only once
+	signatures map[string]string
 }
 
 func main() {
@@ -109,7 +119,11 @@ func main() {
 				"initialize",
 				"withdrawTo",
 			},
-			[]string{},
+			[]string{
+				"L2TokenMapped",
+				"L2ERC20Deposit",
+				"L2ERC20Withdraw",
+			},
 		},
 		{
 			"ChildERC20PredicateACL",
@@ -168,6 +182,8 @@ func main() {
 			},
 			[]string{
 				"TokenMapped",
+				"ERC20Deposit",
+				"ERC20Withdraw",
 			},
 		},
 		{
@@ -192,6 +208,15 @@ func main() {
 			},
 			[]string{},
 		},
+		{
+			"ChildERC20",
+			gensc.ChildERC20,
+			false,
+			[]string{
+				"totalSupply",
+			},
+			[]string{},
+		},
 		{
 			"RootERC1155Predicate",
 			gensc.RootERC1155Predicate,
@@ -200,7 +225,12 @@ func main() {
 				"initialize",
 				"depositBatch",
 			},
-			[]string{},
+			[]string{
+				"ERC1155Deposit",
+				"ERC1155DepositBatch",
+				"ERC1155Withdraw",
+				"ERC1155WithdrawBatch",
+			},
 		},
 		{
 			"ChildMintableERC1155Predicate",
@@ -230,7 +260,12 @@ func main() {
 				"initialize",
 				"withdrawBatch",
 			},
-			[]string{},
+			[]string{
+				"L2ERC1155Deposit",
+				"L2ERC1155DepositBatch",
+				"L2ERC1155Withdraw",
+				"L2ERC1155WithdrawBatch",
+			},
 		},
 		{
 			"ChildERC1155PredicateACL",
@@ -280,7 +315,12 @@ func main() {
 				"initialize",
 				"depositBatch",
 			},
-			[]string{},
+			[]string{
+				"ERC721Deposit",
+				"ERC721DepositBatch",
+				"ERC721Withdraw",
+				"ERC721WithdrawBatch",
+			},
 		},
 		{
 			"ChildMintableERC721Predicate",
@@ -298,6 +338,7 @@ func main() {
 			[]string{
 				"setApprovalForAll",
 				"mint",
+				"balanceOf",
 			},
 			[]string{},
 		},
@@ -309,7 +350,12 @@ func main() {
 				"initialize",
 				"withdrawBatch",
 			},
-			[]string{},
+			[]string{
+				"L2ERC721Deposit",
+				"L2ERC721DepositBatch",
+				"L2ERC721Withdraw",
+				"L2ERC721WithdrawBatch",
+			},
 		},
 		{
 			"ChildERC721PredicateACL",
@@ -481,7 +527,9 @@ func main() {
 			[]string{
 				"initialize",
 			},
-			[]string{},
+			[]string{
+				"CallExecuted",
+			},
 		},
 	}
 
@@ -492,7 +540,7 @@ func main() {
 		functions           []string
 		events              []string
 	}) *generatedData {
-		generatedData := &generatedData{}
+		generatedData := &generatedData{signatures: map[string]string{}}
 
 		for _, c := range contractsMetadata {
 			if c.generateConstructor {
@@ -501,7 +549,23 @@ func main() {
 				}
 			}
 
-			for _, methodRaw := range c.functions {
+			functions, events := c.functions, c.events
+			allFunctions := isAllAbiItems(functions)
+
+			if allFunctions {
+				if len(c.artifact.Abi.Methods) != len(c.artifact.Abi.MethodsBySignature) {
+					log.Fatalf("Contract name %s: overloaded functions are not supported "+
+						"when generating all functions, specify them by signature instead", c.contractName)
+				}
+
+				functions = sortedKeys(c.artifact.Abi.Methods)
+			}
+
+			if isAllAbiItems(events) {
+				events = sortedKeys(c.artifact.Abi.Events)
+			}
+
+			for _, methodRaw := range functions {
 				// There could be two objects with the same name in the generated JSON ABI (hardhat bug).
 				// This case can be fixed by specifying a function signature instead of just name
 				// e.g. "myFunc(address,bool,uint256)" instead of just "myFunc"
@@ -521,14 +585,30 @@ func main() {
 					fmt.Println("Contract name", c.contractName, "Function name", methodRaw)
 					log.Fatal(err)
 				}
+
+				if allFunctions && len(method.Outputs.TupleElems()) > 0 {
+					if err := generateFunctionOutput(generatedData, c.contractName, method); err != nil {
+						fmt.Println("Contract name", c.contractName, "Function name", methodRaw)
+						log.Fatal(err)
+					}
+				}
 			}
 
-			for _, event := range c.events {
+			for _, event := range events {
 				if err := generateEvent(generatedData, c.contractName, c.artifact.Abi.Events[event]); err != nil {
 					fmt.Println("Contract name", c.contractName, "Event name", event)
 					log.Fatal(err)
 				}
 			}
+
+			if allFunctions {
+				for _, abiError := range sortedKeys(c.artifact.Abi.Errors) {
+					if err := generateError(generatedData, c.contractName, c.artifact.Abi.Errors[abiError]); err != nil {
+						fmt.Println("Contract name", c.contractName, "Error name", abiError)
+						log.Fatal(err)
+					}
+				}
+			}
 		}
 
 		return generatedData
@@ -571,71 +651,50 @@ import (
 			"ApexBridgeContracts.Bridge",
 			gensc.ApexBridgeContracts.Bridge,
 			false,
-			[]string{
-				"initialize",
-				"setDependencies",
-			},
-			[]string{},
+			[]string{allAbiItems},
+			[]string{allAbiItems},
 		},
 		{
 			"ApexBridgeContracts.ClaimsHelper",
 			gensc.ApexBridgeContracts.ClaimsHelper,
 			false,
-			[]string{
-				"initialize",
-				"setDependencies",
-			},
-			[]string{},
+			[]string{allAbiItems},
+			[]string{allAbiItems},
 		},
 		{
 			"ApexBridgeContracts.Claims",
 			gensc.ApexBridgeContracts.Claims,
 			false,
-			[]string{
-				"initialize",
-				"setDependencies",
-			},
-			[]string{},
+			[]string{allAbiItems},
+			[]string{allAbiItems},
 		},
 		{
 			"ApexBridgeContracts.SignedBatches",
 			gensc.ApexBridgeContracts.SignedBatches,
 			false,
-			[]string{
-				"initialize",
-				"setDependencies",
-			},
-			[]string{},
+			[]string{allAbiItems},
+			[]string{allAbiItems},
 		},
 		{
 			"ApexBridgeContracts.Slots",
 			gensc.ApexBridgeContracts.Slots,
 			false,
-			[]string{
-				"initialize",
-				"setDependencies",
-			},
-			[]string{},
+			[]string{allAbiItems},
+			[]string{allAbiItems},
 		},
 		{
 			"ApexBridgeContracts.Validators",
 			gensc.ApexBridgeContracts.Validators,
 			false,
-			[]string{
-				"initialize",
-				"setDependencies",
-			},
-			[]string{},
+			[]string{allAbiItems},
+			[]string{allAbiItems},
 		},
 		{
 			"ApexBridgeContracts.Admin",
 			gensc.ApexBridgeContracts.Admin,
 			false,
-			[]string{
-				"initialize",
-				"setDependencies",
-			},
-			[]string{},
+			[]string{allAbiItems},
+			[]string{allAbiItems},
 		},
 	}
 
@@ -645,8 +704,11 @@ import (
 package contractsapi
 
 import (
+	"math/big"
 
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/Ethernal-Tech/ethgo"
+	"github.com/Ethernal-Tech/ethgo/abi"
 )
 
 `
@@ -694,7 +756,7 @@ func generateType(generatedData *generatedData, name string, obj *abi.Type, res
 		"type " + internalType + " struct {",
 	}
 
-	for _, tupleElem := range obj.TupleElems() {
+	for i, tupleElem := range obj.TupleElems() {
 		elem := tupleElem.Elem
 
 		var typ string
@@ -702,7 +764,7 @@ func generateType(generatedData *generatedData, name string, obj *abi.Type, res
 		switch elem.Kind() {
 		case abi.KindTuple:
 			// Struct
-			nestedType, err := generateNestedType(generatedData, tupleElem.Name, elem, res)
+			nestedType, err := generateNestedType(generatedData, getInternalType(tupleElem.Name, elem), elem, res)
 			if err != nil {
 				return "", err
 			}
@@ -761,14 +823,23 @@ func generateType(generatedData *generatedData, name string, obj *abi.Type, res
 		typ = strings.ReplaceAll(typ, "[32]uint8", "types.Hash")
 		typ = strings.ReplaceAll(typ, "]uint8", "]byte")
 
+		// unnamed elements (e.g. function outputs or public mapping getter inputs) are referenced by their index
+		abiName := tupleElem.Name
+		if abiName == "" {
+			abiName = strconv.Itoa(i)
+		}
+
 		// Trim the leading _ from name if it exists
 		fieldName := strings.TrimPrefix(tupleElem.Name, "_")
+		if fieldName == "" {
+			fieldName = fmt.Sprintf("Field%d", i)
+		}
 
 		// Replacement of Id for ID to make the linter happy
 		fieldName = strings.Title(fieldName)
 		fieldName = strings.ReplaceAll(fieldName, "Id", "ID")
 
-		str = append(str, fmt.Sprintf("%s %s `abi:\"%s\"`", fieldName, typ, tupleElem.Name))
+		str = append(str, fmt.Sprintf("%s %s `abi:\"%s\"`", fieldName, typ, abiName))
 	}
 
 	str = append(str, "}")
@@ -828,6 +899,11 @@ func generateAbiFuncsForNestedType(name string) (string, error) {
 // generateEvent generates code for smart contract events
 func generateEvent(generatedData *generatedData, contractName string, event *abi.Event) error {
 	name := fmt.Sprintf(eventNameFormat, event.Name)
+
+	if generated, err := isGenerated(generatedData, name, event.Sig()); generated || err != nil {
+		return err
+	}
+
 	res := []string{}
 
 	_, err := generateType(generatedData, name, event.Inputs, &res)
@@ -986,6 +1062,133 @@ func generateFunction(generatedData *generatedData, contractName string,
 	return nil
 }
 
+// generateFunctionOutput generates code for smart contract function outputs
+func generateFunctionOutput(generatedData *generatedData, contractName string, method *abi.Method) error {
+	outputName := fmt.Sprintf(functionOutputNameFormat,
+		strings.Title(method.Name+strings.ReplaceAll(contractName, ".", "")))
+	res := []string{}
+
+	_, err := generateType(generatedData, outputName, method.Outputs, &res)
+	if err != nil {
+		return err
+	}
+
+	// write decode function
+	tmplString := `
+	{{range .Structs}}
+		{{.}}
+	{{ end }}
+
+	func ({{.Sig}} *{{.TName}}) DecodeAbi(buf []byte) error {
+		return decodeStruct({{.ContractName}}.Abi.Methods["{{.Name}}"].Outputs, buf, &{{.Sig}})
+	}`
+
+	inputs := map[string]interface{}{
+		"Structs":      res,
+		"Sig":          strings.ToLower(string(outputName[0])),
+		"Name":         method.Name,
+		"ContractName": contractName,
+		"TName":        strings.Title(outputName),
+	}
+
+	renderedString, err := renderTmpl(tmplString, inputs)
+	if err != nil {
+		return err
+	}
+
+	generatedData.resultString = append(generatedData.resultString, renderedString)
+
+	return nil
+}
+
+// generateError generates code for smart contract custom errors
+func generateError(generatedData *generatedData, contractName string, abiError *abi.Error) error {
+	name := fmt.Sprintf(errorNameFormat, strings.Title(abiError.Name))
+
+	if generated, err := isGenerated(generatedData, name, errorSignature(abiError)); generated || err != nil {
+		return err
+	}
+
+	res := []string{}
+
+	_, err := generateType(generatedData, name, abiError.Inputs, &res)
+	if err != nil {
+		return err
+	}
+
+	// write sig/decode functions
+	tmplStr := `
+{{range .Structs}}
+	{{.}}
+{{ end }}
+
+func (*{{.TName}}) Sig() []byte {
+	return errorID({{.ContractName}}.Abi.Errors["{{.Name}}"])
+}
+
+func ({{.Sig}} *{{.TName}}) DecodeAbi(buf []byte) error {
+	return decodeError({{.ContractName}}.Abi.Errors["{{.Name}}"], buf, {{.Sig}})
+}
+`
+
+	inputs := map[string]interface{}{
+		"Structs":      res,
+		"Sig":          strings.ToLower(string(name[0])),
+		"Name":         abiError.Name,
+		"TName":        name,
+		"ContractName": contractName,
+	}
+
+	renderedString, err := renderTmpl(tmplStr, inputs)
+	if err != nil {
+		return err
+	}
+
+	generatedData.resultString = append(generatedData.resultString, renderedString)
+
+	return nil
+}
+
+// isGenerated returns true if the event or error with the given type name is already generated
+// (e.g. it is shared by multiple contracts), and fails if the already generated one has a different signature
+func isGenerated(generatedData *generatedData, name, signature string) (bool, error) {
+	generatedSignature, ok := generatedData.signatures[name]
+	if !ok {
+		generatedData.signatures[name] = signature
+
+		return false, nil
+	}
+
+	if generatedSignature != signature {
+		return false, fmt.Errorf("%s is already generated with a different signature: %s, current: %s",
+			name, generatedSignature, signature)
+	}
+
+	return true, nil
+}
+
+// errorSignature returns the signature of the custom error, which is built the same way as the method signature
+func errorSignature(abiError *abi.Error) string {
+	return (&abi.Method{Name: abiError.Name, Inputs: abiError.Inputs}).Sig()
+}
+
+// isAllAbiItems returns true if all the functions or events of the contract should be generated
+func isAllAbiItems(items []string) bool {
+	return len(items) == 1 && items[0] == allAbiItems
+}
+
+// sortedKeys returns the sorted names of the abi items, so the generated code is deterministic
+func sortedKeys[T any](items map[string]T) []string {
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
 func renderTmpl(tmplStr string, inputs map[string]interface{}) (string, error) {
 	tmpl, err := template.New("name").Parse(tmplStr)
 	if err != nil {
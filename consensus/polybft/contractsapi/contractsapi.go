@@ -494,6 +494,87 @@ func (w *WithdrawToChildERC20PredicateFn) DecodeAbi(buf []byte) error {
 	return decodeMethod(ChildERC20Predicate.Abi.Methods["withdrawTo"], buf, w)
 }
 
+type L2TokenMappedEvent struct {
+	RootToken  types.Address `abi:"rootToken"`
+	ChildToken types.Address `abi:"childToken"`
+}
+
+func (*L2TokenMappedEvent) Sig() ethgo.Hash {
+	return ChildERC20Predicate.Abi.Events["L2TokenMapped"].ID()
+}
+
+func (l *L2TokenMappedEvent) Encode() ([]byte, error) {
+	return ChildERC20Predicate.Abi.Events["L2TokenMapped"].Inputs.Encode(l)
+}
+
+func (l *L2TokenMappedEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ChildERC20Predicate.Abi.Events["L2TokenMapped"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ChildERC20Predicate.Abi.Events["L2TokenMapped"], log, l)
+}
+
+func (l *L2TokenMappedEvent) Decode(input []byte) error {
+	return ChildERC20Predicate.Abi.Events["L2TokenMapped"].Inputs.DecodeStruct(input, &l)
+}
+
+type L2ERC20DepositEvent struct {
+	RootToken  types.Address `abi:"rootToken"`
+	ChildToken types.Address `abi:"childToken"`
+	Sender     types.Address `abi:"sender"`
+	Receiver   types.Address `abi:"receiver"`
+	Amount     *big.Int      `abi:"amount"`
+}
+
+func (*L2ERC20DepositEvent) Sig() ethgo.Hash {
+	return ChildERC20Predicate.Abi.Events["L2ERC20Deposit"].ID()
+}
+
+func (l *L2ERC20DepositEvent) Encode() ([]byte, error) {
+	return ChildERC20Predicate.Abi.Events["L2ERC20Deposit"].Inputs.Encode(l)
+}
+
+func (l *L2ERC20DepositEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ChildERC20Predicate.Abi.Events["L2ERC20Deposit"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ChildERC20Predicate.Abi.Events["L2ERC20Deposit"], log, l)
+}
+
+func (l *L2ERC20DepositEvent) Decode(input []byte) error {
+	return ChildERC20Predicate.Abi.Events["L2ERC20Deposit"].Inputs.DecodeStruct(input, &l)
+}
+
+type L2ERC20WithdrawEvent struct {
+	RootToken  types.Address `abi:"rootToken"`
+	ChildToken types.Address `abi:"childToken"`
+	Sender     types.Address `abi:"sender"`
+	Receiver   types.Address `abi:"receiver"`
+	Amount     *big.Int      `abi:"amount"`
+}
+
+func (*L2ERC20WithdrawEvent) Sig() ethgo.Hash {
+	return ChildERC20Predicate.Abi.Events["L2ERC20Withdraw"].ID()
+}
+
+func (l *L2ERC20WithdrawEvent) Encode() ([]byte, error) {
+	return ChildERC20Predicate.Abi.Events["L2ERC20Withdraw"].Inputs.Encode(l)
+}
+
+func (l *L2ERC20WithdrawEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ChildERC20Predicate.Abi.Events["L2ERC20Withdraw"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ChildERC20Predicate.Abi.Events["L2ERC20Withdraw"], log, l)
+}
+
+func (l *L2ERC20WithdrawEvent) Decode(input []byte) error {
+	return ChildERC20Predicate.Abi.Events["L2ERC20Withdraw"].Inputs.DecodeStruct(input, &l)
+}
+
 type InitializeChildERC20PredicateACLFn struct {
 	NewL2StateSender          types.Address `abi:"newL2StateSender"`
 	NewStateReceiver          types.Address `abi:"newStateReceiver"`
@@ -699,6 +780,62 @@ func (t *TokenMappedEvent) Decode(input []byte) error {
 	return RootERC20Predicate.Abi.Events["TokenMapped"].Inputs.DecodeStruct(input, &t)
 }
 
+type ERC20DepositEvent struct {
+	RootToken  types.Address `abi:"rootToken"`
+	ChildToken types.Address `abi:"childToken"`
+	Depositor  types.Address `abi:"depositor"`
+	Receiver   types.Address `abi:"receiver"`
+	Amount     *big.Int      `abi:"amount"`
+}
+
+func (*ERC20DepositEvent) Sig() ethgo.Hash {
+	return RootERC20Predicate.Abi.Events["ERC20Deposit"].ID()
+}
+
+func (e *ERC20DepositEvent) Encode() ([]byte, error) {
+	return RootERC20Predicate.Abi.Events["ERC20Deposit"].Inputs.Encode(e)
+}
+
+func (e *ERC20DepositEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !RootERC20Predicate.Abi.Events["ERC20Deposit"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(RootERC20Predicate.Abi.Events["ERC20Deposit"], log, e)
+}
+
+func (e *ERC20DepositEvent) Decode(input []byte) error {
+	return RootERC20Predicate.Abi.Events["ERC20Deposit"].Inputs.DecodeStruct(input, &e)
+}
+
+type ERC20WithdrawEvent struct {
+	RootToken  types.Address `abi:"rootToken"`
+	ChildToken types.Address `abi:"childToken"`
+	Withdrawer types.Address `abi:"withdrawer"`
+	Receiver   types.Address `abi:"receiver"`
+	Amount     *big.Int      `abi:"amount"`
+}
+
+func (*ERC20WithdrawEvent) Sig() ethgo.Hash {
+	return RootERC20Predicate.Abi.Events["ERC20Withdraw"].ID()
+}
+
+func (e *ERC20WithdrawEvent) Encode() ([]byte, error) {
+	return RootERC20Predicate.Abi.Events["ERC20Withdraw"].Inputs.Encode(e)
+}
+
+func (e *ERC20WithdrawEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !RootERC20Predicate.Abi.Events["ERC20Withdraw"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(RootERC20Predicate.Abi.Events["ERC20Withdraw"], log, e)
+}
+
+func (e *ERC20WithdrawEvent) Decode(input []byte) error {
+	return RootERC20Predicate.Abi.Events["ERC20Withdraw"].Inputs.DecodeStruct(input, &e)
+}
+
 type InitializeChildMintableERC20PredicateFn struct {
 	NewStateSender        types.Address `abi:"newStateSender"`
 	NewExitHelper         types.Address `abi:"newExitHelper"`
@@ -793,6 +930,21 @@ func (m *MintRootERC20Fn) DecodeAbi(buf []byte) error {
 	return decodeMethod(RootERC20.Abi.Methods["mint"], buf, m)
 }
 
+type TotalSupplyChildERC20Fn struct {
+}
+
+func (t *TotalSupplyChildERC20Fn) Sig() []byte {
+	return ChildERC20.Abi.Methods["totalSupply"].ID()
+}
+
+func (t *TotalSupplyChildERC20Fn) EncodeAbi() ([]byte, error) {
+	return ChildERC20.Abi.Methods["totalSupply"].Encode(t)
+}
+
+func (t *TotalSupplyChildERC20Fn) DecodeAbi(buf []byte) error {
+	return decodeMethod(ChildERC20.Abi.Methods["totalSupply"], buf, t)
+}
+
 type InitializeRootERC1155PredicateFn struct {
 	NewStateSender           types.Address `abi:"newStateSender"`
 	NewExitHelper            types.Address `abi:"newExitHelper"`
@@ -831,6 +983,122 @@ func (d *DepositBatchRootERC1155PredicateFn) DecodeAbi(buf []byte) error {
 	return decodeMethod(RootERC1155Predicate.Abi.Methods["depositBatch"], buf, d)
 }
 
+type ERC1155DepositEvent struct {
+	RootToken  types.Address `abi:"rootToken"`
+	ChildToken types.Address `abi:"childToken"`
+	Depositor  types.Address `abi:"depositor"`
+	Receiver   types.Address `abi:"receiver"`
+	TokenID    *big.Int      `abi:"tokenId"`
+	Amount     *big.Int      `abi:"amount"`
+}
+
+func (*ERC1155DepositEvent) Sig() ethgo.Hash {
+	return RootERC1155Predicate.Abi.Events["ERC1155Deposit"].ID()
+}
+
+func (e *ERC1155DepositEvent) Encode() ([]byte, error) {
+	return RootERC1155Predicate.Abi.Events["ERC1155Deposit"].Inputs.Encode(e)
+}
+
+func (e *ERC1155DepositEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !RootERC1155Predicate.Abi.Events["ERC1155Deposit"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(RootERC1155Predicate.Abi.Events["ERC1155Deposit"], log, e)
+}
+
+func (e *ERC1155DepositEvent) Decode(input []byte) error {
+	return RootERC1155Predicate.Abi.Events["ERC1155Deposit"].Inputs.DecodeStruct(input, &e)
+}
+
+type ERC1155DepositBatchEvent struct {
+	RootToken  types.Address   `abi:"rootToken"`
+	ChildToken types.Address   `abi:"childToken"`
+	Depositor  types.Address   `abi:"depositor"`
+	Receivers  []types.Address `abi:"receivers"`
+	TokenIDs   []*big.Int      `abi:"tokenIds"`
+	Amounts    []*big.Int      `abi:"amounts"`
+}
+
+func (*ERC1155DepositBatchEvent) Sig() ethgo.Hash {
+	return RootERC1155Predicate.Abi.Events["ERC1155DepositBatch"].ID()
+}
+
+func (e *ERC1155DepositBatchEvent) Encode() ([]byte, error) {
+	return RootERC1155Predicate.Abi.Events["ERC1155DepositBatch"].Inputs.Encode(e)
+}
+
+func (e *ERC1155DepositBatchEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !RootERC1155Predicate.Abi.Events["ERC1155DepositBatch"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(RootERC1155Predicate.Abi.Events["ERC1155DepositBatch"], log, e)
+}
+
+func (e *ERC1155DepositBatchEvent) Decode(input []byte) error {
+	return RootERC1155Predicate.Abi.Events["ERC1155DepositBatch"].Inputs.DecodeStruct(input, &e)
+}
+
+type ERC1155WithdrawEvent struct {
+	RootToken  types.Address `abi:"rootToken"`
+	ChildToken types.Address `abi:"childToken"`
+	Withdrawer types.Address `abi:"withdrawer"`
+	Receiver   types.Address `abi:"receiver"`
+	TokenID    *big.Int      `abi:"tokenId"`
+	Amount     *big.Int      `abi:"amount"`
+}
+
+func (*ERC1155WithdrawEvent) Sig() ethgo.Hash {
+	return RootERC1155Predicate.Abi.Events["ERC1155Withdraw"].ID()
+}
+
+func (e *ERC1155WithdrawEvent) Encode() ([]byte, error) {
+	return RootERC1155Predicate.Abi.Events["ERC1155Withdraw"].Inputs.Encode(e)
+}
+
+func (e *ERC1155WithdrawEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !RootERC1155Predicate.Abi.Events["ERC1155Withdraw"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(RootERC1155Predicate.Abi.Events["ERC1155Withdraw"], log, e)
+}
+
+func (e *ERC1155WithdrawEvent) Decode(input []byte) error {
+	return RootERC1155Predicate.Abi.Events["ERC1155Withdraw"].Inputs.DecodeStruct(input, &e)
+}
+
+type ERC1155WithdrawBatchEvent struct {
+	RootToken  types.Address   `abi:"rootToken"`
+	ChildToken types.Address   `abi:"childToken"`
+	Withdrawer types.Address   `abi:"withdrawer"`
+	Receivers  []types.Address `abi:"receivers"`
+	TokenIDs   []*big.Int      `abi:"tokenIds"`
+	Amounts    []*big.Int      `abi:"amounts"`
+}
+
+func (*ERC1155WithdrawBatchEvent) Sig() ethgo.Hash {
+	return RootERC1155Predicate.Abi.Events["ERC1155WithdrawBatch"].ID()
+}
+
+func (e *ERC1155WithdrawBatchEvent) Encode() ([]byte, error) {
+	return RootERC1155Predicate.Abi.Events["ERC1155WithdrawBatch"].Inputs.Encode(e)
+}
+
+func (e *ERC1155WithdrawBatchEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !RootERC1155Predicate.Abi.Events["ERC1155WithdrawBatch"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(RootERC1155Predicate.Abi.Events["ERC1155WithdrawBatch"], log, e)
+}
+
+func (e *ERC1155WithdrawBatchEvent) Decode(input []byte) error {
+	return RootERC1155Predicate.Abi.Events["ERC1155WithdrawBatch"].Inputs.DecodeStruct(input, &e)
+}
+
 type InitializeChildMintableERC1155PredicateFn struct {
 	NewStateSender          types.Address `abi:"newStateSender"`
 	NewExitHelper           types.Address `abi:"newExitHelper"`
@@ -941,6 +1209,122 @@ func (w *WithdrawBatchChildERC1155PredicateFn) DecodeAbi(buf []byte) error {
 	return decodeMethod(ChildERC1155Predicate.Abi.Methods["withdrawBatch"], buf, w)
 }
 
+type L2ERC1155DepositEvent struct {
+	RootToken  types.Address `abi:"rootToken"`
+	ChildToken types.Address `abi:"childToken"`
+	Sender     types.Address `abi:"sender"`
+	Receiver   types.Address `abi:"receiver"`
+	TokenID    *big.Int      `abi:"tokenId"`
+	Amount     *big.Int      `abi:"amount"`
+}
+
+func (*L2ERC1155DepositEvent) Sig() ethgo.Hash {
+	return ChildERC1155Predicate.Abi.Events["L2ERC1155Deposit"].ID()
+}
+
+func (l *L2ERC1155DepositEvent) Encode() ([]byte, error) {
+	return ChildERC1155Predicate.Abi.Events["L2ERC1155Deposit"].Inputs.Encode(l)
+}
+
+func (l *L2ERC1155DepositEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ChildERC1155Predicate.Abi.Events["L2ERC1155Deposit"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ChildERC1155Predicate.Abi.Events["L2ERC1155Deposit"], log, l)
+}
+
+func (l *L2ERC1155DepositEvent) Decode(input []byte) error {
+	return ChildERC1155Predicate.Abi.Events["L2ERC1155Deposit"].Inputs.DecodeStruct(input, &l)
+}
+
+type L2ERC1155DepositBatchEvent struct {
+	RootToken  types.Address   `abi:"rootToken"`
+	ChildToken types.Address   `abi:"childToken"`
+	Sender     types.Address   `abi:"sender"`
+	Receivers  []types.Address `abi:"receivers"`
+	TokenIDs   []*big.Int      `abi:"tokenIds"`
+	Amounts    []*big.Int      `abi:"amounts"`
+}
+
+func (*L2ERC1155DepositBatchEvent) Sig() ethgo.Hash {
+	return ChildERC1155Predicate.Abi.Events["L2ERC1155DepositBatch"].ID()
+}
+
+func (l *L2ERC1155DepositBatchEvent) Encode() ([]byte, error) {
+	return ChildERC1155Predicate.Abi.Events["L2ERC1155DepositBatch"].Inputs.Encode(l)
+}
+
+func (l *L2ERC1155DepositBatchEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ChildERC1155Predicate.Abi.Events["L2ERC1155DepositBatch"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ChildERC1155Predicate.Abi.Events["L2ERC1155DepositBatch"], log, l)
+}
+
+func (l *L2ERC1155DepositBatchEvent) Decode(input []byte) error {
+	return ChildERC1155Predicate.Abi.Events["L2ERC1155DepositBatch"].Inputs.DecodeStruct(input, &l)
+}
+
+type L2ERC1155WithdrawEvent struct {
+	RootToken  types.Address `abi:"rootToken"`
+	ChildToken types.Address `abi:"childToken"`
+	Sender     types.Address `abi:"sender"`
+	Receiver   types.Address `abi:"receiver"`
+	TokenID    *big.Int      `abi:"tokenId"`
+	Amount     *big.Int      `abi:"amount"`
+}
+
+func (*L2ERC1155WithdrawEvent) Sig() ethgo.Hash {
+	return ChildERC1155Predicate.Abi.Events["L2ERC1155Withdraw"].ID()
+}
+
+func (l *L2ERC1155WithdrawEvent) Encode() ([]byte, error) {
+	return ChildERC1155Predicate.Abi.Events["L2ERC1155Withdraw"].Inputs.Encode(l)
+}
+
+func (l *L2ERC1155WithdrawEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ChildERC1155Predicate.Abi.Events["L2ERC1155Withdraw"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ChildERC1155Predicate.Abi.Events["L2ERC1155Withdraw"], log, l)
+}
+
+func (l *L2ERC1155WithdrawEvent) Decode(input []byte) error {
+	return ChildERC1155Predicate.Abi.Events["L2ERC1155Withdraw"].Inputs.DecodeStruct(input, &l)
+}
+
+type L2ERC1155WithdrawBatchEvent struct {
+	RootToken  types.Address   `abi:"rootToken"`
+	ChildToken types.Address   `abi:"childToken"`
+	Sender     types.Address   `abi:"sender"`
+	Receivers  []types.Address `abi:"receivers"`
+	TokenIDs   []*big.Int      `abi:"tokenIds"`
+	Amounts    []*big.Int      `abi:"amounts"`
+}
+
+func (*L2ERC1155WithdrawBatchEvent) Sig() ethgo.Hash {
+	return ChildERC1155Predicate.Abi.Events["L2ERC1155WithdrawBatch"].ID()
+}
+
+func (l *L2ERC1155WithdrawBatchEvent) Encode() ([]byte, error) {
+	return ChildERC1155Predicate.Abi.Events["L2ERC1155WithdrawBatch"].Inputs.Encode(l)
+}
+
+func (l *L2ERC1155WithdrawBatchEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ChildERC1155Predicate.Abi.Events["L2ERC1155WithdrawBatch"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ChildERC1155Predicate.Abi.Events["L2ERC1155WithdrawBatch"], log, l)
+}
+
+func (l *L2ERC1155WithdrawBatchEvent) Decode(input []byte) error {
+	return ChildERC1155Predicate.Abi.Events["L2ERC1155WithdrawBatch"].Inputs.DecodeStruct(input, &l)
+}
+
 type InitializeChildERC1155PredicateACLFn struct {
 	NewL2StateSender        types.Address `abi:"newL2StateSender"`
 	NewStateReceiver        types.Address `abi:"newStateReceiver"`
@@ -1119,6 +1503,118 @@ func (d *DepositBatchRootERC721PredicateFn) DecodeAbi(buf []byte) error {
 	return decodeMethod(RootERC721Predicate.Abi.Methods["depositBatch"], buf, d)
 }
 
+type ERC721DepositEvent struct {
+	RootToken  types.Address `abi:"rootToken"`
+	ChildToken types.Address `abi:"childToken"`
+	Depositor  types.Address `abi:"depositor"`
+	Receiver   types.Address `abi:"receiver"`
+	TokenID    *big.Int      `abi:"tokenId"`
+}
+
+func (*ERC721DepositEvent) Sig() ethgo.Hash {
+	return RootERC721Predicate.Abi.Events["ERC721Deposit"].ID()
+}
+
+func (e *ERC721DepositEvent) Encode() ([]byte, error) {
+	return RootERC721Predicate.Abi.Events["ERC721Deposit"].Inputs.Encode(e)
+}
+
+func (e *ERC721DepositEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !RootERC721Predicate.Abi.Events["ERC721Deposit"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(RootERC721Predicate.Abi.Events["ERC721Deposit"], log, e)
+}
+
+func (e *ERC721DepositEvent) Decode(input []byte) error {
+	return RootERC721Predicate.Abi.Events["ERC721Deposit"].Inputs.DecodeStruct(input, &e)
+}
+
+type ERC721DepositBatchEvent struct {
+	RootToken  types.Address   `abi:"rootToken"`
+	ChildToken types.Address   `abi:"childToken"`
+	Depositor  types.Address   `abi:"depositor"`
+	Receivers  []types.Address `abi:"receivers"`
+	TokenIDs   []*big.Int      `abi:"tokenIds"`
+}
+
+func (*ERC721DepositBatchEvent) Sig() ethgo.Hash {
+	return RootERC721Predicate.Abi.Events["ERC721DepositBatch"].ID()
+}
+
+func (e *ERC721DepositBatchEvent) Encode() ([]byte, error) {
+	return RootERC721Predicate.Abi.Events["ERC721DepositBatch"].Inputs.Encode(e)
+}
+
+func (e *ERC721DepositBatchEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !RootERC721Predicate.Abi.Events["ERC721DepositBatch"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(RootERC721Predicate.Abi.Events["ERC721DepositBatch"], log, e)
+}
+
+func (e *ERC721DepositBatchEvent) Decode(input []byte) error {
+	return RootERC721Predicate.Abi.Events["ERC721DepositBatch"].Inputs.DecodeStruct(input, &e)
+}
+
+type ERC721WithdrawEvent struct {
+	RootToken  types.Address `abi:"rootToken"`
+	ChildToken types.Address `abi:"childToken"`
+	Withdrawer types.Address `abi:"withdrawer"`
+	Receiver   types.Address `abi:"receiver"`
+	TokenID    *big.Int      `abi:"tokenId"`
+}
+
+func (*ERC721WithdrawEvent) Sig() ethgo.Hash {
+	return RootERC721Predicate.Abi.Events["ERC721Withdraw"].ID()
+}
+
+func (e *ERC721WithdrawEvent) Encode() ([]byte, error) {
+	return RootERC721Predicate.Abi.Events["ERC721Withdraw"].Inputs.Encode(e)
+}
+
+func (e *ERC721WithdrawEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !RootERC721Predicate.Abi.Events["ERC721Withdraw"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(RootERC721Predicate.Abi.Events["ERC721Withdraw"], log, e)
+}
+
+func (e *ERC721WithdrawEvent) Decode(input []byte) error {
+	return RootERC721Predicate.Abi.Events["ERC721Withdraw"].Inputs.DecodeStruct(input, &e)
+}
+
+type ERC721WithdrawBatchEvent struct {
+	RootToken  types.Address   `abi:"rootToken"`
+	ChildToken types.Address   `abi:"childToken"`
+	Withdrawer types.Address   `abi:"withdrawer"`
+	Receivers  []types.Address `abi:"receivers"`
+	TokenIDs   []*big.Int      `abi:"tokenIds"`
+}
+
+func (*ERC721WithdrawBatchEvent) Sig() ethgo.Hash {
+	return RootERC721Predicate.Abi.Events["ERC721WithdrawBatch"].ID()
+}
+
+func (e *ERC721WithdrawBatchEvent) Encode() ([]byte, error) {
+	return RootERC721Predicate.Abi.Events["ERC721WithdrawBatch"].Inputs.Encode(e)
+}
+
+func (e *ERC721WithdrawBatchEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !RootERC721Predicate.Abi.Events["ERC721WithdrawBatch"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(RootERC721Predicate.Abi.Events["ERC721WithdrawBatch"], log, e)
+}
+
+func (e *ERC721WithdrawBatchEvent) Decode(input []byte) error {
+	return RootERC721Predicate.Abi.Events["ERC721WithdrawBatch"].Inputs.DecodeStruct(input, &e)
+}
+
 type InitializeChildMintableERC721PredicateFn struct {
 	NewStateSender         types.Address `abi:"newStateSender"`
 	NewExitHelper          types.Address `abi:"newExitHelper"`
@@ -1171,6 +1667,22 @@ func (m *MintRootERC721Fn) DecodeAbi(buf []byte) error {
 	return decodeMethod(RootERC721.Abi.Methods["mint"], buf, m)
 }
 
+type BalanceOfRootERC721Fn struct {
+	Owner types.Address `abi:"owner"`
+}
+
+func (b *BalanceOfRootERC721Fn) Sig() []byte {
+	return RootERC721.Abi.Methods["balanceOf"].ID()
+}
+
+func (b *BalanceOfRootERC721Fn) EncodeAbi() ([]byte, error) {
+	return RootERC721.Abi.Methods["balanceOf"].Encode(b)
+}
+
+func (b *BalanceOfRootERC721Fn) DecodeAbi(buf []byte) error {
+	return decodeMethod(RootERC721.Abi.Methods["balanceOf"], buf, b)
+}
+
 type InitializeChildERC721PredicateFn struct {
 	NewL2StateSender       types.Address `abi:"newL2StateSender"`
 	NewStateReceiver       types.Address `abi:"newStateReceiver"`
@@ -1208,6 +1720,118 @@ func (w *WithdrawBatchChildERC721PredicateFn) DecodeAbi(buf []byte) error {
 	return decodeMethod(ChildERC721Predicate.Abi.Methods["withdrawBatch"], buf, w)
 }
 
+type L2ERC721DepositEvent struct {
+	RootToken  types.Address `abi:"rootToken"`
+	ChildToken types.Address `abi:"childToken"`
+	Sender     types.Address `abi:"sender"`
+	Receiver   types.Address `abi:"receiver"`
+	TokenID    *big.Int      `abi:"tokenId"`
+}
+
+func (*L2ERC721DepositEvent) Sig() ethgo.Hash {
+	return ChildERC721Predicate.Abi.Events["L2ERC721Deposit"].ID()
+}
+
+func (l *L2ERC721DepositEvent) Encode() ([]byte, error) {
+	return ChildERC721Predicate.Abi.Events["L2ERC721Deposit"].Inputs.Encode(l)
+}
+
+func (l *L2ERC721DepositEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ChildERC721Predicate.Abi.Events["L2ERC721Deposit"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ChildERC721Predicate.Abi.Events["L2ERC721Deposit"], log, l)
+}
+
+func (l *L2ERC721DepositEvent) Decode(input []byte) error {
+	return ChildERC721Predicate.Abi.Events["L2ERC721Deposit"].Inputs.DecodeStruct(input, &l)
+}
+
+type L2ERC721DepositBatchEvent struct {
+	RootToken  types.Address   `abi:"rootToken"`
+	ChildToken types.Address   `abi:"childToken"`
+	Sender     types.Address   `abi:"sender"`
+	Receivers  []types.Address `abi:"receivers"`
+	TokenIDs   []*big.Int      `abi:"tokenIds"`
+}
+
+func (*L2ERC721DepositBatchEvent) Sig() ethgo.Hash {
+	return ChildERC721Predicate.Abi.Events["L2ERC721DepositBatch"].ID()
+}
+
+func (l *L2ERC721DepositBatchEvent) Encode() ([]byte, error) {
+	return ChildERC721Predicate.Abi.Events["L2ERC721DepositBatch"].Inputs.Encode(l)
+}
+
+func (l *L2ERC721DepositBatchEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ChildERC721Predicate.Abi.Events["L2ERC721DepositBatch"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ChildERC721Predicate.Abi.Events["L2ERC721DepositBatch"], log, l)
+}
+
+func (l *L2ERC721DepositBatchEvent) Decode(input []byte) error {
+	return ChildERC721Predicate.Abi.Events["L2ERC721DepositBatch"].Inputs.DecodeStruct(input, &l)
+}
+
+type L2ERC721WithdrawEvent struct {
+	RootToken  types.Address `abi:"rootToken"`
+	ChildToken types.Address `abi:"childToken"`
+	Sender     types.Address `abi:"sender"`
+	Receiver   types.Address `abi:"receiver"`
+	TokenID    *big.Int      `abi:"tokenId"`
+}
+
+func (*L2ERC721WithdrawEvent) Sig() ethgo.Hash {
+	return ChildERC721Predicate.Abi.Events["L2ERC721Withdraw"].ID()
+}
+
+func (l *L2ERC721WithdrawEvent) Encode() ([]byte, error) {
+	return ChildERC721Predicate.Abi.Events["L2ERC721Withdraw"].Inputs.Encode(l)
+}
+
+func (l *L2ERC721WithdrawEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ChildERC721Predicate.Abi.Events["L2ERC721Withdraw"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ChildERC721Predicate.Abi.Events["L2ERC721Withdraw"], log, l)
+}
+
+func (l *L2ERC721WithdrawEvent) Decode(input []byte) error {
+	return ChildERC721Predicate.Abi.Events["L2ERC721Withdraw"].Inputs.DecodeStruct(input, &l)
+}
+
+type L2ERC721WithdrawBatchEvent struct {
+	RootToken  types.Address   `abi:"rootToken"`
+	ChildToken types.Address   `abi:"childToken"`
+	Sender     types.Address   `abi:"sender"`
+	Receivers  []types.Address `abi:"receivers"`
+	TokenIDs   []*big.Int      `abi:"tokenIds"`
+}
+
+func (*L2ERC721WithdrawBatchEvent) Sig() ethgo.Hash {
+	return ChildERC721Predicate.Abi.Events["L2ERC721WithdrawBatch"].ID()
+}
+
+func (l *L2ERC721WithdrawBatchEvent) Encode() ([]byte, error) {
+	return ChildERC721Predicate.Abi.Events["L2ERC721WithdrawBatch"].Inputs.Encode(l)
+}
+
+func (l *L2ERC721WithdrawBatchEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ChildERC721Predicate.Abi.Events["L2ERC721WithdrawBatch"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ChildERC721Predicate.Abi.Events["L2ERC721WithdrawBatch"], log, l)
+}
+
+func (l *L2ERC721WithdrawBatchEvent) Decode(input []byte) error {
+	return ChildERC721Predicate.Abi.Events["L2ERC721WithdrawBatch"].Inputs.DecodeStruct(input, &l)
+}
+
 type InitializeChildERC721PredicateACLFn struct {
 	NewL2StateSender       types.Address `abi:"newL2StateSender"`
 	NewStateReceiver       types.Address `abi:"newStateReceiver"`
@@ -2475,3 +3099,31 @@ func (i *InitializeChildTimelockFn) EncodeAbi() ([]byte, error) {
 func (i *InitializeChildTimelockFn) DecodeAbi(buf []byte) error {
 	return decodeMethod(ChildTimelock.Abi.Methods["initialize"], buf, i)
 }
+
+type CallExecutedEvent struct {
+	ID     types.Hash    `abi:"id"`
+	Index  *big.Int      `abi:"index"`
+	Target types.Address `abi:"target"`
+	Value  *big.Int      `abi:"value"`
+	Data   []byte        `abi:"data"`
+}
+
+func (*CallExecutedEvent) Sig() ethgo.Hash {
+	return ChildTimelock.Abi.Events["CallExecuted"].ID()
+}
+
+func (c *CallExecutedEvent) Encode() ([]byte, error) {
+	return ChildTimelock.Abi.Events["CallExecuted"].Inputs.Encode(c)
+}
+
+func (c *CallExecutedEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !ChildTimelock.Abi.Events["CallExecuted"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(ChildTimelock.Abi.Events["CallExecuted"], log, c)
+}
+
+func (c *CallExecutedEvent) Decode(input []byte) error {
+	return ChildTimelock.Abi.Events["CallExecuted"].Inputs.DecodeStruct(input, &c)
+}
@@ -41,6 +41,11 @@ func TestEncoding_Method(t *testing.T) {
 			},
 			EpochSize: big.NewInt(10),
 		},
+		// apex function generated from the whole contract abi
+		&GetBatchTransactionsApexBridgeContractsClaimsFn{
+			ChainID: 1,
+			BatchID: 2,
+		},
 	}
 
 	for _, c := range cases {
@@ -129,3 +134,82 @@ func TestEncodingAndParsingEvent(t *testing.T) {
 	require.Error(t, err)
 	require.True(t, doesMatch)
 }
+
+func TestEncoding_ApexFunctionOutput(t *testing.T) {
+	t.Parallel()
+
+	output := &GetConfirmedTransactionsApexBridgeContractsBridgeOutput{
+		ConfirmedTransactions: []*ConfirmedTransaction{
+			{
+				BlockHeight:             big.NewInt(10),
+				TotalAmount:             big.NewInt(100),
+				RetryCounter:            big.NewInt(1),
+				Nonce:                   5,
+				SourceChainID:           2,
+				ObservedTransactionHash: types.StringToHash("0x1"),
+				TransactionType:         1,
+				Receivers: []*Receiver{
+					{Amount: big.NewInt(100), DestinationAddress: "addr_test1"},
+				},
+			},
+		},
+	}
+
+	encoded, err := ApexBridgeContracts.Bridge.Abi.Methods["getConfirmedTransactions"].Outputs.Encode(output)
+	require.NoError(t, err)
+
+	var decoded GetConfirmedTransactionsApexBridgeContractsBridgeOutput
+
+	require.NoError(t, decoded.DecodeAbi(encoded))
+	require.Equal(t, output, &decoded)
+}
+
+func TestDecoding_ApexCustomError(t *testing.T) {
+	t.Parallel()
+
+	apexErr := &ChainIsNotRegisteredError{ChainID: 3}
+
+	encoded, err := ApexBridgeContracts.Claims.Abi.Errors["ChainIsNotRegistered"].Inputs.Encode(apexErr)
+	require.NoError(t, err)
+
+	revertData := append(apexErr.Sig(), encoded...)
+
+	var decoded ChainIsNotRegisteredError
+
+	require.NoError(t, decoded.DecodeAbi(revertData))
+	require.Equal(t, apexErr, &decoded)
+
+	// revert data of another error
+	require.Error(t, new(ChainAlreadyRegisteredError).DecodeAbi(revertData))
+
+	name, args, ok := DecodeCustomError(ApexBridgeContracts.Claims.Abi, revertData)
+	require.True(t, ok)
+	require.Equal(t, "ChainIsNotRegistered", name)
+	require.Equal(t, map[string]interface{}{"_chainId": uint8(3)}, args)
+
+	_, _, ok = DecodeCustomError(ApexBridgeContracts.Claims.Abi, []byte{0x1, 0x2, 0x3, 0x4})
+	require.False(t, ok)
+}
+
+func TestEncodingAndParsingApexEvent(t *testing.T) {
+	t.Parallel()
+
+	event := &ChainDefundedEvent{ChainID: 1, Amount: big.NewInt(1000)}
+
+	// event shared by the apex contracts is generated once
+	require.Equal(t, ApexBridgeContracts.Claims.Abi.Events["ChainDefunded"].ID(), event.Sig())
+
+	data, err := event.Encode()
+	require.NoError(t, err)
+
+	var decoded ChainDefundedEvent
+
+	doesMatch, err := decoded.ParseLog(&ethgo.Log{
+		Address: ethgo.Address(contracts.Claims),
+		Topics:  []ethgo.Hash{event.Sig()},
+		Data:    data,
+	})
+	require.NoError(t, err)
+	require.True(t, doesMatch)
+	require.Equal(t, event, &decoded)
+}
@@ -53,6 +53,56 @@ func decodeStruct(t *abi.Type, input []byte, out interface{}) error {
 	return decodeImpl(val, out)
 }
 
+func decodeError(abiError *abi.Error, input []byte, out interface{}) error {
+	if len(input) < abiMethodIDLength {
+		return fmt.Errorf("invalid error data, len = %d", len(input))
+	}
+
+	if !bytes.HasPrefix(input, errorID(abiError)) {
+		return fmt.Errorf("prefix is not correct")
+	}
+
+	val, err := abi.Decode(abiError.Inputs, input[abiMethodIDLength:])
+	if err != nil {
+		return err
+	}
+
+	return decodeImpl(val, out)
+}
+
+// errorID returns the selector of the custom error, which is calculated the same way as the method id
+func errorID(abiError *abi.Error) []byte {
+	return (&abi.Method{Name: abiError.Name, Inputs: abiError.Inputs}).ID()
+}
+
+// DecodeCustomError decodes the revert data of the contract with the given abi to the name
+// and the arguments of the custom error. It returns false if the revert data is not a custom error of the contract
+func DecodeCustomError(contractAbi *abi.ABI, revertData []byte) (string, map[string]interface{}, bool) {
+	if len(revertData) < abiMethodIDLength {
+		return "", nil, false
+	}
+
+	for name, abiError := range contractAbi.Errors {
+		if !bytes.HasPrefix(revertData, errorID(abiError)) {
+			continue
+		}
+
+		val, err := abi.Decode(abiError.Inputs, revertData[abiMethodIDLength:])
+		if err != nil {
+			return "", nil, false
+		}
+
+		args, ok := val.(map[string]interface{})
+		if !ok {
+			return "", nil, false
+		}
+
+		return name, args, true
+	}
+
+	return "", nil, false
+}
+
 func decodeImpl(input interface{}, out interface{}) error {
 	metadata := &mapstructure.Metadata{}
 	dc := &mapstructure.DecoderConfig{
@@ -13,6 +13,18 @@ type StateTransactionInput interface {
 	DecodeAbi(b []byte) error
 }
 
+// ABIEncoder is an abstraction for the generated function inputs, which are abi encoded when calling the function
+type ABIEncoder interface {
+	// EncodeAbi contains logic for encoding arbitrary data into ABI format
+	EncodeAbi() ([]byte, error)
+}
+
+// ABIDecoder is an abstraction for the generated function outputs and custom errors, which are decoded from ABI
+type ABIDecoder interface {
+	// DecodeAbi contains logic for decoding given ABI data
+	DecodeAbi(b []byte) error
+}
+
 // EventAbi is an interface representing an event generated in contractsapi
 type EventAbi interface {
 	// Sig returns the event ABI signature or ID (which is unique for all event types)
@@ -12,6 +12,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/Ethernal-Tech/ethgo"
 	"github.com/hashicorp/go-hclog"
+	bolt "go.etcd.io/bbolt"
 )
 
 var errUnknownExitEvent = errors.New("unknown event from exit helper or checkpoint manager")
@@ -20,18 +21,20 @@ var errUnknownExitEvent = errors.New("unknown event from exit helper or checkpoi
 type ExitRelayer interface {
 	Close()
 	Init() error
-	AddLog(eventLog *ethgo.Log) error
+	AddLog(eventLog *ethgo.Log, dbTx *bolt.Tx) error
 	PostBlock(req *PostBlockRequest) error
+	Rollback(processedExitIDs []uint64) error
 }
 
 var _ ExitRelayer = (*dummyExitRelayer)(nil)
 
 type dummyExitRelayer struct{}
 
-func (d *dummyExitRelayer) Close()                                {}
-func (d *dummyExitRelayer) Init() error                           { return nil }
-func (d *dummyExitRelayer) AddLog(eventLog *ethgo.Log) error      { return nil }
-func (d *dummyExitRelayer) PostBlock(req *PostBlockRequest) error { return nil }
+func (d *dummyExitRelayer) Close()                                          {}
+func (d *dummyExitRelayer) Init() error                                     { return nil }
+func (d *dummyExitRelayer) AddLog(eventLog *ethgo.Log, dbTx *bolt.Tx) error { return nil }
+func (d *dummyExitRelayer) PostBlock(req *PostBlockRequest) error           { return nil }
+func (d *dummyExitRelayer) Rollback(processedExitIDs []uint64) error        { return nil }
 
 // ExitEventProofRetriever is an interface that exposes function for retrieving exit proof
 type ExitEventProofRetriever interface {
@@ -104,6 +107,7 @@ func (e *exitRelayer) Init() error {
 // Close closes the running go routine
 func (e *exitRelayer) Close() {
 	close(e.closeCh)
+	e.txRelayer.Close()
 }
 
 // PostBlock is a function called on finalization of each block (through consensus or syncer)
@@ -118,7 +122,7 @@ func (e *exitRelayer) PostBlock(req *PostBlockRequest) error {
 
 // AddLog handles the received log from event tracker if it matches
 // a checkpoint submitted event ABI, or exit processed event ABI
-func (e *exitRelayer) AddLog(eventLog *ethgo.Log) error {
+func (e *exitRelayer) AddLog(eventLog *ethgo.Log, dbTx *bolt.Tx) error {
 	var (
 		checkpointSubmittedEvent contractsapi.CheckpointSubmittedEvent
 		exitProcessedEvent       contractsapi.ExitProcessedEvent
@@ -143,6 +147,7 @@ func (e *exitRelayer) AddLog(eventLog *ethgo.Log) error {
 		exitEvents, err := e.exitStore.getExitEventsForProof(
 			checkpointSubmittedEvent.Epoch.Uint64(),
 			checkpointSubmittedEvent.BlockNumber.Uint64(),
+			dbTx,
 		)
 		if err != nil {
 			e.logger.Error("could not get exit events for checkpoint",
@@ -167,7 +172,7 @@ func (e *exitRelayer) AddLog(eventLog *ethgo.Log) error {
 
 		e.logger.Debug("There are exit events that happened in given given checkpoint", "exitEvents", len(newEvents))
 
-		return e.state.UpdateRelayerEvents(newEvents, nil, nil)
+		return e.state.UpdateRelayerEvents(newEvents, nil, dbTx)
 	case exitProcessedEventSig:
 		_, err := exitProcessedEvent.ParseLog(eventLog)
 		if err != nil {
@@ -187,18 +192,36 @@ func (e *exitRelayer) AddLog(eventLog *ethgo.Log) error {
 		if exitProcessedEvent.Success {
 			e.logger.Debug("exit processed event has been handled", "eventID", eventID)
 
-			return e.state.UpdateRelayerEvents(nil, []uint64{eventID}, nil)
+			return e.state.UpdateRelayerEvents(nil, []uint64{eventID}, dbTx)
 		}
 
 		e.logger.Debug("exit event was not successfully executed",
 			"eventID", eventID, "reason", string(exitProcessedEvent.ReturnData))
 
-		return nil
+		return e.state.SetRelayerEventError(eventID,
+			"execution failed: 0x"+hex.EncodeToString(exitProcessedEvent.ReturnData), dbTx)
 	default:
 		return errUnknownExitEvent
 	}
 }
 
+// Rollback queues the exit events, whose processing was reported in the reorged rootchain blocks,
+// so that they are executed again, unless their processing is reported again by the new rootchain blocks
+func (e *exitRelayer) Rollback(processedExitIDs []uint64) error {
+	if len(processedExitIDs) == 0 {
+		return nil
+	}
+
+	e.logger.Warn("Queueing exit events processed in the reorged rootchain blocks", "exitEventIDs", processedExitIDs)
+
+	events := make([]*RelayerEventMetaData, len(processedExitIDs))
+	for i, id := range processedExitIDs {
+		events[i] = &RelayerEventMetaData{EventID: id}
+	}
+
+	return e.state.UpdateRelayerEvents(events, nil, nil)
+}
+
 // sendTx sends unexecuted exit events in a batch to ExitHelper contract
 func (e *exitRelayer) sendTx(events []*RelayerEventMetaData) error {
 	e.logger.Debug("sending exit events in batch to be executed on ExitHelper", "exitEvents", len(events))
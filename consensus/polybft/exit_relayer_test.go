@@ -108,19 +108,21 @@ func TestExitRelayer_FullWorkflow(t *testing.T) {
 	// fail 3rd time
 	dummyTxRelayer.On("SendTransaction", mock.Anything, testKey).Return(
 		(*ethgo.Receipt)(nil), errors.New("e")).Once()
+	// failed batch is bisected, so both events are sent separately
+	dummyTxRelayer.On("SendTransaction", mock.Anything, testKey).Return((*ethgo.Receipt)(nil), nil).Twice()
 	// send 2 events all at once at the end
 	dummyTxRelayer.On("SendTransaction", mock.Anything, testKey).Return((*ethgo.Receipt)(nil), nil).Once()
 
 	require.NoError(t, exitRelayer.Init())
 
 	// post 1st block
-	require.NoError(t, exitRelayer.AddLog(convertLog(checkpointSubmittedLogs[0])))
-	require.NoError(t, exitRelayer.AddLog(convertLog(checkpointSubmittedLogs[1])))
+	require.NoError(t, exitRelayer.AddLog(convertLog(checkpointSubmittedLogs[0]), nil))
+	require.NoError(t, exitRelayer.AddLog(convertLog(checkpointSubmittedLogs[1]), nil))
 	require.NoError(t, exitRelayer.PostBlock(&PostBlockRequest{}))
 
 	time.Sleep(time.Second * 2) // wait for some time
 
-	events, err := state.ExitStore.GetAllAvailableRelayerEvents(0)
+	events, err := state.ExitStore.GetAllAvailableRelayerEvents(0, nil)
 
 	require.NoError(t, err)
 	require.Len(t, events, 4)
@@ -133,13 +135,13 @@ func TestExitRelayer_FullWorkflow(t *testing.T) {
 
 	// post 2nd block
 	// send exit processed events for the two executed events
-	require.NoError(t, exitRelayer.AddLog(convertLog(resultLogs[0])))
-	require.NoError(t, exitRelayer.AddLog(convertLog(resultLogs[1])))
+	require.NoError(t, exitRelayer.AddLog(convertLog(resultLogs[0]), nil))
+	require.NoError(t, exitRelayer.AddLog(convertLog(resultLogs[1]), nil))
 	require.NoError(t, exitRelayer.PostBlock(&PostBlockRequest{}))
 
 	time.Sleep(time.Second * 2) // wait for some time
 
-	events, err = state.ExitStore.GetAllAvailableRelayerEvents(0)
+	events, err = state.ExitStore.GetAllAvailableRelayerEvents(0, nil)
 
 	require.NoError(t, err)
 	// should only have two since first two were successfully executed
@@ -155,7 +157,7 @@ func TestExitRelayer_FullWorkflow(t *testing.T) {
 
 	time.Sleep(time.Second * 2) // wait for some time
 
-	events, err = state.ExitStore.GetAllAvailableRelayerEvents(0)
+	events, err = state.ExitStore.GetAllAvailableRelayerEvents(0, nil)
 
 	require.NoError(t, err)
 	// should only have two since first two were successfully executed
@@ -166,12 +168,12 @@ func TestExitRelayer_FullWorkflow(t *testing.T) {
 	require.Equal(t, uint64(4), events[1].EventID)
 
 	// send exit processed events for the two executed events
-	require.NoError(t, exitRelayer.AddLog(convertLog(resultLogs[2])))
-	require.NoError(t, exitRelayer.AddLog(convertLog(resultLogs[3])))
+	require.NoError(t, exitRelayer.AddLog(convertLog(resultLogs[2]), nil))
+	require.NoError(t, exitRelayer.AddLog(convertLog(resultLogs[3]), nil))
 
 	time.Sleep(time.Second * 2) // wait for some time
 
-	events, err = state.ExitStore.GetAllAvailableRelayerEvents(0)
+	events, err = state.ExitStore.GetAllAvailableRelayerEvents(0, nil)
 
 	require.NoError(t, err)
 	// should have no events since all of them were executed successfully
@@ -233,3 +235,27 @@ func createTestLogForCheckpointSubmittedEvent(
 		Data:    exitRootHash[:],
 	}
 }
+
+func TestExitRelayer_Rollback(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+	exitRelayer := newExitRelayer(nil, createTestKey(t), nil, &blockchainMock{}, state.ExitStore,
+		&relayerConfig{}, hclog.NewNullLogger())
+
+	require.NoError(t, state.ExitStore.UpdateRelayerEvents([]*RelayerEventMetaData{{EventID: 1}, {EventID: 2}}, nil, nil))
+	require.NoError(t, exitRelayer.AddLog(convertLog(createTestLogForExitProcessedEvent(t, 1, types.ZeroAddress)), nil))
+	require.NoError(t, exitRelayer.AddLog(convertLog(createTestLogForExitProcessedEvent(t, 2, types.ZeroAddress)), nil))
+
+	events, err := state.ExitStore.GetAllAvailableRelayerEvents(0, nil)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	// exit processed in the reorged rootchain block is executed again
+	require.NoError(t, exitRelayer.Rollback([]uint64{2}))
+	require.NoError(t, exitRelayer.Rollback(nil))
+
+	events, err = state.ExitStore.GetAllAvailableRelayerEvents(0, nil)
+	require.NoError(t, err)
+	require.Equal(t, []*RelayerEventMetaData{{EventID: 2}}, events)
+}
@@ -44,6 +44,8 @@ var (
 		"is not expected at this block")
 	errDistributeRewardsTxSingleExpected = errors.New("only one distribute rewards transaction is " +
 		"allowed in the given block")
+	errAddressListRoleTxsMismatch = errors.New("address list role transactions do not match " +
+		"the roles changed by the governance")
 	errProposalDontMatch = errors.New("failed to insert proposal, because the validated proposal " +
 		"is either nil or it does not match the received one")
 	errValidatorSetDeltaMismatch           = errors.New("validator set delta mismatch")
@@ -88,6 +90,10 @@ type fsm struct {
 	// It is populated only for epoch-ending blocks.
 	distributeRewardsInput *contractsapi.DistributeRewardForEpochManagerFn
 
+	// addressListRoles holds the address list roles changed by the governance.
+	// It is populated only for the first block of the epoch.
+	addressListRoles []*addressListRole
+
 	// isEndOfEpoch indicates if epoch reached its end
 	isEndOfEpoch bool
 
@@ -156,6 +162,17 @@ func (f *fsm) BuildProposal(currentRound uint64) ([]byte, error) {
 		}
 	}
 
+	addressListRoleTxs, err := f.createAddressListRoleTxs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range addressListRoleTxs {
+		if err := f.blockBuilder.WriteTx(tx); err != nil {
+			return nil, fmt.Errorf("failed to apply address list role transaction: %w", err)
+		}
+	}
+
 	if f.config.IsBridgeEnabled() {
 		if err := f.applyBridgeCommitmentTx(); err != nil {
 			return nil, err
@@ -300,6 +317,27 @@ func (f *fsm) createDistributeRewardsTx() (*types.Transaction, error) {
 	return createStateTransactionWithData(contracts.EpochManagerContract, input), nil
 }
 
+// createAddressListRoleTxs creates StateTransactions, which invoke address list precompiles
+// and apply the address list roles changed by the governance, once the governance address lists fork is active
+func (f *fsm) createAddressListRoleTxs() ([]*types.Transaction, error) {
+	if len(f.addressListRoles) == 0 || !f.forks.IsActive(chain.GovernanceAddressLists, f.Height()) {
+		return nil, nil
+	}
+
+	txs := make([]*types.Transaction, len(f.addressListRoles))
+
+	for i, role := range f.addressListRoles {
+		input, err := role.EncodeAbi()
+		if err != nil {
+			return nil, err
+		}
+
+		txs[i] = createStateTransactionWithData(role.AddressList, input)
+	}
+
+	return txs, nil
+}
+
 // ValidateCommit is used to validate that a given commit is valid
 func (f *fsm) ValidateCommit(signerAddr []byte, seal []byte, proposalHash []byte) error {
 	from := types.BytesToAddress(signerAddr)
@@ -458,8 +496,14 @@ func (f *fsm) VerifyStateTransactions(transactions []*types.Transaction) error {
 		commitmentTxExists        bool
 		commitEpochTxExists       bool
 		distributeRewardsTxExists bool
+		addressListRoleTxsCount   int
 	)
 
+	addressListRoleTxs, err := f.createAddressListRoleTxs()
+	if err != nil {
+		return err
+	}
+
 	for _, tx := range transactions {
 		if tx.Type() != types.StateTxType {
 			continue
@@ -511,6 +555,14 @@ func (f *fsm) VerifyStateTransactions(transactions []*types.Transaction) error {
 			if err := f.verifyDistributeRewardsTx(tx); err != nil {
 				return fmt.Errorf("error while verifying distribute rewards transaction. error: %w", err)
 			}
+		case *addressListRole:
+			// address list role transactions have to be the same as the local ones and in the same order
+			if addressListRoleTxsCount >= len(addressListRoleTxs) ||
+				tx.Hash() != addressListRoleTxs[addressListRoleTxsCount].Hash() {
+				return errAddressListRoleTxsMismatch
+			}
+
+			addressListRoleTxsCount++
 		default:
 			return fmt.Errorf("invalid state transaction data type: %v", stateTxData)
 		}
@@ -524,6 +576,10 @@ func (f *fsm) VerifyStateTransactions(transactions []*types.Transaction) error {
 		}
 	}
 
+	if addressListRoleTxsCount != len(addressListRoleTxs) {
+		return errAddressListRoleTxsMismatch
+	}
+
 	if isRewardDistributionBlock(f.isFirstBlockOfEpoch, f.Height()) {
 		if !distributeRewardsTxExists {
 			// this is a check if distribute rewards transaction is not in the list of transactions at all
@@ -1,10 +1,12 @@
 package polybft
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"time"
 
 	"github.com/Ethernal-Tech/ethgo"
@@ -18,6 +20,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/forkmanager"
 	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/state/runtime/addresslist"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
@@ -25,9 +28,123 @@ const rewardLookbackSize = uint64(1)
 
 var (
 	errUnknownGovernanceEvent = errors.New("unknown event from governance")
+	errUnknownRuntimeConfig   = errors.New("unknown runtime config call")
 	stringABIType             = abi.MustNewType("tuple(string)")
 )
 
+// runtime config calls, executed by the governance proposals on the contracts.RuntimeConfigContract address
+var (
+	setNewBlockGasTargetMethod = abi.MustNewMethod(
+		"function setNewBlockGasTarget(uint256 newBlockGasTarget)")
+	setNewBaseFeeEMMethod = abi.MustNewMethod(
+		"function setNewBaseFeeElasticityMultiplier(uint256 newBaseFeeEM)")
+	setNewAddressListAdminsMethod = abi.MustNewMethod(
+		"function setNewAddressListAdmins(address addressList, address[] admins)")
+	setNewTxOrderingMethod = abi.MustNewMethod(
+		"function setNewTxOrdering(tuple(string name, address[] senders, address[] contracts, " +
+			"bool systemContracts, uint256 reservedGasShare)[] lanes)")
+)
+
+// blockGasTargetCall is the decoded setNewBlockGasTarget runtime config call
+type blockGasTargetCall struct {
+	NewBlockGasTarget *big.Int `abi:"newBlockGasTarget"`
+}
+
+// baseFeeEMCall is the decoded setNewBaseFeeElasticityMultiplier runtime config call
+type baseFeeEMCall struct {
+	NewBaseFeeEM *big.Int `abi:"newBaseFeeEM"`
+}
+
+// addressListAdminsCall is the decoded setNewAddressListAdmins runtime config call
+type addressListAdminsCall struct {
+	AddressList types.Address   `abi:"addressList"`
+	Admins      []types.Address `abi:"admins"`
+}
+
+// txOrderingCall is the decoded setNewTxOrdering runtime config call
+type txOrderingCall struct {
+	Lanes []*txLaneCall `abi:"lanes"`
+}
+
+// txLaneCall is the single priority lane of the setNewTxOrdering runtime config call
+type txLaneCall struct {
+	Name             string          `abi:"name"`
+	Senders          []types.Address `abi:"senders"`
+	Contracts        []types.Address `abi:"contracts"`
+	SystemContracts  bool            `abi:"systemContracts"`
+	ReservedGasShare *big.Int        `abi:"reservedGasShare"`
+}
+
+// runtimeConfigUpdate holds the configuration updated by the runtime config calls executed in an epoch
+type runtimeConfigUpdate struct {
+	params        *chain.Params
+	polybftConfig *PolyBFTConfig
+	// addressListRoles are the roles applied by the state transactions in the first block of the next epoch
+	addressListRoles []*addressListRole
+}
+
+var _ contractsapi.StateTransactionInput = (*addressListRole)(nil)
+
+// addressListRole is the role of the account in the address list changed by the governance.
+// It is applied by the state transaction, sent by the system caller to the address list precompile
+type addressListRole struct {
+	AddressList types.Address `json:"addressList"`
+	Account     types.Address `json:"account"`
+	Role        uint64        `json:"role"`
+}
+
+// EncodeAbi encodes the address list precompile call which sets the role of the account
+func (r *addressListRole) EncodeAbi() ([]byte, error) {
+	var method *abi.Method
+
+	switch r.Role {
+	case addresslist.AdminRole.Uint64():
+		method = addresslist.SetAdminFunc
+	case addresslist.EnabledRole.Uint64():
+		method = addresslist.SetEnabledFunc
+	case addresslist.NoRole.Uint64():
+		method = addresslist.SetNoneFunc
+	default:
+		return nil, fmt.Errorf("invalid address list role: %d", r.Role)
+	}
+
+	return method.Encode([]interface{}{r.Account})
+}
+
+// DecodeAbi decodes the address list precompile call which sets the role of the account.
+// Address list is the target of the state transaction, so it is not decoded
+func (r *addressListRole) DecodeAbi(b []byte) error {
+	if len(b) < abiMethodIDLength {
+		return errors.New("invalid address list call")
+	}
+
+	var (
+		sig  = b[:abiMethodIDLength]
+		role addresslist.Role
+	)
+
+	switch {
+	case bytes.Equal(sig, addresslist.SetAdminFunc.ID()):
+		role = addresslist.AdminRole
+	case bytes.Equal(sig, addresslist.SetEnabledFunc.ID()):
+		role = addresslist.EnabledRole
+	case bytes.Equal(sig, addresslist.SetNoneFunc.ID()):
+		role = addresslist.NoRole
+	default:
+		return errors.New("invalid address list call")
+	}
+
+	// all the address list calls have the single address argument
+	if len(b) != abiMethodIDLength+types.HashLength {
+		return errors.New("invalid address list call input")
+	}
+
+	r.Account = types.BytesToAddress(b[abiMethodIDLength:])
+	r.Role = role.Uint64()
+
+	return nil
+}
+
 // isRewardDistributionBlock indicates if reward distribution transaction
 // should happen in given block
 func isRewardDistributionBlock(isFirstBlockOfEpoch bool, pendingBlockNumber uint64) bool {
@@ -41,6 +158,8 @@ type GovernanceManager interface {
 	PostBlock(req *PostBlockRequest) error
 	PostEpoch(req *PostEpochRequest) error
 	GetClientConfig(dbTx *bolt.Tx) (*chain.Params, error)
+	GetClientConfigAt(block uint64) (*chain.Params, error)
+	GetAddressListRoles(epoch uint64) ([]*addressListRole, error)
 }
 
 var _ GovernanceManager = (*dummyGovernanceManager)(nil)
@@ -60,6 +179,12 @@ func (d *dummyGovernanceManager) GetClientConfig(dbTx *bolt.Tx) (*chain.Params,
 
 	return nil, nil
 }
+func (d *dummyGovernanceManager) GetClientConfigAt(block uint64) (*chain.Params, error) {
+	return d.GetClientConfig(nil)
+}
+func (d *dummyGovernanceManager) GetAddressListRoles(epoch uint64) ([]*addressListRole, error) {
+	return nil, nil
+}
 
 // EventSubscriber implementation
 func (d *dummyGovernanceManager) GetLogFilters() map[types.Address][]types.Hash {
@@ -89,7 +214,7 @@ func newGovernanceManager(genesisParams *chain.Params,
 	config, err := state.GovernanceStore.getClientConfig(dbTx)
 	if config == nil || errors.Is(err, errClientConfigNotFound) {
 		// insert initial config to db if not already inserted
-		if err = state.GovernanceStore.insertClientConfig(genesisParams, dbTx); err != nil {
+		if err = state.GovernanceStore.insertClientConfig(0, genesisParams, dbTx); err != nil {
 			return nil, err
 		}
 	} else if err != nil {
@@ -135,6 +260,17 @@ func (g *governanceManager) GetClientConfig(dbTx *bolt.Tx) (*chain.Params, error
 	return g.state.GovernanceStore.getClientConfig(dbTx)
 }
 
+// GetClientConfigAt returns client configuration in effect at the given block from boltdb
+func (g *governanceManager) GetClientConfigAt(block uint64) (*chain.Params, error) {
+	return g.state.GovernanceStore.getClientConfigAt(block, nil)
+}
+
+// GetAddressListRoles returns the address list roles changed by the governance,
+// which are applied by the state transactions in the first block of the given epoch
+func (g *governanceManager) GetAddressListRoles(epoch uint64) ([]*addressListRole, error) {
+	return g.state.GovernanceStore.getAddressListRoles(epoch, nil)
+}
+
 // PostEpoch notifies the governance manager that an epoch has changed
 func (g *governanceManager) PostEpoch(req *PostEpochRequest) error {
 	if !req.Forks.IsActive(chain.Governance, req.FirstBlockOfEpoch) {
@@ -187,6 +323,9 @@ func (g *governanceManager) PostEpoch(req *PostEpochRequest) error {
 		proposalThresholdEvent   contractsapi.NewProposalThresholdEvent
 		sprintSizeEvent          contractsapi.NewSprintSizeEvent
 		baseFeeChangeDenomEvent  contractsapi.NewBaseFeeChangeDenomEvent
+		callExecutedEvent        contractsapi.CallExecutedEvent
+
+		runtimeConfig = &runtimeConfigUpdate{params: latestChainParams, polybftConfig: &latestPolybftConfig}
 	)
 
 	// unmarshal events that happened in previous epoch and update last saved config
@@ -322,6 +461,18 @@ func (g *governanceManager) PostEpoch(req *PostEpochRequest) error {
 			g.logger.Debug("Post epoch - Base fee change denominator changed in governance",
 				"epoch", previousEpoch, "baseFeeChangeDenom", latestChainParams.BaseFeeChangeDenom)
 
+		case callExecutedEvent.Sig():
+			event, err := unmarshalGovernanceEvent[*contractsapi.CallExecutedEvent](e)
+			if err != nil {
+				return fmt.Errorf("could not unmarshal CallExecutedEvent: %w", err)
+			}
+
+			if err := g.applyRuntimeConfigCall(previousEpoch, event.Data, runtimeConfig); err != nil {
+				// proposal has already been executed, so an invalid runtime config call must not halt the chain
+				g.logger.Warn("Post epoch - Could not apply runtime config change from governance",
+					"epoch", previousEpoch, "proposalID", event.ID, "err", err)
+			}
+
 		default:
 			return errUnknownGovernanceEvent
 		}
@@ -329,8 +480,166 @@ func (g *governanceManager) PostEpoch(req *PostEpochRequest) error {
 
 	latestChainParams.Engine[ConsensusName] = latestPolybftConfig
 
+	if len(runtimeConfig.addressListRoles) > 0 {
+		// roles are applied in state by the state transactions in the first block of the new epoch
+		err := g.state.GovernanceStore.insertAddressListRoles(req.NewEpochID, runtimeConfig.addressListRoles, req.DBTx)
+		if err != nil {
+			return err
+		}
+	}
+
 	// save updated config to db
-	return g.state.GovernanceStore.insertClientConfig(latestChainParams, req.DBTx)
+	return g.state.GovernanceStore.insertClientConfig(req.FirstBlockOfEpoch, latestChainParams, req.DBTx)
+}
+
+// applyRuntimeConfigCall decodes the runtime config call executed by a governance proposal
+// and applies the change to the provided runtime config update
+func (g *governanceManager) applyRuntimeConfigCall(epoch uint64, data []byte, update *runtimeConfigUpdate) error {
+	if len(data) < 4 {
+		return errUnknownRuntimeConfig
+	}
+
+	sig, input := data[:4], data[4:]
+	params := update.params
+
+	switch {
+	case bytes.Equal(sig, setNewBlockGasTargetMethod.ID()):
+		var call blockGasTargetCall
+		if err := setNewBlockGasTargetMethod.Inputs.DecodeStruct(input, &call); err != nil {
+			return err
+		}
+
+		params.BlockGasTarget = call.NewBlockGasTarget.Uint64()
+		g.logger.Debug("Post epoch - Block gas target changed in governance",
+			"epoch", epoch, "blockGasTarget", params.BlockGasTarget)
+
+	case bytes.Equal(sig, setNewBaseFeeEMMethod.ID()):
+		var call baseFeeEMCall
+		if err := setNewBaseFeeEMMethod.Inputs.DecodeStruct(input, &call); err != nil {
+			return err
+		}
+
+		if call.NewBaseFeeEM.Sign() <= 0 {
+			return fmt.Errorf("invalid base fee elasticity multiplier: %s", call.NewBaseFeeEM)
+		}
+
+		params.BaseFeeEM = call.NewBaseFeeEM.Uint64()
+		g.logger.Debug("Post epoch - Base fee elasticity multiplier changed in governance",
+			"epoch", epoch, "baseFeeEM", params.BaseFeeEM)
+
+	case bytes.Equal(sig, setNewAddressListAdminsMethod.ID()):
+		var call addressListAdminsCall
+		if err := setNewAddressListAdminsMethod.Inputs.DecodeStruct(input, &call); err != nil {
+			return err
+		}
+
+		listConfig := getAddressListConfig(params, call.AddressList)
+		if listConfig == nil {
+			return fmt.Errorf("address list %s is not enabled", call.AddressList)
+		}
+
+		update.addressListRoles = append(update.addressListRoles,
+			getAddressListRoles(call.AddressList, listConfig, call.Admins)...)
+		listConfig.AdminAddresses = call.Admins
+		g.logger.Debug("Post epoch - Address list admins changed in governance",
+			"epoch", epoch, "addressList", call.AddressList, "admins", call.Admins)
+
+	case bytes.Equal(sig, setNewTxOrderingMethod.ID()):
+		var call txOrderingCall
+		if err := setNewTxOrderingMethod.Inputs.DecodeStruct(input, &call); err != nil {
+			return err
+		}
+
+		txOrdering := &TxOrderingConfig{Lanes: make([]*TxLaneConfig, len(call.Lanes))}
+		for i, lane := range call.Lanes {
+			if !lane.ReservedGasShare.IsUint64() {
+				return fmt.Errorf("invalid reserved gas share of lane %s: %s", lane.Name, lane.ReservedGasShare)
+			}
+
+			txOrdering.Lanes[i] = &TxLaneConfig{
+				Name:             lane.Name,
+				Senders:          lane.Senders,
+				Contracts:        lane.Contracts,
+				SystemContracts:  lane.SystemContracts,
+				ReservedGasShare: lane.ReservedGasShare.Uint64(),
+			}
+		}
+
+		if err := txOrdering.Validate(); err != nil {
+			return err
+		}
+
+		update.polybftConfig.TxOrdering = txOrdering
+		g.logger.Debug("Post epoch - Tx ordering changed in governance",
+			"epoch", epoch, "lanes", len(txOrdering.Lanes))
+
+	default:
+		return errUnknownRuntimeConfig
+	}
+
+	return nil
+}
+
+// getAddressListConfig returns the configuration of the address list with the given precompile address
+func getAddressListConfig(params *chain.Params, addressList types.Address) *chain.AddressListConfig {
+	switch addressList {
+	case contracts.AllowListContractsAddr:
+		return params.ContractDeployerAllowList
+	case contracts.BlockListContractsAddr:
+		return params.ContractDeployerBlockList
+	case contracts.AllowListTransactionsAddr:
+		return params.TransactionsAllowList
+	case contracts.BlockListTransactionsAddr:
+		return params.TransactionsBlockList
+	case contracts.AllowListBridgeAddr:
+		return params.BridgeAllowList
+	case contracts.BlockListBridgeAddr:
+		return params.BridgeBlockList
+	default:
+		return nil
+	}
+}
+
+// getAddressListRoles returns the roles which replace the admins of the address list with the new ones.
+// Removed admins keep the enabled role, if they are enabled in the address list config
+func getAddressListRoles(addressList types.Address, config *chain.AddressListConfig,
+	admins []types.Address) []*addressListRole {
+	var (
+		roles     []*addressListRole
+		oldAdmins = make(map[types.Address]struct{}, len(config.AdminAddresses))
+		newAdmins = make(map[types.Address]struct{}, len(admins))
+	)
+
+	for _, admin := range config.AdminAddresses {
+		oldAdmins[admin] = struct{}{}
+	}
+
+	for _, admin := range admins {
+		newAdmins[admin] = struct{}{}
+
+		if _, exists := oldAdmins[admin]; !exists {
+			roles = append(roles, &addressListRole{
+				AddressList: addressList,
+				Account:     admin,
+				Role:        addresslist.AdminRole.Uint64(),
+			})
+		}
+	}
+
+	for _, admin := range config.AdminAddresses {
+		if _, exists := newAdmins[admin]; exists {
+			continue
+		}
+
+		role := addresslist.NoRole
+		if slices.Contains(config.EnabledAddresses, admin) {
+			role = addresslist.EnabledRole
+		}
+
+		roles = append(roles, &addressListRole{AddressList: addressList, Account: admin, Role: role.Uint64()})
+	}
+
+	return roles
 }
 
 // PostBlock notifies governance manager that a block was finalized
@@ -455,6 +764,7 @@ func parseGovernanceEvent(log *ethgo.Log) (contractsapi.EventAbi, bool, error) {
 		newFeatureEvent          contractsapi.NewFeatureEvent
 		updatedFeatureEvent      contractsapi.UpdatedFeatureEvent
 		baseFeeChangeDenomEvent  contractsapi.NewBaseFeeChangeDenomEvent
+		callExecutedEvent        contractsapi.CallExecutedEvent
 	)
 
 	parseEvent := func(event contractsapi.EventAbi) (contractsapi.EventAbi, bool, error) {
@@ -494,6 +804,14 @@ func parseGovernanceEvent(log *ethgo.Log) (contractsapi.EventAbi, bool, error) {
 		return parseEvent(&newFeatureEvent)
 	case updatedFeatureEvent.Sig():
 		return parseEvent(&updatedFeatureEvent)
+	case callExecutedEvent.Sig():
+		event, doesMatch, err := parseEvent(&callExecutedEvent)
+		if err != nil || !doesMatch {
+			return event, doesMatch, err
+		}
+
+		// only the runtime config calls are governance events, the rest of the executed proposals are ignored
+		return event, callExecutedEvent.Target == contracts.RuntimeConfigContract, nil
 	default:
 		return nil, false, errUnknownGovernanceEvent
 	}
@@ -521,6 +839,9 @@ func (g *governanceManager) GetLogFilters() map[types.Address][]types.Hash {
 			types.Hash(new(contractsapi.NewFeatureEvent).Sig()),
 			types.Hash(new(contractsapi.UpdatedFeatureEvent).Sig()),
 		},
+		contracts.ChildTimelockContract: {
+			types.Hash(new(contractsapi.CallExecutedEvent).Sig()),
+		},
 	}
 }
 
@@ -6,8 +6,14 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/forkmanager"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/state/runtime/addresslist"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/Ethernal-Tech/ethgo"
+	"github.com/Ethernal-Tech/ethgo/abi"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/require"
 )
@@ -42,7 +48,7 @@ func TestGovernanceManager_PostEpoch(t *testing.T) {
 	}
 
 	// insert initial config
-	require.NoError(t, state.GovernanceStore.insertClientConfig(params, nil))
+	require.NoError(t, state.GovernanceStore.insertClientConfig(0, params, nil))
 
 	// PostEpoch will now update config with new epoch reward value
 	require.NoError(t, governanceManager.PostEpoch(&PostEpochRequest{
@@ -61,6 +67,244 @@ func TestGovernanceManager_PostEpoch(t *testing.T) {
 	require.Equal(t, epochRewardEvent.Reward.Uint64(), pbftConfig.EpochReward)
 }
 
+func TestGovernanceManager_PostEpoch_RuntimeConfig(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+	governanceManager := &governanceManager{
+		state:  state,
+		logger: hclog.NewNullLogger(),
+	}
+
+	admin := types.StringToAddress("0xa")
+
+	encodeCall := func(method *abi.Method, args interface{}) []byte {
+		input, err := method.Encode(args)
+		require.NoError(t, err)
+
+		return input
+	}
+
+	calls := [][]byte{
+		encodeCall(setNewBlockGasTargetMethod, &blockGasTargetCall{NewBlockGasTarget: big.NewInt(50_000_000)}),
+		encodeCall(setNewBaseFeeEMMethod, &baseFeeEMCall{NewBaseFeeEM: big.NewInt(4)}),
+		encodeCall(setNewAddressListAdminsMethod, &addressListAdminsCall{
+			AddressList: contracts.AllowListContractsAddr,
+			Admins:      []types.Address{admin},
+		}),
+		// address list not enabled, ignored
+		encodeCall(setNewAddressListAdminsMethod, &addressListAdminsCall{
+			AddressList: contracts.BlockListContractsAddr,
+			Admins:      []types.Address{admin},
+		}),
+		encodeCall(setNewTxOrderingMethod, &txOrderingCall{Lanes: []*txLaneCall{
+			{
+				Name:             "system",
+				Senders:          []types.Address{},
+				Contracts:        []types.Address{},
+				SystemContracts:  true,
+				ReservedGasShare: big.NewInt(20),
+			},
+			{
+				Name:             "oracles",
+				Senders:          []types.Address{admin},
+				Contracts:        []types.Address{},
+				ReservedGasShare: big.NewInt(10),
+			},
+		}}),
+		// reserved gas share exceeds the maximum, ignored
+		encodeCall(setNewTxOrderingMethod, &txOrderingCall{Lanes: []*txLaneCall{
+			{
+				Name:             "oracles",
+				Senders:          []types.Address{admin},
+				Contracts:        []types.Address{},
+				ReservedGasShare: big.NewInt(int64(maxReservedGasShare) + 1),
+			},
+		}}),
+		// unknown call, ignored
+		{0x1, 0x2, 0x3, 0x4},
+	}
+
+	for i, data := range calls {
+		require.NoError(t, state.GovernanceStore.insertGovernanceEvent(1, &contractsapi.CallExecutedEvent{
+			ID:     types.StringToHash("0x1"),
+			Index:  big.NewInt(int64(i)),
+			Target: contracts.RuntimeConfigContract,
+			Value:  big.NewInt(0),
+			Data:   data,
+		}, nil))
+	}
+
+	withdrawalPeriodEvent := &contractsapi.NewWithdrawalWaitPeriodEvent{WithdrawalPeriod: big.NewInt(5)}
+	require.NoError(t, state.GovernanceStore.insertGovernanceEvent(1, withdrawalPeriodEvent, nil))
+
+	require.NoError(t, state.GovernanceStore.insertClientConfig(0, &chain.Params{
+		BlockGasTarget:            30_000_000,
+		BaseFeeEM:                 2,
+		ContractDeployerAllowList: &chain.AddressListConfig{},
+		Engine:                    map[string]interface{}{ConsensusName: createTestPolybftConfig()},
+	}, nil))
+
+	require.NoError(t, governanceManager.PostEpoch(&PostEpochRequest{
+		NewEpochID:        2,
+		FirstBlockOfEpoch: 21,
+		Forks:             &chain.Forks{chain.Governance: chain.NewFork(0)},
+	}))
+
+	updatedConfig, err := state.GovernanceStore.getClientConfig(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50_000_000), updatedConfig.BlockGasTarget)
+	require.Equal(t, uint64(4), updatedConfig.BaseFeeEM)
+	require.Equal(t, []types.Address{admin}, updatedConfig.ContractDeployerAllowList.AdminAddresses)
+	require.Nil(t, updatedConfig.ContractDeployerBlockList)
+
+	roles, err := governanceManager.GetAddressListRoles(2)
+	require.NoError(t, err)
+	require.Equal(t, []*addressListRole{
+		{AddressList: contracts.AllowListContractsAddr, Account: admin, Role: addresslist.AdminRole.Uint64()},
+	}, roles)
+
+	pbftConfig, err := GetPolyBFTConfig(updatedConfig)
+	require.NoError(t, err)
+	require.Equal(t, withdrawalPeriodEvent.WithdrawalPeriod.Uint64(), pbftConfig.WithdrawalWaitPeriod)
+	require.Equal(t, &TxOrderingConfig{Lanes: []*TxLaneConfig{
+		{Name: "system", SystemContracts: true, ReservedGasShare: 20},
+		{Name: "oracles", Senders: []types.Address{admin}, ReservedGasShare: 10},
+	}}, pbftConfig.TxOrdering)
+}
+
+func TestGovernanceManager_PostEpoch_AddressListAdmins(t *testing.T) {
+	t.Parallel()
+
+	var (
+		oldAdmin        = types.StringToAddress("0xa")
+		enabledOldAdmin = types.StringToAddress("0xb")
+		newAdmin        = types.StringToAddress("0xc")
+		polybftState    = newTestState(t)
+		params          = &chain.Params{
+			ChainID:      100,
+			Forks:        chain.AllForksEnabled,
+			BurnContract: map[uint64]types.Address{0: types.StringToAddress("0xb0")},
+			ContractDeployerAllowList: &chain.AddressListConfig{
+				AdminAddresses:   []types.Address{oldAdmin, enabledOldAdmin},
+				EnabledAddresses: []types.Address{enabledOldAdmin},
+			},
+			Engine: map[string]interface{}{ConsensusName: createTestPolybftConfig()},
+		}
+		governanceManager = &governanceManager{
+			state:  polybftState,
+			logger: hclog.NewNullLogger(),
+		}
+	)
+
+	data, err := setNewAddressListAdminsMethod.Encode(&addressListAdminsCall{
+		AddressList: contracts.AllowListContractsAddr,
+		Admins:      []types.Address{newAdmin},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, polybftState.GovernanceStore.insertGovernanceEvent(1, &contractsapi.CallExecutedEvent{
+		ID:     types.StringToHash("0x1"),
+		Index:  big.NewInt(0),
+		Target: contracts.RuntimeConfigContract,
+		Value:  big.NewInt(0),
+		Data:   data,
+	}, nil))
+	require.NoError(t, polybftState.GovernanceStore.insertClientConfig(0, params, nil))
+
+	// genesis roles are set from the genesis config, before it is changed by the governance
+	genesis := &chain.Genesis{Alloc: map[types.Address]*chain.GenesisAccount{}}
+	addresslist.ApplyGenesisAllocs(genesis, contracts.AllowListContractsAddr, params.ContractDeployerAllowList)
+
+	require.NoError(t, governanceManager.PostEpoch(&PostEpochRequest{
+		NewEpochID:        2,
+		FirstBlockOfEpoch: 21,
+		Forks:             &chain.Forks{chain.Governance: chain.NewFork(0)},
+	}))
+
+	roles, err := governanceManager.GetAddressListRoles(2)
+	require.NoError(t, err)
+
+	// roles are applied by the state transactions in the first block of the epoch
+	f := &fsm{parent: &types.Header{Number: 20}, forks: params.Forks, addressListRoles: roles}
+
+	txs, err := f.createAddressListRoleTxs()
+	require.NoError(t, err)
+	require.Len(t, txs, 3)
+	require.NoError(t, f.VerifyStateTransactions(txs))
+	require.ErrorIs(t, f.VerifyStateTransactions(txs[1:]), errAddressListRoleTxsMismatch)
+
+	// roles are not applied before the governance address lists fork
+	beforeFork := &fsm{
+		parent:           &types.Header{Number: 20},
+		forks:            &chain.Forks{chain.GovernanceAddressLists: chain.NewFork(22)},
+		addressListRoles: roles,
+	}
+
+	beforeForkTxs, err := beforeFork.createAddressListRoleTxs()
+	require.NoError(t, err)
+	require.Empty(t, beforeForkTxs)
+	require.NoError(t, beforeFork.VerifyStateTransactions(nil))
+	require.ErrorIs(t, beforeFork.VerifyStateTransactions(txs), errAddressListRoleTxsMismatch)
+
+	executor := state.NewExecutor(params, itrie.NewState(itrie.NewMemoryStorage()), hclog.NewNullLogger())
+	executor.GetHash = func(header *types.Header) state.GetHashByNumber {
+		return func(i uint64) types.Hash {
+			return types.ZeroHash
+		}
+	}
+
+	genesisRoot, err := executor.WriteGenesis(genesis.Alloc, types.ZeroHash)
+	require.NoError(t, err)
+
+	transition, err := executor.BeginTxn(genesisRoot, &types.Header{Number: 21, GasLimit: 30_000_000}, types.ZeroAddress)
+	require.NoError(t, err)
+
+	for _, tx := range txs {
+		require.NoError(t, transition.Write(tx))
+	}
+
+	for _, receipt := range transition.Receipts() {
+		require.Equal(t, types.ReceiptSuccess, *receipt.Status)
+	}
+
+	allowList := addresslist.NewAddressList(transition, contracts.AllowListContractsAddr)
+	require.Equal(t, addresslist.AdminRole, allowList.GetRole(newAdmin))
+	require.Equal(t, addresslist.NoRole, allowList.GetRole(oldAdmin))
+	require.Equal(t, addresslist.EnabledRole, allowList.GetRole(enabledOldAdmin))
+}
+
+func TestGovernanceManager_ParseRuntimeConfigEvent(t *testing.T) {
+	t.Parallel()
+
+	newLog := func(target types.Address) *ethgo.Log {
+		event := &contractsapi.CallExecutedEvent{
+			ID:     types.StringToHash("0x1"),
+			Index:  big.NewInt(0),
+			Target: target,
+			Value:  big.NewInt(0),
+			Data:   []byte{0x1},
+		}
+
+		data, err := abi.MustNewType("tuple(address target,uint256 value,bytes data)").Encode(event)
+		require.NoError(t, err)
+
+		return &ethgo.Log{
+			Address: ethgo.Address(contracts.ChildTimelockContract),
+			Topics:  []ethgo.Hash{event.Sig(), ethgo.Hash(event.ID), ethgo.BytesToHash(event.Index.Bytes())},
+			Data:    data,
+		}
+	}
+
+	_, isGovernanceEvent, err := parseGovernanceEvent(newLog(contracts.RuntimeConfigContract))
+	require.NoError(t, err)
+	require.True(t, isGovernanceEvent)
+
+	_, isGovernanceEvent, err = parseGovernanceEvent(newLog(contracts.NetworkParamsContract))
+	require.NoError(t, err)
+	require.False(t, isGovernanceEvent)
+}
+
 func TestGovernanceManager_PostBlock(t *testing.T) {
 	t.Parallel()
 
@@ -35,7 +35,8 @@ func (m *blockchainMock) CommitBlock(block *types.FullBlock) error {
 }
 
 func (m *blockchainMock) NewBlockBuilder(parent *types.Header, coinbase types.Address,
-	txPool txPoolInterface, blockTime time.Duration, logger hclog.Logger) (blockBuilder, error) {
+	txPool txPoolInterface, blockTime time.Duration, txOrdering TxOrderingPolicy,
+	logger hclog.Logger) (blockBuilder, error) {
 	args := m.Called()
 
 	return args.Get(0).(blockBuilder), args.Error(1)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"path/filepath"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/go-hclog"
@@ -124,6 +125,16 @@ type Polybft struct {
 
 	// tx pool as interface
 	txPool txPoolInterface
+
+	// finalizedBlock is the cached latest finalized block, along with the head it is computed for
+	finalizedBlock atomic.Pointer[finalizedBlock]
+}
+
+// finalizedBlock is the latest block whose committed seals reach the quorum,
+// computed when the head block is inserted
+type finalizedBlock struct {
+	head   types.Hash
+	header *types.Header
 }
 
 func GenesisPostHookFactory(config *chain.Chain, engineName string) func(txn *state.Transition) error {
@@ -206,8 +217,10 @@ func GenesisPostHookFactory(config *chain.Chain, engineName string) func(txn *st
 		}
 
 		// Initialize Apex contracts
-		if err = initApex(transition, polyBFTConfig); err != nil {
-			return err
+		if !polyBFTConfig.ApexBridgeDisabled {
+			if err = initApex(transition, polyBFTConfig); err != nil {
+				return err
+			}
 		}
 
 		bridgeCfg := polyBFTConfig.Bridge
@@ -589,6 +602,7 @@ func (p *Polybft) initRuntime() error {
 		bridgeTopic:     p.bridgeTopic,
 		consensusConfig: p.config.Config,
 		eventTracker:    p.config.EventTracker,
+		onBlockInserted: p.updateFinalizedBlock,
 	}
 
 	runtime, err := newConsensusRuntime(p.logger, runtimeConfig)
@@ -838,10 +852,10 @@ func (p *Polybft) PreCommitState(block *types.Block, _ *state.Transition) error
 	return nil
 }
 
-// GetLatestChainConfig returns the latest chain configuration
-func (p *Polybft) GetLatestChainConfig() (*chain.Params, error) {
+// GetChainConfigAt returns the chain configuration in effect at the given block
+func (p *Polybft) GetChainConfigAt(blockNumber uint64) (*chain.Params, error) {
 	if p.runtime != nil {
-		return p.runtime.governanceManager.GetClientConfig(nil)
+		return p.runtime.governanceManager.GetClientConfigAt(blockNumber)
 	}
 
 	return nil, nil
@@ -853,6 +867,80 @@ func (p *Polybft) GetBridgeProvider() consensus.BridgeDataProvider {
 	return p.runtime
 }
 
+// GetFinalizedBlockNumber is an implementation of Consensus interface.
+// Returns the number of the latest block whose committed seals reach the quorum of its validator set.
+// Finalized block is cached when the head block is inserted.
+func (p *Polybft) GetFinalizedBlockNumber() (uint64, error) {
+	finalized := p.finalizedBlock.Load()
+	if finalized == nil {
+		if err := p.updateFinalizedBlock(p.blockchain.CurrentHeader()); err != nil {
+			return 0, err
+		}
+
+		finalized = p.finalizedBlock.Load()
+	}
+
+	return finalized.header.Number, nil
+}
+
+// updateFinalizedBlock finds the latest finalized block, starting from the given head and walking back
+// no further than the previously finalized block, and caches it for the head.
+// Committed seals of the inserted blocks are already verified (either by the consensus, or by the header
+// verification when syncing), so the block is finalized if its extra carries the committed seals.
+func (p *Polybft) updateFinalizedBlock(head *types.Header) error {
+	previous := p.finalizedBlock.Load()
+	if previous != nil && previous.head == head.Hash {
+		return nil
+	}
+
+	header := head
+
+	for header.Number > 0 {
+		if previous != nil && previous.header.Hash == header.Hash {
+			break
+		}
+
+		extra, err := GetIbftExtra(header.ExtraData)
+		if err == nil && extra.Committed != nil && extra.Checkpoint != nil {
+			break
+		}
+
+		p.logger.Debug("block committed seals are not present", "block", header.Number, "err", err)
+
+		parent, ok := p.blockchain.GetHeaderByHash(header.ParentHash)
+		if !ok {
+			return fmt.Errorf("unable to get parent header by hash for block number %d", header.Number)
+		}
+
+		header = parent
+	}
+
+	p.finalizedBlock.Store(&finalizedBlock{head: head.Hash, header: header})
+
+	return nil
+}
+
+// GetSafeBlockNumber is an implementation of Consensus interface.
+// Returns the number of the latest finalized block which is checkpointed on the rootchain,
+// if the bridge is enabled. Otherwise, returns the latest finalized block number.
+func (p *Polybft) GetSafeBlockNumber() (uint64, error) {
+	finalizedBlock, err := p.GetFinalizedBlockNumber()
+	if err != nil {
+		return 0, err
+	}
+
+	if p.runtime == nil || !p.genesisClientConfig.IsBridgeEnabled() {
+		return finalizedBlock, nil
+	}
+
+	checkpointBlock, err := p.runtime.bridgeManager.LastCheckpointBlock()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the last checkpoint block: %w", err)
+	}
+
+	return min(checkpointBlock, finalizedBlock), nil
+}
+
 // FilterExtra is an implementation of Consensus interface
 func (p *Polybft) FilterExtra(extra []byte) ([]byte, error) {
 	return GetIbftExtraClean(extra)
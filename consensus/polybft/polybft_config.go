@@ -99,6 +99,12 @@ type PolyBFTConfig struct {
 
 	// StakeTokenAddr represents the stake token contract address
 	StakeTokenAddr types.Address `json:"stakeTokenAddr"`
+
+	// TxOrdering defines the priority lanes used when filling the block with the tx pool transactions
+	TxOrdering *TxOrderingConfig `json:"txOrdering,omitempty"`
+
+	// ApexBridgeDisabled indicates that apex bridge contracts are not deployed and initialized in genesis
+	ApexBridgeDisabled bool `json:"apexBridgeDisabled,omitempty"`
 }
 
 // LoadPolyBFTConfig loads chain config from provided path and unmarshals PolyBFTConfig
@@ -153,6 +159,10 @@ type BridgeConfig struct {
 
 	JSONRPCEndpoint         string                   `json:"jsonRPCEndpoint"`
 	EventTrackerStartBlocks map[types.Address]uint64 `json:"eventTrackerStartBlocks"`
+
+	// CheckpointLagAlertThreshold is the number of child chain blocks not checkpointed on the rootchain,
+	// after which the checkpoint lag alert is raised (default threshold is used if not set)
+	CheckpointLagAlertThreshold uint64 `json:"checkpointLagAlertThreshold,omitempty"`
 }
 
 func (p *PolyBFTConfig) IsBridgeEnabled() bool {
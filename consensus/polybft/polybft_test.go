@@ -243,6 +243,54 @@ func TestPolybft_Close(t *testing.T) {
 	syncer.AssertExpectations(t)
 }
 
+func TestPolybft_GetFinalizedBlockNumber(t *testing.T) {
+	t.Parallel()
+
+	sealedExtra := (&Extra{Committed: &Signature{}, Checkpoint: &CheckpointData{}}).MarshalRLPTo(nil)
+
+	// only the block 2 and 5 carry committed seals
+	headers := make([]*types.Header, 6)
+	for i := range headers {
+		headers[i] = &types.Header{Number: uint64(i), Hash: types.Hash{byte(i + 1)}}
+		if i > 0 {
+			headers[i].ParentHash = headers[i-1].Hash
+		}
+
+		if i == 2 || i == 5 {
+			headers[i].ExtraData = sealedExtra
+		}
+	}
+
+	blockchain := new(blockchainMock)
+	blockchain.On("CurrentHeader").Return(headers[4]).Once()
+
+	for _, h := range headers[3:5] {
+		blockchain.On("GetHeaderByHash", h.ParentHash).Return(headers[h.Number-1]).Once()
+	}
+
+	// committed seals are not verified again, so the validators are not needed
+	polybft := &Polybft{
+		blockchain: blockchain,
+		logger:     hclog.NewNullLogger(),
+	}
+
+	finalized, err := polybft.GetFinalizedBlockNumber()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), finalized)
+
+	// served from the cache, head is not queried again
+	finalized, err = polybft.GetFinalizedBlockNumber()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), finalized)
+
+	// inserted head with committed seals is finalized without walking back
+	require.NoError(t, polybft.updateFinalizedBlock(headers[5]))
+	require.Equal(t, headers[5].Hash, polybft.finalizedBlock.Load().head)
+	require.Equal(t, headers[5], polybft.finalizedBlock.Load().header)
+
+	blockchain.AssertExpectations(t)
+}
+
 func TestPolybft_GetSyncProgression(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,212 @@
+package polybft
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Ethernal-Tech/ethgo"
+	hclog "github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// defaultRootchainReorgWindow is the number of the latest processed rootchain blocks,
+	// whose hashes are tracked in order to detect the rootchain reorgs
+	defaultRootchainReorgWindow = uint64(1000)
+
+	// defaultRootchainReorgCheckInterval is the interval of the rootchain reorg checks,
+	// used if the event tracker poll interval is not set
+	defaultRootchainReorgCheckInterval = 2 * time.Second
+)
+
+// rootchainBlockProvider provides the blocks of the rootchain
+type rootchainBlockProvider interface {
+	GetBlockByNumber(i ethgo.BlockNumber, full bool) (*ethgo.Block, error)
+}
+
+// rootchainReorgDetector detects the rootchain reorgs deeper than the number of block confirmations.
+// Event tracker handles the reorgs of the blocks which are not yet confirmed, but the events of
+// the confirmed blocks are already processed and saved to the bridge state, so such reorgs are not handled.
+// Detector tracks the hashes of the processed rootchain blocks which contain the bridge events,
+// along with the hash of the last processed block, and periodically compares them to the rootchain blocks
+type rootchainReorgDetector struct {
+	store         *RootchainStore
+	blockProvider rootchainBlockProvider
+	// lastProcessedBlockFn returns the last rootchain block processed by the event tracker
+	lastProcessedBlockFn func() (uint64, error)
+	// rollbackFn rolls back the bridge state to the given fork block
+	rollbackFn func(forkBlock uint64) error
+
+	numBlockConfirmations uint64
+	window                uint64
+	logger                hclog.Logger
+}
+
+// newRootchainReorgDetector creates a new instance of rootchainReorgDetector
+func newRootchainReorgDetector(store *RootchainStore, blockProvider rootchainBlockProvider,
+	lastProcessedBlockFn func() (uint64, error), rollbackFn func(forkBlock uint64) error,
+	numBlockConfirmations uint64, logger hclog.Logger) *rootchainReorgDetector {
+	return &rootchainReorgDetector{
+		store:                 store,
+		blockProvider:         blockProvider,
+		lastProcessedBlockFn:  lastProcessedBlockFn,
+		rollbackFn:            rollbackFn,
+		numBlockConfirmations: numBlockConfirmations,
+		window:                defaultRootchainReorgWindow,
+		logger:                logger,
+	}
+}
+
+// addLog tracks the hash of the rootchain block in which the given event log is emitted,
+// along with the id of the state sync event or the successfully processed exit event
+func (r *rootchainReorgDetector) addLog(eventLog *ethgo.Log) error {
+	block := &rootchainBlock{Number: eventLog.BlockNumber, Hash: types.Hash(eventLog.BlockHash)}
+
+	switch eventLog.Topics[0] {
+	case stateSyncEventSig:
+		var stateSyncedEvent contractsapi.StateSyncedEvent
+		if _, err := stateSyncedEvent.ParseLog(eventLog); err != nil {
+			return err
+		}
+
+		block.StateSyncIDs = []uint64{stateSyncedEvent.ID.Uint64()}
+	case exitProcessedEventSig:
+		var exitProcessedEvent contractsapi.ExitProcessedEvent
+		if _, err := exitProcessedEvent.ParseLog(eventLog); err != nil {
+			return err
+		}
+
+		if exitProcessedEvent.Success {
+			block.ProcessedExitIDs = []uint64{exitProcessedEvent.ID.Uint64()}
+		}
+	}
+
+	return r.store.insertRootchainBlock(block)
+}
+
+// run checks for the rootchain reorgs on each tick of the given interval, until the close channel is closed
+func (r *rootchainReorgDetector) run(interval time.Duration, closeCh <-chan struct{}) {
+	if interval <= 0 {
+		interval = defaultRootchainReorgCheckInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-closeCh:
+			return
+		case <-ticker.C:
+			if err := r.checkReorg(); err != nil {
+				r.logger.Error("Failed to check for rootchain reorg", "err", err)
+			}
+		}
+	}
+}
+
+// checkReorg compares the tracked block hashes to the rootchain blocks,
+// and rolls back the bridge state to the fork block, if the tracked blocks got reorged
+func (r *rootchainReorgDetector) checkReorg() error {
+	if err := r.trackLastProcessedBlock(); err != nil {
+		return err
+	}
+
+	blocks, err := r.store.getRootchainBlocks()
+	if err != nil || len(blocks) == 0 {
+		return err
+	}
+
+	// since each block commits to the hash of its parent, all the tracked blocks are canonical
+	// if the latest one is, so the fork block is looked for only if the latest tracked block got reorged
+	forkBlock := blocks[0].Number
+	reorged := false
+
+	for i := len(blocks) - 1; i >= 0; i-- {
+		canonical, err := r.isCanonical(blocks[i])
+		if err != nil {
+			return err
+		}
+
+		if canonical {
+			forkBlock = blocks[i].Number + 1
+
+			break
+		}
+
+		reorged = true
+	}
+
+	if !reorged {
+		return nil
+	}
+
+	if forkBlock == blocks[0].Number {
+		r.logger.Warn("All the tracked rootchain blocks got reorged, reorg might be deeper than the tracked window",
+			"window", r.window, "oldestTrackedBlock", forkBlock)
+	}
+
+	latestBlock, err := r.blockProvider.GetBlockByNumber(ethgo.Latest, false)
+	if err != nil {
+		return fmt.Errorf("failed to get the latest rootchain block: %w", err)
+	}
+
+	depth := uint64(0)
+	if latestBlock.Number >= forkBlock {
+		depth = latestBlock.Number - forkBlock + 1
+	}
+
+	r.logger.Error("Rootchain reorg deeper than the number of block confirmations detected",
+		"forkBlock", forkBlock, "depth", depth, "numBlockConfirmations", r.numBlockConfirmations)
+
+	updateRootchainReorgMetrics(depth)
+
+	if err := r.rollbackFn(forkBlock); err != nil {
+		return fmt.Errorf("failed to roll back bridge state to the rootchain block %d: %w", forkBlock, err)
+	}
+
+	return nil
+}
+
+// trackLastProcessedBlock tracks the hash of the last block processed by the event tracker,
+// so the reorgs of the blocks without bridge events are detected as well,
+// and prunes the tracked blocks which are out of the tracked window
+func (r *rootchainReorgDetector) trackLastProcessedBlock() error {
+	lastProcessedBlock, err := r.lastProcessedBlockFn()
+	if err != nil || lastProcessedBlock == 0 {
+		return err
+	}
+
+	block, err := r.blockProvider.GetBlockByNumber(ethgo.BlockNumber(lastProcessedBlock), false)
+	if err != nil {
+		return fmt.Errorf("failed to get the rootchain block %d: %w", lastProcessedBlock, err)
+	}
+
+	if block == nil {
+		// rootchain got shorter than the last processed block, so the reorg is detected by the tracked blocks
+		return nil
+	}
+
+	err = r.store.insertRootchainBlock(&rootchainBlock{Number: block.Number, Hash: types.Hash(block.Hash)})
+	if err != nil {
+		return err
+	}
+
+	if lastProcessedBlock <= r.window {
+		return nil
+	}
+
+	return r.store.pruneRootchainBlocks(lastProcessedBlock - r.window)
+}
+
+// isCanonical returns true if the given tracked block is still a part of the rootchain
+func (r *rootchainReorgDetector) isCanonical(block *rootchainBlock) (bool, error) {
+	rootchainBlock, err := r.blockProvider.GetBlockByNumber(ethgo.BlockNumber(block.Number), false)
+	if err != nil {
+		return false, fmt.Errorf("failed to get the rootchain block %d: %w", block.Number, err)
+	}
+
+	return rootchainBlock != nil && types.Hash(rootchainBlock.Hash) == block.Hash, nil
+}
@@ -0,0 +1,198 @@
+package polybft
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/Ethernal-Tech/ethgo"
+	"github.com/Ethernal-Tech/ethgo/abi"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+// testRootchain is a rootchain whose blocks starting from the fork block get different hashes after the fork
+type testRootchain struct {
+	head      uint64
+	forkBlock uint64
+	forked    bool
+}
+
+func (r *testRootchain) hash(number uint64) ethgo.Hash {
+	version := uint64(0)
+	if r.forked && number >= r.forkBlock {
+		version = 1
+	}
+
+	return ethgo.BytesToHash(append(common.EncodeUint64ToBytes(number), common.EncodeUint64ToBytes(version)...))
+}
+
+func (r *testRootchain) fork(forkBlock, head uint64) {
+	r.forked = true
+	r.forkBlock = forkBlock
+	r.head = head
+}
+
+func (r *testRootchain) GetBlockByNumber(i ethgo.BlockNumber, _ bool) (*ethgo.Block, error) {
+	if i < 0 {
+		i = ethgo.BlockNumber(r.head)
+	}
+
+	number := uint64(i)
+	if number > r.head {
+		return nil, nil
+	}
+
+	return &ethgo.Block{Number: number, Hash: r.hash(number)}, nil
+}
+
+func newTestRootchainReorgDetector(t *testing.T, rootchain *testRootchain,
+	lastProcessedBlock *uint64, forkBlocks *[]uint64) *rootchainReorgDetector {
+	t.Helper()
+
+	return newRootchainReorgDetector(newTestState(t).RootchainStore, rootchain,
+		func() (uint64, error) { return *lastProcessedBlock, nil },
+		func(forkBlock uint64) error {
+			*forkBlocks = append(*forkBlocks, forkBlock)
+
+			return nil
+		}, 2, hclog.NewNullLogger())
+}
+
+func createTestLogForStateSyncedEvent(t *testing.T, id uint64, block ethgo.Block) *ethgo.Log {
+	t.Helper()
+
+	data, err := abi.MustNewType("tuple(bytes data)").Encode(map[string]interface{}{"data": []byte{0x1}})
+	require.NoError(t, err)
+
+	return &ethgo.Log{
+		BlockNumber: block.Number,
+		BlockHash:   block.Hash,
+		Topics: []ethgo.Hash{
+			stateSyncEventSig,
+			ethgo.BytesToHash(common.EncodeUint64ToBytes(id)),
+			ethgo.BytesToHash(types.StringToAddress("0x1").Bytes()),
+			ethgo.BytesToHash(types.StringToAddress("0x2").Bytes()),
+		},
+		Data: data,
+	}
+}
+
+func TestRootchainReorgDetector_AddLog(t *testing.T) {
+	t.Parallel()
+
+	rootchain := &testRootchain{head: 10}
+	lastProcessedBlock := uint64(0)
+	forkBlocks := []uint64{}
+	detector := newTestRootchainReorgDetector(t, rootchain, &lastProcessedBlock, &forkBlocks)
+
+	block, err := rootchain.GetBlockByNumber(3, false)
+	require.NoError(t, err)
+
+	require.NoError(t, detector.addLog(createTestLogForStateSyncedEvent(t, 1, *block)))
+	require.NoError(t, detector.addLog(createTestLogForStateSyncedEvent(t, 2, *block)))
+	require.NoError(t, detector.addLog(createTestLogForStateSyncedEvent(t, 2, *block)))
+
+	exitProcessedLog := convertLog(createTestLogForExitProcessedEvent(t, 7, types.ZeroAddress))
+	exitProcessedLog.BlockNumber, exitProcessedLog.BlockHash = block.Number, block.Hash
+	require.NoError(t, detector.addLog(exitProcessedLog))
+
+	blocks, err := detector.store.getRootchainBlocks()
+	require.NoError(t, err)
+	require.Len(t, blocks, 1)
+	require.Equal(t, uint64(3), blocks[0].Number)
+	require.Equal(t, types.Hash(block.Hash), blocks[0].Hash)
+	require.Equal(t, []uint64{1, 2}, blocks[0].StateSyncIDs)
+	require.Equal(t, []uint64{7}, blocks[0].ProcessedExitIDs)
+}
+
+func TestRootchainReorgDetector_CheckReorg(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name              string
+		forkBlock         uint64
+		newHead           uint64
+		expectedForkBlock []uint64
+	}{
+		{
+			name:              "no reorg",
+			expectedForkBlock: []uint64{},
+		},
+		{
+			name:              "reorg after the last tracked event block",
+			forkBlock:         8,
+			newHead:           12,
+			expectedForkBlock: []uint64{7},
+		},
+		{
+			name:              "reorg between the tracked event blocks",
+			forkBlock:         5,
+			newHead:           12,
+			expectedForkBlock: []uint64{4},
+		},
+		{
+			name:              "reorg of all the tracked blocks",
+			forkBlock:         2,
+			newHead:           12,
+			expectedForkBlock: []uint64{3},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			rootchain := &testRootchain{head: 12}
+			lastProcessedBlock := uint64(10)
+			forkBlocks := []uint64{}
+			detector := newTestRootchainReorgDetector(t, rootchain, &lastProcessedBlock, &forkBlocks)
+
+			for _, number := range []uint64{3, 6} {
+				require.NoError(t, detector.store.insertRootchainBlock(&rootchainBlock{
+					Number: number, Hash: types.Hash(rootchain.hash(number)), StateSyncIDs: []uint64{number}}))
+			}
+
+			require.NoError(t, detector.checkReorg())
+			require.Empty(t, forkBlocks)
+
+			blocks, err := detector.store.getRootchainBlocks()
+			require.NoError(t, err)
+			require.Len(t, blocks, 3)
+			require.Equal(t, uint64(10), blocks[2].Number)
+
+			if c.newHead > 0 {
+				rootchain.fork(c.forkBlock, c.newHead)
+			}
+
+			require.NoError(t, detector.checkReorg())
+			require.Equal(t, c.expectedForkBlock, forkBlocks)
+		})
+	}
+}
+
+func TestRootchainReorgDetector_PruneTrackedBlocks(t *testing.T) {
+	t.Parallel()
+
+	rootchain := &testRootchain{head: 20}
+	lastProcessedBlock := uint64(0)
+	forkBlocks := []uint64{}
+	detector := newTestRootchainReorgDetector(t, rootchain, &lastProcessedBlock, &forkBlocks)
+	detector.window = 5
+
+	for _, number := range []uint64{3, 6, 9} {
+		require.NoError(t, detector.store.insertRootchainBlock(
+			&rootchainBlock{Number: number, Hash: types.Hash(rootchain.hash(number))}))
+	}
+
+	lastProcessedBlock = 12
+	require.NoError(t, detector.checkReorg())
+
+	blocks, err := detector.store.getRootchainBlocks()
+	require.NoError(t, err)
+	require.Len(t, blocks, 2)
+	require.Equal(t, uint64(9), blocks[0].Number)
+	require.Equal(t, uint64(12), blocks[1].Number)
+	require.Empty(t, forkBlocks)
+}
@@ -541,3 +541,9 @@ func (d *dummyStakeTxRelayer) Client() *jsonrpc.EthClient {
 func (d *dummyStakeTxRelayer) GetTxnHashes() []types.Hash {
 	return nil
 }
+
+func (d *dummyStakeTxRelayer) GetReplacedTxnHashes() map[types.Hash]types.Hash {
+	return nil
+}
+
+func (d *dummyStakeTxRelayer) Close() {}
@@ -43,6 +43,8 @@ type State struct {
 	ProposerSnapshotStore *ProposerSnapshotStore
 	StakeStore            *StakeStore
 	GovernanceStore       *GovernanceStore
+	TokenMappingStore     *TokenMappingStore
+	RootchainStore        *RootchainStore
 }
 
 // newState creates new instance of State
@@ -61,6 +63,8 @@ func newState(path string, closeCh chan struct{}) (*State, error) {
 		ProposerSnapshotStore: &ProposerSnapshotStore{db: db},
 		StakeStore:            &StakeStore{db: db},
 		GovernanceStore:       &GovernanceStore{db: db},
+		TokenMappingStore:     &TokenMappingStore{db: db},
+		RootchainStore:        &RootchainStore{db: db},
 	}
 
 	if err = s.initStorages(); err != nil {
@@ -94,6 +98,14 @@ func (s *State) initStorages() error {
 			return err
 		}
 
+		if err := s.TokenMappingStore.initialize(tx); err != nil {
+			return err
+		}
+
+		if err := s.RootchainStore.initialize(tx); err != nil {
+			return err
+		}
+
 		_, err := tx.CreateBucketIfNotExists(edgeEventsLastProcessedBlockBucket)
 		if err != nil {
 			return fmt.Errorf("failed to create bucket=%s: %w", string(edgeEventsLastProcessedBlockBucket), err)
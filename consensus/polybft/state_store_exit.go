@@ -18,6 +18,11 @@ var (
 	exitEventsBucket             = []byte("exitEvent")
 	exitEventToEpochLookupBucket = []byte("exitIdToEpochLookup")
 	exitRelayerEventsBucket      = []byte("exitRelayerEvents")
+	exitDeadLetterEventsBucket   = []byte("exitDeadLetterEvents")
+	exitTransfersBucket          = []byte("exitTransfers")
+	exitTxLookupBucket           = []byte("exitTxLookup")
+	checkpointsBucket            = []byte("checkpoints")
+	exitTreesBucket              = []byte("exitTrees")
 )
 
 type exitEventNotFoundError struct {
@@ -46,6 +51,16 @@ exit events/
 |--> (exitEventID) -> epochNumber
 relayerEvents/
 |--> RelayerEventData.EventID -> *RelayerEventData (json marshalled)
+deadLetterEvents/
+|--> RelayerEventData.EventID -> *RelayerEventData (json marshalled)
+exit transfers/
+|--> (exitEventID) -> transferStages (json marshalled)
+exit tx lookup/
+|--> child chain tx hash -> []exitEventID (json marshalled)
+checkpoints/
+|--> checkpoint block number -> *types.BridgeTransferStage (json marshalled)
+exit trees/
+|--> (epoch+checkpointBlock) -> *exitTree (json marshalled)
 */
 type ExitStore struct {
 	db *bolt.DB
@@ -65,6 +80,26 @@ func (s *ExitStore) initialize(tx *bolt.Tx) error {
 		return fmt.Errorf("failed to create bucket=%s: %w", string(exitRelayerEventsBucket), err)
 	}
 
+	if _, err := tx.CreateBucketIfNotExists(exitDeadLetterEventsBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(exitDeadLetterEventsBucket), err)
+	}
+
+	if _, err := tx.CreateBucketIfNotExists(exitTransfersBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(exitTransfersBucket), err)
+	}
+
+	if _, err := tx.CreateBucketIfNotExists(exitTxLookupBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(exitTxLookupBucket), err)
+	}
+
+	if _, err := tx.CreateBucketIfNotExists(checkpointsBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(checkpointsBucket), err)
+	}
+
+	if _, err := tx.CreateBucketIfNotExists(exitTreesBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(exitTreesBucket), err)
+	}
+
 	return nil
 }
 
@@ -151,22 +186,26 @@ func getExitEventSingle(exitEventID uint64, tx *bolt.Tx) (*ExitEvent, error) {
 func (s *ExitStore) getExitEventsByEpoch(epoch uint64) ([]*ExitEvent, error) {
 	return s.getExitEvents(epoch, func(exitEvent *ExitEvent) bool {
 		return exitEvent.EpochNumber == epoch
-	})
+	}, nil)
 }
 
 // getExitEventsForProof returns all exit events that happened in and prior to the given checkpoint block number
 // with respect to the epoch in which block is added
-func (s *ExitStore) getExitEventsForProof(epoch, checkpointBlock uint64) ([]*ExitEvent, error) {
+func (s *ExitStore) getExitEventsForProof(epoch, checkpointBlock uint64, dbTx *bolt.Tx) ([]*ExitEvent, error) {
 	return s.getExitEvents(epoch, func(exitEvent *ExitEvent) bool {
 		return exitEvent.EpochNumber == epoch && exitEvent.BlockNumber <= checkpointBlock
-	})
+	}, dbTx)
 }
 
 // getExitEvents returns exit events for given epoch and provided filter
-func (s *ExitStore) getExitEvents(epoch uint64, filter func(exitEvent *ExitEvent) bool) ([]*ExitEvent, error) {
-	var events []*ExitEvent
-
-	err := s.db.View(func(tx *bolt.Tx) error {
+func (s *ExitStore) getExitEvents(epoch uint64, filter func(exitEvent *ExitEvent) bool,
+	dbTx *bolt.Tx) ([]*ExitEvent, error) {
+	var (
+		events []*ExitEvent
+		err    error
+	)
+
+	getFn := func(tx *bolt.Tx) error {
 		c := tx.Bucket(exitEventsBucket).Cursor()
 		prefix := common.EncodeUint64ToBytes(epoch)
 
@@ -182,7 +221,13 @@ func (s *ExitStore) getExitEvents(epoch uint64, filter func(exitEvent *ExitEvent
 		}
 
 		return nil
-	})
+	}
+
+	if dbTx == nil {
+		err = s.db.View(getFn)
+	} else {
+		err = getFn(dbTx)
+	}
 
 	// enforce sequential order
 	sort.Slice(events, func(i, j int) bool {
@@ -192,10 +237,12 @@ func (s *ExitStore) getExitEvents(epoch uint64, filter func(exitEvent *ExitEvent
 	return events, err
 }
 
-// getAllAvailableRelayerEvents retrieves all Exit RelayerEventData that should be sent as a transactions
-func (s *ExitStore) GetAllAvailableRelayerEvents(limit int) (result []*RelayerEventMetaData, err error) {
+// getAllAvailableRelayerEvents retrieves all Exit RelayerEventData that should be sent as a transactions.
+// If isAvailable is provided, only events for which it returns true are retrieved and counted against the limit
+func (s *ExitStore) GetAllAvailableRelayerEvents(limit int,
+	isAvailable func(*RelayerEventMetaData) bool) (result []*RelayerEventMetaData, err error) {
 	if err = s.db.View(func(tx *bolt.Tx) error {
-		result, err = getAvailableRelayerEvents(limit, exitRelayerEventsBucket, tx)
+		result, err = getAvailableRelayerEvents(limit, isAvailable, exitRelayerEventsBucket, tx)
 		if err != nil {
 			return err
 		}
@@ -214,6 +261,155 @@ func (s *ExitStore) UpdateRelayerEvents(
 	return updateRelayerEvents(exitRelayerEventsBucket, events, removeIDs, s.db, dbTx)
 }
 
+// SetRelayerEventError saves the reason of the failed execution of given exit relayer event
+func (s *ExitStore) SetRelayerEventError(eventID uint64, reason string, dbTx *bolt.Tx) error {
+	return setRelayerEventError(exitRelayerEventsBucket, eventID, reason, s.db, dbTx)
+}
+
+// DeadLetterRelayerEvents moves given exit relayer events to the dead-letter queue
+func (s *ExitStore) DeadLetterRelayerEvents(events []*RelayerEventMetaData, dbTx *bolt.Tx) error {
+	return deadLetterRelayerEvents(exitRelayerEventsBucket, exitDeadLetterEventsBucket, events, s.db, dbTx)
+}
+
+// GetDeadLetterRelayerEvents retrieves all exit relayer events from the dead-letter queue
+func (s *ExitStore) GetDeadLetterRelayerEvents() (result []*RelayerEventMetaData, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		result, err = getAvailableRelayerEvents(0, nil, exitDeadLetterEventsBucket, tx)
+
+		return err
+	})
+
+	return result, err
+}
+
+// RetryDeadLetterRelayerEvents moves given exit relayer events from the dead-letter queue
+// back to the relayer queue, resetting their attempts
+func (s *ExitStore) RetryDeadLetterRelayerEvents(eventIDs []uint64) error {
+	return retryDeadLetterRelayerEvents(exitRelayerEventsBucket, exitDeadLetterEventsBucket, eventIDs, s.db)
+}
+
+// SkipDeadLetterRelayerEvents removes given exit relayer events from the dead-letter queue
+func (s *ExitStore) SkipDeadLetterRelayerEvents(eventIDs []uint64) error {
+	return skipDeadLetterRelayerEvents(exitDeadLetterEventsBucket, eventIDs, s.db)
+}
+
+// insertTransferStage saves the reached stage of the exit transfer with given id.
+// ExitEvent stage transaction is saved as the originating transaction of the transfer
+func (s *ExitStore) insertTransferStage(exitEventID uint64, stage *types.BridgeTransferStage, dbTx *bolt.Tx) error {
+	var lookupBucket []byte
+	if stage.Name == types.ExitEventStage {
+		lookupBucket = exitTxLookupBucket
+	}
+
+	insertFn := func(tx *bolt.Tx) error {
+		return insertTransferStage(tx, exitTransfersBucket, lookupBucket, []uint64{exitEventID}, stage)
+	}
+
+	if dbTx == nil {
+		return s.db.Update(insertFn)
+	}
+
+	return insertFn(dbTx)
+}
+
+// insertCheckpoint saves the rootchain block and transaction in which the checkpoint was submitted
+func (s *ExitStore) insertCheckpoint(checkpointBlock uint64, stage *types.BridgeTransferStage, dbTx *bolt.Tx) error {
+	raw, err := json.Marshal(stage)
+	if err != nil {
+		return err
+	}
+
+	insertFn := func(tx *bolt.Tx) error {
+		return tx.Bucket(checkpointsBucket).Put(common.EncodeUint64ToBytes(checkpointBlock), raw)
+	}
+
+	if dbTx == nil {
+		return s.db.Update(insertFn)
+	}
+
+	return insertFn(dbTx)
+}
+
+// insertExitTree saves the exit tree built for the given checkpoint block of the given epoch
+func (s *ExitStore) insertExitTree(epoch, checkpointBlock uint64, tree *exitTree) error {
+	raw, err := json.Marshal(tree)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(exitTreesBucket).Put(generateExitTreeKey(epoch, checkpointBlock), raw)
+	})
+}
+
+// getExitTree returns the exit tree built for the given checkpoint block of the given epoch,
+// or nil if the tree is not cached
+func (s *ExitStore) getExitTree(epoch, checkpointBlock uint64) (*exitTree, error) {
+	var tree *exitTree
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		raw := tx.Bucket(exitTreesBucket).Get(generateExitTreeKey(epoch, checkpointBlock))
+		if raw == nil {
+			return nil
+		}
+
+		return json.Unmarshal(raw, &tree)
+	})
+
+	return tree, err
+}
+
+// getTransferStatus returns the status of the exit transfer with given id.
+// Exit is checkpointed (and its proof is available) once the first checkpoint
+// which includes the exit event block is submitted
+func (s *ExitStore) getTransferStatus(exitEventID uint64) (*types.BridgeTransferStatus, error) {
+	var stages transferStages
+
+	err := s.db.View(func(tx *bolt.Tx) (err error) {
+		stages, err = getTransferStages(tx, exitTransfersBucket, exitEventID)
+		if err != nil {
+			return err
+		}
+
+		exitEvent, err := getExitEventSingle(exitEventID, tx)
+		if err != nil {
+			// exit event is not tracked by this node, so the checkpoint can not be determined
+			return nil //nolint:nilerr
+		}
+
+		_, raw := tx.Bucket(checkpointsBucket).Cursor().Seek(common.EncodeUint64ToBytes(exitEvent.BlockNumber))
+		if raw == nil {
+			return nil
+		}
+
+		var checkpoint *types.BridgeTransferStage
+		if err := json.Unmarshal(raw, &checkpoint); err != nil {
+			return err
+		}
+
+		stages[types.CheckpointedStage] = checkpoint
+		stages[types.ProofAvailableStage] = &types.BridgeTransferStage{Name: types.ProofAvailableStage}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newTransferStatus(types.ExitTransfer, exitEventID, types.ExitTransferStages, stages)
+}
+
+// getTransferIDsByTxHash returns ids of the exit events emitted by the child chain transaction with given hash
+func (s *ExitStore) getTransferIDsByTxHash(txHash types.Hash) (ids []uint64, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		ids, err = getTransferIDsByTxHash(tx, exitTxLookupBucket, txHash)
+
+		return err
+	})
+
+	return ids, err
+}
+
 func generateExitEventKey(exitEventID, epoch, blockNumber uint64) []byte {
 	return bytes.Join([][]byte{
 		common.EncodeUint64ToBytes(epoch),
@@ -221,6 +417,12 @@ func generateExitEventKey(exitEventID, epoch, blockNumber uint64) []byte {
 		common.EncodeUint64ToBytes(blockNumber)}, nil)
 }
 
+func generateExitTreeKey(epoch, checkpointBlock uint64) []byte {
+	return bytes.Join([][]byte{
+		common.EncodeUint64ToBytes(epoch),
+		common.EncodeUint64ToBytes(checkpointBlock)}, nil)
+}
+
 // decodeExitEvent tries to decode exit event from the provided log
 func decodeExitEvent(log *ethgo.Log, epoch, block uint64) (*ExitEvent, error) {
 	var l2StateSyncedEvent contractsapi.L2StateSyncedEvent
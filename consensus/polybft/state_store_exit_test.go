@@ -65,7 +65,7 @@ func TestState_Insert_And_Get_ExitEvents_ForProof(t *testing.T) {
 	}
 
 	for _, c := range cases {
-		events, err := state.ExitStore.getExitEventsForProof(c.epoch, c.checkpointBlockNumber)
+		events, err := state.ExitStore.getExitEventsForProof(c.epoch, c.checkpointBlockNumber, nil)
 
 		assert.NoError(t, err)
 		assert.Len(t, events, c.expectedNumberOfEvents)
@@ -78,12 +78,48 @@ func TestState_Insert_And_Get_ExitEvents_ForProof_NoEvents(t *testing.T) {
 	state := newTestState(t)
 	insertTestExitEvents(t, state, 1, 10, 1)
 
-	events, err := state.ExitStore.getExitEventsForProof(2, 11)
+	events, err := state.ExitStore.getExitEventsForProof(2, 11, nil)
 
 	assert.NoError(t, err)
 	assert.Nil(t, events)
 }
 
+func TestState_Insert_And_Get_ExitTree(t *testing.T) {
+	const (
+		epoch           = uint64(2)
+		checkpointBlock = uint64(14)
+	)
+
+	state := newTestState(t)
+
+	tree, err := state.ExitStore.getExitTree(epoch, checkpointBlock)
+	require.NoError(t, err)
+	require.Nil(t, tree)
+
+	expectedTree := &exitTree{
+		ExitIDs: []uint64{3, 4, 5},
+		Layers: [][]types.Hash{
+			{types.StringToHash("0x1"), types.StringToHash("0x2"), types.StringToHash("0x3")},
+			{types.StringToHash("0x4"), types.StringToHash("0x5")},
+			{types.StringToHash("0x6")},
+		},
+	}
+	require.NoError(t, state.ExitStore.insertExitTree(epoch, checkpointBlock, expectedTree))
+
+	tree, err = state.ExitStore.getExitTree(epoch, checkpointBlock)
+	require.NoError(t, err)
+	require.Equal(t, expectedTree, tree)
+
+	// tree is cached per epoch and checkpoint block
+	tree, err = state.ExitStore.getExitTree(epoch, checkpointBlock+1)
+	require.NoError(t, err)
+	require.Nil(t, tree)
+
+	tree, err = state.ExitStore.getExitTree(epoch+1, checkpointBlock)
+	require.NoError(t, err)
+	require.Nil(t, tree)
+}
+
 func TestState_NoEpochForExitEventInLookup(t *testing.T) {
 	t.Parallel()
 
@@ -218,7 +254,7 @@ func TestState_ExitRelayerDataAndEvents(t *testing.T) {
 	}, []uint64{}, nil))
 
 	// get available events
-	events, err := state.ExitStore.GetAllAvailableRelayerEvents(0)
+	events, err := state.ExitStore.GetAllAvailableRelayerEvents(0, nil)
 
 	require.NoError(t, err)
 	require.Len(t, events, 2)
@@ -236,7 +272,7 @@ func TestState_ExitRelayerDataAndEvents(t *testing.T) {
 	))
 
 	// get available events
-	events, err = state.ExitStore.GetAllAvailableRelayerEvents(10)
+	events, err = state.ExitStore.GetAllAvailableRelayerEvents(10, nil)
 
 	require.NoError(t, err)
 	require.Len(t, events, 2)
@@ -249,7 +285,7 @@ func TestState_ExitRelayerDataAndEvents(t *testing.T) {
 	require.NoError(t, state.ExitStore.UpdateRelayerEvents(events[1:2], []uint64{3}, nil))
 
 	// get available events with limit
-	events, err = state.ExitStore.GetAllAvailableRelayerEvents(2)
+	events, err = state.ExitStore.GetAllAvailableRelayerEvents(2, nil)
 
 	require.NoError(t, err)
 	require.Len(t, events, 1)
@@ -19,6 +19,8 @@ var (
 	forkParamsEventsBucket    = []byte("forkParamsEvents")
 	clientConfigBucket        = []byte("clientConfig")
 	clientConfigKey           = []byte("clientConfigKey")
+	clientConfigHistoryBucket = []byte("clientConfigHistory")
+	addressListRolesBucket    = []byte("addressListRoles")
 
 	errClientConfigNotFound = errors.New("client (polybft) config not found in db")
 )
@@ -31,6 +33,8 @@ type eventsRaw [][]byte
 // |--> epoch -> slice of contractsapi.EventAbi
 // |--> fork name hash -> block from which is active
 // |--> clientConfigKey -> *PolyBFTConfig
+// |--> block from which config is in effect -> *PolyBFTConfig
+// |--> epoch -> slice of *addressListRole
 type GovernanceStore struct {
 	db *bolt.DB
 }
@@ -52,6 +56,16 @@ func (g *GovernanceStore) initialize(tx *bolt.Tx) error {
 			string(clientConfigBucket), err)
 	}
 
+	if _, err := tx.CreateBucketIfNotExists(clientConfigHistoryBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w",
+			string(clientConfigHistoryBucket), err)
+	}
+
+	if _, err := tx.CreateBucketIfNotExists(addressListRolesBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w",
+			string(addressListRolesBucket), err)
+	}
+
 	return nil
 }
 
@@ -155,14 +169,29 @@ func (g *GovernanceStore) getAllForkEvents(dbTx *bolt.Tx) (map[types.Hash]*big.I
 	return allForks, err
 }
 
-// insertClientConfig inserts client (polybft) config to bolt db
-func (g *GovernanceStore) insertClientConfig(config *chain.Params, dbTx *bolt.Tx) error {
+// insertClientConfig inserts client (polybft) config, which is in effect from the given block, to bolt db
+func (g *GovernanceStore) insertClientConfig(fromBlock uint64, config *chain.Params, dbTx *bolt.Tx) error {
 	insertFn := func(tx *bolt.Tx) error {
 		raw, err := json.Marshal(config)
 		if err != nil {
 			return err
 		}
 
+		history := tx.Bucket(clientConfigHistoryBucket)
+
+		// config inserted before the history was kept is in effect until the given block
+		if k, _ := history.Cursor().First(); k == nil {
+			if latest := tx.Bucket(clientConfigBucket).Get(clientConfigKey); latest != nil {
+				if err := history.Put(common.EncodeUint64ToBytes(0), bytes.Clone(latest)); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := history.Put(common.EncodeUint64ToBytes(fromBlock), raw); err != nil {
+			return err
+		}
+
 		return tx.Bucket(clientConfigBucket).Put(clientConfigKey, raw)
 	}
 
@@ -202,6 +231,96 @@ func (g *GovernanceStore) getClientConfig(dbTx *bolt.Tx) (*chain.Params, error)
 	return config, err
 }
 
+// getClientConfigAt returns client (polybft) config in effect at the given block from bolt db.
+// The latest config is returned if the history is not kept yet, since it was inserted by the earlier version
+func (g *GovernanceStore) getClientConfigAt(block uint64, dbTx *bolt.Tx) (*chain.Params, error) {
+	var (
+		config *chain.Params
+		err    error
+	)
+
+	getFn := func(tx *bolt.Tx) error {
+		var (
+			key    = common.EncodeUint64ToBytes(block)
+			cursor = tx.Bucket(clientConfigHistoryBucket).Cursor()
+		)
+
+		k, val := cursor.Seek(key)
+		if k == nil {
+			k, val = cursor.Last()
+		} else if !bytes.Equal(k, key) {
+			k, val = cursor.Prev()
+		}
+
+		if k == nil {
+			val = tx.Bucket(clientConfigBucket).Get(clientConfigKey)
+		}
+
+		if val == nil {
+			return errClientConfigNotFound
+		}
+
+		return json.Unmarshal(val, &config)
+	}
+
+	if dbTx == nil {
+		err = g.db.View(func(tx *bolt.Tx) error {
+			return getFn(tx)
+		})
+	} else {
+		err = getFn(dbTx)
+	}
+
+	return config, err
+}
+
+// insertAddressListRoles inserts the address list roles, which are applied in the first block of the given epoch
+func (g *GovernanceStore) insertAddressListRoles(epoch uint64, roles []*addressListRole, dbTx *bolt.Tx) error {
+	insertFn := func(tx *bolt.Tx) error {
+		raw, err := json.Marshal(roles)
+		if err != nil {
+			return err
+		}
+
+		return tx.Bucket(addressListRolesBucket).Put(common.EncodeUint64ToBytes(epoch), raw)
+	}
+
+	if dbTx == nil {
+		return g.db.Update(func(tx *bolt.Tx) error {
+			return insertFn(tx)
+		})
+	}
+
+	return insertFn(dbTx)
+}
+
+// getAddressListRoles returns the address list roles, which are applied in the first block of the given epoch
+func (g *GovernanceStore) getAddressListRoles(epoch uint64, dbTx *bolt.Tx) ([]*addressListRole, error) {
+	var (
+		roles []*addressListRole
+		err   error
+	)
+
+	getFn := func(tx *bolt.Tx) error {
+		val := tx.Bucket(addressListRolesBucket).Get(common.EncodeUint64ToBytes(epoch))
+		if val != nil {
+			return json.Unmarshal(val, &roles)
+		}
+
+		return nil // this is valid, since the address list admins are rarely changed
+	}
+
+	if dbTx == nil {
+		err = g.db.View(func(tx *bolt.Tx) error {
+			return getFn(tx)
+		})
+	} else {
+		err = getFn(dbTx)
+	}
+
+	return roles, err
+}
+
 // networkParamsEventToByteArray marshals event but adds it's signature
 // to the beginning of marshaled array so that later we can know which type of event it is
 func networkParamsEventToByteArray(event contractsapi.EventAbi) ([]byte, error) {
@@ -1,6 +1,7 @@
 package polybft
 
 import (
+	"encoding/json"
 	"math/big"
 	"testing"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
 )
 
 func TestGovernanceStore_InsertAndGetEvents(t *testing.T) {
@@ -113,7 +115,7 @@ func TestGovernanceStore_InsertAndGetClientConfig(t *testing.T) {
 	require.ErrorIs(t, err, errClientConfigNotFound)
 
 	// insert config
-	require.NoError(t, state.GovernanceStore.insertClientConfig(initialConfig, nil))
+	require.NoError(t, state.GovernanceStore.insertClientConfig(0, initialConfig, nil))
 
 	// now config should exist
 	configFromDB, err := state.GovernanceStore.getClientConfig(nil)
@@ -133,6 +135,43 @@ func TestGovernanceStore_InsertAndGetClientConfig(t *testing.T) {
 	require.Equal(t, configFromDB.BaseFeeChangeDenom, initialConfig.BaseFeeChangeDenom)
 }
 
+func TestGovernanceStore_GetClientConfigAt(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+
+	// try get config when there is none
+	_, err := state.GovernanceStore.getClientConfigAt(10, nil)
+	require.ErrorIs(t, err, errClientConfigNotFound)
+
+	// latest config inserted without the history is returned for any block
+	require.NoError(t, state.db.Update(func(tx *bolt.Tx) error {
+		raw, err := json.Marshal(&chain.Params{BaseFeeChangeDenom: 4})
+		if err != nil {
+			return err
+		}
+
+		return tx.Bucket(clientConfigBucket).Put(clientConfigKey, raw)
+	}))
+
+	config, err := state.GovernanceStore.getClientConfigAt(10, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), config.BaseFeeChangeDenom)
+
+	require.NoError(t, state.GovernanceStore.insertClientConfig(11, &chain.Params{BaseFeeChangeDenom: 8}, nil))
+	require.NoError(t, state.GovernanceStore.insertClientConfig(21, &chain.Params{BaseFeeChangeDenom: 16}, nil))
+
+	for block, denom := range map[uint64]uint64{10: 4, 11: 8, 20: 8, 21: 16, 100: 16} {
+		config, err := state.GovernanceStore.getClientConfigAt(block, nil)
+		require.NoError(t, err)
+		require.Equal(t, denom, config.BaseFeeChangeDenom, "block %d", block)
+	}
+
+	config, err = state.GovernanceStore.getClientConfig(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), config.BaseFeeChangeDenom)
+}
+
 func createTestPolybftConfig() *PolyBFTConfig {
 	return &PolyBFTConfig{
 		InitialValidatorSet: []*validator.GenesisValidator{
@@ -0,0 +1,155 @@
+package polybft
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	rootchainBlocksBucket = []byte("rootchainBlocks")
+)
+
+// rootchainBlock is a rootchain block processed by the event tracker, whose hash is tracked
+// in order to detect the rootchain reorgs deeper than the number of block confirmations
+type rootchainBlock struct {
+	Number uint64     `json:"number"`
+	Hash   types.Hash `json:"hash"`
+	// StateSyncIDs are the ids of the state sync events emitted in the block
+	StateSyncIDs []uint64 `json:"stateSyncIds,omitempty"`
+	// ProcessedExitIDs are the ids of the exit events successfully processed in the block
+	ProcessedExitIDs []uint64 `json:"processedExitIds,omitempty"`
+}
+
+/*
+Bolt DB schema:
+
+rootchain blocks/
+|--> rootchain block number -> *rootchainBlock (json marshalled)
+*/
+type RootchainStore struct {
+	db *bolt.DB
+}
+
+// initialize creates necessary buckets in DB if they don't already exist
+func (s *RootchainStore) initialize(tx *bolt.Tx) error {
+	if _, err := tx.CreateBucketIfNotExists(rootchainBlocksBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(rootchainBlocksBucket), err)
+	}
+
+	return nil
+}
+
+// insertRootchainBlock saves the hash of the processed rootchain block, along with the ids of its events.
+// Hash of the already saved block is preserved, so if the block got reorged in the meantime,
+// the reorg is still detected, while the event ids are merged
+func (s *RootchainStore) insertRootchainBlock(newBlock *rootchainBlock) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(rootchainBlocksBucket)
+		key := common.EncodeUint64ToBytes(newBlock.Number)
+		block := &rootchainBlock{Number: newBlock.Number, Hash: newBlock.Hash}
+
+		if raw := bucket.Get(key); raw != nil {
+			if err := json.Unmarshal(raw, &block); err != nil {
+				return err
+			}
+		}
+
+		for _, id := range newBlock.StateSyncIDs {
+			if !slices.Contains(block.StateSyncIDs, id) {
+				block.StateSyncIDs = append(block.StateSyncIDs, id)
+			}
+		}
+
+		for _, id := range newBlock.ProcessedExitIDs {
+			if !slices.Contains(block.ProcessedExitIDs, id) {
+				block.ProcessedExitIDs = append(block.ProcessedExitIDs, id)
+			}
+		}
+
+		raw, err := json.Marshal(block)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(key, raw)
+	})
+}
+
+// getRootchainBlocks returns all the tracked rootchain blocks, ordered by the block number
+func (s *RootchainStore) getRootchainBlocks() ([]*rootchainBlock, error) {
+	var blocks []*rootchainBlock
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(rootchainBlocksBucket).ForEach(func(_, v []byte) error {
+			var block *rootchainBlock
+			if err := json.Unmarshal(v, &block); err != nil {
+				return err
+			}
+
+			blocks = append(blocks, block)
+
+			return nil
+		})
+	})
+
+	return blocks, err
+}
+
+// removeRootchainBlocks removes the tracked rootchain blocks starting from the given block number,
+// and returns the removed blocks
+func (s *RootchainStore) removeRootchainBlocks(fromBlock uint64) ([]*rootchainBlock, error) {
+	var removed []*rootchainBlock
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		c := tx.Bucket(rootchainBlocksBucket).Cursor()
+
+		for k, v := c.Seek(common.EncodeUint64ToBytes(fromBlock)); k != nil; k, v = c.Next() {
+			var block *rootchainBlock
+			if err := json.Unmarshal(v, &block); err != nil {
+				return err
+			}
+
+			removed = append(removed, block)
+		}
+
+		for _, block := range removed {
+			if err := tx.Bucket(rootchainBlocksBucket).Delete(common.EncodeUint64ToBytes(block.Number)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return removed, err
+}
+
+// pruneRootchainBlocks removes the tracked rootchain blocks older than the given block number
+func (s *RootchainStore) pruneRootchainBlocks(toBlock uint64) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(rootchainBlocksBucket)
+		c := bucket.Cursor()
+		toKey := common.EncodeUint64ToBytes(toBlock)
+
+		var keys [][]byte
+
+		for k, _ := c.First(); k != nil && bytes.Compare(k, toKey) < 0; k, _ = c.Next() {
+			keys = append(keys, bytes.Clone(k))
+		}
+
+		for _, k := range keys {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,61 @@
+package polybft
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestState_Insert_And_Get_RootchainBlocks(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+
+	blocks, err := state.RootchainStore.getRootchainBlocks()
+	require.NoError(t, err)
+	require.Empty(t, blocks)
+
+	hash5, hash7, hash9 := types.StringToHash("0x5"), types.StringToHash("0x7"), types.StringToHash("0x9")
+
+	for _, block := range []*rootchainBlock{
+		{Number: 9, Hash: hash9},
+		{Number: 5, Hash: hash5, StateSyncIDs: []uint64{1}},
+		{Number: 5, Hash: hash5, StateSyncIDs: []uint64{2}, ProcessedExitIDs: []uint64{8}},
+		{Number: 5, Hash: hash5, StateSyncIDs: []uint64{2}, ProcessedExitIDs: []uint64{8}},
+		{Number: 7, Hash: hash7, StateSyncIDs: []uint64{3}},
+		// hash of the already tracked block is preserved, while event ids are merged
+		{Number: 7, Hash: types.StringToHash("0x77"), StateSyncIDs: []uint64{4}},
+	} {
+		require.NoError(t, state.RootchainStore.insertRootchainBlock(block))
+	}
+
+	blocks, err = state.RootchainStore.getRootchainBlocks()
+	require.NoError(t, err)
+	require.Equal(t, []*rootchainBlock{
+		{Number: 5, Hash: hash5, StateSyncIDs: []uint64{1, 2}, ProcessedExitIDs: []uint64{8}},
+		{Number: 7, Hash: hash7, StateSyncIDs: []uint64{3, 4}},
+		{Number: 9, Hash: hash9},
+	}, blocks)
+
+	removed, err := state.RootchainStore.removeRootchainBlocks(6)
+	require.NoError(t, err)
+	require.Equal(t, []*rootchainBlock{
+		{Number: 7, Hash: hash7, StateSyncIDs: []uint64{3, 4}},
+		{Number: 9, Hash: hash9},
+	}, removed)
+
+	blocks, err = state.RootchainStore.getRootchainBlocks()
+	require.NoError(t, err)
+	require.Len(t, blocks, 1)
+	require.Equal(t, uint64(5), blocks[0].Number)
+
+	require.NoError(t, state.RootchainStore.insertRootchainBlock(&rootchainBlock{Number: 8, Hash: hash7}))
+	require.NoError(t, state.RootchainStore.pruneRootchainBlocks(8))
+
+	blocks, err = state.RootchainStore.getRootchainBlocks()
+	require.NoError(t, err)
+	require.Len(t, blocks, 1)
+	require.Equal(t, uint64(8), blocks[0].Number)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
 	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
 	bolt "go.etcd.io/bbolt"
 )
 
@@ -21,6 +22,12 @@ var (
 	messageVotesBucket = []byte("votes")
 	// bucket to store all state sync relayer events
 	stateSyncRelayerEventsBucket = []byte("stateSyncRelayerEvents")
+	// bucket to store state sync relayer events which could not be relayed
+	stateSyncDeadLetterEventsBucket = []byte("stateSyncDeadLetterEvents")
+	// bucket to store reached stages of state sync transfers
+	stateSyncTransfersBucket = []byte("stateSyncTransfers")
+	// bucket to store state sync ids by the hash of the rootchain transaction which emitted them
+	stateSyncTxLookupBucket = []byte("stateSyncTxLookup")
 
 	// errNotEnoughStateSyncs error message
 	errNotEnoughStateSyncs = errors.New("there is either a gap or not enough sync events")
@@ -42,6 +49,15 @@ stateSyncProofs/
 
 relayerEvents/
 |--> RelayerEventData.EventID -> *RelayerEventData (json marshalled)
+
+deadLetterEvents/
+|--> RelayerEventData.EventID -> *RelayerEventData (json marshalled)
+
+stateSyncTransfers/
+|--> stateSyncEvent.Id -> transferStages (json marshalled)
+
+stateSyncTxLookup/
+|--> rootchain tx hash -> []stateSyncEvent.Id (json marshalled)
 */
 
 type StateSyncStore struct {
@@ -66,12 +82,24 @@ func (s *StateSyncStore) initialize(tx *bolt.Tx) error {
 		return fmt.Errorf("failed to create bucket=%s: %w", string(stateSyncRelayerEventsBucket), err)
 	}
 
+	if _, err := tx.CreateBucketIfNotExists(stateSyncDeadLetterEventsBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(stateSyncDeadLetterEventsBucket), err)
+	}
+
+	if _, err := tx.CreateBucketIfNotExists(stateSyncTransfersBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(stateSyncTransfersBucket), err)
+	}
+
+	if _, err := tx.CreateBucketIfNotExists(stateSyncTxLookupBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(stateSyncTxLookupBucket), err)
+	}
+
 	return nil
 }
 
 // insertStateSyncEvent inserts a new state sync event to state event bucket in db
-func (s *StateSyncStore) insertStateSyncEvent(event *contractsapi.StateSyncedEvent) error {
-	return s.db.Update(func(tx *bolt.Tx) error {
+func (s *StateSyncStore) insertStateSyncEvent(event *contractsapi.StateSyncedEvent, dbTx *bolt.Tx) error {
+	insertFn := func(tx *bolt.Tx) error {
 		raw, err := json.Marshal(event)
 		if err != nil {
 			return err
@@ -80,7 +108,13 @@ func (s *StateSyncStore) insertStateSyncEvent(event *contractsapi.StateSyncedEve
 		bucket := tx.Bucket(stateSyncEventsBucket)
 
 		return bucket.Put(common.EncodeUint64ToBytes(event.ID.Uint64()), raw)
-	})
+	}
+
+	if dbTx == nil {
+		return s.db.Update(insertFn)
+	}
+
+	return insertFn(dbTx)
 }
 
 // removeStateSyncEventsAndProofs removes state sync events and their proofs from the buckets in db
@@ -381,10 +415,12 @@ func (s *StateSyncStore) UpdateRelayerEvents(
 	return updateRelayerEvents(stateSyncRelayerEventsBucket, events, removeIDs, s.db, dbTx)
 }
 
-// getAllAvailableRelayerEvents retrieves all StateSync RelayerEventData that should be sent as a transactions
-func (s *StateSyncStore) GetAllAvailableRelayerEvents(limit int) (result []*RelayerEventMetaData, err error) {
+// getAllAvailableRelayerEvents retrieves all StateSync RelayerEventData that should be sent as a transactions.
+// If isAvailable is provided, only events for which it returns true are retrieved and counted against the limit
+func (s *StateSyncStore) GetAllAvailableRelayerEvents(limit int,
+	isAvailable func(*RelayerEventMetaData) bool) (result []*RelayerEventMetaData, err error) {
 	if err = s.db.View(func(tx *bolt.Tx) error {
-		result, err = getAvailableRelayerEvents(limit, stateSyncRelayerEventsBucket, tx)
+		result, err = getAvailableRelayerEvents(limit, isAvailable, stateSyncRelayerEventsBucket, tx)
 		if err != nil {
 			return err
 		}
@@ -397,8 +433,10 @@ func (s *StateSyncStore) GetAllAvailableRelayerEvents(limit int) (result []*Rela
 	return result, nil
 }
 
-// getAvailableRelayerEvents retrieves all relayer that should be sent as a transactions
-func getAvailableRelayerEvents(limit int, bucket []byte, tx *bolt.Tx) (result []*RelayerEventMetaData, err error) {
+// getAvailableRelayerEvents retrieves all relayer that should be sent as a transactions,
+// skipping the ones for which isAvailable (if provided) returns false
+func getAvailableRelayerEvents(limit int, isAvailable func(*RelayerEventMetaData) bool,
+	bucket []byte, tx *bolt.Tx) (result []*RelayerEventMetaData, err error) {
 	cursor := tx.Bucket(bucket).Cursor()
 
 	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
@@ -408,6 +446,10 @@ func getAvailableRelayerEvents(limit int, bucket []byte, tx *bolt.Tx) (result []
 			return
 		}
 
+		if isAvailable != nil && !isAvailable(event) {
+			continue
+		}
+
 		result = append(result, event)
 
 		if limit > 0 && len(result) >= limit {
@@ -460,3 +502,181 @@ func updateRelayerEvents(
 
 	return updateFn(openedTx)
 }
+
+// SetRelayerEventError saves the reason of the failed execution of given state sync relayer event
+func (s *StateSyncStore) SetRelayerEventError(eventID uint64, reason string, dbTx *bolt.Tx) error {
+	return setRelayerEventError(stateSyncRelayerEventsBucket, eventID, reason, s.db, dbTx)
+}
+
+// DeadLetterRelayerEvents moves given state sync relayer events to the dead-letter queue
+func (s *StateSyncStore) DeadLetterRelayerEvents(events []*RelayerEventMetaData, dbTx *bolt.Tx) error {
+	return deadLetterRelayerEvents(stateSyncRelayerEventsBucket, stateSyncDeadLetterEventsBucket, events, s.db, dbTx)
+}
+
+// GetDeadLetterRelayerEvents retrieves all state sync relayer events from the dead-letter queue
+func (s *StateSyncStore) GetDeadLetterRelayerEvents() (result []*RelayerEventMetaData, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		result, err = getAvailableRelayerEvents(0, nil, stateSyncDeadLetterEventsBucket, tx)
+
+		return err
+	})
+
+	return result, err
+}
+
+// RetryDeadLetterRelayerEvents moves given state sync relayer events from the dead-letter queue
+// back to the relayer queue, resetting their attempts
+func (s *StateSyncStore) RetryDeadLetterRelayerEvents(eventIDs []uint64) error {
+	return retryDeadLetterRelayerEvents(stateSyncRelayerEventsBucket, stateSyncDeadLetterEventsBucket, eventIDs, s.db)
+}
+
+// SkipDeadLetterRelayerEvents removes given state sync relayer events from the dead-letter queue
+func (s *StateSyncStore) SkipDeadLetterRelayerEvents(eventIDs []uint64) error {
+	return skipDeadLetterRelayerEvents(stateSyncDeadLetterEventsBucket, eventIDs, s.db)
+}
+
+// setRelayerEventError saves the reason of the failed execution of given relayer event and marks it as not sent,
+// so that it is resent once its backoff period expires. Unknown events are ignored
+func setRelayerEventError(bucket []byte, eventID uint64, reason string, db *bolt.DB, openedTx *bolt.Tx) error {
+	updateFn := func(tx *bolt.Tx) error {
+		relayerEventsBucket := tx.Bucket(bucket)
+		key := common.EncodeUint64ToBytes(eventID)
+
+		raw := relayerEventsBucket.Get(key)
+		if raw == nil {
+			return nil
+		}
+
+		var event *RelayerEventMetaData
+		if err := json.Unmarshal(raw, &event); err != nil {
+			return err
+		}
+
+		event.SentStatus = false
+		event.LastError = reason
+
+		raw, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		return relayerEventsBucket.Put(key, raw)
+	}
+
+	if openedTx == nil {
+		return db.Update(updateFn)
+	}
+
+	return updateFn(openedTx)
+}
+
+// deadLetterRelayerEvents moves given relayer events from the relayer events bucket to the dead-letter bucket
+func deadLetterRelayerEvents(bucket, deadLetterBucket []byte, events []*RelayerEventMetaData,
+	db *bolt.DB, openedTx *bolt.Tx) error {
+	updateFn := func(tx *bolt.Tx) error {
+		eventIDs := make([]uint64, len(events))
+		for i, event := range events {
+			eventIDs[i] = event.EventID
+		}
+
+		if err := updateRelayerEvents(deadLetterBucket, events, nil, db, tx); err != nil {
+			return err
+		}
+
+		return updateRelayerEvents(bucket, nil, eventIDs, db, tx)
+	}
+
+	if openedTx == nil {
+		return db.Update(updateFn)
+	}
+
+	return updateFn(openedTx)
+}
+
+// retryDeadLetterRelayerEvents moves given relayer events from the dead-letter bucket back to
+// the relayer events bucket, resetting their attempts
+func retryDeadLetterRelayerEvents(bucket, deadLetterBucket []byte, eventIDs []uint64, db *bolt.DB) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		events := make([]*RelayerEventMetaData, len(eventIDs))
+
+		for i, eventID := range eventIDs {
+			raw := tx.Bucket(deadLetterBucket).Get(common.EncodeUint64ToBytes(eventID))
+			if raw == nil {
+				return fmt.Errorf("event %d is not in the dead-letter queue", eventID)
+			}
+
+			if err := json.Unmarshal(raw, &events[i]); err != nil {
+				return err
+			}
+
+			events[i].CountTries = 0
+			events[i].BlockNumber = 0
+			events[i].SentStatus = false
+		}
+
+		if err := updateRelayerEvents(bucket, events, nil, db, tx); err != nil {
+			return err
+		}
+
+		return updateRelayerEvents(deadLetterBucket, nil, eventIDs, db, tx)
+	})
+}
+
+// skipDeadLetterRelayerEvents removes given relayer events from the dead-letter bucket
+func skipDeadLetterRelayerEvents(deadLetterBucket []byte, eventIDs []uint64, db *bolt.DB) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		for _, eventID := range eventIDs {
+			if tx.Bucket(deadLetterBucket).Get(common.EncodeUint64ToBytes(eventID)) == nil {
+				return fmt.Errorf("event %d is not in the dead-letter queue", eventID)
+			}
+		}
+
+		return updateRelayerEvents(deadLetterBucket, nil, eventIDs, db, tx)
+	})
+}
+
+// insertTransferStage saves the reached stage of the state sync transfers with given ids.
+// StateSynced stage transaction is saved as the originating transaction of the transfers
+func (s *StateSyncStore) insertTransferStage(stateSyncIDs []uint64,
+	stage *types.BridgeTransferStage, dbTx *bolt.Tx) error {
+	var lookupBucket []byte
+	if stage.Name == types.StateSyncedStage {
+		lookupBucket = stateSyncTxLookupBucket
+	}
+
+	insertFn := func(tx *bolt.Tx) error {
+		return insertTransferStage(tx, stateSyncTransfersBucket, lookupBucket, stateSyncIDs, stage)
+	}
+
+	if dbTx == nil {
+		return s.db.Update(insertFn)
+	}
+
+	return insertFn(dbTx)
+}
+
+// getTransferStatus returns the status of the state sync transfer with given id
+func (s *StateSyncStore) getTransferStatus(stateSyncID uint64) (*types.BridgeTransferStatus, error) {
+	var stages transferStages
+
+	if err := s.db.View(func(tx *bolt.Tx) (err error) {
+		stages, err = getTransferStages(tx, stateSyncTransfersBucket, stateSyncID)
+
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return newTransferStatus(types.StateSyncTransfer, stateSyncID, types.StateSyncTransferStages, stages)
+}
+
+// getTransferIDsByTxHash returns ids of the state syncs emitted by the rootchain transaction with given hash
+func (s *StateSyncStore) getTransferIDsByTxHash(txHash types.Hash) (ids []uint64, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		ids, err = getTransferIDsByTxHash(tx, stateSyncTxLookupBucket, txHash)
+
+		return err
+	})
+
+	return ids, err
+}
@@ -25,7 +25,7 @@ func TestState_InsertEvent(t *testing.T) {
 		Data:     []byte{},
 	}
 
-	err := state.StateSyncStore.insertStateSyncEvent(event1)
+	err := state.StateSyncStore.insertStateSyncEvent(event1, nil)
 	assert.NoError(t, err)
 
 	events, err := state.StateSyncStore.list()
@@ -64,7 +64,7 @@ func TestState_getStateSyncEventsForCommitment_NotEnoughEvents(t *testing.T) {
 		assert.NoError(t, state.StateSyncStore.insertStateSyncEvent(&contractsapi.StateSyncedEvent{
 			ID:   big.NewInt(int64(i)),
 			Data: []byte{1, 2},
-		}))
+		}, nil))
 	}
 
 	_, err := state.StateSyncStore.getStateSyncEventsForCommitment(0, maxCommitmentSize-1, nil)
@@ -80,7 +80,7 @@ func TestState_getStateSyncEventsForCommitment(t *testing.T) {
 		assert.NoError(t, state.StateSyncStore.insertStateSyncEvent(&contractsapi.StateSyncedEvent{
 			ID:   big.NewInt(int64(i)),
 			Data: []byte{1, 2},
-		}))
+		}, nil))
 	}
 
 	t.Run("Return all - forced. Enough events", func(t *testing.T) {
@@ -312,7 +312,7 @@ func TestState_StateSync_StateSyncRelayerDataAndEvents(t *testing.T) {
 	}, []uint64{}, nil))
 
 	// get available events
-	events, err := state.StateSyncStore.GetAllAvailableRelayerEvents(0)
+	events, err := state.StateSyncStore.GetAllAvailableRelayerEvents(0, nil)
 
 	require.NoError(t, err)
 	require.Len(t, events, 3)
@@ -332,7 +332,7 @@ func TestState_StateSync_StateSyncRelayerDataAndEvents(t *testing.T) {
 	))
 
 	// get available events
-	events, err = state.StateSyncStore.GetAllAvailableRelayerEvents(1000)
+	events, err = state.StateSyncStore.GetAllAvailableRelayerEvents(1000, nil)
 
 	require.NoError(t, err)
 	require.Len(t, events, 4)
@@ -346,7 +346,7 @@ func TestState_StateSync_StateSyncRelayerDataAndEvents(t *testing.T) {
 	require.NoError(t, state.StateSyncStore.UpdateRelayerEvents(events[1:2], []uint64{2}, nil))
 
 	// get available events with limit
-	events, err = state.StateSyncStore.GetAllAvailableRelayerEvents(2)
+	events, err = state.StateSyncStore.GetAllAvailableRelayerEvents(2, nil)
 
 	require.NoError(t, err)
 	require.Len(t, events, 2)
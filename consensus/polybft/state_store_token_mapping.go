@@ -0,0 +1,202 @@
+package polybft
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	tokenMappingsBucket = []byte("tokenMappings")
+)
+
+/*
+Bolt DB schema:
+
+token mappings/
+|--> (tokenType+rootToken+childToken) -> *types.BridgeTokenMapping (json marshalled)
+*/
+type TokenMappingStore struct {
+	db *bolt.DB
+}
+
+// initialize creates necessary buckets in DB if they don't already exist
+func (s *TokenMappingStore) initialize(tx *bolt.Tx) error {
+	if _, err := tx.CreateBucketIfNotExists(tokenMappingsBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(tokenMappingsBucket), err)
+	}
+
+	return nil
+}
+
+// insertTokenMapping saves the token mapping. Since the same mapping is reported both on the rootchain
+// and on the child chain, the block numbers of the already saved mapping are preserved
+func (s *TokenMappingStore) insertTokenMapping(mapping *types.BridgeTokenMapping, dbTx *bolt.Tx) error {
+	insertFn := func(tx *bolt.Tx) error {
+		key, err := tokenMappingKey(mapping)
+		if err != nil {
+			return err
+		}
+
+		bucket := tx.Bucket(tokenMappingsBucket)
+		merged := *mapping
+
+		if raw := bucket.Get(key); raw != nil {
+			var existing types.BridgeTokenMapping
+			if err := json.Unmarshal(raw, &existing); err != nil {
+				return err
+			}
+
+			if merged.RootBlock == 0 {
+				merged.RootBlock = existing.RootBlock
+			}
+
+			if merged.ChildBlock == 0 {
+				merged.ChildBlock = existing.ChildBlock
+			}
+		}
+
+		raw, err := json.Marshal(&merged)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(key, raw)
+	}
+
+	if dbTx == nil {
+		return s.db.Update(func(tx *bolt.Tx) error {
+			return insertFn(tx)
+		})
+	}
+
+	return insertFn(dbTx)
+}
+
+// removeRootTokenMappings rolls back the token mappings reported in the rootchain blocks starting
+// from the given block number. Mappings which are reported on the child chain as well are kept,
+// without the rootchain block, while the others are removed
+func (s *TokenMappingStore) removeRootTokenMappings(fromBlock uint64) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(tokenMappingsBucket)
+
+		var (
+			removedKeys [][]byte
+			updated     = map[string]*types.BridgeTokenMapping{}
+		)
+
+		err := bucket.ForEach(func(k, v []byte) error {
+			var mapping *types.BridgeTokenMapping
+			if err := json.Unmarshal(v, &mapping); err != nil {
+				return err
+			}
+
+			if mapping.RootBlock < fromBlock {
+				return nil
+			}
+
+			if mapping.ChildBlock == 0 {
+				removedKeys = append(removedKeys, bytes.Clone(k))
+			} else {
+				mapping.RootBlock = 0
+				updated[string(k)] = mapping
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, k := range removedKeys {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		for k, mapping := range updated {
+			raw, err := json.Marshal(mapping)
+			if err != nil {
+				return err
+			}
+
+			if err := bucket.Put([]byte(k), raw); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// getTokenMappings returns the page of token mappings matching the query,
+// ordered by the token type and the token addresses
+func (s *TokenMappingStore) getTokenMappings(
+	query *types.BridgeTokenMappingQuery) (*types.BridgeTokenMappings, error) {
+	var prefix []byte
+
+	if query.TokenType != "" {
+		typePrefix, err := tokenTypePrefix(query.TokenType)
+		if err != nil {
+			return nil, err
+		}
+
+		prefix = []byte{typePrefix}
+	}
+
+	result := &types.BridgeTokenMappings{Mappings: []*types.BridgeTokenMapping{}}
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(tokenMappingsBucket).Cursor()
+
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			result.Total++
+
+			if result.Total <= query.Offset ||
+				(query.Limit > 0 && uint64(len(result.Mappings)) >= query.Limit) {
+				continue
+			}
+
+			var mapping *types.BridgeTokenMapping
+			if err := json.Unmarshal(v, &mapping); err != nil {
+				return err
+			}
+
+			result.Mappings = append(result.Mappings, mapping)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// tokenMappingKey returns the db key of the token mapping,
+// which is prefixed by the token type so that the mappings can be filtered by it
+func tokenMappingKey(mapping *types.BridgeTokenMapping) ([]byte, error) {
+	typePrefix, err := tokenTypePrefix(mapping.TokenType)
+	if err != nil {
+		return nil, err
+	}
+
+	key := make([]byte, 0, 1+2*types.AddressLength)
+	key = append(key, typePrefix)
+	key = append(key, mapping.RootToken.Bytes()...)
+
+	return append(key, mapping.ChildToken.Bytes()...), nil
+}
+
+// tokenTypePrefix returns the db key prefix of given token type
+func tokenTypePrefix(tokenType types.BridgeTokenType) (byte, error) {
+	for i, t := range types.BridgeTokenTypes {
+		if t == tokenType {
+			return byte(i + 1), nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown token type: %s", tokenType)
+}
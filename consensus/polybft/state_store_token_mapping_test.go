@@ -0,0 +1,122 @@
+package polybft
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestTokenMappingStore_GetTokenMappings(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+
+	for i, tokenType := range []types.BridgeTokenType{
+		types.ERC1155BridgeToken, types.ERC20BridgeToken, types.ERC721BridgeToken,
+		types.ERC20BridgeToken, types.ERC20BridgeToken,
+	} {
+		require.NoError(t, state.TokenMappingStore.insertTokenMapping(&types.BridgeTokenMapping{
+			TokenType:  tokenType,
+			RootToken:  types.BytesToAddress([]byte{byte(i + 1)}),
+			ChildToken: types.BytesToAddress([]byte{byte(i + 100)}),
+			RootBlock:  uint64(i + 1),
+		}, nil))
+	}
+
+	cases := []struct {
+		name        string
+		query       *types.BridgeTokenMappingQuery
+		total       uint64
+		rootTokens  []byte
+		expectedErr string
+	}{
+		{"all", &types.BridgeTokenMappingQuery{}, 5, []byte{2, 4, 5, 3, 1}, ""},
+		{"paged", &types.BridgeTokenMappingQuery{Offset: 1, Limit: 2}, 5, []byte{4, 5}, ""},
+		{"by type", &types.BridgeTokenMappingQuery{TokenType: types.ERC20BridgeToken, Offset: 2}, 3, []byte{5}, ""},
+		{"offset out of range", &types.BridgeTokenMappingQuery{Offset: 10}, 5, []byte{}, ""},
+		{"unknown type", &types.BridgeTokenMappingQuery{TokenType: "erc777"}, 0, nil, "unknown token type: erc777"},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := state.TokenMappingStore.getTokenMappings(c.query)
+			if c.expectedErr != "" {
+				require.ErrorContains(t, err, c.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, c.total, result.Total)
+
+			rootTokens := make([]byte, len(result.Mappings))
+			for i, mapping := range result.Mappings {
+				rootTokens[i] = mapping.RootToken[types.AddressLength-1]
+			}
+
+			require.Equal(t, c.rootTokens, rootTokens)
+		})
+	}
+}
+
+func TestTokenMappingStore_InsertTokenMapping_PreservesBlocks(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+	mapping := &types.BridgeTokenMapping{
+		TokenType:  types.ERC721BridgeToken,
+		RootToken:  types.StringToAddress("0x1"),
+		ChildToken: types.StringToAddress("0x2"),
+	}
+
+	rootMapping := *mapping
+	rootMapping.RootBlock = 7
+	require.NoError(t, state.TokenMappingStore.insertTokenMapping(&rootMapping, nil))
+
+	childMapping := *mapping
+	childMapping.ChildBlock = 3
+	require.NoError(t, state.TokenMappingStore.insertTokenMapping(&childMapping, nil))
+
+	result, err := state.TokenMappingStore.getTokenMappings(&types.BridgeTokenMappingQuery{})
+	require.NoError(t, err)
+	require.Len(t, result.Mappings, 1)
+	require.Equal(t, uint64(7), result.Mappings[0].RootBlock)
+	require.Equal(t, uint64(3), result.Mappings[0].ChildBlock)
+}
+
+func TestTokenMappingStore_RemoveRootTokenMappings(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+
+	mappings := []*types.BridgeTokenMapping{
+		{RootBlock: 5},
+		{RootBlock: 10},
+		{RootBlock: 12, ChildBlock: 30},
+		{ChildBlock: 40},
+	}
+
+	for i, mapping := range mappings {
+		mapping.TokenType = types.ERC20BridgeToken
+		mapping.RootToken = types.BytesToAddress([]byte{byte(i + 1)})
+		mapping.ChildToken = types.BytesToAddress([]byte{byte(i + 100)})
+
+		require.NoError(t, state.TokenMappingStore.insertTokenMapping(mapping, nil))
+	}
+
+	require.NoError(t, state.TokenMappingStore.removeRootTokenMappings(10))
+
+	result, err := state.TokenMappingStore.getTokenMappings(&types.BridgeTokenMappingQuery{})
+	require.NoError(t, err)
+
+	// mapping reported only in the reorged rootchain block is removed,
+	// while the one reported on the child chain as well loses its rootchain block
+	mappings[2].RootBlock = 0
+	require.Equal(t, []*types.BridgeTokenMapping{mappings[0], mappings[2], mappings[3]}, result.Mappings)
+}
@@ -155,8 +155,9 @@ func (cm *CommitmentMessageSigned) DecodeAbi(txData []byte) error {
 	return nil
 }
 
-// getCommitmentMessageSignedTx returns a CommitmentMessageSigned object from a commit state transaction
-func getCommitmentMessageSignedTx(txs []*types.Transaction) (*CommitmentMessageSigned, error) {
+// getCommitmentMessageSignedTx returns a CommitmentMessageSigned object from a commit state transaction,
+// along with the hash of that transaction
+func getCommitmentMessageSignedTx(txs []*types.Transaction) (*CommitmentMessageSigned, types.Hash, error) {
 	var commitFn contractsapi.CommitStateReceiverFn
 	for _, tx := range txs {
 		// skip non state CommitmentMessageSigned transactions
@@ -169,13 +170,13 @@ func getCommitmentMessageSignedTx(txs []*types.Transaction) (*CommitmentMessageS
 		obj := &CommitmentMessageSigned{}
 
 		if err := obj.DecodeAbi(tx.Input()); err != nil {
-			return nil, fmt.Errorf("get commitment message signed tx error: %w", err)
+			return nil, types.ZeroHash, fmt.Errorf("get commitment message signed tx error: %w", err)
 		}
 
-		return obj, nil
+		return obj, tx.Hash(), nil
 	}
 
-	return nil, nil
+	return nil, types.ZeroHash, nil
 }
 
 // createMerkleTree creates a merkle tree from provided state sync events
@@ -37,11 +37,12 @@ type StateSyncProof struct {
 type StateSyncManager interface {
 	EventSubscriber
 	Init() error
-	AddLog(eventLog *ethgo.Log) error
+	AddLog(eventLog *ethgo.Log, dbTx *bolt.Tx) error
 	Commitment(blockNumber uint64) (*CommitmentMessageSigned, error)
 	GetStateSyncProof(stateSyncID uint64) (types.Proof, error)
 	PostBlock(req *PostBlockRequest) error
 	PostEpoch(req *PostEpochRequest) error
+	Rollback(stateSyncIDs []uint64) error
 }
 
 var _ StateSyncManager = (*dummyStateSyncManager)(nil)
@@ -49,13 +50,14 @@ var _ StateSyncManager = (*dummyStateSyncManager)(nil)
 // dummyStateSyncManager is used when bridge is not enabled
 type dummyStateSyncManager struct{}
 
-func (d *dummyStateSyncManager) Init() error                      { return nil }
-func (d *dummyStateSyncManager) AddLog(eventLog *ethgo.Log) error { return nil }
+func (d *dummyStateSyncManager) Init() error                                     { return nil }
+func (d *dummyStateSyncManager) AddLog(eventLog *ethgo.Log, dbTx *bolt.Tx) error { return nil }
 func (d *dummyStateSyncManager) Commitment(blockNumber uint64) (*CommitmentMessageSigned, error) {
 	return nil, nil
 }
 func (d *dummyStateSyncManager) PostBlock(req *PostBlockRequest) error { return nil }
 func (d *dummyStateSyncManager) PostEpoch(req *PostEpochRequest) error { return nil }
+func (d *dummyStateSyncManager) Rollback(stateSyncIDs []uint64) error  { return nil }
 func (d *dummyStateSyncManager) GetStateSyncProof(stateSyncID uint64) (types.Proof, error) {
 	return types.Proof{}, nil
 }
@@ -215,7 +217,7 @@ func (s *stateSyncManager) verifyVoteSignature(valSet validator.ValidatorSet, si
 }
 
 // AddLog saves the received log from event tracker if it matches a state sync event ABI
-func (s *stateSyncManager) AddLog(eventLog *ethgo.Log) error {
+func (s *stateSyncManager) AddLog(eventLog *ethgo.Log, dbTx *bolt.Tx) error {
 	event := &contractsapi.StateSyncedEvent{}
 
 	doesMatch, err := event.ParseLog(eventLog)
@@ -236,13 +238,13 @@ func (s *stateSyncManager) AddLog(eventLog *ethgo.Log) error {
 		return err
 	}
 
-	if err := s.state.StateSyncStore.insertStateSyncEvent(event); err != nil {
+	if err := s.state.StateSyncStore.insertStateSyncEvent(event, dbTx); err != nil {
 		s.logger.Error("could not save state sync event to boltDb", "err", err)
 
 		return err
 	}
 
-	if err := s.buildCommitment(nil); err != nil {
+	if err := s.buildCommitment(dbTx); err != nil {
 		// we don't return an error here. If state sync event is inserted in db,
 		// we will just try to build a commitment on next block or next event arrival
 		s.logger.Error("could not build a commitment on arrival of new state sync", "err", err, "stateSyncID", event.ID)
@@ -381,7 +383,7 @@ func (s *stateSyncManager) PostEpoch(req *PostEpochRequest) error {
 // so that it can build state sync proofs if a block has a commitment submission transaction.
 // Additionally, it will remove any processed state sync events and their proofs from the store.
 func (s *stateSyncManager) PostBlock(req *PostBlockRequest) error {
-	commitment, err := getCommitmentMessageSignedTx(req.FullBlock.Block.Transactions)
+	commitment, commitmentTxHash, err := getCommitmentMessageSignedTx(req.FullBlock.Block.Transactions)
 	if err != nil {
 		return err
 	}
@@ -395,6 +397,19 @@ func (s *stateSyncManager) PostBlock(req *PostBlockRequest) error {
 		return fmt.Errorf("insert commitment message error: %w", err)
 	}
 
+	committedIDs := make([]uint64, 0, commitment.Message.EndID.Uint64()-commitment.Message.StartID.Uint64()+1)
+	for id := commitment.Message.StartID.Uint64(); id <= commitment.Message.EndID.Uint64(); id++ {
+		committedIDs = append(committedIDs, id)
+	}
+
+	if err := s.state.StateSyncStore.insertTransferStage(committedIDs, &types.BridgeTransferStage{
+		Name:        types.CommittedStage,
+		BlockNumber: req.FullBlock.Block.Number(),
+		TxHash:      commitmentTxHash,
+	}, req.DBTx); err != nil {
+		return fmt.Errorf("insert committed transfer stage error: %w", err)
+	}
+
 	if err := s.buildProofs(commitment.Message, req.DBTx); err != nil {
 		return fmt.Errorf("build commitment proofs error: %w", err)
 	}
@@ -409,6 +424,33 @@ func (s *stateSyncManager) PostBlock(req *PostBlockRequest) error {
 	return nil
 }
 
+// Rollback removes given state sync events, which were emitted in the reorged rootchain blocks,
+// and discards the pending commitments, since they might contain them.
+// State sync events which are already committed can not be rolled back, so they are only reported
+func (s *stateSyncManager) Rollback(stateSyncIDs []uint64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	uncommittedIDs := make([]uint64, 0, len(stateSyncIDs))
+
+	for _, id := range stateSyncIDs {
+		if id < s.nextCommittedIndex {
+			s.logger.Error("State sync event from the reorged rootchain block is already committed",
+				"stateSyncID", id, "nextCommittedIndex", s.nextCommittedIndex)
+
+			continue
+		}
+
+		uncommittedIDs = append(uncommittedIDs, id)
+	}
+
+	s.logger.Warn("Rolling back state sync events from the reorged rootchain blocks", "stateSyncIDs", uncommittedIDs)
+
+	s.pendingCommitments = nil
+
+	return s.state.StateSyncStore.removeStateSyncEventsAndProofs(uncommittedIDs)
+}
+
 // GetStateSyncProof returns the proof for the state sync
 func (s *stateSyncManager) GetStateSyncProof(stateSyncID uint64) (types.Proof, error) {
 	stateSyncProof, err := s.state.StateSyncStore.getStateSyncProof(stateSyncID)
@@ -625,5 +667,20 @@ func (s *stateSyncManager) ProcessLog(header *types.Header, log *ethgo.Log, dbTx
 		return nil
 	}
 
+	executedStage := &types.BridgeTransferStage{
+		Name:        types.ExecutedStage,
+		BlockNumber: header.Number,
+		TxHash:      types.Hash(log.TransactionHash),
+	}
+
+	if !stateSyncResultEvent.Status {
+		executedStage.Error = "state sync execution failed: 0x" + hex.EncodeToString(stateSyncResultEvent.Message)
+	}
+
+	if err := s.state.StateSyncStore.insertTransferStage(
+		[]uint64{stateSyncResultEvent.Counter.Uint64()}, executedStage, dbTx); err != nil {
+		return err
+	}
+
 	return s.state.StateSyncStore.removeStateSyncEventsAndProofs([]uint64{stateSyncResultEvent.Counter.Uint64()})
 }
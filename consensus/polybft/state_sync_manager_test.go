@@ -66,7 +66,7 @@ func TestStateSyncManager_PostEpoch_BuildCommitment(t *testing.T) {
 
 		// add 5 state syncs starting in index 0, it will generate one smaller commitment
 		for i := 0; i < 5; i++ {
-			require.NoError(t, s.state.StateSyncStore.insertStateSyncEvent(stateSyncs10[i]))
+			require.NoError(t, s.state.StateSyncStore.insertStateSyncEvent(stateSyncs10[i], nil))
 		}
 
 		require.NoError(t, s.buildCommitment(nil))
@@ -77,7 +77,7 @@ func TestStateSyncManager_PostEpoch_BuildCommitment(t *testing.T) {
 
 		// add the next 5 state syncs, at that point, so that it generates a larger commitment
 		for i := 5; i < 10; i++ {
-			require.NoError(t, s.state.StateSyncStore.insertStateSyncEvent(stateSyncs10[i]))
+			require.NoError(t, s.state.StateSyncStore.insertStateSyncEvent(stateSyncs10[i], nil))
 		}
 
 		require.NoError(t, s.buildCommitment(nil))
@@ -99,7 +99,7 @@ func TestStateSyncManager_PostEpoch_BuildCommitment(t *testing.T) {
 
 		// add 5 state syncs starting in index 0, they will be saved to db
 		for i := 0; i < 5; i++ {
-			require.NoError(t, s.state.StateSyncStore.insertStateSyncEvent(stateSyncs10[i]))
+			require.NoError(t, s.state.StateSyncStore.insertStateSyncEvent(stateSyncs10[i], nil))
 		}
 
 		// I am not a validator so no commitments should be built
@@ -287,7 +287,7 @@ func TestStateSyncerManager_BuildProofs(t *testing.T) {
 	s := newTestStateSyncManager(t, vals.GetValidator("0"), &mockRuntime{isActiveValidator: true})
 
 	for _, evnt := range generateStateSyncEvents(t, 20, 0) {
-		require.NoError(t, s.state.StateSyncStore.insertStateSyncEvent(evnt))
+		require.NoError(t, s.state.StateSyncStore.insertStateSyncEvent(evnt, nil))
 	}
 
 	require.NoError(t, s.buildCommitment(nil))
@@ -308,6 +308,7 @@ func TestStateSyncerManager_BuildProofs(t *testing.T) {
 	req := &PostBlockRequest{
 		FullBlock: &types.FullBlock{
 			Block: &types.Block{
+				Header:       &types.Header{Number: 5},
 				Transactions: []*types.Transaction{tx},
 			},
 		},
@@ -316,6 +317,11 @@ func TestStateSyncerManager_BuildProofs(t *testing.T) {
 	require.NoError(t, s.PostBlock(req))
 	require.Equal(t, mockMsg.Message.EndID.Uint64()+1, s.nextCommittedIndex)
 
+	status, err := s.state.StateSyncStore.getTransferStatus(mockMsg.Message.EndID.Uint64())
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), status.Stages[1].BlockNumber)
+	require.Equal(t, tx.Hash(), status.Stages[1].TxHash)
+
 	for i := uint64(0); i < 10; i++ {
 		proof, err := s.state.StateSyncStore.getStateSyncProof(i)
 		require.NoError(t, err)
@@ -332,7 +338,7 @@ func TestStateSyncerManager_RemoveProcessedEventsAndProofs(t *testing.T) {
 	stateSyncEvents := generateStateSyncEvents(t, stateSyncEventsCount, 0)
 
 	for _, event := range stateSyncEvents {
-		require.NoError(t, s.state.StateSyncStore.insertStateSyncEvent(event))
+		require.NoError(t, s.state.StateSyncStore.insertStateSyncEvent(event, nil))
 	}
 
 	require.NoError(t, s.buildProofs(&contractsapi.StateSyncCommitment{
@@ -367,6 +373,47 @@ func TestStateSyncerManager_RemoveProcessedEventsAndProofs(t *testing.T) {
 	}
 }
 
+func TestStateSyncManager_Rollback(t *testing.T) {
+	t.Parallel()
+
+	vals := validator.NewTestValidators(t, 5)
+
+	s := newTestStateSyncManager(t, vals.GetValidator("0"), &mockRuntime{isActiveValidator: true})
+	stateSyncEvents := generateStateSyncEvents(t, 10, 0)
+
+	for _, event := range stateSyncEvents {
+		require.NoError(t, s.state.StateSyncStore.insertStateSyncEvent(event, nil))
+	}
+
+	// state syncs 0-2 are already committed
+	s.nextCommittedIndex = 3
+
+	require.NoError(t, s.buildCommitment(nil))
+	require.Len(t, s.pendingCommitments, 1)
+
+	// state syncs 2-9 are emitted in the reorged rootchain blocks
+	require.NoError(t, s.Rollback([]uint64{2, 3, 4, 5, 6, 7, 8, 9}))
+	require.Nil(t, s.pendingCommitments)
+
+	events, err := s.state.StateSyncStore.list()
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+
+	for i, event := range events {
+		require.Equal(t, uint64(i), event.ID.Uint64())
+	}
+
+	// state syncs of the new canonical rootchain blocks are committed afterwards
+	for _, event := range generateStateSyncEvents(t, 2, 3) {
+		require.NoError(t, s.state.StateSyncStore.insertStateSyncEvent(event, nil))
+	}
+
+	require.NoError(t, s.buildCommitment(nil))
+	require.Len(t, s.pendingCommitments, 1)
+	require.Equal(t, uint64(3), s.pendingCommitments[0].StartID.Uint64())
+	require.Equal(t, uint64(4), s.pendingCommitments[0].EndID.Uint64())
+}
+
 func TestStateSyncerManager_AddLog_BuildCommitments(t *testing.T) {
 	t.Parallel()
 
@@ -378,7 +425,7 @@ func TestStateSyncerManager_AddLog_BuildCommitments(t *testing.T) {
 		s := newTestStateSyncManager(t, vals.GetValidator("0"), &mockRuntime{isActiveValidator: true})
 
 		// empty log which is not an state sync
-		require.NoError(t, s.AddLog(&ethgo.Log{}))
+		require.NoError(t, s.AddLog(&ethgo.Log{}, nil))
 		stateSyncs, err := s.state.StateSyncStore.list()
 
 		require.NoError(t, err)
@@ -389,7 +436,7 @@ func TestStateSyncerManager_AddLog_BuildCommitments(t *testing.T) {
 		stateSyncEventID := stateSyncedEvent.Sig()
 
 		// log with the state sync topic but incorrect content
-		require.Error(t, s.AddLog(&ethgo.Log{Topics: []ethgo.Hash{stateSyncEventID}}))
+		require.Error(t, s.AddLog(&ethgo.Log{Topics: []ethgo.Hash{stateSyncEventID}}, nil))
 		stateSyncs, err = s.state.StateSyncStore.list()
 
 		require.NoError(t, err)
@@ -409,7 +456,7 @@ func TestStateSyncerManager_AddLog_BuildCommitments(t *testing.T) {
 			Data: data,
 		}
 
-		require.NoError(t, s.AddLog(goodLog))
+		require.NoError(t, s.AddLog(goodLog, nil))
 
 		stateSyncs, err = s.state.StateSyncStore.getStateSyncEventsForCommitment(0, 0, nil)
 		require.NoError(t, err)
@@ -421,7 +468,7 @@ func TestStateSyncerManager_AddLog_BuildCommitments(t *testing.T) {
 		// add one more log to have a minimum commitment
 		goodLog2 := goodLog.Copy()
 		goodLog2.Topics[1] = ethgo.BytesToHash([]byte{0x1}) // state sync index 1
-		require.NoError(t, s.AddLog(goodLog2))
+		require.NoError(t, s.AddLog(goodLog2, nil))
 
 		require.Len(t, s.pendingCommitments, 2)
 		require.Equal(t, uint64(0), s.pendingCommitments[1].StartID.Uint64())
@@ -430,11 +477,11 @@ func TestStateSyncerManager_AddLog_BuildCommitments(t *testing.T) {
 		// add two more logs to have larger commitments
 		goodLog3 := goodLog.Copy()
 		goodLog3.Topics[1] = ethgo.BytesToHash([]byte{0x2}) // state sync index 2
-		require.NoError(t, s.AddLog(goodLog3))
+		require.NoError(t, s.AddLog(goodLog3, nil))
 
 		goodLog4 := goodLog.Copy()
 		goodLog4.Topics[1] = ethgo.BytesToHash([]byte{0x3}) // state sync index 3
-		require.NoError(t, s.AddLog(goodLog4))
+		require.NoError(t, s.AddLog(goodLog4, nil))
 
 		require.Len(t, s.pendingCommitments, 4)
 		require.Equal(t, uint64(0), s.pendingCommitments[3].StartID.Uint64())
@@ -462,7 +509,7 @@ func TestStateSyncerManager_AddLog_BuildCommitments(t *testing.T) {
 			Data: data,
 		}
 
-		require.NoError(t, s.AddLog(goodLog))
+		require.NoError(t, s.AddLog(goodLog, nil))
 
 		// node should have inserted given state sync event, but it shouldn't build any commitment
 		stateSyncs, err := s.state.StateSyncStore.getStateSyncEventsForCommitment(0, 0, nil)
@@ -546,7 +593,7 @@ func TestStateSyncManager_GetProofs_NoProof_BuildProofs(t *testing.T) {
 	}
 
 	for _, sse := range stateSyncs {
-		require.NoError(t, state.StateSyncStore.insertStateSyncEvent(sse))
+		require.NoError(t, state.StateSyncStore.insertStateSyncEvent(sse, nil))
 	}
 
 	require.NoError(t, state.StateSyncStore.insertCommitmentMessage(commitment, nil))
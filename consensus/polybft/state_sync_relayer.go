@@ -1,6 +1,7 @@
 package polybft
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -115,6 +116,7 @@ func (ssr *stateSyncRelayerImpl) Init() error {
 
 func (ssr *stateSyncRelayerImpl) Close() {
 	close(ssr.closeCh)
+	ssr.txRelayer.Close()
 }
 
 func (ssr *stateSyncRelayerImpl) PostBlock(req *PostBlockRequest) error {
@@ -235,14 +237,18 @@ func (ssr *stateSyncRelayerImpl) ProcessLog(header *types.Header, log *ethgo.Log
 		ssr.logger.Debug("state sync result event failed to process", "block", header.Number,
 			"stateSyncID", eventID, "reason", string(stateSyncResultEvent.Message))
 
-		return nil
+		return ssr.state.SetRelayerEventError(eventID,
+			"execution failed: 0x"+hex.EncodeToString(stateSyncResultEvent.Message), dbTx)
 
 	default:
 		return errUnknownStateSyncRelayerEvent
 	}
 }
 
-func getBridgeTxRelayer(rpcEndpoint string, logger hclog.Logger) (txrelayer.TxRelayer, error) {
+// getBridgeTxRelayer creates a TxRelayer for the bridge relayers, which does not wait for the receipts,
+// but replaces the transactions which are not processed in time
+func getBridgeTxRelayer(rpcEndpoint string, logger hclog.Logger,
+	opts ...txrelayer.TxRelayerOption) (txrelayer.TxRelayer, error) {
 	if rpcEndpoint == "" || strings.Contains(rpcEndpoint, "0.0.0.0") {
 		_, port, err := net.SplitHostPort(rpcEndpoint)
 		if err == nil {
@@ -252,7 +258,9 @@ func getBridgeTxRelayer(rpcEndpoint string, logger hclog.Logger) (txrelayer.TxRe
 		}
 	}
 
-	return txrelayer.NewTxRelayer(
+	return txrelayer.NewTxRelayer(append([]txrelayer.TxRelayerOption{
 		txrelayer.WithIPAddress(rpcEndpoint), txrelayer.WithNoWaiting(),
-		txrelayer.WithWriter(logger.StandardWriter(&hclog.StandardLoggerOptions{})))
+		txrelayer.WithWriter(logger.StandardWriter(&hclog.StandardLoggerOptions{})),
+		txrelayer.WithTxReplacement(txrelayer.DefaultReplacementTimeout, txrelayer.DefaultFeeBumpPercentage),
+	}, opts...)...)
 }
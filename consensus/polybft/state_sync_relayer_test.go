@@ -91,7 +91,7 @@ func TestStateSyncRelayer_FullWorkflow(t *testing.T) {
 
 	time.Sleep(time.Second * 2) // wait for some time
 
-	events, err := state.StateSyncStore.GetAllAvailableRelayerEvents(0)
+	events, err := state.StateSyncStore.GetAllAvailableRelayerEvents(0, nil)
 
 	require.NoError(t, err)
 	require.Len(t, events, 3)
@@ -107,7 +107,7 @@ func TestStateSyncRelayer_FullWorkflow(t *testing.T) {
 
 	time.Sleep(time.Second * 2) // wait for some time
 
-	events, err = state.StateSyncStore.GetAllAvailableRelayerEvents(0)
+	events, err = state.StateSyncStore.GetAllAvailableRelayerEvents(0, nil)
 
 	require.NoError(t, err)
 	require.Len(t, events, 4)
@@ -122,11 +122,14 @@ func TestStateSyncRelayer_FullWorkflow(t *testing.T) {
 
 	time.Sleep(time.Second * 2) // wait for some time
 
-	events, err = state.StateSyncStore.GetAllAvailableRelayerEvents(0)
+	events, err = state.StateSyncStore.GetAllAvailableRelayerEvents(0, nil)
 
 	require.NoError(t, err)
 	require.Len(t, events, 3)
-	require.True(t, events[0].SentStatus)
+	// sending failed, so the event is marked as not sent and backs off
+	require.False(t, events[0].SentStatus)
+	require.Equal(t, "e", events[0].LastError)
+	require.Equal(t, uint64(1), events[0].CountTries)
 	require.Equal(t, uint64(3), events[0].EventID)
 	require.False(t, events[1].SentStatus)
 
@@ -137,7 +140,7 @@ func TestStateSyncRelayer_FullWorkflow(t *testing.T) {
 
 	time.Sleep(time.Second * 2) // wait for some time
 
-	events, err = state.StateSyncStore.GetAllAvailableRelayerEvents(0)
+	events, err = state.StateSyncStore.GetAllAvailableRelayerEvents(0, nil)
 
 	require.NoError(t, err)
 	require.Len(t, events, 3)
@@ -151,7 +154,7 @@ func TestStateSyncRelayer_FullWorkflow(t *testing.T) {
 
 	time.Sleep(time.Second * 2) // wait for some time
 
-	events, err = state.StateSyncStore.GetAllAvailableRelayerEvents(0)
+	events, err = state.StateSyncStore.GetAllAvailableRelayerEvents(0, nil)
 
 	require.NoError(t, err)
 	require.Len(t, events, 0)
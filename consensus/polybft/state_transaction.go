@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/state/runtime/addresslist"
 )
 
 const abiMethodIDLength = 4
@@ -36,6 +37,12 @@ func decodeStateTransaction(txData []byte) (contractsapi.StateTransactionInput,
 		// distribute rewards
 		obj = &contractsapi.DistributeRewardForEpochManagerFn{}
 
+	case bytes.Equal(sig, addresslist.SetAdminFunc.ID()),
+		bytes.Equal(sig, addresslist.SetEnabledFunc.ID()),
+		bytes.Equal(sig, addresslist.SetNoneFunc.ID()):
+		// address list role changed by the governance
+		obj = &addressListRole{}
+
 	default:
 		return nil, fmt.Errorf("unknown state transaction")
 	}
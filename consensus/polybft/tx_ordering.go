@@ -0,0 +1,354 @@
+package polybft
+
+import (
+	"container/heap"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-metrics"
+)
+
+const (
+	// defaultLaneName is the name of the lane which holds transactions not matched by any configured lane
+	defaultLaneName = "default"
+
+	// maxReservedGasShare is the maximal sum of the reserved gas shares of all lanes (percentage)
+	maxReservedGasShare = 100
+)
+
+var (
+	errEmptyLaneName           = errors.New("lane name must not be empty")
+	errReservedGasShareExceeds = fmt.Errorf("sum of the lanes reserved gas shares must not exceed %d",
+		maxReservedGasShare)
+)
+
+// systemContracts are the bridge and system contracts whose transactions
+// belong to the lanes with SystemContracts flag set
+var systemContracts = []types.Address{
+	contracts.L2StateSenderContract,
+	contracts.ChildERC20PredicateContract,
+	contracts.ChildERC721PredicateContract,
+	contracts.ChildERC1155PredicateContract,
+	contracts.RootMintableERC20PredicateContract,
+	contracts.RootMintableERC721PredicateContract,
+	contracts.RootMintableERC1155PredicateContract,
+	contracts.NativeERC20TokenContract,
+	contracts.StateReceiverContract,
+	contracts.EpochManagerContract,
+	contracts.StakeManagerContract,
+	contracts.ChildGovernorContract,
+	contracts.ChildTimelockContract,
+	contracts.NetworkParamsContract,
+	contracts.ForkParamsContract,
+}
+
+// TxOrderingConfig defines in which order the tx pool transactions are included into the block.
+// It is a part of the client configuration, so it is set in genesis and can be changed by the governance
+// (setNewTxOrdering runtime config call), in which case it is picked up on the next epoch
+type TxOrderingConfig struct {
+	// Lanes are the priority lanes, sorted by priority (first lane has the highest priority).
+	// Transactions not matched by any lane are included by price, once the lanes are served
+	Lanes []*TxLaneConfig `json:"lanes"`
+}
+
+// TxLaneConfig is the configuration of a single priority lane
+type TxLaneConfig struct {
+	// Name is the lane name (used for metrics)
+	Name string `json:"name"`
+
+	// Senders are the senders whose transactions belong to the lane
+	Senders []types.Address `json:"senders,omitempty"`
+
+	// Contracts are the recipients whose transactions belong to the lane
+	Contracts []types.Address `json:"contracts,omitempty"`
+
+	// SystemContracts indicates whether transactions sent to the bridge and system contracts belong to the lane
+	SystemContracts bool `json:"systemContracts,omitempty"`
+
+	// ReservedGasShare is the percentage of the block gas limit reserved for the lane transactions
+	ReservedGasShare uint64 `json:"reservedGasShare"`
+}
+
+// LoadTxOrderingConfig loads the tx ordering configuration from the provided JSON file
+func LoadTxOrderingConfig(path string) (*TxOrderingConfig, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tx ordering config file: %w", err)
+	}
+
+	var config TxOrderingConfig
+	if err := json.Unmarshal(raw, &config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tx ordering config: %w", err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// Validate validates the tx ordering configuration
+func (c *TxOrderingConfig) Validate() error {
+	var (
+		totalShare uint64
+		names      = make(map[string]struct{}, len(c.Lanes))
+	)
+
+	for _, lane := range c.Lanes {
+		if lane.Name == "" {
+			return errEmptyLaneName
+		}
+
+		if _, exists := names[lane.Name]; exists || lane.Name == defaultLaneName {
+			return fmt.Errorf("lane name %s is not unique", lane.Name)
+		}
+
+		names[lane.Name] = struct{}{}
+		totalShare += lane.ReservedGasShare
+	}
+
+	if totalShare > maxReservedGasShare {
+		return errReservedGasShareExceeds
+	}
+
+	return nil
+}
+
+// TxOrderingPolicy decides which tx pool transaction is the next one to be written into the block
+type TxOrderingPolicy interface {
+	// Reset prepares the policy for filling a new block
+	Reset(txPool txPoolInterface, gasLimit, baseFee uint64)
+	// Next returns the next transaction to be written into the block or nil if there are none left
+	Next() *types.Transaction
+	// Included notifies the policy that the transaction has been written into the block
+	Included(tx *types.Transaction, gasUsed uint64)
+	// Done notifies the policy that the block filling has finished
+	Done()
+}
+
+// NewTxOrderingPolicy creates the tx ordering policy for the given configuration.
+// If no lanes are configured, transactions are ordered by price only
+func NewTxOrderingPolicy(config *TxOrderingConfig) TxOrderingPolicy {
+	if config == nil || len(config.Lanes) == 0 {
+		return &priceOrderingPolicy{}
+	}
+
+	return newLaneOrderingPolicy(config)
+}
+
+var _ TxOrderingPolicy = (*priceOrderingPolicy)(nil)
+
+// priceOrderingPolicy includes transactions in the order provided by the tx pool (by price)
+type priceOrderingPolicy struct {
+	txPool txPoolInterface
+}
+
+func (p *priceOrderingPolicy) Reset(txPool txPoolInterface, gasLimit, baseFee uint64) {
+	p.txPool = txPool
+}
+
+func (p *priceOrderingPolicy) Next() *types.Transaction {
+	return p.txPool.Peek()
+}
+
+func (p *priceOrderingPolicy) Included(tx *types.Transaction, gasUsed uint64) {}
+
+func (p *priceOrderingPolicy) Done() {}
+
+var _ TxOrderingPolicy = (*laneOrderingPolicy)(nil)
+
+// laneOrderingPolicy includes the priority lanes transactions first, up to the lane reserved gas,
+// and afterwards all the remaining transactions by price.
+// Transactions are drained from the tx pool into the lanes. Since the tx pool provides
+// only the next executable transaction of each account, nonce ordering is preserved
+type laneOrderingPolicy struct {
+	config *TxOrderingConfig
+	lookup map[types.Address]int
+
+	txPool  txPoolInterface
+	baseFee *big.Int
+	lanes   []*txLane
+}
+
+// txLane holds the lane transactions waiting for inclusion and the lane utilization
+type txLane struct {
+	name        string
+	txs         *laneTxs
+	reservedGas uint64
+	gasUsed     uint64
+	txsIncluded int
+}
+
+func newLaneOrderingPolicy(config *TxOrderingConfig) *laneOrderingPolicy {
+	lookup := make(map[types.Address]int)
+
+	for i := len(config.Lanes) - 1; i >= 0; i-- {
+		lane := config.Lanes[i]
+
+		if lane.SystemContracts {
+			for _, addr := range systemContracts {
+				lookup[addr] = i
+			}
+		}
+
+		for _, addr := range lane.Contracts {
+			lookup[addr] = i
+		}
+	}
+
+	// senders take precedence over the recipients, and lanes with higher priority take precedence
+	senders := make(map[types.Address]int)
+
+	for i := len(config.Lanes) - 1; i >= 0; i-- {
+		for _, addr := range config.Lanes[i].Senders {
+			senders[addr] = i
+		}
+	}
+
+	for addr, i := range senders {
+		lookup[addr] = i
+	}
+
+	return &laneOrderingPolicy{
+		config: config,
+		lookup: lookup,
+	}
+}
+
+// Reset implements TxOrderingPolicy interface
+func (l *laneOrderingPolicy) Reset(txPool txPoolInterface, gasLimit, baseFee uint64) {
+	l.txPool = txPool
+	l.baseFee = new(big.Int).SetUint64(baseFee)
+	l.lanes = make([]*txLane, len(l.config.Lanes)+1)
+
+	for i, lane := range l.config.Lanes {
+		l.lanes[i] = &txLane{
+			name:        lane.Name,
+			txs:         &laneTxs{baseFee: l.baseFee},
+			reservedGas: gasLimit * lane.ReservedGasShare / maxReservedGasShare,
+		}
+	}
+
+	l.lanes[len(l.config.Lanes)] = &txLane{
+		name: defaultLaneName,
+		txs:  &laneTxs{baseFee: l.baseFee},
+	}
+}
+
+// Next implements TxOrderingPolicy interface
+func (l *laneOrderingPolicy) Next() *types.Transaction {
+	l.drain()
+
+	// serve the lanes by priority, up to the reserved gas
+	for _, lane := range l.lanes {
+		if lane.txs.Len() == 0 || lane.gasUsed >= lane.reservedGas {
+			continue
+		}
+
+		if tx := lane.txs.peek(); tx.Gas() <= lane.reservedGas-lane.gasUsed {
+			return heap.Pop(lane.txs).(*types.Transaction) //nolint:forcetypeassert
+		}
+	}
+
+	// the rest of the block is filled by price
+	var best *txLane
+
+	for _, lane := range l.lanes {
+		if lane.txs.Len() == 0 {
+			continue
+		}
+
+		if best == nil || lane.txs.peek().EffectiveGasTip(l.baseFee).Cmp(
+			best.txs.peek().EffectiveGasTip(l.baseFee)) > 0 {
+			best = lane
+		}
+	}
+
+	if best == nil {
+		return nil
+	}
+
+	return heap.Pop(best.txs).(*types.Transaction) //nolint:forcetypeassert
+}
+
+// Included implements TxOrderingPolicy interface
+func (l *laneOrderingPolicy) Included(tx *types.Transaction, gasUsed uint64) {
+	lane := l.lanes[l.laneIndex(tx)]
+	lane.gasUsed += gasUsed
+	lane.txsIncluded++
+}
+
+// Done implements TxOrderingPolicy interface
+func (l *laneOrderingPolicy) Done() {
+	updateLaneMetrics(l.lanes)
+}
+
+// drain moves all the executable transactions from the tx pool into the lanes
+func (l *laneOrderingPolicy) drain() {
+	for tx := l.txPool.Peek(); tx != nil; tx = l.txPool.Peek() {
+		heap.Push(l.lanes[l.laneIndex(tx)].txs, tx)
+	}
+}
+
+// laneIndex returns the index of the lane the transaction belongs to
+func (l *laneOrderingPolicy) laneIndex(tx *types.Transaction) int {
+	if i, ok := l.lookup[tx.From()]; ok {
+		return i
+	}
+
+	if tx.To() != nil {
+		if i, ok := l.lookup[*tx.To()]; ok {
+			return i
+		}
+	}
+
+	return len(l.lanes) - 1
+}
+
+// laneTxs is a max heap of transactions ordered by the effective tip
+type laneTxs struct {
+	txs     []*types.Transaction
+	baseFee *big.Int
+}
+
+func (t *laneTxs) peek() *types.Transaction { return t.txs[0] }
+
+func (t *laneTxs) Len() int { return len(t.txs) }
+
+func (t *laneTxs) Less(i, j int) bool {
+	return t.txs[i].EffectiveGasTip(t.baseFee).Cmp(t.txs[j].EffectiveGasTip(t.baseFee)) > 0
+}
+
+func (t *laneTxs) Swap(i, j int) { t.txs[i], t.txs[j] = t.txs[j], t.txs[i] }
+
+func (t *laneTxs) Push(x interface{}) {
+	t.txs = append(t.txs, x.(*types.Transaction)) //nolint:forcetypeassert
+}
+
+func (t *laneTxs) Pop() interface{} {
+	old := t.txs
+	n := len(old)
+	x := old[n-1]
+	t.txs = old[0 : n-1]
+
+	return x
+}
+
+// updateLaneMetrics updates the utilization metrics of the tx ordering lanes
+func updateLaneMetrics(lanes []*txLane) {
+	for _, lane := range lanes {
+		metrics.SetGauge([]string{consensusMetricsPrefix, "lane", lane.name, "gas_used"}, float32(lane.gasUsed))
+		metrics.SetGauge([]string{consensusMetricsPrefix, "lane", lane.name, "num_txs"}, float32(lane.txsIncluded))
+
+		if lane.reservedGas > 0 {
+			metrics.SetGauge([]string{consensusMetricsPrefix, "lane", lane.name, "utilization"},
+				float32(lane.gasUsed)/float32(lane.reservedGas))
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package polybft
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+// executablesPoolMock mimics the tx pool executables queue: only the first transaction
+// of each account is available through Peek, the next one becomes available once the previous is popped
+type executablesPoolMock struct {
+	txPoolInterface
+
+	accounts    map[types.Address][]*types.Transaction
+	executables []*types.Transaction
+}
+
+func newExecutablesPoolMock(txs ...*types.Transaction) *executablesPoolMock {
+	pool := &executablesPoolMock{accounts: map[types.Address][]*types.Transaction{}}
+
+	for _, tx := range txs {
+		if len(pool.accounts[tx.From()]) == 0 {
+			pool.executables = append(pool.executables, tx)
+		}
+
+		pool.accounts[tx.From()] = append(pool.accounts[tx.From()], tx)
+	}
+
+	return pool
+}
+
+func (p *executablesPoolMock) Peek() *types.Transaction {
+	if len(p.executables) == 0 {
+		return nil
+	}
+
+	best := 0
+
+	for i, tx := range p.executables {
+		if tx.GasPrice().Cmp(p.executables[best].GasPrice()) > 0 {
+			best = i
+		}
+	}
+
+	tx := p.executables[best]
+	p.executables = append(p.executables[:best], p.executables[best+1:]...)
+
+	return tx
+}
+
+func (p *executablesPoolMock) Pop(tx *types.Transaction) {
+	queue := p.accounts[tx.From()][1:]
+	p.accounts[tx.From()] = queue
+
+	if len(queue) > 0 {
+		p.executables = append(p.executables, queue[0])
+	}
+}
+
+func newOrderingTestTx(from types.Address, to types.Address, nonce uint64, gasPrice int64) *types.Transaction {
+	return types.NewTx(types.NewLegacyTx(
+		types.WithFrom(from),
+		types.WithTo(&to),
+		types.WithNonce(nonce),
+		types.WithGas(21000),
+		types.WithGasPrice(big.NewInt(gasPrice)),
+	))
+}
+
+// fillWithPolicy simulates the block builder filling the block using the given policy
+func fillWithPolicy(policy TxOrderingPolicy, pool *executablesPoolMock, gasLimit uint64) []*types.Transaction {
+	var (
+		included []*types.Transaction
+		gasUsed  uint64
+	)
+
+	policy.Reset(pool, gasLimit, 0)
+
+	for tx := policy.Next(); tx != nil; tx = policy.Next() {
+		if gasUsed+tx.Gas() > gasLimit {
+			break
+		}
+
+		gasUsed += tx.Gas()
+		included = append(included, tx)
+
+		pool.Pop(tx)
+		policy.Included(tx, tx.Gas())
+	}
+
+	policy.Done()
+
+	return included
+}
+
+func TestTxOrderingConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		config *TxOrderingConfig
+		err    string
+	}{
+		{
+			name: "valid",
+			config: &TxOrderingConfig{Lanes: []*TxLaneConfig{
+				{Name: "a", ReservedGasShare: 40},
+				{Name: "b", ReservedGasShare: 60},
+			}},
+		},
+		{
+			name:   "empty name",
+			config: &TxOrderingConfig{Lanes: []*TxLaneConfig{{ReservedGasShare: 40}}},
+			err:    errEmptyLaneName.Error(),
+		},
+		{
+			name:   "duplicate name",
+			config: &TxOrderingConfig{Lanes: []*TxLaneConfig{{Name: "a"}, {Name: "a"}}},
+			err:    "lane name a is not unique",
+		},
+		{
+			name:   "default lane name",
+			config: &TxOrderingConfig{Lanes: []*TxLaneConfig{{Name: defaultLaneName}}},
+			err:    "lane name default is not unique",
+		},
+		{
+			name: "reserved gas share exceeded",
+			config: &TxOrderingConfig{Lanes: []*TxLaneConfig{
+				{Name: "a", ReservedGasShare: 50},
+				{Name: "b", ReservedGasShare: 51},
+			}},
+			err: errReservedGasShareExceeds.Error(),
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.config.Validate()
+			if c.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, c.err)
+			}
+		})
+	}
+}
+
+func TestTxOrderingPolicy_Price(t *testing.T) {
+	t.Parallel()
+
+	var (
+		alice = types.StringToAddress("0xa")
+		bob   = types.StringToAddress("0xb")
+		to    = types.StringToAddress("0x1")
+	)
+
+	pool := newExecutablesPoolMock(
+		newOrderingTestTx(alice, to, 0, 10),
+		newOrderingTestTx(alice, to, 1, 30),
+		newOrderingTestTx(bob, to, 0, 20),
+	)
+
+	policy := NewTxOrderingPolicy(nil)
+	require.IsType(t, &priceOrderingPolicy{}, policy)
+
+	included := fillWithPolicy(policy, pool, 21000*10)
+	require.Len(t, included, 3)
+	// bob's transaction has higher price than alice's first transaction
+	require.Equal(t, bob, included[0].From())
+	require.Equal(t, uint64(0), included[1].Nonce())
+	require.Equal(t, uint64(1), included[2].Nonce())
+}
+
+func TestTxOrderingPolicy_Lanes(t *testing.T) {
+	t.Parallel()
+
+	var (
+		relayer  = types.StringToAddress("0xa")
+		priority = types.StringToAddress("0xb")
+		regular  = types.StringToAddress("0xc")
+		to       = types.StringToAddress("0x1")
+	)
+
+	config := &TxOrderingConfig{
+		Lanes: []*TxLaneConfig{
+			{Name: "bridge", SystemContracts: true, ReservedGasShare: 20},
+			{Name: "priority", Senders: []types.Address{priority}, ReservedGasShare: 20},
+		},
+	}
+	require.NoError(t, config.Validate())
+
+	pool := newExecutablesPoolMock(
+		newOrderingTestTx(relayer, contracts.L2StateSenderContract, 0, 1),
+		newOrderingTestTx(relayer, contracts.L2StateSenderContract, 1, 1),
+		newOrderingTestTx(relayer, contracts.L2StateSenderContract, 2, 1),
+		newOrderingTestTx(priority, to, 0, 2),
+		newOrderingTestTx(priority, to, 1, 2),
+		newOrderingTestTx(priority, to, 2, 2),
+		newOrderingTestTx(regular, to, 0, 100),
+		newOrderingTestTx(regular, to, 1, 100),
+	)
+
+	policy := NewTxOrderingPolicy(config)
+	require.IsType(t, &laneOrderingPolicy{}, policy)
+
+	// each lane has reserved gas for 2 transactions
+	included := fillWithPolicy(policy, pool, 21000*10)
+	require.Len(t, included, 8)
+
+	senders := make([]types.Address, len(included))
+	for i, tx := range included {
+		senders[i] = tx.From()
+	}
+
+	require.Equal(t, []types.Address{
+		// lanes up to the reserved gas share
+		relayer, relayer, priority, priority,
+		// the rest by price
+		regular, regular, priority, relayer,
+	}, senders)
+
+	lanes := policy.(*laneOrderingPolicy).lanes //nolint:forcetypeassert
+	require.Len(t, lanes, 3)
+	require.Equal(t, uint64(21000*2), lanes[0].reservedGas)
+	require.Equal(t, uint64(21000*3), lanes[0].gasUsed)
+	require.Equal(t, uint64(21000*3), lanes[1].gasUsed)
+	require.Equal(t, 2, lanes[2].txsIncluded)
+}
+
+func TestTxOrderingPolicy_LaneSendersTakePrecedence(t *testing.T) {
+	t.Parallel()
+
+	var (
+		priority = types.StringToAddress("0xb")
+		regular  = types.StringToAddress("0xc")
+	)
+
+	policy := newLaneOrderingPolicy(&TxOrderingConfig{
+		Lanes: []*TxLaneConfig{
+			{Name: "bridge", SystemContracts: true},
+			{Name: "priority", Senders: []types.Address{priority}},
+		},
+	})
+	policy.Reset(newExecutablesPoolMock(), 0, 0)
+
+	require.Equal(t, 1, policy.laneIndex(newOrderingTestTx(priority, contracts.L2StateSenderContract, 0, 1)))
+	require.Equal(t, 0, policy.laneIndex(newOrderingTestTx(regular, contracts.L2StateSenderContract, 0, 1)))
+	require.Equal(t, 2, policy.laneIndex(newOrderingTestTx(regular, types.ZeroAddress, 0, 1)))
+}
@@ -35,6 +35,7 @@ func DecodeArtifact(data []byte) (*Artifact, error) {
 		Abi:              hexRes.Abi,
 		Bytecode:         hex.MustDecodeHex(hexRes.ByteCode),
 		DeployedBytecode: hex.MustDecodeHex(hexRes.DeployedBytecode),
+		StorageLayout:    hexRes.StorageLayout,
 	}, nil
 }
 
@@ -52,10 +53,13 @@ type HexArtifact struct {
 	Abi              *abi.ABI
 	ByteCode         string
 	DeployedBytecode string
+	StorageLayout    *StorageLayout
 }
 
 type Artifact struct {
 	Abi              *abi.ABI
 	Bytecode         []byte
 	DeployedBytecode []byte
+	// StorageLayout is present only if the contract is compiled with the storage layout output selection
+	StorageLayout *StorageLayout
 }
@@ -0,0 +1,196 @@
+package contracts
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// storageGapLabel is the conventional name of the reserved storage slots of upgradeable contracts,
+// which are consumed by the variables added in the newer implementations
+const storageGapLabel = "__gap"
+
+// StorageLayout is the storage layout of the contract, as emitted by the solidity compiler
+// (storageLayout output selection) in the artifact metadata
+type StorageLayout struct {
+	Storage []*StorageVariable      `json:"storage"`
+	Types   map[string]*StorageType `json:"types"`
+}
+
+// StorageVariable is the single state variable (or struct member) of the storage layout
+type StorageVariable struct {
+	Contract string `json:"contract"`
+	Label    string `json:"label"`
+	Offset   uint64 `json:"offset"`
+	Slot     string `json:"slot"`
+	Type     string `json:"type"`
+}
+
+// StorageType is the type of the state variable, referenced by its identifier from the storage layout
+type StorageType struct {
+	Encoding      string             `json:"encoding"`
+	Label         string             `json:"label"`
+	NumberOfBytes string             `json:"numberOfBytes"`
+	Key           string             `json:"key,omitempty"`
+	Value         string             `json:"value,omitempty"`
+	Base          string             `json:"base,omitempty"`
+	Members       []*StorageVariable `json:"members,omitempty"`
+}
+
+// CheckUpgrade checks whether the contract with the given storage layout can replace the contract
+// with the current storage layout behind the proxy, without corrupting the existing state.
+// Existing variables must keep their slot, offset, name and type, while the new variables
+// can be appended or can consume the storage gaps (whose end slot must remain the same).
+func (l *StorageLayout) CheckUpgrade(newLayout *StorageLayout) error {
+	newVariables := make(map[string]*StorageVariable, len(newLayout.Storage))
+	for _, v := range newLayout.Storage {
+		newVariables[v.position()] = v
+	}
+
+	var errs []error
+
+	for _, oldVar := range l.Storage {
+		if oldVar.Label == storageGapLabel {
+			if err := l.checkGap(oldVar, newLayout); err != nil {
+				errs = append(errs, err)
+			}
+
+			continue
+		}
+
+		newVar, exists := newVariables[oldVar.position()]
+		if !exists {
+			errs = append(errs, fmt.Errorf("variable %s is removed or moved from %s", oldVar, oldVar.position()))
+
+			continue
+		}
+
+		if newVar.Label != oldVar.Label {
+			errs = append(errs, fmt.Errorf("variable %s is renamed to %s", oldVar, newVar.Label))
+
+			continue
+		}
+
+		if !l.typesEqual(oldVar.Type, newLayout, newVar.Type) {
+			errs = append(errs, fmt.Errorf("variable %s changed type from %s to %s",
+				oldVar, l.typeLabel(oldVar.Type), newLayout.typeLabel(newVar.Type)))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkGap checks that the storage gap of the contract is shrunk only by the number of the slots
+// consumed by the new variables, so that the storage of the inheriting contracts stays in place
+func (l *StorageLayout) checkGap(oldGap *StorageVariable, newLayout *StorageLayout) error {
+	var newGap *StorageVariable
+
+	for _, v := range newLayout.Storage {
+		if v.Label == storageGapLabel && v.Contract == oldGap.Contract {
+			newGap = v
+
+			break
+		}
+	}
+
+	if newGap == nil {
+		return fmt.Errorf("storage gap %s is removed", oldGap)
+	}
+
+	oldStart, oldEnd, err := l.slotRange(oldGap)
+	if err != nil {
+		return err
+	}
+
+	newStart, newEnd, err := newLayout.slotRange(newGap)
+	if err != nil {
+		return err
+	}
+
+	if newStart < oldStart || newEnd != oldEnd {
+		return fmt.Errorf("storage gap %s changed from slots [%d, %d) to [%d, %d)",
+			oldGap, oldStart, oldEnd, newStart, newEnd)
+	}
+
+	return nil
+}
+
+// slotRange returns the range of the slots occupied by the variable
+func (l *StorageLayout) slotRange(v *StorageVariable) (uint64, uint64, error) {
+	start, err := strconv.ParseUint(v.Slot, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid slot of variable %s: %w", v, err)
+	}
+
+	t, exists := l.Types[v.Type]
+	if !exists {
+		return 0, 0, fmt.Errorf("unknown type %s of variable %s", v.Type, v)
+	}
+
+	size, err := strconv.ParseUint(t.NumberOfBytes, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid size of type %s: %w", v.Type, err)
+	}
+
+	return start, start + (size+31)/32, nil
+}
+
+// typesEqual compares the types of the two storage layouts structurally, since type identifiers
+// of the structs and enums contain AST ids, which differ between the compilations
+func (l *StorageLayout) typesEqual(oldType string, newLayout *StorageLayout, newType string) bool {
+	if oldType == "" || newType == "" {
+		return oldType == newType
+	}
+
+	o, oldExists := l.Types[oldType]
+	n, newExists := newLayout.Types[newType]
+
+	if !oldExists || !newExists {
+		return oldExists == newExists && oldType == newType
+	}
+
+	if o.Encoding != n.Encoding || o.Label != n.Label || o.NumberOfBytes != n.NumberOfBytes ||
+		len(o.Members) != len(n.Members) {
+		return false
+	}
+
+	if !l.typesEqual(o.Key, newLayout, n.Key) ||
+		!l.typesEqual(o.Value, newLayout, n.Value) ||
+		!l.typesEqual(o.Base, newLayout, n.Base) {
+		return false
+	}
+
+	for i, oldMember := range o.Members {
+		newMember := n.Members[i]
+
+		if oldMember.Label != newMember.Label || oldMember.position() != newMember.position() ||
+			!l.typesEqual(oldMember.Type, newLayout, newMember.Type) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (l *StorageLayout) typeLabel(typeID string) string {
+	if t, exists := l.Types[typeID]; exists {
+		return t.Label
+	}
+
+	return typeID
+}
+
+func (v *StorageVariable) position() string {
+	return fmt.Sprintf("slot %s offset %d", v.Slot, v.Offset)
+}
+
+func (v *StorageVariable) String() string {
+	// contract is given as the fully qualified name (source path and contract name)
+	contract := v.Contract
+	if i := strings.LastIndex(contract, ":"); i >= 0 {
+		contract = contract[i+1:]
+	}
+
+	return fmt.Sprintf("%s.%s", contract, v.Label)
+}
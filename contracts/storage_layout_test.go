@@ -0,0 +1,159 @@
+package contracts
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testStorageLayout = `{
+	"storage": [
+		{"contract": "contracts/Bridge.sol:Bridge", "label": "owner", "offset": 0, "slot": "0", "type": "t_address"},
+		{"contract": "contracts/Bridge.sol:Bridge", "label": "paused", "offset": 20, "slot": "0", "type": "t_bool"},
+		{"contract": "contracts/Bridge.sol:Bridge", "label": "chains", "offset": 0, "slot": "1",
+			"type": "t_mapping(t_uint8,t_struct(Chain)12_storage)"},
+		{"contract": "contracts/Bridge.sol:Bridge", "label": "__gap", "offset": 0, "slot": "2",
+			"type": "t_array(t_uint256)50_storage"}
+	],
+	"types": {
+		"t_address": {"encoding": "inplace", "label": "address", "numberOfBytes": "20"},
+		"t_bool": {"encoding": "inplace", "label": "bool", "numberOfBytes": "1"},
+		"t_uint8": {"encoding": "inplace", "label": "uint8", "numberOfBytes": "1"},
+		"t_uint256": {"encoding": "inplace", "label": "uint256", "numberOfBytes": "32"},
+		"t_array(t_uint256)50_storage": {"encoding": "inplace", "label": "uint256[50]", "numberOfBytes": "1600",
+			"base": "t_uint256"},
+		"t_array(t_uint256)49_storage": {"encoding": "inplace", "label": "uint256[49]", "numberOfBytes": "1568",
+			"base": "t_uint256"},
+		"t_mapping(t_uint8,t_struct(Chain)12_storage)": {"encoding": "mapping",
+			"label": "mapping(uint8 => struct Bridge.Chain)", "numberOfBytes": "32",
+			"key": "t_uint8", "value": "t_struct(Chain)12_storage"},
+		"t_struct(Chain)12_storage": {"encoding": "inplace", "label": "struct Bridge.Chain", "numberOfBytes": "32",
+			"members": [
+				{"contract": "contracts/Bridge.sol:Bridge", "label": "id", "offset": 0, "slot": "0", "type": "t_uint8"}
+			]}
+	}
+}`
+
+func Test_StorageLayout_CheckUpgrade(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		modify func(l *StorageLayout)
+		err    string
+	}{
+		{
+			name:   "same layout",
+			modify: func(l *StorageLayout) {},
+		},
+		{
+			name: "appended variable",
+			modify: func(l *StorageLayout) {
+				l.Storage = append(l.Storage, &StorageVariable{
+					Contract: "contracts/Bridge.sol:Bridge", Label: "fee", Slot: "52", Type: "t_uint256"})
+			},
+		},
+		{
+			name: "variable consumes gap",
+			modify: func(l *StorageLayout) {
+				l.Storage[3] = &StorageVariable{
+					Contract: "contracts/Bridge.sol:Bridge", Label: "fee", Slot: "2", Type: "t_uint256"}
+				l.Storage = append(l.Storage, &StorageVariable{
+					Contract: "contracts/Bridge.sol:Bridge", Label: "__gap", Slot: "3", Type: "t_array(t_uint256)49_storage"})
+			},
+		},
+		{
+			name: "variable inserted without shrinking gap",
+			modify: func(l *StorageLayout) {
+				l.Storage[3] = &StorageVariable{
+					Contract: "contracts/Bridge.sol:Bridge", Label: "fee", Slot: "2", Type: "t_uint256"}
+				l.Storage = append(l.Storage, &StorageVariable{
+					Contract: "contracts/Bridge.sol:Bridge", Label: "__gap", Slot: "3", Type: "t_array(t_uint256)50_storage"})
+			},
+			err: "storage gap Bridge.__gap changed from slots [2, 52) to [3, 53)",
+		},
+		{
+			name: "removed gap",
+			modify: func(l *StorageLayout) {
+				l.Storage = l.Storage[:3]
+			},
+			err: "storage gap Bridge.__gap is removed",
+		},
+		{
+			name: "variable inserted before existing",
+			modify: func(l *StorageLayout) {
+				l.Storage[1].Offset = 21
+			},
+			err: "variable Bridge.paused is removed or moved from slot 0 offset 20",
+		},
+		{
+			name: "renamed variable",
+			modify: func(l *StorageLayout) {
+				l.Storage[0].Label = "admin"
+			},
+			err: "variable Bridge.owner is renamed to admin",
+		},
+		{
+			name: "changed type",
+			modify: func(l *StorageLayout) {
+				l.Storage[1].Type = "t_uint8"
+			},
+			err: "variable Bridge.paused changed type from bool to uint8",
+		},
+		{
+			name: "changed struct member in mapping",
+			modify: func(l *StorageLayout) {
+				l.Types["t_struct(Chain)12_storage"].Members[0].Type = "t_bool"
+			},
+			err: "variable Bridge.chains changed type",
+		},
+		{
+			name: "recompiled struct with different ast id",
+			modify: func(l *StorageLayout) {
+				mapping := l.Types["t_mapping(t_uint8,t_struct(Chain)12_storage)"]
+				delete(l.Types, "t_mapping(t_uint8,t_struct(Chain)12_storage)")
+				l.Types["t_mapping(t_uint8,t_struct(Chain)40_storage)"] = mapping
+				l.Types["t_struct(Chain)40_storage"] = l.Types["t_struct(Chain)12_storage"]
+				mapping.Value = "t_struct(Chain)40_storage"
+				l.Storage[2].Type = "t_mapping(t_uint8,t_struct(Chain)40_storage)"
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			var oldLayout, newLayout StorageLayout
+
+			require.NoError(t, json.Unmarshal([]byte(testStorageLayout), &oldLayout))
+			require.NoError(t, json.Unmarshal([]byte(testStorageLayout), &newLayout))
+
+			c.modify(&newLayout)
+
+			err := oldLayout.CheckUpgrade(&newLayout)
+			if c.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, c.err)
+			}
+		})
+	}
+}
+
+func Test_DecodeArtifact_StorageLayout(t *testing.T) {
+	t.Parallel()
+
+	artifact, err := DecodeArtifact([]byte(`{"abi": [], "bytecode": "0x00", "deployedBytecode": "0x00"}`))
+	require.NoError(t, err)
+	require.Nil(t, artifact.StorageLayout)
+
+	artifact, err = DecodeArtifact([]byte(`{"abi": [], "bytecode": "0x00", "deployedBytecode": "0x00",
+		"storageLayout": ` + testStorageLayout + `}`))
+	require.NoError(t, err)
+	require.NotNil(t, artifact.StorageLayout)
+	require.Len(t, artifact.StorageLayout.Storage, 4)
+}
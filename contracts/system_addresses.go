@@ -89,6 +89,10 @@ var (
 	ForkParamsContract = types.StringToAddress("0x100f")
 	// ForkParamsContract is the proxy address of ForkParams contract which holds data of enabled forks
 	ForkParamsContractV1 = types.StringToAddress("0x100f1")
+	// RuntimeConfigContract is an address without code, targeted by the governance proposals which change
+	// the client runtime configuration (block gas target, base fee parameters, address list admins).
+	// Calls to it are only recorded by the timelock and applied by the client at the end of the epoch
+	RuntimeConfigContract = types.StringToAddress("0x1012")
 
 	// SystemCaller is address of account, used for system calls to smart contracts
 	SystemCaller = types.StringToAddress("0xffffFFFfFFffffffffffffffFfFFFfffFFFfFFfE")
@@ -35,6 +35,14 @@ var (
 
 	// CardanoVerifySignaturePrecompile is an address of precompile that allows verifying cardano signatures
 	CardanoVerifySignaturePrecompile = types.StringToAddress("0x2050")
+	// CardanoAddressPrecompile is an address of precompile that allows validating and parsing cardano addresses
+	CardanoAddressPrecompile = types.StringToAddress("0x2051")
+	// CardanoKeyHashPrecompile is an address of precompile that calculates cardano key hashes
+	CardanoKeyHashPrecompile = types.StringToAddress("0x2052")
+	// CardanoNativeScriptPrecompile is an address of precompile that allows evaluating cardano native scripts
+	CardanoNativeScriptPrecompile = types.StringToAddress("0x2053")
+	// CardanoTxDecodePrecompile is an address of precompile that decodes raw cardano transactions
+	CardanoTxDecodePrecompile = types.StringToAddress("0x2054")
 	// CardanoVerifySignaturePrecompile is an address of precompile that allows verifying BLS signatures for Apex
 	ApexBLSSignaturesVerificationPrecompile = types.StringToAddress("0x2060")
 )
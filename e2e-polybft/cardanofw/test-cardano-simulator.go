@@ -0,0 +1,749 @@
+package cardanofw
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Ethernal-Tech/cardano-infrastructure/wallet"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/mr-tron/base58"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	simulatorJSONRPCVersion = "2.0"
+	simulatorEra            = "babbage"
+	simulatorMaxTxSize      = 16384
+
+	// ogmios error codes returned by the simulator
+	simulatorErrCodeUnknownMethod  = -32601
+	simulatorErrCodeInvalidParams  = -32602
+	simulatorErrCodeSubmitRejected = 3005
+)
+
+var (
+	errSimulatorUnknownInput     = errors.New("unknown or already spent input")
+	errSimulatorMissingWitness   = errors.New("missing verification key witness")
+	errSimulatorInvalidWitness   = errors.New("invalid verification key witness")
+	errSimulatorValueNotConserve = errors.New("value is not conserved")
+	errSimulatorOutsideValidity  = errors.New("transaction is outside of its validity interval")
+)
+
+// TestCardanoSimulatorConfig is the configuration of the in-process cardano chain simulator
+type TestCardanoSimulatorConfig struct {
+	NetworkType  wallet.CardanoNetworkType
+	Port         int
+	SlotDuration time.Duration
+	// BlockSlots is the number of the slots between two blocks
+	BlockSlots  uint64
+	EpochLength uint64
+	MinFeeA     uint64
+	MinFeeB     uint64
+	// InitialFunds are the lovelace amounts of the hex encoded genesis addresses
+	InitialFunds map[string]uint64
+}
+
+// NewSimulatorConfig returns the simulator configuration matching the shelley genesis of the network
+func NewSimulatorConfig(networkType wallet.CardanoNetworkType, port int, blockTime time.Duration,
+) *TestCardanoSimulatorConfig {
+	config := &TestCardanoSimulatorConfig{
+		NetworkType:  networkType,
+		Port:         port,
+		SlotDuration: time.Millisecond * 100,
+		EpochLength:  500,
+		MinFeeA:      44,
+		MinFeeB:      155381,
+		InitialFunds: map[string]uint64{},
+	}
+
+	if networkType == wallet.VectorTestNetNetwork || networkType == wallet.VectorMainNetNetwork {
+		config.SlotDuration = time.Second
+		config.EpochLength = 8640
+		config.MinFeeA = 45
+		config.MinFeeB = 156253
+	}
+
+	config.BlockSlots = uint64(blockTime / config.SlotDuration)
+	if config.BlockSlots == 0 {
+		config.BlockSlots = 1
+	}
+
+	return config
+}
+
+// TestCardanoSimulator is the in-process stand-in of ogmios backed by cardano-node. It keeps the ledger in memory,
+// produces the blocks with the configured slot time, and serves the subset of ogmios JSON-RPC
+// used by the tests: tip, block height, epoch, protocol parameters, utxo queries and tx submission.
+// It is meant for testing ogmios clients without cardano binaries. It is not a replacement of TestCardanoCluster:
+// it does not replace cardano-cli, which is used to build the transactions, and it does not
+// implement the node-to-node protocol, so apex validators can not chain sync from it
+type TestCardanoSimulator struct {
+	config   *TestCardanoSimulatorConfig
+	server   *http.Server
+	closeCh  chan struct{}
+	doneCh   chan struct{}
+	genesis  time.Time
+	stopOnce sync.Once
+
+	lock        sync.RWMutex
+	blockHeight uint64
+	blockSlot   uint64
+	blockHash   [32]byte
+	utxos       map[simulatorUtxoID]*simulatorTxOutput
+	mempool     []*simulatorTx
+	spent       map[simulatorUtxoID]struct{}
+}
+
+type simulatorUtxoID struct {
+	TxHash [32]byte
+	Index  uint64
+}
+
+type simulatorTxOutput struct {
+	Address []byte
+	Amount  uint64
+	// Tokens are the amounts of the native tokens by the hex encoded policy id and asset name
+	Tokens map[string]map[string]uint64
+}
+
+type simulatorTx struct {
+	Hash    [32]byte
+	Inputs  []simulatorUtxoID
+	Outputs []*simulatorTxOutput
+}
+
+func NewCardanoSimulator(config *TestCardanoSimulatorConfig) (*TestCardanoSimulator, error) {
+	sim, err := newCardanoSimulatorLedger(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return sim, sim.Start()
+}
+
+// newCardanoSimulatorLedger creates the simulator with the genesis utxos, without starting it
+func newCardanoSimulatorLedger(config *TestCardanoSimulatorConfig) (*TestCardanoSimulator, error) {
+	sim := &TestCardanoSimulator{
+		config: config,
+		utxos:  map[simulatorUtxoID]*simulatorTxOutput{},
+		spent:  map[simulatorUtxoID]struct{}{},
+	}
+
+	for addr, amount := range config.InitialFunds {
+		rawAddr, err := hex.DecodeString(addr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid initial funds address %s: %w", addr, err)
+		}
+
+		// genesis utxos are referenced by the hash of the address, same as in the shelley genesis
+		sim.utxos[simulatorUtxoID{TxHash: blake2b.Sum256(rawAddr)}] = &simulatorTxOutput{
+			Address: rawAddr,
+			Amount:  amount,
+		}
+	}
+
+	sim.genesis = time.Now()
+
+	return sim, nil
+}
+
+func (s *TestCardanoSimulator) Start() error {
+	listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", hostIP, s.config.Port))
+	if err != nil {
+		return err
+	}
+
+	s.closeCh = make(chan struct{})
+	s.doneCh = make(chan struct{})
+	s.stopOnce = sync.Once{}
+	s.server = &http.Server{
+		Handler:           http.HandlerFunc(s.handleJSONRPC),
+		ReadHeaderTimeout: time.Second * 5,
+	}
+
+	go func() {
+		_ = s.server.Serve(listener)
+	}()
+
+	go s.produceBlocks()
+
+	return nil
+}
+
+func (s *TestCardanoSimulator) Stop() error {
+	var err error
+
+	s.stopOnce.Do(func() {
+		close(s.closeCh)
+		<-s.doneCh
+
+		err = s.server.Shutdown(context.Background())
+		s.server = nil
+	})
+
+	return err
+}
+
+func (s *TestCardanoSimulator) IsRunning() bool {
+	return s.server != nil
+}
+
+func (s *TestCardanoSimulator) URL() string {
+	return fmt.Sprintf("http://localhost:%d", s.config.Port)
+}
+
+// Tip returns the latest block of the simulated chain
+func (s *TestCardanoSimulator) Tip() *wallet.QueryTipData {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return &wallet.QueryTipData{
+		Block: s.blockHeight,
+		Hash:  hex.EncodeToString(s.blockHash[:]),
+		Slot:  s.blockSlot,
+	}
+}
+
+// SubmitTx validates the signed transaction and adds it to the mempool, so it is included in the next block
+func (s *TestCardanoSimulator) SubmitTx(txRaw []byte) (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	tx, err := s.validateTx(txRaw)
+	if err != nil {
+		return "", err
+	}
+
+	for _, input := range tx.Inputs {
+		s.spent[input] = struct{}{}
+	}
+
+	s.mempool = append(s.mempool, tx)
+
+	return hex.EncodeToString(tx.Hash[:]), nil
+}
+
+func (s *TestCardanoSimulator) currentSlot() uint64 {
+	return uint64(time.Since(s.genesis) / s.config.SlotDuration)
+}
+
+func (s *TestCardanoSimulator) produceBlocks() {
+	defer close(s.doneCh)
+
+	ticker := time.NewTicker(s.config.SlotDuration * time.Duration(s.config.BlockSlots))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.closeCh:
+			return
+		case <-ticker.C:
+			s.produceBlock()
+		}
+	}
+}
+
+func (s *TestCardanoSimulator) produceBlock() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	slot := s.currentSlot()
+	header := make([]byte, 0, 48+32*len(s.mempool))
+	header = append(header, s.blockHash[:]...)
+	header = binary.BigEndian.AppendUint64(header, s.blockHeight+1)
+	header = binary.BigEndian.AppendUint64(header, slot)
+
+	for _, tx := range s.mempool {
+		for _, input := range tx.Inputs {
+			delete(s.utxos, input)
+			delete(s.spent, input)
+		}
+
+		for i, output := range tx.Outputs {
+			s.utxos[simulatorUtxoID{TxHash: tx.Hash, Index: uint64(i)}] = output
+		}
+
+		header = append(header, tx.Hash[:]...)
+	}
+
+	s.mempool = nil
+	s.blockHeight++
+	s.blockSlot = slot
+	s.blockHash = blake2b.Sum256(header)
+}
+
+// validateTx decodes the signed transaction and validates it against the ledger and the mempool
+func (s *TestCardanoSimulator) validateTx(txRaw []byte) (*simulatorTx, error) {
+	if len(txRaw) > simulatorMaxTxSize {
+		return nil, fmt.Errorf("transaction size %d exceeds maximum %d", len(txRaw), simulatorMaxTxSize)
+	}
+
+	var txParts []cbor.RawMessage
+	if err := cbor.Unmarshal(txRaw, &txParts); err != nil || len(txParts) < 2 {
+		return nil, fmt.Errorf("invalid transaction: %w", err)
+	}
+
+	var body struct {
+		Inputs        cbor.RawMessage                               `cbor:"0,keyasint"`
+		Outputs       []cbor.RawMessage                             `cbor:"1,keyasint"`
+		Fee           uint64                                        `cbor:"2,keyasint"`
+		TTL           *uint64                                       `cbor:"3,keyasint"`
+		ValidityStart *uint64                                       `cbor:"8,keyasint"`
+		Mint          map[cbor.ByteString]map[cbor.ByteString]int64 `cbor:"9,keyasint"`
+	}
+	if err := cbor.Unmarshal(txParts[0], &body); err != nil {
+		return nil, fmt.Errorf("invalid transaction body: %w", err)
+	}
+
+	var witnessSet struct {
+		VKeyWitnesses cbor.RawMessage `cbor:"0,keyasint"`
+	}
+	if err := cbor.Unmarshal(txParts[1], &witnessSet); err != nil {
+		return nil, fmt.Errorf("invalid transaction witness set: %w", err)
+	}
+
+	tx := &simulatorTx{Hash: blake2b.Sum256(txParts[0])}
+
+	var inputs []struct {
+		_      struct{} `cbor:",toarray"`
+		TxHash []byte
+		Index  uint64
+	}
+	if err := unmarshalSimulatorSet(body.Inputs, &inputs); err != nil {
+		return nil, fmt.Errorf("invalid transaction inputs: %w", err)
+	}
+
+	var witnesses []struct {
+		_         struct{} `cbor:",toarray"`
+		VKey      []byte
+		Signature []byte
+	}
+	if len(witnessSet.VKeyWitnesses) > 0 {
+		if err := unmarshalSimulatorSet(witnessSet.VKeyWitnesses, &witnesses); err != nil {
+			return nil, fmt.Errorf("invalid verification key witnesses: %w", err)
+		}
+	}
+
+	signers := make(map[[28]byte]struct{}, len(witnesses))
+
+	for _, witness := range witnesses {
+		if len(witness.VKey) != ed25519.PublicKeySize || !ed25519.Verify(witness.VKey, tx.Hash[:], witness.Signature) {
+			return nil, errSimulatorInvalidWitness
+		}
+
+		signers[blake2b224(witness.VKey)] = struct{}{}
+	}
+
+	slot := s.currentSlot()
+	if (body.TTL != nil && slot >= *body.TTL) || (body.ValidityStart != nil && slot < *body.ValidityStart) {
+		return nil, errSimulatorOutsideValidity
+	}
+
+	if minFee := s.config.MinFeeA*uint64(len(txRaw)) + s.config.MinFeeB; body.Fee < minFee {
+		return nil, fmt.Errorf("fee %d is lower than minimal fee %d", body.Fee, minFee)
+	}
+
+	consumed := &simulatorTxOutput{Tokens: map[string]map[string]uint64{}}
+
+	for _, input := range inputs {
+		id := simulatorUtxoID{Index: input.Index}
+		copy(id.TxHash[:], input.TxHash)
+
+		output, exists := s.utxos[id]
+		if _, spent := s.spent[id]; !exists || spent || len(input.TxHash) != len(id.TxHash) {
+			return nil, fmt.Errorf("%w: %x#%d", errSimulatorUnknownInput, input.TxHash, input.Index)
+		}
+
+		// outputs locked by the payment key must be witnessed by that key
+		if len(output.Address) >= 29 && (output.Address[0]>>4) <= 6 && (output.Address[0]>>4)%2 == 0 {
+			var keyHash [28]byte
+
+			copy(keyHash[:], output.Address[1:29])
+
+			if _, signed := signers[keyHash]; !signed {
+				return nil, fmt.Errorf("%w: %x", errSimulatorMissingWitness, keyHash)
+			}
+		}
+
+		consumed.add(output)
+		tx.Inputs = append(tx.Inputs, id)
+	}
+
+	for policyID, assets := range body.Mint {
+		for name, amount := range assets {
+			consumed.addToken(hex.EncodeToString([]byte(policyID)), hex.EncodeToString([]byte(name)), amount)
+		}
+	}
+
+	produced := &simulatorTxOutput{Amount: body.Fee, Tokens: map[string]map[string]uint64{}}
+
+	for _, outputRaw := range body.Outputs {
+		output, err := decodeSimulatorTxOutput(outputRaw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transaction output: %w", err)
+		}
+
+		produced.add(output)
+		tx.Outputs = append(tx.Outputs, output)
+	}
+
+	if !consumed.equal(produced) {
+		return nil, errSimulatorValueNotConserve
+	}
+
+	return tx, nil
+}
+
+// addressUtxos returns the unspent outputs of the address from the produced blocks
+func (s *TestCardanoSimulator) addressUtxos(rawAddr []byte) []simulatorUtxo {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	var result []simulatorUtxo
+
+	for id, output := range s.utxos {
+		if bytes.Equal(output.Address, rawAddr) {
+			result = append(result, simulatorUtxo{ID: id, Output: output})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if cmp := bytes.Compare(result[i].ID.TxHash[:], result[j].ID.TxHash[:]); cmp != 0 {
+			return cmp < 0
+		}
+
+		return result[i].ID.Index < result[j].ID.Index
+	})
+
+	return result
+}
+
+type simulatorUtxo struct {
+	ID     simulatorUtxoID
+	Output *simulatorTxOutput
+}
+
+func (o *simulatorTxOutput) add(other *simulatorTxOutput) {
+	o.Amount += other.Amount
+
+	for policyID, assets := range other.Tokens {
+		for name, amount := range assets {
+			o.addToken(policyID, name, int64(amount))
+		}
+	}
+}
+
+func (o *simulatorTxOutput) addToken(policyID, name string, amount int64) {
+	assets, exists := o.Tokens[policyID]
+	if !exists {
+		assets = map[string]uint64{}
+		o.Tokens[policyID] = assets
+	}
+
+	assets[name] = uint64(int64(assets[name]) + amount)
+	if assets[name] == 0 {
+		delete(assets, name)
+	}
+
+	if len(assets) == 0 {
+		delete(o.Tokens, policyID)
+	}
+}
+
+func (o *simulatorTxOutput) equal(other *simulatorTxOutput) bool {
+	if o.Amount != other.Amount || len(o.Tokens) != len(other.Tokens) {
+		return false
+	}
+
+	for policyID, assets := range o.Tokens {
+		if len(assets) != len(other.Tokens[policyID]) {
+			return false
+		}
+
+		for name, amount := range assets {
+			if other.Tokens[policyID][name] != amount {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// decodeSimulatorTxOutput decodes both legacy (array) and post-alonzo (map) transaction outputs
+func decodeSimulatorTxOutput(raw cbor.RawMessage) (*simulatorTxOutput, error) {
+	var (
+		address []byte
+		value   cbor.RawMessage
+	)
+
+	var legacy []cbor.RawMessage
+	if err := cbor.Unmarshal(raw, &legacy); err == nil {
+		if len(legacy) < 2 {
+			return nil, errors.New("missing address or value")
+		}
+
+		if err := cbor.Unmarshal(legacy[0], &address); err != nil {
+			return nil, err
+		}
+
+		value = legacy[1]
+	} else {
+		var output struct {
+			Address []byte          `cbor:"0,keyasint"`
+			Value   cbor.RawMessage `cbor:"1,keyasint"`
+		}
+		if err := cbor.Unmarshal(raw, &output); err != nil {
+			return nil, err
+		}
+
+		address, value = output.Address, output.Value
+	}
+
+	output := &simulatorTxOutput{Address: address, Tokens: map[string]map[string]uint64{}}
+	if err := cbor.Unmarshal(value, &output.Amount); err == nil {
+		return output, nil
+	}
+
+	var multiAsset struct {
+		_      struct{} `cbor:",toarray"`
+		Amount uint64
+		Assets map[cbor.ByteString]map[cbor.ByteString]uint64
+	}
+	if err := cbor.Unmarshal(value, &multiAsset); err != nil {
+		return nil, err
+	}
+
+	output.Amount = multiAsset.Amount
+
+	for policyID, assets := range multiAsset.Assets {
+		for name, amount := range assets {
+			output.addToken(hex.EncodeToString([]byte(policyID)), hex.EncodeToString([]byte(name)), int64(amount))
+		}
+	}
+
+	return output, nil
+}
+
+// unmarshalSimulatorSet unmarshals the array which could be tagged as a set (tag 258)
+func unmarshalSimulatorSet(raw cbor.RawMessage, v interface{}) error {
+	var tag cbor.RawTag
+	if err := cbor.Unmarshal(raw, &tag); err == nil && tag.Number == 258 {
+		raw = tag.Content
+	}
+
+	return cbor.Unmarshal(raw, v)
+}
+
+func blake2b224(data []byte) (result [28]byte) {
+	hash, _ := blake2b.New(len(result), nil)
+	hash.Write(data)
+	copy(result[:], hash.Sum(nil))
+
+	return result
+}
+
+// decodeSimulatorAddress decodes bech32 (shelley) or base58 (byron) address
+func decodeSimulatorAddress(addr string) ([]byte, error) {
+	if _, data, err := bech32.DecodeNoLimit(addr); err == nil {
+		return bech32.ConvertBits(data, 5, 8, false)
+	}
+
+	return base58.Decode(addr)
+}
+
+// encodeSimulatorAddress encodes the raw address with the prefix of the simulated network
+func (s *TestCardanoSimulator) encodeSimulatorAddress(rawAddr []byte) string {
+	if len(rawAddr) > 0 && rawAddr[0]>>4 == 8 {
+		return base58.Encode(rawAddr)
+	}
+
+	prefix := "addr_test"
+
+	switch s.config.NetworkType {
+	case wallet.MainNetNetwork:
+		prefix = "addr"
+	case wallet.VectorMainNetNetwork:
+		prefix = "vector"
+	case wallet.VectorTestNetNetwork:
+		prefix = "vector_test"
+	}
+
+	data, err := bech32.ConvertBits(rawAddr, 8, 5, true)
+	if err != nil {
+		return hex.EncodeToString(rawAddr)
+	}
+
+	encoded, err := bech32.Encode(prefix, data)
+	if err != nil {
+		return hex.EncodeToString(rawAddr)
+	}
+
+	return encoded
+}
+
+type simulatorRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+	ID      interface{}     `json:"id"`
+}
+
+type simulatorError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type simulatorResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *simulatorError `json:"error,omitempty"`
+	ID      interface{}     `json:"id"`
+}
+
+func (s *TestCardanoSimulator) handleJSONRPC(w http.ResponseWriter, r *http.Request) {
+	var request simulatorRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	response := simulatorResponse{JSONRPC: simulatorJSONRPCVersion, Method: request.Method, ID: request.ID}
+	response.Result, response.Error = s.execute(request.Method, request.Params)
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func (s *TestCardanoSimulator) execute(method string, params json.RawMessage) (interface{}, *simulatorError) {
+	switch method {
+	case "queryNetwork/tip", "queryLedgerState/tip":
+		tip := s.Tip()
+
+		return map[string]interface{}{"slot": tip.Slot, "id": tip.Hash}, nil
+	case "queryNetwork/blockHeight":
+		return s.Tip().Block, nil
+	case "queryLedgerState/epoch":
+		return s.currentSlot() / s.config.EpochLength, nil
+	case "queryLedgerState/era":
+		return simulatorEra, nil
+	case "queryLedgerState/protocolParameters":
+		return s.protocolParameters(), nil
+	case "queryLedgerState/utxo":
+		return s.queryUtxos(params)
+	case "submitTransaction":
+		var submitParams struct {
+			Transaction struct {
+				CBOR string `json:"cbor"`
+			} `json:"transaction"`
+		}
+
+		if err := json.Unmarshal(params, &submitParams); err != nil {
+			return nil, &simulatorError{Code: simulatorErrCodeInvalidParams, Message: err.Error()}
+		}
+
+		txRaw, err := hex.DecodeString(submitParams.Transaction.CBOR)
+		if err != nil {
+			return nil, &simulatorError{Code: simulatorErrCodeInvalidParams, Message: err.Error()}
+		}
+
+		txHash, err := s.SubmitTx(txRaw)
+		if err != nil {
+			return nil, &simulatorError{Code: simulatorErrCodeSubmitRejected, Message: err.Error()}
+		}
+
+		return map[string]interface{}{"transaction": map[string]string{"id": txHash}}, nil
+	default:
+		return nil, &simulatorError{Code: simulatorErrCodeUnknownMethod, Message: "unknown method " + method}
+	}
+}
+
+func (s *TestCardanoSimulator) queryUtxos(params json.RawMessage) (interface{}, *simulatorError) {
+	var utxoParams struct {
+		Addresses []string `json:"addresses"`
+	}
+
+	if err := json.Unmarshal(params, &utxoParams); err != nil {
+		return nil, &simulatorError{Code: simulatorErrCodeInvalidParams, Message: err.Error()}
+	}
+
+	result := []map[string]interface{}{}
+
+	for _, addr := range utxoParams.Addresses {
+		rawAddr, err := decodeSimulatorAddress(addr)
+		if err != nil {
+			return nil, &simulatorError{Code: simulatorErrCodeInvalidParams, Message: err.Error()}
+		}
+
+		for _, utxo := range s.addressUtxos(rawAddr) {
+			value := map[string]interface{}{
+				"ada": map[string]uint64{"lovelace": utxo.Output.Amount},
+			}
+
+			for policyID, assets := range utxo.Output.Tokens {
+				value[policyID] = assets
+			}
+
+			result = append(result, map[string]interface{}{
+				"transaction": map[string]string{"id": hex.EncodeToString(utxo.ID.TxHash[:])},
+				"index":       utxo.ID.Index,
+				// address is returned in the same format as queried
+				"address": addr,
+				"value":   value,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// protocolParameters returns the babbage protocol parameters in the ogmios format
+func (s *TestCardanoSimulator) protocolParameters() map[string]interface{} {
+	lovelace := func(amount uint64) map[string]interface{} {
+		return map[string]interface{}{"ada": map[string]uint64{"lovelace": amount}}
+	}
+
+	return map[string]interface{}{
+		"minFeeCoefficient":             s.config.MinFeeA,
+		"minFeeConstant":                lovelace(s.config.MinFeeB),
+		"maxBlockBodySize":              map[string]uint64{"bytes": 65536},
+		"maxBlockHeaderSize":            map[string]uint64{"bytes": 1100},
+		"maxTransactionSize":            map[string]uint64{"bytes": simulatorMaxTxSize},
+		"stakeCredentialDeposit":        lovelace(0),
+		"stakePoolDeposit":              lovelace(0),
+		"stakePoolRetirementEpochBound": 18,
+		"desiredNumberOfStakePools":     100,
+		"stakePoolPledgeInfluence":      "0/1",
+		"monetaryExpansion":             "1/10",
+		"treasuryExpansion":             "1/10",
+		"minStakePoolCost":              lovelace(0),
+		"minUtxoDepositConstant":        lovelace(0),
+		"minUtxoDepositCoefficient":     4310,
+		"plutusCostModels":              map[string][]int64{},
+		"scriptExecutionPrices":         map[string]string{"memory": "577/10000", "cpu": "721/10000000"},
+		"maxExecutionUnitsPerTransaction": map[string]uint64{
+			"memory": 14000000, "cpu": 10000000000,
+		},
+		"maxExecutionUnitsPerBlock": map[string]uint64{
+			"memory": 62000000, "cpu": 20000000000,
+		},
+		"maxValueSize":         map[string]uint64{"bytes": 5000},
+		"collateralPercentage": 150,
+		"maxCollateralInputs":  3,
+		"version":              map[string]uint64{"major": 8, "minor": 0},
+	}
+}
@@ -0,0 +1,460 @@
+package cardanofw
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Ethernal-Tech/cardano-infrastructure/wallet"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	simulatorTestGenesisAmount = uint64(10_000_000_000)
+	simulatorTestFee           = uint64(1_000_000)
+)
+
+func TestCardanoSimulator_SubmitTx(t *testing.T) {
+	t.Parallel()
+
+	sender, receiver := newSimulatorTestWallet(t), newSimulatorTestWallet(t)
+	sim := newTestCardanoSimulator(t, sender)
+	genesisInput := sender.genesisInput()
+
+	txRaw, txHash := newSimulatorTestTx(t, map[uint64]interface{}{
+		0: simulatorTestInputs(genesisInput),
+		1: []interface{}{
+			[]interface{}{receiver.address, uint64(5_000_000)},
+			[]interface{}{sender.address, simulatorTestGenesisAmount - 5_000_000 - simulatorTestFee},
+		},
+		2: simulatorTestFee,
+		3: sim.currentSlot() + 100,
+	}, sender.signingKey)
+
+	hash, err := sim.SubmitTx(txRaw)
+	require.NoError(t, err)
+	require.Equal(t, hex.EncodeToString(txHash[:]), hash)
+
+	// submitted transaction is not in the ledger until the next block, but its inputs are already taken
+	require.Empty(t, sim.addressUtxos(receiver.address))
+	require.Len(t, sim.addressUtxos(sender.address), 1)
+
+	_, err = sim.SubmitTx(txRaw)
+	require.ErrorIs(t, err, errSimulatorUnknownInput)
+
+	sim.produceBlock()
+
+	require.Equal(t, uint64(1), sim.Tip().Block)
+	require.Equal(t, []simulatorUtxo{{
+		ID:     simulatorUtxoID{TxHash: txHash, Index: 0},
+		Output: &simulatorTxOutput{Address: receiver.address, Amount: 5_000_000, Tokens: map[string]map[string]uint64{}},
+	}}, sim.addressUtxos(receiver.address))
+	require.Equal(t, []simulatorUtxo{{
+		ID: simulatorUtxoID{TxHash: txHash, Index: 1},
+		Output: &simulatorTxOutput{
+			Address: sender.address,
+			Amount:  simulatorTestGenesisAmount - 5_000_000 - simulatorTestFee,
+			Tokens:  map[string]map[string]uint64{},
+		},
+	}}, sim.addressUtxos(sender.address))
+
+	_, err = sim.SubmitTx(txRaw)
+	require.ErrorIs(t, err, errSimulatorUnknownInput)
+}
+
+func TestCardanoSimulator_ValidateTx(t *testing.T) {
+	t.Parallel()
+
+	policyID := bytes.Repeat([]byte{1}, 28)
+	tokens := map[cbor.ByteString]map[cbor.ByteString]uint64{
+		cbor.ByteString(policyID): {cbor.ByteString("token"): 100},
+	}
+
+	cases := []struct {
+		name string
+		// buildTx returns the signed transaction spending the genesis utxo of the sender
+		buildTx func(t *testing.T, sim *TestCardanoSimulator, sender, receiver *simulatorTestWallet) []byte
+		err     error
+		errMsg  string
+	}{
+		{
+			name: "valid transfer",
+			buildTx: func(t *testing.T, sim *TestCardanoSimulator, sender, receiver *simulatorTestWallet) []byte {
+				t.Helper()
+
+				tx, _ := newSimulatorTestTx(t, sender.transferBody(sim, receiver, simulatorTestFee), sender.signingKey)
+
+				return tx
+			},
+		},
+		{
+			name: "minted tokens",
+			buildTx: func(t *testing.T, sim *TestCardanoSimulator, sender, receiver *simulatorTestWallet) []byte {
+				t.Helper()
+
+				body := sender.transferBody(sim, receiver, simulatorTestFee)
+				body[1] = []interface{}{
+					[]interface{}{receiver.address, []interface{}{simulatorTestGenesisAmount - simulatorTestFee, tokens}},
+				}
+				body[9] = map[cbor.ByteString]map[cbor.ByteString]int64{
+					cbor.ByteString(policyID): {cbor.ByteString("token"): 100},
+				}
+
+				tx, _ := newSimulatorTestTx(t, body, sender.signingKey)
+
+				return tx
+			},
+		},
+		{
+			name: "tokens not minted",
+			buildTx: func(t *testing.T, sim *TestCardanoSimulator, sender, receiver *simulatorTestWallet) []byte {
+				t.Helper()
+
+				body := sender.transferBody(sim, receiver, simulatorTestFee)
+				body[1] = []interface{}{
+					[]interface{}{receiver.address, []interface{}{simulatorTestGenesisAmount - simulatorTestFee, tokens}},
+				}
+
+				tx, _ := newSimulatorTestTx(t, body, sender.signingKey)
+
+				return tx
+			},
+			err: errSimulatorValueNotConserve,
+		},
+		{
+			name: "value not conserved",
+			buildTx: func(t *testing.T, sim *TestCardanoSimulator, sender, receiver *simulatorTestWallet) []byte {
+				t.Helper()
+
+				body := sender.transferBody(sim, receiver, simulatorTestFee)
+				body[2] = simulatorTestFee * 2
+
+				tx, _ := newSimulatorTestTx(t, body, sender.signingKey)
+
+				return tx
+			},
+			err: errSimulatorValueNotConserve,
+		},
+		{
+			name: "fee too low",
+			buildTx: func(t *testing.T, sim *TestCardanoSimulator, sender, receiver *simulatorTestWallet) []byte {
+				t.Helper()
+
+				tx, _ := newSimulatorTestTx(t, sender.transferBody(sim, receiver, 1), sender.signingKey)
+
+				return tx
+			},
+			errMsg: "is lower than minimal fee",
+		},
+		{
+			name: "missing witness",
+			buildTx: func(t *testing.T, sim *TestCardanoSimulator, sender, receiver *simulatorTestWallet) []byte {
+				t.Helper()
+
+				tx, _ := newSimulatorTestTx(t, sender.transferBody(sim, receiver, simulatorTestFee), receiver.signingKey)
+
+				return tx
+			},
+			err: errSimulatorMissingWitness,
+		},
+		{
+			name: "witness of another transaction",
+			buildTx: func(t *testing.T, sim *TestCardanoSimulator, sender, receiver *simulatorTestWallet) []byte {
+				t.Helper()
+
+				body := sender.transferBody(sim, receiver, simulatorTestFee)
+				otherTx, _ := newSimulatorTestTx(t, body, sender.signingKey)
+
+				body[3] = sim.currentSlot() + 200
+				tx, _ := newSimulatorTestTx(t, body)
+
+				var otherTxParts, txParts []cbor.RawMessage
+
+				require.NoError(t, cbor.Unmarshal(otherTx, &otherTxParts))
+				require.NoError(t, cbor.Unmarshal(tx, &txParts))
+
+				txParts[1] = otherTxParts[1]
+
+				tx, err := cbor.Marshal(txParts)
+				require.NoError(t, err)
+
+				return tx
+			},
+			err: errSimulatorInvalidWitness,
+		},
+		{
+			name: "expired",
+			buildTx: func(t *testing.T, sim *TestCardanoSimulator, sender, receiver *simulatorTestWallet) []byte {
+				t.Helper()
+
+				body := sender.transferBody(sim, receiver, simulatorTestFee)
+				body[3] = uint64(0)
+
+				tx, _ := newSimulatorTestTx(t, body, sender.signingKey)
+
+				return tx
+			},
+			err: errSimulatorOutsideValidity,
+		},
+		{
+			name: "not yet valid",
+			buildTx: func(t *testing.T, sim *TestCardanoSimulator, sender, receiver *simulatorTestWallet) []byte {
+				t.Helper()
+
+				body := sender.transferBody(sim, receiver, simulatorTestFee)
+				body[8] = sim.currentSlot() + 50
+
+				tx, _ := newSimulatorTestTx(t, body, sender.signingKey)
+
+				return tx
+			},
+			err: errSimulatorOutsideValidity,
+		},
+		{
+			name: "unknown input",
+			buildTx: func(t *testing.T, sim *TestCardanoSimulator, sender, receiver *simulatorTestWallet) []byte {
+				t.Helper()
+
+				input := sender.genesisInput()
+				input.Index = 1
+
+				body := sender.transferBody(sim, receiver, simulatorTestFee)
+				body[0] = simulatorTestInputs(input)
+
+				tx, _ := newSimulatorTestTx(t, body, sender.signingKey)
+
+				return tx
+			},
+			err: errSimulatorUnknownInput,
+		},
+		{
+			name: "too large",
+			buildTx: func(t *testing.T, sim *TestCardanoSimulator, sender, receiver *simulatorTestWallet) []byte {
+				t.Helper()
+
+				return make([]byte, simulatorMaxTxSize+1)
+			},
+			errMsg: "exceeds maximum",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			sender, receiver := newSimulatorTestWallet(t), newSimulatorTestWallet(t)
+			sim := newTestCardanoSimulator(t, sender)
+
+			_, err := sim.SubmitTx(c.buildTx(t, sim, sender, receiver))
+
+			switch {
+			case c.err != nil:
+				require.ErrorIs(t, err, c.err)
+			case c.errMsg != "":
+				require.ErrorContains(t, err, c.errMsg)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCardanoSimulator_JSONRPC(t *testing.T) {
+	t.Parallel()
+
+	sender, receiver := newSimulatorTestWallet(t), newSimulatorTestWallet(t)
+	sim := newTestCardanoSimulator(t, sender)
+	senderAddr := sim.encodeSimulatorAddress(sender.address)
+	genesisInput := sender.genesisInput()
+
+	var utxos []struct {
+		Transaction struct {
+			ID string `json:"id"`
+		} `json:"transaction"`
+		Index   uint64 `json:"index"`
+		Address string `json:"address"`
+		Value   struct {
+			Ada struct {
+				Lovelace uint64 `json:"lovelace"`
+			} `json:"ada"`
+		} `json:"value"`
+	}
+
+	callSimulator(t, sim, "queryLedgerState/utxo", map[string]interface{}{"addresses": []string{senderAddr}}, &utxos)
+	require.Len(t, utxos, 1)
+	require.Equal(t, hex.EncodeToString(genesisInput.TxHash[:]), utxos[0].Transaction.ID)
+	require.Equal(t, uint64(0), utxos[0].Index)
+	require.Equal(t, senderAddr, utxos[0].Address)
+	require.Equal(t, simulatorTestGenesisAmount, utxos[0].Value.Ada.Lovelace)
+
+	txRaw, txHash := newSimulatorTestTx(t, sender.transferBody(sim, receiver, simulatorTestFee), sender.signingKey)
+	submitParams := map[string]interface{}{
+		"transaction": map[string]string{"cbor": hex.EncodeToString(txRaw)},
+	}
+
+	var submitResult struct {
+		Transaction struct {
+			ID string `json:"id"`
+		} `json:"transaction"`
+	}
+
+	callSimulator(t, sim, "submitTransaction", submitParams, &submitResult)
+	require.Equal(t, hex.EncodeToString(txHash[:]), submitResult.Transaction.ID)
+
+	err := callSimulator(t, sim, "submitTransaction", submitParams, nil)
+	require.NotNil(t, err)
+	require.Equal(t, simulatorErrCodeSubmitRejected, err.Code)
+
+	var blockHeight uint64
+
+	callSimulator(t, sim, "queryNetwork/blockHeight", nil, &blockHeight)
+	require.Equal(t, uint64(0), blockHeight)
+
+	sim.produceBlock()
+
+	callSimulator(t, sim, "queryNetwork/blockHeight", nil, &blockHeight)
+	require.Equal(t, uint64(1), blockHeight)
+
+	// genesis utxo is spent, while the whole amount is sent to the receiver
+	callSimulator(t, sim, "queryLedgerState/utxo", map[string]interface{}{"addresses": []string{senderAddr}}, &utxos)
+	require.Empty(t, utxos)
+
+	receiverAddr := sim.encodeSimulatorAddress(receiver.address)
+
+	callSimulator(t, sim, "queryLedgerState/utxo", map[string]interface{}{"addresses": []string{receiverAddr}}, &utxos)
+	require.Len(t, utxos, 1)
+	require.Equal(t, hex.EncodeToString(txHash[:]), utxos[0].Transaction.ID)
+	require.Equal(t, simulatorTestGenesisAmount-simulatorTestFee, utxos[0].Value.Ada.Lovelace)
+
+	err = callSimulator(t, sim, "queryLedgerState/rewardAccountSummaries", nil, nil)
+	require.NotNil(t, err)
+	require.Equal(t, simulatorErrCodeUnknownMethod, err.Code)
+}
+
+// simulatorTestWallet is the payment key with its enterprise testnet address
+type simulatorTestWallet struct {
+	signingKey ed25519.PrivateKey
+	address    []byte
+}
+
+func newSimulatorTestWallet(t *testing.T) *simulatorTestWallet {
+	t.Helper()
+
+	verificationKey, signingKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	keyHash := blake2b224(verificationKey)
+
+	return &simulatorTestWallet{
+		signingKey: signingKey,
+		address:    append([]byte{0x60}, keyHash[:]...),
+	}
+}
+
+func (w *simulatorTestWallet) genesisInput() simulatorUtxoID {
+	return simulatorUtxoID{TxHash: blake2b.Sum256(w.address)}
+}
+
+// transferBody returns the transaction body which sends the whole genesis amount (minus fee) to the receiver
+func (w *simulatorTestWallet) transferBody(
+	sim *TestCardanoSimulator, receiver *simulatorTestWallet, fee uint64,
+) map[uint64]interface{} {
+	return map[uint64]interface{}{
+		0: simulatorTestInputs(w.genesisInput()),
+		1: []interface{}{[]interface{}{receiver.address, simulatorTestGenesisAmount - fee}},
+		2: fee,
+		3: sim.currentSlot() + 100,
+	}
+}
+
+// newTestCardanoSimulator creates the simulator (without the server and the block production),
+// where each of the wallets owns a genesis utxo
+func newTestCardanoSimulator(t *testing.T, wallets ...*simulatorTestWallet) *TestCardanoSimulator {
+	t.Helper()
+
+	config := NewSimulatorConfig(wallet.TestNetNetwork, 0, time.Second)
+	for _, w := range wallets {
+		config.InitialFunds[hex.EncodeToString(w.address)] = simulatorTestGenesisAmount
+	}
+
+	sim, err := newCardanoSimulatorLedger(config)
+	require.NoError(t, err)
+
+	return sim
+}
+
+func simulatorTestInputs(ids ...simulatorUtxoID) []interface{} {
+	inputs := make([]interface{}, len(ids))
+	for i, id := range ids {
+		inputs[i] = []interface{}{id.TxHash[:], id.Index}
+	}
+
+	return inputs
+}
+
+// newSimulatorTestTx encodes the transaction body and signs it with the keys, returning signed transaction and its hash
+func newSimulatorTestTx(
+	t *testing.T, body map[uint64]interface{}, keys ...ed25519.PrivateKey,
+) ([]byte, [32]byte) {
+	t.Helper()
+
+	bodyRaw, err := cbor.Marshal(body)
+	require.NoError(t, err)
+
+	txHash := blake2b.Sum256(bodyRaw)
+	witnesses := make([]interface{}, len(keys))
+
+	for i, key := range keys {
+		witnesses[i] = []interface{}{[]byte(key.Public().(ed25519.PublicKey)), ed25519.Sign(key, txHash[:])}
+	}
+
+	tx, err := cbor.Marshal([]interface{}{
+		cbor.RawMessage(bodyRaw), map[uint64]interface{}{0: witnesses}, true, nil,
+	})
+	require.NoError(t, err)
+
+	return tx, txHash
+}
+
+// callSimulator sends the ogmios request to the simulator and decodes the result, returning the ogmios error if any
+func callSimulator(
+	t *testing.T, sim *TestCardanoSimulator, method string, params interface{}, result interface{},
+) *simulatorError {
+	t.Helper()
+
+	body, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": simulatorJSONRPCVersion, "method": method, "params": params, "id": 1,
+	})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	sim.handleJSONRPC(recorder, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var response struct {
+		Result json.RawMessage `json:"result"`
+		Error  *simulatorError `json:"error"`
+	}
+
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+
+	if response.Error != nil {
+		return response.Error
+	}
+
+	if result != nil {
+		require.NoError(t, json.Unmarshal(response.Result, result))
+	}
+
+	return nil
+}
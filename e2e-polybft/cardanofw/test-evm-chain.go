@@ -43,7 +43,7 @@ type TestEVMChainConfig struct {
 	PreminesAddresses      []types.Address
 	PremineAmount          *big.Int
 	StartingPort           int64
-	ApexConfig             uint8
+	GenesisProfile         string
 	BurnContractInfo       *polybft.BurnContractInfo
 }
 
@@ -57,7 +57,7 @@ func NewNexusChainConfig(isEnabled bool) *TestEVMChainConfig {
 			BlockNumber: 0,
 			Address:     types.ZeroAddress,
 		},
-		ApexConfig:             genesis.ApexConfigNexus,
+		GenesisProfile:         genesis.ProfileNexus,
 		InitialHotWalletAmount: big.NewInt(0),
 		PremineAmount:          ethgo.Ether(defaultPremineEthTokenAmount),
 		FundAmount:             ethgo.Ether(defaultFundEthTokenAmount),
@@ -107,7 +107,7 @@ func (ec *TestEVMChain) RunChain(t *testing.T) error {
 		framework.WithInitialPort(ec.config.StartingPort),
 		framework.WithLogsDirSuffix(ec.config.ChainID),
 		framework.WithBladeAdmin(ec.admin.Address().String()),
-		framework.WithApexConfig(ec.config.ApexConfig),
+		framework.WithGenesisProfile(ec.config.GenesisProfile),
 		framework.WithBurnContract(ec.config.BurnContractInfo),
 	)
 
@@ -116,7 +116,7 @@ type TestClusterConfig struct {
 	BladeAdmin           string
 	RewardWallet         string
 	PredeployContract    string
-	ApexConfig           uint8
+	GenesisProfile       string
 
 	ContractDeployerAllowListAdmin   []types.Address
 	ContractDeployerAllowListEnabled []types.Address
@@ -246,9 +246,9 @@ type TestCluster struct {
 
 type ClusterOption func(*TestClusterConfig)
 
-func WithApexConfig(apexConfig uint8) ClusterOption {
+func WithGenesisProfile(profile string) ClusterOption {
 	return func(h *TestClusterConfig) {
-		h.ApexConfig = apexConfig
+		h.GenesisProfile = profile
 	}
 }
 
@@ -518,18 +518,18 @@ func NewTestCluster(t *testing.T, validatorsCount int, opts ...ClusterOption) *T
 	var err error
 
 	config := &TestClusterConfig{
-		t:             t,
-		WithLogs:      isTrueEnv(envLogsEnabled),
-		WithStdout:    isTrueEnv(envStdoutEnabled),
-		Binary:        resolveBinary(),
-		EpochSize:     10,
-		EpochReward:   1,
-		BlockGasLimit: command.DefaultGenesisGasLimit,
-		StakeAmounts:  []*big.Int{},
-		HasBridge:     false,
-		VotingDelay:   10,
-		ApexConfig:    genesis.ApexConfigDefault,
-		InitialPort:   30300,
+		t:              t,
+		WithLogs:       isTrueEnv(envLogsEnabled),
+		WithStdout:     isTrueEnv(envStdoutEnabled),
+		Binary:         resolveBinary(),
+		EpochSize:      10,
+		EpochReward:    1,
+		BlockGasLimit:  command.DefaultGenesisGasLimit,
+		StakeAmounts:   []*big.Int{},
+		HasBridge:      false,
+		VotingDelay:    10,
+		GenesisProfile: genesis.ProfileApexBridge,
+		InitialPort:    30300,
 	}
 
 	if config.ValidatorPrefix == "" {
@@ -752,7 +752,7 @@ func NewTestCluster(t *testing.T, validatorsCount int, opts ...ClusterOption) *T
 			args = append(args, "--stake-token", parts[0])
 		}
 
-		args = append(args, "--apex-config", fmt.Sprint(config.ApexConfig))
+		args = append(args, "--profile", config.GenesisProfile)
 
 		args = append(args, "--bootnode-port", fmt.Sprint(config.InitialPort))
 
@@ -852,7 +852,7 @@ func (c *TestCluster) InitTestServer(t *testing.T,
 		config.TLSCertFile = c.Config.TLSCertFile
 		config.TLSKeyFile = c.Config.TLSKeyFile
 
-		if c.Config.ApexConfig == genesis.ApexConfigDefault {
+		if c.Config.GenesisProfile == genesis.ProfileApexBridge {
 			priceLimit := uint64(0)
 			config.PriceLimit = &priceLimit
 		}
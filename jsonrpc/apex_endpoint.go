@@ -0,0 +1,414 @@
+package jsonrpc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	errInvalidApexChainID       = fmt.Errorf("chain id must not be greater than %d", math.MaxUint8)
+	errApexChainNotRegistered   = errors.New("chain is not registered on the apex bridge")
+	errApexContractsNotDeployed = errors.New("apex bridge contracts are not deployed")
+)
+
+// apexStore interface provides access to the methods needed by apex endpoint
+type apexStore interface {
+	blockGetter
+
+	// ApplyTxn applies a transaction object to the blockchain
+	ApplyTxn(
+		header *types.Header,
+		txn *types.Transaction,
+		override types.StateOverride,
+		nonPayable bool,
+	) (*runtime.ExecutionResult, error)
+}
+
+// Apex is the apex bridge jsonrpc endpoint, which queries the state of the apex bridge contracts
+type Apex struct {
+	store apexStore
+}
+
+// apexChain is a chain registered on the apex bridge
+type apexChain struct {
+	ID              argUint64 `json:"id"`
+	ChainType       argUint64 `json:"chainType"`
+	AddressMultisig string    `json:"addressMultisig"`
+	AddressFeePayer string    `json:"addressFeePayer"`
+}
+
+// apexValidatorChainData is the data (verifying keys) of a single validator for the given chain
+type apexValidatorChainData struct {
+	Key [4]*argBig `json:"key"`
+}
+
+// apexConfirmedBatch is the last batch of the given destination chain confirmed by the validators
+type apexConfirmedBatch struct {
+	ID             argUint64  `json:"id"`
+	RawTransaction argBytes   `json:"rawTransaction"`
+	Signatures     []argBytes `json:"signatures"`
+	FeeSignatures  []argBytes `json:"feeSignatures"`
+	Bitmap         *argBig    `json:"bitmap"`
+}
+
+// apexPendingBatch contains the confirmed transactions of the given destination chain
+// which are going to be included in the next batch
+type apexPendingBatch struct {
+	ID                argUint64                   `json:"id"`
+	ShouldCreateBatch bool                        `json:"shouldCreateBatch"`
+	Transactions      []*apexConfirmedTransaction `json:"transactions"`
+}
+
+// apexConfirmedTransaction is a bridging transaction confirmed by the validators
+type apexConfirmedTransaction struct {
+	Nonce                   argUint64       `json:"nonce"`
+	SourceChainID           argUint64       `json:"sourceChainId"`
+	ObservedTransactionHash types.Hash      `json:"observedTransactionHash"`
+	TransactionType         argUint64       `json:"transactionType"`
+	BlockHeight             *argBig         `json:"blockHeight"`
+	TotalAmount             *argBig         `json:"totalAmount"`
+	RetryCounter            *argBig         `json:"retryCounter"`
+	Receivers               []*apexReceiver `json:"receivers"`
+}
+
+// apexReceiver is a receiver of the bridging transaction
+type apexReceiver struct {
+	DestinationAddress string  `json:"destinationAddress"`
+	Amount             *argBig `json:"amount"`
+}
+
+// apexBatchTransaction identifies the bridging transaction included in a batch
+type apexBatchTransaction struct {
+	SourceChainID           argUint64  `json:"sourceChainId"`
+	ObservedTransactionHash types.Hash `json:"observedTransactionHash"`
+}
+
+// apexClaimVotes is the number of validator votes for the given claim hash, along with the quorum
+type apexClaimVotes struct {
+	Votes  argUint64 `json:"votes"`
+	Quorum argUint64 `json:"quorum"`
+}
+
+// apexCardanoBlock is the last cardano block observed by the validators for the given chain
+type apexCardanoBlock struct {
+	BlockSlot *argBig    `json:"blockSlot"`
+	BlockHash types.Hash `json:"blockHash"`
+}
+
+// GetRegisteredChains returns the chains registered on the apex bridge
+func (a *Apex) GetRegisteredChains(filter BlockNumberOrHash) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, a.store)
+	if err != nil {
+		return nil, err
+	}
+
+	var out contractsapi.GetAllRegisteredChainsApexBridgeContractsBridgeOutput
+	if err := a.call(header, contracts.Bridge, contractsapi.ApexBridgeContracts.Bridge,
+		&contractsapi.GetAllRegisteredChainsApexBridgeContractsBridgeFn{}, &out); err != nil {
+		return nil, err
+	}
+
+	chains := make([]*apexChain, len(out.Chains))
+	for i, c := range out.Chains {
+		chains[i] = &apexChain{
+			ID:              argUint64(c.ID),
+			ChainType:       argUint64(c.ChainType),
+			AddressMultisig: c.AddressMultisig,
+			AddressFeePayer: c.AddressFeePayer,
+		}
+	}
+
+	return chains, nil
+}
+
+// GetValidatorsChainData returns the data of all the validators for the given chain
+func (a *Apex) GetValidatorsChainData(chainID argUint64, filter BlockNumberOrHash) (interface{}, error) {
+	header, err := a.getRegisteredChainHeader(chainID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var out contractsapi.GetValidatorsChainDataApexBridgeContractsValidatorsOutput
+	if err := a.call(header, contracts.Validators, contractsapi.ApexBridgeContracts.Validators,
+		&contractsapi.GetValidatorsChainDataApexBridgeContractsValidatorsFn{ChainID: uint8(chainID)}, &out); err != nil {
+		return nil, err
+	}
+
+	data := make([]*apexValidatorChainData, len(out.Field0))
+	for i, d := range out.Field0 {
+		data[i] = &apexValidatorChainData{}
+		for j, k := range d.Key {
+			data[i].Key[j] = argBigPtr(k)
+		}
+	}
+
+	return data, nil
+}
+
+// GetConfirmedBatch returns the last confirmed batch of the given destination chain
+func (a *Apex) GetConfirmedBatch(chainID argUint64, filter BlockNumberOrHash) (interface{}, error) {
+	header, err := a.getRegisteredChainHeader(chainID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var out contractsapi.GetConfirmedBatchApexBridgeContractsSignedBatchesOutput
+	if err := a.call(header, contracts.SignedBatches, contractsapi.ApexBridgeContracts.SignedBatches,
+		&contractsapi.GetConfirmedBatchApexBridgeContractsSignedBatchesFn{DestinationChain: uint8(chainID)},
+		&out); err != nil {
+		return nil, err
+	}
+
+	batch := &apexConfirmedBatch{
+		ID:             argUint64(out.Batch.ID),
+		RawTransaction: out.Batch.RawTransaction,
+		Signatures:     make([]argBytes, len(out.Batch.Signatures)),
+		FeeSignatures:  make([]argBytes, len(out.Batch.FeeSignatures)),
+		Bitmap:         argBigPtr(out.Batch.Bitmap),
+	}
+
+	for i, s := range out.Batch.Signatures {
+		batch.Signatures[i] = s
+	}
+
+	for i, s := range out.Batch.FeeSignatures {
+		batch.FeeSignatures[i] = s
+	}
+
+	return batch, nil
+}
+
+// GetPendingBatch returns the confirmed transactions of the given destination chain,
+// which are going to be included in the next batch, along with the id of the next batch
+func (a *Apex) GetPendingBatch(chainID argUint64, filter BlockNumberOrHash) (interface{}, error) {
+	header, err := a.getRegisteredChainHeader(chainID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		nextBatchID       contractsapi.GetNextBatchIdApexBridgeContractsBridgeOutput
+		shouldCreateBatch contractsapi.ShouldCreateBatchApexBridgeContractsBridgeOutput
+		confirmedTxs      contractsapi.GetConfirmedTransactionsApexBridgeContractsBridgeOutput
+	)
+
+	if err := a.call(header, contracts.Bridge, contractsapi.ApexBridgeContracts.Bridge,
+		&contractsapi.GetNextBatchIdApexBridgeContractsBridgeFn{DestinationChain: uint8(chainID)},
+		&nextBatchID); err != nil {
+		return nil, err
+	}
+
+	if err := a.call(header, contracts.Bridge, contractsapi.ApexBridgeContracts.Bridge,
+		&contractsapi.ShouldCreateBatchApexBridgeContractsBridgeFn{DestinationChain: uint8(chainID)},
+		&shouldCreateBatch); err != nil {
+		return nil, err
+	}
+
+	if err := a.call(header, contracts.Bridge, contractsapi.ApexBridgeContracts.Bridge,
+		&contractsapi.GetConfirmedTransactionsApexBridgeContractsBridgeFn{DestinationChain: uint8(chainID)},
+		&confirmedTxs); err != nil {
+		return nil, err
+	}
+
+	batch := &apexPendingBatch{
+		ID:                argUint64(nextBatchID.Result),
+		ShouldCreateBatch: shouldCreateBatch.Batch,
+		Transactions:      make([]*apexConfirmedTransaction, len(confirmedTxs.ConfirmedTransactions)),
+	}
+
+	for i, tx := range confirmedTxs.ConfirmedTransactions {
+		batch.Transactions[i] = toApexConfirmedTransaction(tx)
+	}
+
+	return batch, nil
+}
+
+// GetBatchTransactions returns the bridging transactions included in the given batch of the given chain
+func (a *Apex) GetBatchTransactions(
+	chainID argUint64, batchID argUint64, filter BlockNumberOrHash) (interface{}, error) {
+	header, err := a.getRegisteredChainHeader(chainID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var out contractsapi.GetBatchTransactionsApexBridgeContractsClaimsOutput
+	if err := a.call(header, contracts.Claims, contractsapi.ApexBridgeContracts.Claims,
+		&contractsapi.GetBatchTransactionsApexBridgeContractsClaimsFn{
+			ChainID: uint8(chainID),
+			BatchID: uint64(batchID),
+		}, &out); err != nil {
+		return nil, err
+	}
+
+	txs := make([]*apexBatchTransaction, len(out.Field0))
+	for i, tx := range out.Field0 {
+		txs[i] = &apexBatchTransaction{
+			SourceChainID:           argUint64(tx.SourceChainID),
+			ObservedTransactionHash: tx.ObservedTransactionHash,
+		}
+	}
+
+	return txs, nil
+}
+
+// GetClaimVotes returns the number of validator votes for the given claim hash, along with the quorum
+func (a *Apex) GetClaimVotes(hash types.Hash, filter BlockNumberOrHash) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, a.store)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		votes  contractsapi.NumberOfVotesApexBridgeContractsClaimsHelperOutput
+		quorum contractsapi.GetQuorumNumberOfValidatorsApexBridgeContractsValidatorsOutput
+	)
+
+	if err := a.call(header, contracts.ClaimsHelper, contractsapi.ApexBridgeContracts.ClaimsHelper,
+		&contractsapi.NumberOfVotesApexBridgeContractsClaimsHelperFn{Field0: hash}, &votes); err != nil {
+		return nil, err
+	}
+
+	if err := a.call(header, contracts.Validators, contractsapi.ApexBridgeContracts.Validators,
+		&contractsapi.GetQuorumNumberOfValidatorsApexBridgeContractsValidatorsFn{}, &quorum); err != nil {
+		return nil, err
+	}
+
+	return &apexClaimVotes{
+		Votes:  argUint64(votes.Field0),
+		Quorum: argUint64(quorum.Quorum),
+	}, nil
+}
+
+// GetLastObservedBlock returns the last cardano block (slot) observed by the validators for the given chain
+func (a *Apex) GetLastObservedBlock(chainID argUint64, filter BlockNumberOrHash) (interface{}, error) {
+	header, err := a.getRegisteredChainHeader(chainID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var out contractsapi.GetLastObservedBlockApexBridgeContractsSlotsOutput
+	if err := a.call(header, contracts.Slots, contractsapi.ApexBridgeContracts.Slots,
+		&contractsapi.GetLastObservedBlockApexBridgeContractsSlotsFn{ChainID: uint8(chainID)}, &out); err != nil {
+		return nil, err
+	}
+
+	return &apexCardanoBlock{
+		BlockSlot: argBigPtr(out.Cb.BlockSlot),
+		BlockHash: out.Cb.BlockHash,
+	}, nil
+}
+
+// GetChainTokenQuantity returns the quantity of the tokens available on the given chain
+func (a *Apex) GetChainTokenQuantity(chainID argUint64, filter BlockNumberOrHash) (interface{}, error) {
+	header, err := a.getRegisteredChainHeader(chainID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var out contractsapi.GetChainTokenQuantityApexBridgeContractsClaimsOutput
+	if err := a.call(header, contracts.Claims, contractsapi.ApexBridgeContracts.Claims,
+		&contractsapi.GetChainTokenQuantityApexBridgeContractsClaimsFn{ChainID: uint8(chainID)}, &out); err != nil {
+		return nil, err
+	}
+
+	return argBigPtr(out.Field0), nil
+}
+
+// getRegisteredChainHeader returns the header of the requested block,
+// if the given chain is registered on the apex bridge in that block
+func (a *Apex) getRegisteredChainHeader(chainID argUint64, filter BlockNumberOrHash) (*types.Header, error) {
+	if chainID > math.MaxUint8 {
+		return nil, errInvalidApexChainID
+	}
+
+	header, err := GetHeaderFromBlockNumberOrHash(filter, a.store)
+	if err != nil {
+		return nil, err
+	}
+
+	var out contractsapi.IsChainRegisteredApexBridgeContractsClaimsOutput
+	if err := a.call(header, contracts.Claims, contractsapi.ApexBridgeContracts.Claims,
+		&contractsapi.IsChainRegisteredApexBridgeContractsClaimsFn{Field0: uint8(chainID)}, &out); err != nil {
+		return nil, err
+	}
+
+	if !out.Field0 {
+		return nil, fmt.Errorf("%w: %d", errApexChainNotRegistered, chainID)
+	}
+
+	return header, nil
+}
+
+// call executes the given view function of the apex contract against the state of the given block,
+// and decodes its result to the given output
+func (a *Apex) call(header *types.Header, contract types.Address, artifact *contracts.Artifact,
+	fn contractsapi.ABIEncoder, out contractsapi.ABIDecoder) error {
+	input, err := fn.EncodeAbi()
+	if err != nil {
+		return fmt.Errorf("failed to encode call input: %w", err)
+	}
+
+	txn := types.NewTx(types.NewLegacyTx(
+		types.WithFrom(types.ZeroAddress),
+		types.WithTo(&contract),
+		types.WithInput(input),
+		types.WithGas(header.GasLimit),
+		types.WithGasPrice(big.NewInt(0)),
+	))
+
+	result, err := a.store.ApplyTxn(header, txn, nil, true)
+	if err != nil {
+		return err
+	}
+
+	if result.Reverted() {
+		if name, args, ok := contractsapi.DecodeCustomError(artifact.Abi, result.ReturnValue); ok {
+			return fmt.Errorf("%w: %s%v", result.Err, name, args)
+		}
+
+		return constructErrorFromRevert(result)
+	}
+
+	if result.Failed() {
+		return fmt.Errorf("unable to execute call: %w", result.Err)
+	}
+
+	if len(result.ReturnValue) == 0 {
+		return errApexContractsNotDeployed
+	}
+
+	if err := out.DecodeAbi(result.ReturnValue); err != nil {
+		return fmt.Errorf("failed to decode call result: %w", err)
+	}
+
+	return nil
+}
+
+// toApexConfirmedTransaction converts the confirmed transaction to its json representation
+func toApexConfirmedTransaction(tx *contractsapi.ConfirmedTransaction) *apexConfirmedTransaction {
+	receivers := make([]*apexReceiver, len(tx.Receivers))
+	for i, r := range tx.Receivers {
+		receivers[i] = &apexReceiver{
+			DestinationAddress: r.DestinationAddress,
+			Amount:             argBigPtr(r.Amount),
+		}
+	}
+
+	return &apexConfirmedTransaction{
+		Nonce:                   argUint64(tx.Nonce),
+		SourceChainID:           argUint64(tx.SourceChainID),
+		ObservedTransactionHash: tx.ObservedTransactionHash,
+		TransactionType:         argUint64(tx.TransactionType),
+		BlockHeight:             argBigPtr(tx.BlockHeight),
+		TotalAmount:             argBigPtr(tx.TotalAmount),
+		RetryCounter:            argBigPtr(tx.RetryCounter),
+		Receivers:               receivers,
+	}
+}
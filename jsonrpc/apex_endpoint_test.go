@@ -0,0 +1,304 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/Ethernal-Tech/ethgo/abi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// newTestApexEndpoint creates apex endpoint whose calls are answered with the given (abi encoded) method results
+func newTestApexEndpoint(t *testing.T, results map[string]interface{}) *Apex {
+	t.Helper()
+
+	artifacts := map[types.Address]*contracts.Artifact{
+		contracts.Bridge:        contractsapi.ApexBridgeContracts.Bridge,
+		contracts.ClaimsHelper:  contractsapi.ApexBridgeContracts.ClaimsHelper,
+		contracts.Claims:        contractsapi.ApexBridgeContracts.Claims,
+		contracts.SignedBatches: contractsapi.ApexBridgeContracts.SignedBatches,
+		contracts.Slots:         contractsapi.ApexBridgeContracts.Slots,
+		contracts.Validators:    contractsapi.ApexBridgeContracts.Validators,
+	}
+
+	store := &mockSpecialStore{
+		block: &types.Block{
+			Header: &types.Header{
+				Hash:     types.StringToHash("0x1"),
+				Number:   1,
+				GasLimit: 10_000_000,
+			},
+		},
+		applyTxnHook: func(_ *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error) {
+			for name, method := range artifacts[*txn.To()].Abi.Methods {
+				if !bytes.Equal(method.ID(), txn.Input()[:4]) {
+					continue
+				}
+
+				result, ok := results[name]
+				if !ok {
+					return &runtime.ExecutionResult{}, nil
+				}
+
+				output, err := method.Outputs.Encode(result)
+				require.NoError(t, err)
+
+				return &runtime.ExecutionResult{ReturnValue: output}, nil
+			}
+
+			return &runtime.ExecutionResult{Err: runtime.ErrExecutionReverted}, nil
+		},
+	}
+
+	return &Apex{store: store}
+}
+
+func TestApexEndpoint_GetRegisteredChains(t *testing.T) {
+	t.Parallel()
+
+	apex := newTestApexEndpoint(t, map[string]interface{}{
+		"getAllRegisteredChains": map[string]interface{}{
+			"_chains": []map[string]interface{}{
+				{"id": uint8(1), "chainType": uint8(0), "addressMultisig": "addr_test1", "addressFeePayer": "addr_test2"},
+				{"id": uint8(2), "chainType": uint8(1), "addressMultisig": "addr_test3", "addressFeePayer": "addr_test4"},
+			},
+		},
+	})
+
+	res, err := apex.GetRegisteredChains(BlockNumberOrHash{})
+	require.NoError(t, err)
+	require.Equal(t, []*apexChain{
+		{ID: 1, ChainType: 0, AddressMultisig: "addr_test1", AddressFeePayer: "addr_test2"},
+		{ID: 2, ChainType: 1, AddressMultisig: "addr_test3", AddressFeePayer: "addr_test4"},
+	}, res)
+}
+
+func TestApexEndpoint_ChainQueries(t *testing.T) {
+	t.Parallel()
+
+	observedTxHash := types.StringToHash("0xabc")
+	blockHash := types.StringToHash("0xdef")
+
+	apex := newTestApexEndpoint(t, map[string]interface{}{
+		"isChainRegistered": map[string]interface{}{"0": true},
+		"getValidatorsChainData": map[string]interface{}{
+			"0": []map[string]interface{}{
+				{"key": [4]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4)}},
+			},
+		},
+		"getConfirmedBatch": map[string]interface{}{
+			"_batch": map[string]interface{}{
+				"signatures":     [][]byte{{0x1}},
+				"feeSignatures":  [][]byte{{0x2}},
+				"bitmap":         big.NewInt(3),
+				"rawTransaction": []byte{0x4},
+				"id":             uint64(5),
+			},
+		},
+		"getNextBatchId":    map[string]interface{}{"_result": uint64(6)},
+		"shouldCreateBatch": map[string]interface{}{"_batch": true},
+		"getConfirmedTransactions": map[string]interface{}{
+			"_confirmedTransactions": []map[string]interface{}{
+				{
+					"blockHeight":             big.NewInt(10),
+					"totalAmount":             big.NewInt(100),
+					"retryCounter":            big.NewInt(1),
+					"nonce":                   uint64(7),
+					"sourceChainId":           uint8(2),
+					"observedTransactionHash": observedTxHash,
+					"transactionType":         uint8(0),
+					"receivers": []map[string]interface{}{
+						{"amount": big.NewInt(100), "destinationAddress": "addr_test5"},
+					},
+				},
+			},
+		},
+		"getBatchTransactions": map[string]interface{}{
+			"0": []map[string]interface{}{
+				{"sourceChainId": uint8(2), "observedTransactionHash": observedTxHash},
+			},
+		},
+		"getLastObservedBlock": map[string]interface{}{
+			"_cb": map[string]interface{}{"blockSlot": big.NewInt(1000), "blockHash": blockHash},
+		},
+		"getChainTokenQuantity": map[string]interface{}{"0": big.NewInt(5000)},
+	})
+
+	t.Run("validators chain data", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := apex.GetValidatorsChainData(1, BlockNumberOrHash{})
+		require.NoError(t, err)
+		require.Equal(t, []*apexValidatorChainData{
+			{Key: [4]*argBig{argBigPtr(big.NewInt(1)), argBigPtr(big.NewInt(2)),
+				argBigPtr(big.NewInt(3)), argBigPtr(big.NewInt(4))}},
+		}, res)
+	})
+
+	t.Run("confirmed batch", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := apex.GetConfirmedBatch(1, BlockNumberOrHash{})
+		require.NoError(t, err)
+		require.Equal(t, &apexConfirmedBatch{
+			ID:             5,
+			RawTransaction: argBytes{0x4},
+			Signatures:     []argBytes{{0x1}},
+			FeeSignatures:  []argBytes{{0x2}},
+			Bitmap:         argBigPtr(big.NewInt(3)),
+		}, res)
+	})
+
+	t.Run("pending batch", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := apex.GetPendingBatch(1, BlockNumberOrHash{})
+		require.NoError(t, err)
+		require.Equal(t, &apexPendingBatch{
+			ID:                6,
+			ShouldCreateBatch: true,
+			Transactions: []*apexConfirmedTransaction{
+				{
+					Nonce:                   7,
+					SourceChainID:           2,
+					ObservedTransactionHash: observedTxHash,
+					BlockHeight:             argBigPtr(big.NewInt(10)),
+					TotalAmount:             argBigPtr(big.NewInt(100)),
+					RetryCounter:            argBigPtr(big.NewInt(1)),
+					Receivers: []*apexReceiver{
+						{DestinationAddress: "addr_test5", Amount: argBigPtr(big.NewInt(100))},
+					},
+				},
+			},
+		}, res)
+	})
+
+	t.Run("batch transactions", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := apex.GetBatchTransactions(1, 5, BlockNumberOrHash{})
+		require.NoError(t, err)
+		require.Equal(t, []*apexBatchTransaction{
+			{SourceChainID: 2, ObservedTransactionHash: observedTxHash},
+		}, res)
+	})
+
+	t.Run("last observed block", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := apex.GetLastObservedBlock(1, BlockNumberOrHash{})
+		require.NoError(t, err)
+		require.Equal(t, &apexCardanoBlock{BlockSlot: argBigPtr(big.NewInt(1000)), BlockHash: blockHash}, res)
+	})
+
+	t.Run("chain token quantity", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := apex.GetChainTokenQuantity(1, BlockNumberOrHash{})
+		require.NoError(t, err)
+		require.Equal(t, argBigPtr(big.NewInt(5000)), res)
+	})
+
+	t.Run("invalid chain id", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := apex.GetChainTokenQuantity(256, BlockNumberOrHash{})
+		require.ErrorIs(t, err, errInvalidApexChainID)
+	})
+}
+
+func TestApexEndpoint_GetClaimVotes(t *testing.T) {
+	t.Parallel()
+
+	apex := newTestApexEndpoint(t, map[string]interface{}{
+		"numberOfVotes":               map[string]interface{}{"0": uint8(3)},
+		"getQuorumNumberOfValidators": map[string]interface{}{"_quorum": uint8(4)},
+	})
+
+	res, err := apex.GetClaimVotes(types.StringToHash("0x1"), BlockNumberOrHash{})
+	require.NoError(t, err)
+	require.Equal(t, &apexClaimVotes{Votes: 3, Quorum: 4}, res)
+}
+
+func TestApexEndpoint_Errors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("chain not registered", func(t *testing.T) {
+		t.Parallel()
+
+		apex := newTestApexEndpoint(t, map[string]interface{}{
+			"isChainRegistered": map[string]interface{}{"0": false},
+		})
+
+		_, err := apex.GetLastObservedBlock(1, BlockNumberOrHash{})
+		require.ErrorIs(t, err, errApexChainNotRegistered)
+	})
+
+	t.Run("contracts not deployed", func(t *testing.T) {
+		t.Parallel()
+
+		apex := newTestApexEndpoint(t, map[string]interface{}{})
+
+		_, err := apex.GetRegisteredChains(BlockNumberOrHash{})
+		require.ErrorIs(t, err, errApexContractsNotDeployed)
+	})
+
+	t.Run("unknown block", func(t *testing.T) {
+		t.Parallel()
+
+		apex := newTestApexEndpoint(t, map[string]interface{}{})
+		blockNumber := BlockNumber(10)
+
+		_, err := apex.GetRegisteredChains(BlockNumberOrHash{BlockNumber: &blockNumber})
+		require.Error(t, err)
+	})
+}
+
+func TestApexEndpoint_Call_Reverted(t *testing.T) {
+	t.Parallel()
+
+	revertReason, err := abi.MustNewType("tuple(string reason)").Encode(map[string]interface{}{"reason": "not allowed"})
+	require.NoError(t, err)
+
+	apex := &Apex{store: &mockSpecialStore{
+		block: &types.Block{Header: &types.Header{Number: 1}},
+		applyTxnHook: func(_ *types.Header, _ *types.Transaction) (*runtime.ExecutionResult, error) {
+			return &runtime.ExecutionResult{
+				Err:         runtime.ErrExecutionReverted,
+				ReturnValue: append([]byte{0x08, 0xc3, 0x79, 0xa0}, revertReason...),
+			}, nil
+		},
+	}}
+
+	_, err = apex.GetRegisteredChains(BlockNumberOrHash{})
+	require.ErrorIs(t, err, runtime.ErrExecutionReverted)
+	require.ErrorContains(t, err, "not allowed")
+}
+
+func TestApexEndpoint_Call_CustomError(t *testing.T) {
+	t.Parallel()
+
+	apexErr := &contractsapi.ChainIsNotRegisteredError{ChainID: 3}
+	encoded, err := contractsapi.ApexBridgeContracts.Claims.Abi.Errors["ChainIsNotRegistered"].Inputs.Encode(apexErr)
+	require.NoError(t, err)
+
+	apex := &Apex{store: &mockSpecialStore{
+		block: &types.Block{Header: &types.Header{Number: 1}},
+		applyTxnHook: func(_ *types.Header, _ *types.Transaction) (*runtime.ExecutionResult, error) {
+			return &runtime.ExecutionResult{
+				Err:         runtime.ErrExecutionReverted,
+				ReturnValue: append(apexErr.Sig(), encoded...),
+			}, nil
+		},
+	}}
+
+	_, err = apex.GetChainTokenQuantity(3, BlockNumberOrHash{})
+	require.ErrorIs(t, err, runtime.ErrExecutionReverted)
+	require.ErrorContains(t, err, "ChainIsNotRegistered")
+}
@@ -1,13 +1,40 @@
 package jsonrpc
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
+const (
+	// defaultTokenMappingsLimit is the number of token mappings returned if the limit is not provided
+	defaultTokenMappingsLimit = uint64(100)
+	// maxTokenMappingsLimit is the maximum number of token mappings returned by a single request
+	maxTokenMappingsLimit = uint64(1000)
+	// maxExitProofsCount is the maximum number of exit proofs generated by a single request
+	maxExitProofsCount = uint64(256)
+)
+
+var (
+	errInvalidTransferStatusArgs = errors.New("exactly one of stateSyncId, exitId or txHash must be provided")
+	errInvalidTokenMappingsLimit = fmt.Errorf("limit must be between 1 and %d", maxTokenMappingsLimit)
+	errInvalidExitProofsArgs     = errors.New("either exitIds or both from and to must be provided")
+	errInvalidExitProofsRange    = errors.New("from must not be greater than to")
+	errTooManyExitProofs         = fmt.Errorf("at most %d exit proofs can be generated at once", maxExitProofsCount)
+)
+
 // bridgeStore interface provides access to the methods needed by bridge endpoint
 type bridgeStore interface {
 	GenerateExitProof(exitID uint64) (types.Proof, error)
+	GenerateExitProofs(exitIDs []uint64) ([]types.Proof, error)
 	GetStateSyncProof(stateSyncID uint64) (types.Proof, error)
+	GetTransferStatus(query *types.BridgeTransferQuery) ([]*types.BridgeTransferStatus, error)
+	GetDeadLetterEvents(transferType types.BridgeTransferType) ([]*types.BridgeRelayerEvent, error)
+	RetryDeadLetterEvents(transferType types.BridgeTransferType, eventIDs []uint64) error
+	SkipDeadLetterEvents(transferType types.BridgeTransferType, eventIDs []uint64) error
+	GetTokenMappings(query *types.BridgeTokenMappingQuery) (*types.BridgeTokenMappings, error)
+	GetCheckpointLag() (*types.BridgeCheckpointLag, error)
 }
 
 // Bridge is the bridge jsonrpc endpoint
@@ -15,12 +42,159 @@ type Bridge struct {
 	store bridgeStore
 }
 
+// transferStatusArgs identifies the bridge transfers whose status is requested
+type transferStatusArgs struct {
+	StateSyncID *argUint64  `json:"stateSyncId"`
+	ExitID      *argUint64  `json:"exitId"`
+	TxHash      *types.Hash `json:"txHash"`
+}
+
+// exitProofsArgs identifies the exit events whose proofs are requested,
+// either as a list of ids or as an inclusive range of ids
+type exitProofsArgs struct {
+	ExitIDs []argUint64 `json:"exitIds"`
+	From    *argUint64  `json:"from"`
+	To      *argUint64  `json:"to"`
+}
+
+// tokenMappingsArgs filters and pages the token mappings
+type tokenMappingsArgs struct {
+	TokenType types.BridgeTokenType `json:"tokenType"`
+	Offset    *argUint64            `json:"offset"`
+	Limit     *argUint64            `json:"limit"`
+}
+
 // GenerateExitProof generates exit proof for given exit event
 func (b *Bridge) GenerateExitProof(exitID argUint64) (interface{}, error) {
 	return b.store.GenerateExitProof(uint64(exitID))
 }
 
+// GenerateExitProofs generates exit proofs for given exit events, identified either by the list of ids
+// or by the inclusive range of ids. Proofs are returned in the order of requested ids
+func (b *Bridge) GenerateExitProofs(args *exitProofsArgs) (interface{}, error) {
+	if args == nil {
+		return nil, errInvalidExitProofsArgs
+	}
+
+	var exitIDs []uint64
+
+	switch {
+	case len(args.ExitIDs) > 0 && args.From == nil && args.To == nil:
+		if uint64(len(args.ExitIDs)) > maxExitProofsCount {
+			return nil, errTooManyExitProofs
+		}
+
+		exitIDs = toUint64Slice(args.ExitIDs)
+
+	case len(args.ExitIDs) == 0 && args.From != nil && args.To != nil:
+		from, to := uint64(*args.From), uint64(*args.To)
+		if from > to {
+			return nil, errInvalidExitProofsRange
+		}
+
+		if to-from >= maxExitProofsCount {
+			return nil, errTooManyExitProofs
+		}
+
+		exitIDs = make([]uint64, 0, to-from+1)
+		for id := from; id <= to; id++ {
+			exitIDs = append(exitIDs, id)
+		}
+
+	default:
+		return nil, errInvalidExitProofsArgs
+	}
+
+	return b.store.GenerateExitProofs(exitIDs)
+}
+
 // GetStateSyncProof retrieves the StateSync proof
 func (b *Bridge) GetStateSyncProof(stateSyncID argUint64) (interface{}, error) {
 	return b.store.GetStateSyncProof(uint64(stateSyncID))
 }
+
+// GetTransferStatus retrieves the status of the bridge transfers, identified either
+// by the state sync id, the exit id or the hash of the originating transaction
+func (b *Bridge) GetTransferStatus(args *transferStatusArgs) (interface{}, error) {
+	query := &types.BridgeTransferQuery{TxHash: args.TxHash}
+	provided := 0
+
+	if args.StateSyncID != nil {
+		id := uint64(*args.StateSyncID)
+		query.StateSyncID = &id
+		provided++
+	}
+
+	if args.ExitID != nil {
+		id := uint64(*args.ExitID)
+		query.ExitID = &id
+		provided++
+	}
+
+	if args.TxHash != nil {
+		provided++
+	}
+
+	if provided != 1 {
+		return nil, errInvalidTransferStatusArgs
+	}
+
+	return b.store.GetTransferStatus(query)
+}
+
+// GetDeadLetterEvents retrieves the events of given transfer type (stateSync or exit)
+// which the relayer failed to relay
+func (b *Bridge) GetDeadLetterEvents(transferType types.BridgeTransferType) (interface{}, error) {
+	return b.store.GetDeadLetterEvents(transferType)
+}
+
+// RetryDeadLetterEvents returns given dead-lettered events of given transfer type back to the relayer queue
+func (b *Bridge) RetryDeadLetterEvents(transferType types.BridgeTransferType,
+	eventIDs []argUint64) (interface{}, error) {
+	return nil, b.store.RetryDeadLetterEvents(transferType, toUint64Slice(eventIDs))
+}
+
+// SkipDeadLetterEvents removes given dead-lettered events of given transfer type, so they are never relayed
+func (b *Bridge) SkipDeadLetterEvents(transferType types.BridgeTransferType,
+	eventIDs []argUint64) (interface{}, error) {
+	return nil, b.store.SkipDeadLetterEvents(transferType, toUint64Slice(eventIDs))
+}
+
+// GetTokenMappings retrieves the root to child token mappings done by the bridge predicates,
+// optionally filtered by the token type (erc20, erc721 or erc1155) and paged by the offset and limit
+func (b *Bridge) GetTokenMappings(args *tokenMappingsArgs) (interface{}, error) {
+	query := &types.BridgeTokenMappingQuery{Limit: defaultTokenMappingsLimit}
+
+	if args != nil {
+		query.TokenType = args.TokenType
+
+		if args.Offset != nil {
+			query.Offset = uint64(*args.Offset)
+		}
+
+		if args.Limit != nil {
+			query.Limit = uint64(*args.Limit)
+		}
+	}
+
+	if query.Limit == 0 || query.Limit > maxTokenMappingsLimit {
+		return nil, errInvalidTokenMappingsLimit
+	}
+
+	return b.store.GetTokenMappings(query)
+}
+
+// GetCheckpointLag retrieves the number of child chain blocks which are not checkpointed on the rootchain,
+// along with the checkpoint lag alert threshold
+func (b *Bridge) GetCheckpointLag() (interface{}, error) {
+	return b.store.GetCheckpointLag()
+}
+
+func toUint64Slice(values []argUint64) []uint64 {
+	result := make([]uint64, len(values))
+	for i, value := range values {
+		result[i] = uint64(value)
+	}
+
+	return result
+}
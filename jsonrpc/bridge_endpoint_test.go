@@ -6,6 +6,8 @@ import (
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
 )
 
 func TestBridgeEndpoint(t *testing.T) {
@@ -51,4 +53,176 @@ func TestBridgeEndpoint(t *testing.T) {
 	require.NoError(t, json.Unmarshal(data, resp))
 	require.Nil(t, resp.Error)
 	require.NotNil(t, resp.Result)
+
+	msg = []byte(`{
+		"method": "bridge_getTransferStatus",
+		"params": [{"stateSyncId": "0x5"}],
+		"id": 1
+	}`)
+
+	data, err = dispatcher.HandleWs(msg, mockConnection)
+	require.NoError(t, err)
+
+	resp = new(SuccessResponse)
+	require.NoError(t, json.Unmarshal(data, resp))
+	require.Nil(t, resp.Error)
+
+	var statuses []*types.BridgeTransferStatus
+	require.NoError(t, json.Unmarshal(resp.Result, &statuses))
+	require.Len(t, statuses, 1)
+	require.Equal(t, uint64(5), statuses[0].ID)
+	require.Len(t, statuses[0].Stages, 3)
+
+	msg = []byte(`{
+		"method": "bridge_getTransferStatus",
+		"params": [{"stateSyncId": "0x5", "exitId": "0x1"}],
+		"id": 1
+	}`)
+
+	data, err = dispatcher.HandleWs(msg, mockConnection)
+	require.NoError(t, err)
+
+	resp = new(SuccessResponse)
+	require.NoError(t, json.Unmarshal(data, resp))
+	require.NotNil(t, resp.Error)
+
+	msg = []byte(`{
+		"method": "bridge_getDeadLetterEvents",
+		"params": ["exit"],
+		"id": 1
+	}`)
+
+	data, err = dispatcher.HandleWs(msg, mockConnection)
+	require.NoError(t, err)
+
+	resp = new(SuccessResponse)
+	require.NoError(t, json.Unmarshal(data, resp))
+	require.Nil(t, resp.Error)
+
+	var events []*types.BridgeRelayerEvent
+	require.NoError(t, json.Unmarshal(resp.Result, &events))
+	require.Len(t, events, 1)
+	require.Equal(t, uint64(15), events[0].CountTries)
+
+	for _, method := range []string{"bridge_retryDeadLetterEvents", "bridge_skipDeadLetterEvents"} {
+		msg = []byte(`{
+			"method": "` + method + `",
+			"params": ["exit", ["0x1", "0x2"]],
+			"id": 1
+		}`)
+
+		data, err = dispatcher.HandleWs(msg, mockConnection)
+		require.NoError(t, err)
+
+		resp = new(SuccessResponse)
+		require.NoError(t, json.Unmarshal(data, resp))
+		require.Nil(t, resp.Error)
+	}
+	msg = []byte(`{
+		"method": "bridge_getTokenMappings",
+		"params": [{"tokenType": "erc721", "offset": "0x1", "limit": "0x2"}],
+		"id": 1
+	}`)
+
+	data, err = dispatcher.HandleWs(msg, mockConnection)
+	require.NoError(t, err)
+
+	resp = new(SuccessResponse)
+	require.NoError(t, json.Unmarshal(data, resp))
+	require.Nil(t, resp.Error)
+
+	var mappings *types.BridgeTokenMappings
+	require.NoError(t, json.Unmarshal(resp.Result, &mappings))
+	require.Equal(t, uint64(3), mappings.Total)
+	require.Len(t, mappings.Mappings, 1)
+	require.Equal(t, types.ERC721BridgeToken, mappings.Mappings[0].TokenType)
+
+	msg = []byte(`{
+		"method": "bridge_getTokenMappings",
+		"params": [{"limit": "0x0"}],
+		"id": 1
+	}`)
+
+	data, err = dispatcher.HandleWs(msg, mockConnection)
+	require.NoError(t, err)
+
+	resp = new(SuccessResponse)
+	require.NoError(t, json.Unmarshal(data, resp))
+	require.NotNil(t, resp.Error)
+
+	msg = []byte(`{
+		"method": "bridge_getCheckpointLag",
+		"params": [],
+		"id": 1
+	}`)
+
+	data, err = dispatcher.HandleWs(msg, mockConnection)
+	require.NoError(t, err)
+
+	resp = new(SuccessResponse)
+	require.NoError(t, json.Unmarshal(data, resp))
+	require.Nil(t, resp.Error)
+
+	var lag *types.BridgeCheckpointLag
+	require.NoError(t, json.Unmarshal(resp.Result, &lag))
+	require.Equal(t, uint64(40), lag.Lag)
+	require.True(t, lag.Alert)
+
+	msg = []byte(`{
+		"method": "bridge_generateExitProofs",
+		"params": [{"from": "0x3", "to": "0x5"}],
+		"id": 1
+	}`)
+
+	data, err = dispatcher.HandleWs(msg, mockConnection)
+	require.NoError(t, err)
+
+	resp = new(SuccessResponse)
+	require.NoError(t, json.Unmarshal(data, resp))
+	require.Nil(t, resp.Error)
+
+	var proofs []types.Proof
+	require.NoError(t, json.Unmarshal(resp.Result, &proofs))
+	require.Len(t, proofs, 3)
+
+	for i, proof := range proofs {
+		require.Equal(t, float64(3+i), proof.Metadata["LeafIndex"])
+	}
+
+	msg = []byte(`{
+		"method": "bridge_generateExitProofs",
+		"params": [{"exitIds": ["0x7", "0x2"]}],
+		"id": 1
+	}`)
+
+	data, err = dispatcher.HandleWs(msg, mockConnection)
+	require.NoError(t, err)
+
+	resp = new(SuccessResponse)
+	require.NoError(t, json.Unmarshal(data, resp))
+	require.Nil(t, resp.Error)
+	require.NoError(t, json.Unmarshal(resp.Result, &proofs))
+	require.Len(t, proofs, 2)
+	require.Equal(t, float64(7), proofs[0].Metadata["LeafIndex"])
+	require.Equal(t, float64(2), proofs[1].Metadata["LeafIndex"])
+
+	for _, params := range []string{
+		`{"exitIds": ["0x1"], "from": "0x1", "to": "0x2"}`,
+		`{"from": "0x5", "to": "0x1"}`,
+		`{"from": "0x1", "to": "0x1000"}`,
+		`{}`,
+	} {
+		msg = []byte(`{
+			"method": "bridge_generateExitProofs",
+			"params": [` + params + `],
+			"id": 1
+		}`)
+
+		data, err = dispatcher.HandleWs(msg, mockConnection)
+		require.NoError(t, err)
+
+		errResp := new(ErrorResponse)
+		require.NoError(t, json.Unmarshal(data, errResp))
+		require.NotNil(t, errResp.Error, params)
+	}
 }
@@ -18,6 +18,12 @@ func init() {
 
 	earliestBN := EarliestBlockNumber
 	EarliestBlockNumberOrHash = BlockNumberOrHash{BlockNumber: &earliestBN}
+
+	finalizedBN := FinalizedBlockNumber
+	FinalizedBlockNumberOrHash = BlockNumberOrHash{BlockNumber: &finalizedBN}
+
+	safeBN := SafeBlockNumber
+	SafeBlockNumberOrHash = BlockNumberOrHash{BlockNumber: &safeBN}
 }
 
 // Request is a jsonrpc request
@@ -127,21 +133,27 @@ func (e *ObjectError) MarshalJSON() ([]byte, error) {
 }
 
 const (
-	pending  = "pending"
-	latest   = "latest"
-	earliest = "earliest"
+	pending   = "pending"
+	latest    = "latest"
+	earliest  = "earliest"
+	finalized = "finalized"
+	safe      = "safe"
 )
 
 const (
-	PendingBlockNumber  = BlockNumber(-3)
-	LatestBlockNumber   = BlockNumber(-2)
-	EarliestBlockNumber = BlockNumber(-1)
+	SafeBlockNumber      = BlockNumber(-5)
+	FinalizedBlockNumber = BlockNumber(-4)
+	PendingBlockNumber   = BlockNumber(-3)
+	LatestBlockNumber    = BlockNumber(-2)
+	EarliestBlockNumber  = BlockNumber(-1)
 )
 
 var (
-	PendingBlockNumberOrHash  BlockNumberOrHash
-	LatestBlockNumberOrHash   BlockNumberOrHash
-	EarliestBlockNumberOrHash BlockNumberOrHash
+	PendingBlockNumberOrHash   BlockNumberOrHash
+	LatestBlockNumberOrHash    BlockNumberOrHash
+	EarliestBlockNumberOrHash  BlockNumberOrHash
+	FinalizedBlockNumberOrHash BlockNumberOrHash
+	SafeBlockNumberOrHash      BlockNumberOrHash
 )
 
 type BlockNumber int64
@@ -155,6 +167,10 @@ func (b BlockNumber) String() string {
 		return latest
 	case EarliestBlockNumber:
 		return earliest
+	case FinalizedBlockNumber:
+		return finalized
+	case SafeBlockNumber:
+		return safe
 	}
 
 	return fmt.Sprintf("0x%x", uint64(b))
@@ -177,7 +193,7 @@ func (bnh BlockNumberOrHash) String() string {
 // UnmarshalJSON will try to extract the filter's data.
 // Here are the possible input formats :
 //
-// 1 - "latest", "pending" or "earliest"	- self-explaining keywords
+// 1 - "latest", "pending", "earliest", "finalized" or "safe"	- self-explaining keywords
 // 2 - "0x2"								- block number #2 (EIP-1898 backward compatible)
 // 3 - {blockNumber:	"0x2"}				- EIP-1898 compliant block number #2
 // 4 - {blockHash:		"0xe0e..."}			- EIP-1898 compliant block hash 0xe0e...
@@ -237,6 +253,10 @@ func stringToBlockNumber(str string) (BlockNumber, error) {
 		return LatestBlockNumber, nil
 	case earliest:
 		return EarliestBlockNumber, nil
+	case finalized:
+		return FinalizedBlockNumber, nil
+	case safe:
+		return SafeBlockNumber, nil
 	}
 
 	n, err := common.ParseUint64orHex(&str)
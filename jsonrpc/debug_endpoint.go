@@ -101,6 +101,8 @@ type debugStore interface {
 	debugBlockchainStore
 	debugTxPoolStore
 	debugStateStore
+	finalityGetter
+	pendingBlockGetter
 }
 
 // Debug is the debug jsonrpc endpoint
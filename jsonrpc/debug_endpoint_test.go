@@ -35,12 +35,31 @@ type debugEndpointMockStore struct {
 	traceCallFn           func(*types.Transaction, *types.Header, tracer.Tracer) (interface{}, error)
 	getNonceFn            func(types.Address) uint64
 	getAccountFn          func(types.Hash, types.Address) (*Account, error)
+	getFinalizedFn        func() (uint64, error)
+	getSafeFn             func() (uint64, error)
+	getPendingBlockFn     func() (*types.Block, bool)
 }
 
 func (s *debugEndpointMockStore) Header() *types.Header {
 	return s.headerFn()
 }
 
+func (s *debugEndpointMockStore) GetFinalizedBlockNumber() (uint64, error) {
+	return s.getFinalizedFn()
+}
+
+func (s *debugEndpointMockStore) GetSafeBlockNumber() (uint64, error) {
+	return s.getSafeFn()
+}
+
+func (s *debugEndpointMockStore) GetPendingBlock() (*types.Block, bool) {
+	if s.getPendingBlockFn == nil {
+		return nil, false
+	}
+
+	return s.getPendingBlockFn()
+}
+
 func (s *debugEndpointMockStore) GetHeaderByNumber(num uint64) (*types.Header, bool) {
 	return s.getHeaderByNumberFn(num)
 }
@@ -1387,7 +1406,7 @@ func TestDumpBlock(t *testing.T) {
 	}{
 		{
 			name:        "GetNumericBlockNumberNotValid",
-			blockNumber: BlockNumber(-5),
+			blockNumber: BlockNumber(-10),
 			store:       &debugEndpointMockStore{},
 			returnErr:   "failed to get block number",
 			result:      nil,
@@ -1494,8 +1513,8 @@ func TestGetAccessibleState(t *testing.T) {
 	}{
 		{
 			name:      "GetNumericBlockNumberNotValid",
-			start:     BlockNumber(-5),
-			end:       BlockNumber(-5),
+			start:     BlockNumber(-10),
+			end:       BlockNumber(-10),
 			store:     &debugEndpointMockStore{},
 			returnErr: "failed to get block number",
 			result:    0,
@@ -44,6 +44,7 @@ type endpoints struct {
 	Net      *Net
 	TxPool   *TxPool
 	Bridge   *Bridge
+	Apex     *Apex
 	Debug    *Debug
 	Personal *Personal
 }
@@ -119,6 +120,9 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore, manager accounts.Acco
 	d.endpoints.Bridge = &Bridge{
 		store,
 	}
+	d.endpoints.Apex = &Apex{
+		store,
+	}
 	d.endpoints.Debug = NewDebug(store, d.params.concurrentRequestsDebug)
 	d.endpoints.Personal = NewPersonal(manager)
 
@@ -144,6 +148,10 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore, manager accounts.Acco
 		return err
 	}
 
+	if err = d.registerService("apex", d.endpoints.Apex); err != nil {
+		return err
+	}
+
 	if err = d.registerService("personal", d.endpoints.Personal); err != nil {
 		return err
 	}
@@ -230,6 +238,9 @@ func (d *Dispatcher) handleSubscribe(req Request, conn wsConn) (string, Error) {
 	case "newPendingTransactions":
 		filterID = d.filterManager.NewPendingTxFilter(conn)
 
+	case "finalizedHeads":
+		filterID = d.filterManager.NewFinalizedHeadFilter(conn)
+
 	default:
 		return "", NewSubscriptionNotFoundError(subscribeMethod)
 	}
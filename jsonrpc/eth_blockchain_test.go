@@ -14,6 +14,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/txpool/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestEth_Block_GetBlockByNumber(t *testing.T) {
@@ -54,6 +55,33 @@ func TestEth_Block_GetBlockByNumber(t *testing.T) {
 	}
 }
 
+func TestEth_Block_GetBlockByNumber_Pending(t *testing.T) {
+	store := &mockBlockStore{}
+	for i := 0; i < 10; i++ {
+		store.add(newTestBlock(uint64(i), hash1))
+	}
+
+	eth := newTestEthEndpoint(store)
+
+	// pending block is not built yet, latest block is returned
+	res, err := eth.GetBlockByNumber(PendingBlockNumber, false)
+	require.NoError(t, err)
+	require.Equal(t, argUint64(9), res.(*block).Number) //nolint:forcetypeassert
+
+	store.pendingBlock = &types.Block{
+		Header:       &types.Header{Number: 10, Hash: hash2},
+		Transactions: []*types.Transaction{types.NewTx(types.NewLegacyTx())},
+	}
+
+	res, err = eth.GetBlockByNumber(PendingBlockNumber, false)
+	require.NoError(t, err)
+	require.Equal(t, argUint64(10), res.(*block).Number) //nolint:forcetypeassert
+
+	res, err = eth.GetBlockTransactionCountByNumber(PendingBlockNumber)
+	require.NoError(t, err)
+	require.Equal(t, "0x1", res)
+}
+
 func TestEth_Block_GetBlockByHash(t *testing.T) {
 	store := &mockBlockStore{}
 	store.add(newTestBlock(1, hash1))
@@ -711,6 +739,7 @@ type mockBlockStore struct {
 	blocks          []*types.Block
 	topics          []types.Hash
 	pendingTxns     []*types.Transaction
+	pendingBlock    *types.Block
 	receipts        map[types.Hash][]*types.Receipt
 	isSyncing       bool
 	averageGasPrice int64
@@ -865,6 +894,10 @@ func (m *mockBlockStore) GetBlockByHash(hash types.Hash, full bool) (*types.Bloc
 	return nil, false
 }
 
+func (m *mockBlockStore) GetPendingBlock() (*types.Block, bool) {
+	return m.pendingBlock, m.pendingBlock != nil
+}
+
 func (m *mockBlockStore) Header() *types.Header {
 	return m.blocks[len(m.blocks)-1].Header
 }
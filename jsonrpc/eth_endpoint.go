@@ -92,6 +92,8 @@ type ethStore interface {
 	ethStateStore
 	ethBlockchainStore
 	ethFilter
+	finalityGetter
+	pendingBlockGetter
 	gasprice.GasStore
 }
 
@@ -134,6 +136,12 @@ func (e *Eth) Syncing() (interface{}, error) {
 
 // GetBlockByNumber returns information about a block by block number
 func (e *Eth) GetBlockByNumber(number BlockNumber, fullTx bool) (interface{}, error) {
+	if number == PendingBlockNumber {
+		if block, ok := e.store.GetPendingBlock(); ok {
+			return toBlock(block, fullTx), nil
+		}
+	}
+
 	num, err := GetNumericBlockNumber(number, e.store)
 	if err != nil {
 		return nil, err
@@ -151,6 +159,24 @@ func (e *Eth) GetBlockByNumber(number BlockNumber, fullTx bool) (interface{}, er
 	return toBlock(block, fullTx), nil
 }
 
+// getBlockByNumber returns a block by block number, including the pending block
+func (e *Eth) getBlockByNumber(number BlockNumber) (*types.Block, bool, error) {
+	if number == PendingBlockNumber {
+		if block, ok := e.store.GetPendingBlock(); ok {
+			return block, true, nil
+		}
+	}
+
+	num, err := GetNumericBlockNumber(number, e.store)
+	if err != nil {
+		return nil, false, err
+	}
+
+	block, ok := e.store.GetBlockByNumber(num, true)
+
+	return block, ok, nil
+}
+
 // GetBlockByHash returns information about a block by hash
 func (e *Eth) GetBlockByHash(hash types.Hash, fullTx bool) (interface{}, error) {
 	block, ok := e.store.GetBlockByHash(hash, true)
@@ -167,6 +193,12 @@ func (e *Eth) GetBlockByHash(hash types.Hash, fullTx bool) (interface{}, error)
 
 // GetHeaderByNumber returns the requested canonical block header.
 func (e *Eth) GetHeaderByNumber(number BlockNumber) (interface{}, error) {
+	if number == PendingBlockNumber {
+		if block, ok := e.store.GetPendingBlock(); ok {
+			return toHeader(block.Header), nil
+		}
+	}
+
 	num, err := GetNumericBlockNumber(number, e.store)
 	if err != nil {
 		return nil, err
@@ -276,13 +308,11 @@ func (e *Eth) GetBlockTransactionCountByHash(blockHash types.Hash) (interface{},
 }
 
 func (e *Eth) GetBlockTransactionCountByNumber(number BlockNumber) (interface{}, error) {
-	num, err := GetNumericBlockNumber(number, e.store)
+	block, ok, err := e.getBlockByNumber(number)
 	if err != nil {
 		return nil, err
 	}
 
-	block, ok := e.store.GetBlockByNumber(num, true)
-
 	if !ok {
 		return nil, nil
 	}
@@ -292,12 +322,11 @@ func (e *Eth) GetBlockTransactionCountByNumber(number BlockNumber) (interface{},
 
 // GetTransactionByBlockNumberAndIndex returns the transaction for the given block number and index.
 func (e *Eth) GetTransactionByBlockNumberAndIndex(number BlockNumber, index argUint64) (interface{}, error) {
-	num, err := GetNumericBlockNumber(number, e.store)
+	block, ok, err := e.getBlockByNumber(number)
 	if err != nil {
 		return nil, err
 	}
 
-	block, ok := e.store.GetBlockByNumber(num, true)
 	if !ok {
 		return nil, nil
 	}
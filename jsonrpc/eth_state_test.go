@@ -8,9 +8,12 @@ import (
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -862,6 +865,10 @@ func (m *mockSpecialStore) GetBlockByNumber(blockNumber uint64, full bool) (*typ
 	return m.block, true
 }
 
+func (m *mockSpecialStore) GetPendingBlock() (*types.Block, bool) {
+	return nil, false
+}
+
 func (m *mockSpecialStore) Header() *types.Header {
 	return m.block.Header
 }
@@ -912,3 +919,213 @@ func (m *mockSpecialStore) ApplyTxn(header *types.Header, txn *types.Transaction
 
 	return &runtime.ExecutionResult{}, nil
 }
+
+// TestEth_State_Pending tests the state queries of the pending block,
+// which state is kept in memory by the pending block producer
+func TestEth_State_Pending(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sender    = types.StringToAddress("0x10")
+		recipient = types.StringToAddress("0x20")
+		counter   = types.StringToAddress("0x30")
+		// counterCode increments the value at the slot 0 and returns it
+		counterCode = []byte{
+			0x60, 0x00, 0x54, 0x60, 0x01, 0x01, 0x80, 0x60, 0x00, 0x55,
+			0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3,
+		}
+		pending = PendingBlockNumber
+		latest  = LatestBlockNumber
+	)
+
+	store := newPendingStateStore(t, map[types.Address]*chain.GenesisAccount{
+		sender:  {Balance: big.NewInt(1_000_000_000)},
+		counter: {Balance: big.NewInt(0), Code: counterCode},
+	})
+
+	newTx := func(nonce uint64, to types.Address, value int64) *types.Transaction {
+		return types.NewTx(types.NewLegacyTx(
+			types.WithFrom(sender),
+			types.WithTo(&to),
+			types.WithNonce(nonce),
+			types.WithGas(100_000),
+			types.WithGasPrice(big.NewInt(10)),
+			types.WithValue(big.NewInt(value)),
+		))
+	}
+
+	callCounter := func(eth *Eth, number BlockNumber) *argBytes {
+		t.Helper()
+
+		res, err := eth.Call(&txnArgs{
+			To:       &counter,
+			Gas:      argUintPtr(100_000),
+			GasPrice: argBytesPtr([]byte{0x1}),
+		}, BlockNumberOrHash{BlockNumber: &number}, nil)
+		require.NoError(t, err)
+
+		return res.(*argBytes) //nolint:forcetypeassert
+	}
+
+	getBalance := func(eth *Eth, addr types.Address, number BlockNumber) interface{} {
+		t.Helper()
+
+		res, err := eth.GetBalance(addr, BlockNumberOrHash{BlockNumber: &number})
+		require.NoError(t, err)
+
+		return res
+	}
+
+	eth := newTestEthEndpoint(store)
+
+	// pending block is disabled, the latest state is returned
+	require.Equal(t, types.BytesToHash([]byte{1}).Bytes(), []byte(*callCounter(eth, pending)))
+	require.Equal(t, argUintPtr(0), getBalance(eth, recipient, pending))
+
+	store.buildPending(newTx(0, recipient, 1_000), newTx(1, counter, 0))
+
+	require.Equal(t, types.BytesToHash([]byte{2}).Bytes(), []byte(*callCounter(eth, pending)))
+	require.Equal(t, types.BytesToHash([]byte{1}).Bytes(), []byte(*callCounter(eth, latest)))
+	require.Equal(t, argBigPtr(big.NewInt(1_000)), getBalance(eth, recipient, pending))
+	require.Equal(t, argUintPtr(0), getBalance(eth, recipient, latest))
+
+	res, err := eth.GetStorageAt(counter, types.ZeroHash, BlockNumberOrHash{BlockNumber: &pending})
+	require.NoError(t, err)
+	require.Equal(t, types.BytesToHash([]byte{1}).Bytes(), []byte(*res.(*argBytes))) //nolint:forcetypeassert
+}
+
+// pendingStateStore resolves the state of the pending block from its in-memory snapshot
+// and the state of the other blocks from the storage, the same way the JSON-RPC hub of the server does
+type pendingStateStore struct {
+	ethStore
+	t *testing.T
+
+	state    state.State
+	executor *state.Executor
+	header   *types.Header
+
+	pendingBlock *types.Block
+	pendingSnap  state.Snapshot
+}
+
+func newPendingStateStore(t *testing.T, alloc map[types.Address]*chain.GenesisAccount) *pendingStateStore {
+	t.Helper()
+
+	st := itrie.NewState(itrie.NewMemoryStorage())
+	params := &chain.Params{
+		ChainID:      100,
+		Forks:        chain.AllForksEnabled,
+		BurnContract: map[uint64]types.Address{0: types.StringToAddress("0xb0")},
+	}
+
+	executor := state.NewExecutor(params, st, hclog.NewNullLogger())
+	executor.GetHash = func(*types.Header) state.GetHashByNumber {
+		return func(uint64) types.Hash {
+			return types.ZeroHash
+		}
+	}
+
+	root, err := executor.WriteGenesis(alloc, types.ZeroHash)
+	require.NoError(t, err)
+
+	return &pendingStateStore{
+		t:        t,
+		state:    st,
+		executor: executor,
+		header:   &types.Header{Number: 0, StateRoot: root, GasLimit: 30_000_000},
+	}
+}
+
+// buildPending builds the pending block on top of the latest block, without writing its state to the storage
+func (p *pendingStateStore) buildPending(txs ...*types.Transaction) {
+	header := &types.Header{Number: p.header.Number + 1, ParentHash: p.header.Hash, GasLimit: p.header.GasLimit}
+
+	transition, err := p.executor.BeginTxn(p.header.StateRoot, header, types.ZeroAddress)
+	require.NoError(p.t, err)
+
+	for _, tx := range txs {
+		require.NoError(p.t, transition.Write(tx))
+	}
+
+	p.pendingSnap, header.StateRoot, err = transition.CommitInMemory()
+	require.NoError(p.t, err)
+
+	p.pendingBlock = &types.Block{Header: header.ComputeHash(), Transactions: txs}
+}
+
+func (p *pendingStateStore) snapshotAt(root types.Hash) (state.Snapshot, error) {
+	if p.pendingBlock != nil && p.pendingBlock.Header.StateRoot == root {
+		return p.pendingSnap, nil
+	}
+
+	return p.state.NewSnapshot(root)
+}
+
+func (p *pendingStateStore) GetPendingBlock() (*types.Block, bool) {
+	return p.pendingBlock, p.pendingBlock != nil
+}
+
+func (p *pendingStateStore) Header() *types.Header {
+	return p.header
+}
+
+func (p *pendingStateStore) GetHeaderByNumber(num uint64) (*types.Header, bool) {
+	return p.header, p.header.Number == num
+}
+
+func (p *pendingStateStore) GetBaseFee() uint64 {
+	return 0
+}
+
+func (p *pendingStateStore) GetAccount(root types.Hash, addr types.Address) (*Account, error) {
+	snap, err := p.snapshotAt(root)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := snap.GetAccount(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		return nil, ErrStateNotFound
+	}
+
+	return &Account{Balance: account.Balance, Nonce: account.Nonce}, nil
+}
+
+func (p *pendingStateStore) GetStorage(root types.Hash, addr types.Address, slot types.Hash) ([]byte, error) {
+	snap, err := p.snapshotAt(root)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := snap.GetAccount(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		return nil, ErrStateNotFound
+	}
+
+	return snap.GetStorage(addr, account.Root, slot).Bytes(), nil
+}
+
+func (p *pendingStateStore) ApplyTxn(header *types.Header, txn *types.Transaction,
+	_ types.StateOverride, nonPayable bool) (*runtime.ExecutionResult, error) {
+	snap, err := p.snapshotAt(header.StateRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	transition, err := p.executor.BeginTxnAt(snap, header, types.ZeroAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	transition.SetNonPayable(nonPayable)
+
+	return transition.Apply(txn)
+}
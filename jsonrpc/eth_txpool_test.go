@@ -77,6 +77,10 @@ func (m *mockStoreTxn) AddAccount(addr types.Address) *mockAccount {
 	return acct
 }
 
+func (m *mockStoreTxn) GetPendingBlock() (*types.Block, bool) {
+	return nil, false
+}
+
 func (m *mockStoreTxn) Header() *types.Header {
 	return &types.Header{}
 }
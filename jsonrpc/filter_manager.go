@@ -181,6 +181,77 @@ func (f *blockFilter) getSubscriptionType() subscriptionType {
 	return Blocks
 }
 
+// finalizedHeadFilter is a filter to store the headers of newly finalized blocks
+type finalizedHeadFilter struct {
+	filterBase
+	sync.Mutex
+
+	// lastFinalized is the number of the last finalized block known to the filter
+	lastFinalized uint64
+	blocks        []*block
+}
+
+// appendBlock appends newly finalized block to the filter
+func (f *finalizedHeadFilter) appendBlock(block *block) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.blocks = append(f.blocks, block)
+	f.lastFinalized = uint64(block.Number)
+}
+
+// getLastFinalized returns the number of the last finalized block known to the filter
+func (f *finalizedHeadFilter) getLastFinalized() uint64 {
+	f.Lock()
+	defer f.Unlock()
+
+	return f.lastFinalized
+}
+
+// takeFinalizedUpdates returns all saved finalized blocks in filter and sets a new slice
+func (f *finalizedHeadFilter) takeFinalizedUpdates() []*block {
+	f.Lock()
+	defer f.Unlock()
+
+	blocks := f.blocks
+	f.blocks = []*block{}
+
+	return blocks
+}
+
+// getUpdates returns hashes of newly finalized blocks
+func (f *finalizedHeadFilter) getUpdates() (interface{}, error) {
+	blocks := f.takeFinalizedUpdates()
+
+	updates := make([]string, len(blocks))
+	for index, block := range blocks {
+		updates[index] = block.Hash.String()
+	}
+
+	return updates, nil
+}
+
+// sendUpdates writes the headers of newly finalized blocks to web socket stream
+func (f *finalizedHeadFilter) sendUpdates() error {
+	for _, block := range f.takeFinalizedUpdates() {
+		raw, err := json.Marshal(block)
+		if err != nil {
+			return err
+		}
+
+		if err := f.writeMessageToWs(string(raw)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getSubscriptionType returns the type of the event the filter is subscribed to
+func (f *finalizedHeadFilter) getSubscriptionType() subscriptionType {
+	return Blocks
+}
+
 // logFilter is a filter to store logs that meet the conditions in query
 type logFilter struct {
 	filterBase
@@ -293,6 +364,8 @@ func (f *pendingTxFilter) sendUpdates() error {
 
 // filterManagerStore provides methods required by FilterManager
 type filterManagerStore interface {
+	finalityGetter
+
 	// Header returns the current header of the chain (genesis if empty)
 	Header() *types.Header
 
@@ -445,6 +518,28 @@ func (f *FilterManager) NewBlockFilter(ws wsConn) string {
 	return f.addFilter(filter)
 }
 
+// NewFinalizedHeadFilter adds new finalizedHeadFilter
+func (f *FilterManager) NewFinalizedHeadFilter(ws wsConn) string {
+	lastFinalized, err := f.store.GetFinalizedBlockNumber()
+	if err != nil {
+		f.logger.Warn("failed to get finalized block number", "err", err)
+
+		lastFinalized = f.store.Header().Number
+	}
+
+	filter := &finalizedHeadFilter{
+		filterBase:    newFilterBase(ws),
+		lastFinalized: lastFinalized,
+		blocks:        []*block{},
+	}
+
+	if filter.hasWSConn() {
+		ws.SetFilterID(filter.id)
+	}
+
+	return f.addFilter(filter)
+}
+
 // NewLogFilter adds new LogFilter
 func (f *FilterManager) NewLogFilter(logQuery *LogQuery, ws wsConn) string {
 	filter := &logFilter{
@@ -769,6 +864,53 @@ func (f *FilterManager) processBlockEvent(evnt *blockchain.Event) {
 			f.logger.Error(fmt.Sprintf("Unable to process block, %v", processErr))
 		}
 	}
+
+	if processErr := f.appendFinalizedHeadsToFilters(); processErr != nil {
+		f.logger.Error(fmt.Sprintf("Unable to process finalized blocks, %v", processErr))
+	}
+}
+
+// appendFinalizedHeadsToFilters makes each finalizedHeadFilter append the blocks
+// which got finalized since the last time the filter was updated
+func (f *FilterManager) appendFinalizedHeadsToFilters() error {
+	finalizedFilters := make([]*finalizedHeadFilter, 0)
+
+	for _, f := range f.filters {
+		if finalizedFilter, ok := f.(*finalizedHeadFilter); ok {
+			finalizedFilters = append(finalizedFilters, finalizedFilter)
+		}
+	}
+
+	if len(finalizedFilters) == 0 {
+		return nil
+	}
+
+	finalizedNumber, err := f.store.GetFinalizedBlockNumber()
+	if err != nil {
+		return err
+	}
+
+	// cache fetched blocks, since most of the filters wait for the same blocks
+	blocks := make(map[uint64]*block)
+
+	for _, filter := range finalizedFilters {
+		for num := filter.getLastFinalized() + 1; num <= finalizedNumber; num++ {
+			finalizedBlock, ok := blocks[num]
+			if !ok {
+				b, ok := f.store.GetBlockByNumber(num, false)
+				if !ok {
+					return fmt.Errorf("finalized block %d not found", num)
+				}
+
+				finalizedBlock = toBlock(b, false)
+				blocks[num] = finalizedBlock
+			}
+
+			filter.appendBlock(finalizedBlock)
+		}
+	}
+
+	return nil
 }
 
 // appendLogsToFilters makes each LogFilters append logs in the header
@@ -589,6 +589,57 @@ func TestFilterWebsocket(t *testing.T) {
 	}
 }
 
+func TestFilterFinalizedHeadsWebsocket(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+
+	for i := uint64(1); i <= 3; i++ {
+		store.addHeader(&types.Header{
+			Number: i,
+			Hash:   types.StringToHash(strconv.FormatUint(i, 10)),
+		})
+	}
+
+	mock, msgCh := newMockWsConnWithMsgCh()
+
+	m := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	defer m.Close()
+
+	go m.Run()
+
+	m.NewFinalizedHeadFilter(mock)
+
+	// blocks 1 and 2 got finalized, block 3 is not final yet
+	store.finalized.Store(2)
+
+	store.emitEvent(&mockEvent{
+		NewChain: []*mockHeader{
+			{
+				header: &types.Header{
+					Number: 3,
+					Hash:   types.StringToHash("3"),
+				},
+			},
+		},
+	})
+
+	for _, expectedHash := range []types.Hash{types.StringToHash("1"), types.StringToHash("2")} {
+		select {
+		case msg := <-msgCh:
+			assert.Contains(t, string(msg), expectedHash.String())
+		case <-time.After(2 * time.Second):
+			t.Fatal("no finalized block events received in the predefined time slot")
+		}
+	}
+
+	select {
+	case msg := <-msgCh:
+		t.Fatalf("unexpected finalized block event received: %s", string(msg))
+	case <-time.After(500 * time.Millisecond):
+	}
+}
+
 func TestFilterPendingTxWebsocket(t *testing.T) {
 	t.Parallel()
 
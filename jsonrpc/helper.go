@@ -23,7 +23,23 @@ var (
 	ErrInsufficientFunds        = errors.New("insufficient funds for execution")
 )
 
+// finalityGetter provides access to the finality information of the chain
+type finalityGetter interface {
+	// GetFinalizedBlockNumber returns the number of the latest block which can not be reverted
+	GetFinalizedBlockNumber() (uint64, error)
+
+	// GetSafeBlockNumber returns the number of the latest block which is considered safe
+	GetSafeBlockNumber() (uint64, error)
+}
+
+// pendingBlockGetter provides access to the pending block
+type pendingBlockGetter interface {
+	// GetPendingBlock returns the block built on top of the latest block from the tx pool executables, if any
+	GetPendingBlock() (*types.Block, bool)
+}
+
 type latestHeaderGetter interface {
+	finalityGetter
 	Header() *types.Header
 }
 
@@ -41,6 +57,12 @@ func GetNumericBlockNumber(number BlockNumber, store latestHeaderGetter) (uint64
 	case EarliestBlockNumber:
 		return 0, nil
 
+	case FinalizedBlockNumber:
+		return store.GetFinalizedBlockNumber()
+
+	case SafeBlockNumber:
+		return store.GetSafeBlockNumber()
+
 	default:
 		if number < 0 {
 			return 0, ErrNegativeBlockNumber
@@ -67,6 +89,8 @@ func GetTransactionByBlockAndIndex(block *types.Block, index argUint64) (interfa
 }
 
 type headerGetter interface {
+	finalityGetter
+	pendingBlockGetter
 	Header() *types.Header
 	GetHeaderByNumber(uint64) (*types.Header, bool)
 }
@@ -74,7 +98,14 @@ type headerGetter interface {
 // GetBlockHeader returns a header using the provided number
 func GetBlockHeader(number BlockNumber, store headerGetter) (*types.Header, error) {
 	switch number {
-	case PendingBlockNumber, LatestBlockNumber:
+	case PendingBlockNumber:
+		if block, ok := store.GetPendingBlock(); ok {
+			return block.Header, nil
+		}
+
+		return store.Header(), nil
+
+	case LatestBlockNumber:
 		return store.Header(), nil
 
 	case EarliestBlockNumber:
@@ -85,6 +116,19 @@ func GetBlockHeader(number BlockNumber, store headerGetter) (*types.Header, erro
 
 		return header, nil
 
+	case FinalizedBlockNumber, SafeBlockNumber:
+		num, err := GetNumericBlockNumber(number, store)
+		if err != nil {
+			return nil, err
+		}
+
+		header, ok := store.GetHeaderByNumber(num)
+		if !ok {
+			return nil, fmt.Errorf("error fetching %s block number %d header", number, num)
+		}
+
+		return header, nil
+
 	default:
 		// Convert the block number from hex to uint64
 		header, ok := store.GetHeaderByNumber(uint64(number))
@@ -121,6 +165,8 @@ func GetTxAndBlockByTxHash(txHash types.Hash, store txLookupAndBlockGetter) (*ty
 }
 
 type blockGetter interface {
+	finalityGetter
+	pendingBlockGetter
 	Header() *types.Header
 	GetHeaderByNumber(uint64) (*types.Header, bool)
 	GetBlockByHash(types.Hash, bool) (*types.Block, bool)
@@ -152,6 +198,8 @@ func GetHeaderFromBlockNumberOrHash(bnh BlockNumberOrHash, store blockGetter) (*
 }
 
 type nonceGetter interface {
+	finalityGetter
+	pendingBlockGetter
 	Header() *types.Header
 	GetHeaderByNumber(uint64) (*types.Header, bool)
 	GetNonce(types.Address) uint64
@@ -182,9 +182,31 @@ func TestGetNumericBlockNumber(t *testing.T) {
 			expected: 10,
 			err:      nil,
 		},
+		{
+			name: "should return the finalized block's number if finalized is given",
+			num:  FinalizedBlockNumber,
+			store: &debugEndpointMockStore{
+				getFinalizedFn: func() (uint64, error) {
+					return 8, nil
+				},
+			},
+			expected: 8,
+			err:      nil,
+		},
+		{
+			name: "should return the safe block's number if safe is given",
+			num:  SafeBlockNumber,
+			store: &debugEndpointMockStore{
+				getSafeFn: func() (uint64, error) {
+					return 4, nil
+				},
+			},
+			expected: 4,
+			err:      nil,
+		},
 		{
 			name:     "should return error if negative number is given",
-			num:      -5,
+			num:      -10,
 			store:    &debugEndpointMockStore{},
 			expected: 0,
 			err:      ErrNegativeBlockNumber,
@@ -270,6 +292,17 @@ func TestGetBlockHeader(t *testing.T) {
 			expected: testLatestHeader,
 			err:      nil,
 		},
+		{
+			name: "should return pending block header if pending is given and pending block is built",
+			num:  PendingBlockNumber,
+			store: &debugEndpointMockStore{
+				getPendingBlockFn: func() (*types.Block, bool) {
+					return testBlock10, true
+				},
+			},
+			expected: testHeader10,
+			err:      nil,
+		},
 		{
 			name: "should return header at arbitrary height",
 			num:  10,
@@ -38,6 +38,7 @@ type JSONRPCStore interface {
 	txPoolStore
 	filterManagerStore
 	bridgeStore
+	apexStore
 	debugStore
 }
 
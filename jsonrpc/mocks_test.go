@@ -3,6 +3,7 @@ package jsonrpc
 import (
 	"math/big"
 	"sync"
+	"sync/atomic"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/txpool/proto"
@@ -51,6 +52,8 @@ type mockStore struct {
 	receiptsLock  sync.Mutex
 	receipts      map[types.Hash][]*types.Receipt
 	accounts      map[types.Address]*Account
+	finalized     atomic.Uint64
+	pendingBlock  *types.Block
 
 	// headers is the list of historical headers
 	historicalHeaders []*types.Header
@@ -136,6 +139,18 @@ func (m *mockStore) Header() *types.Header {
 	return m.header
 }
 
+func (m *mockStore) GetFinalizedBlockNumber() (uint64, error) {
+	return m.finalized.Load(), nil
+}
+
+func (m *mockStore) GetSafeBlockNumber() (uint64, error) {
+	return m.finalized.Load(), nil
+}
+
+func (m *mockStore) GetPendingBlock() (*types.Block, bool) {
+	return m.pendingBlock, m.pendingBlock != nil
+}
+
 func (m *mockStore) GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error) {
 	m.receiptsLock.Lock()
 	defer m.receiptsLock.Unlock()
@@ -203,6 +218,21 @@ func (m *mockStore) GenerateExitProof(exitID uint64) (types.Proof, error) {
 	}, nil
 }
 
+func (m *mockStore) GenerateExitProofs(exitIDs []uint64) ([]types.Proof, error) {
+	proofs := make([]types.Proof, len(exitIDs))
+
+	for i, exitID := range exitIDs {
+		proofs[i] = types.Proof{
+			Data: []types.Hash{types.BytesToHash([]byte{byte(exitID)})},
+			Metadata: map[string]interface{}{
+				"LeafIndex": exitID,
+			},
+		}
+	}
+
+	return proofs, nil
+}
+
 func (m *mockStore) GetPeers() int {
 	return 20
 }
@@ -217,6 +247,54 @@ func (m *mockStore) GetStateSyncProof(stateSyncID uint64) (types.Proof, error) {
 	return ssp, nil
 }
 
+func (m *mockStore) GetDeadLetterEvents(
+	transferType types.BridgeTransferType) ([]*types.BridgeRelayerEvent, error) {
+	return []*types.BridgeRelayerEvent{{EventID: 1, CountTries: 15, LastError: "execution failed"}}, nil
+}
+
+func (m *mockStore) RetryDeadLetterEvents(transferType types.BridgeTransferType, eventIDs []uint64) error {
+	return nil
+}
+
+func (m *mockStore) SkipDeadLetterEvents(transferType types.BridgeTransferType, eventIDs []uint64) error {
+	return nil
+}
+
+func (m *mockStore) GetTokenMappings(
+	query *types.BridgeTokenMappingQuery) (*types.BridgeTokenMappings, error) {
+	return &types.BridgeTokenMappings{
+		Total: query.Offset + 2,
+		Mappings: []*types.BridgeTokenMapping{
+			{TokenType: query.TokenType, RootToken: types.StringToAddress("0x1"), ChildToken: types.StringToAddress("0x2")},
+		},
+	}, nil
+}
+
+func (m *mockStore) GetCheckpointLag() (*types.BridgeCheckpointLag, error) {
+	return &types.BridgeCheckpointLag{
+		ChildHeight:         100,
+		LastCheckpointBlock: 60,
+		Lag:                 40,
+		AlertThreshold:      30,
+		Alert:               true,
+	}, nil
+}
+
+func (m *mockStore) GetTransferStatus(
+	query *types.BridgeTransferQuery) ([]*types.BridgeTransferStatus, error) {
+	return []*types.BridgeTransferStatus{
+		{
+			Type: types.StateSyncTransfer,
+			ID:   *query.StateSyncID,
+			Stages: []*types.BridgeTransferStage{
+				{Name: types.StateSyncedStage, Completed: true, Rootchain: true, BlockNumber: 10},
+				{Name: types.CommittedStage},
+				{Name: types.ExecutedStage},
+			},
+		},
+	}, nil
+}
+
 func (m *mockStore) FilterExtra(extra []byte) ([]byte, error) {
 	return extra, nil
 }
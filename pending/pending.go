@@ -0,0 +1,372 @@
+package pending
+
+import (
+	"container/heap"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+// DefaultRefreshInterval is the minimal period between two rebuilds of the pending block
+const DefaultRefreshInterval = 500 * time.Millisecond
+
+// Blockchain is the interface representing blockchain
+type Blockchain interface {
+	Header() *types.Header
+	CalculateGasLimit(number uint64) (uint64, error)
+	CalculateBaseFee(parent *types.Header) uint64
+	SubscribeEvents() blockchain.Subscription
+	UnsubscribeEvents(blockchain.Subscription)
+}
+
+// TxPool is the interface representing transaction pool
+type TxPool interface {
+	// GetTxs gets pending and queued transactions
+	GetTxs(inclQueued bool) (map[types.Address][]*types.Transaction, map[types.Address][]*types.Transaction)
+	// TxPoolSubscribe subscribes for tx pool events
+	TxPoolSubscribe(request *proto.SubscribeRequest) (<-chan *proto.TxPoolEvent, func(), error)
+}
+
+// Executor is the interface representing state executor
+type Executor interface {
+	BeginTxn(parentRoot types.Hash, header *types.Header, coinbase types.Address) (*state.Transition, error)
+}
+
+// PendingBlockStore interface is providing access to the pending block
+type PendingBlockStore interface {
+	// GetPendingBlock returns the block built on top of the latest block from the tx pool executables
+	GetPendingBlock() (*types.Block, bool)
+	// GetPendingState returns the state snapshot of the pending block, if the given root is its state root
+	GetPendingState(root types.Hash) (state.Snapshot, bool)
+}
+
+var (
+	_ PendingBlockStore = (*BlockProducer)(nil)
+	_ PendingBlockStore = DisabledBlockStore{}
+)
+
+// DisabledBlockStore is the PendingBlockStore used when the pending block is not built,
+// so the pending queries are resolved against the latest block
+type DisabledBlockStore struct{}
+
+// GetPendingBlock always reports that the pending block is not available
+func (DisabledBlockStore) GetPendingBlock() (*types.Block, bool) {
+	return nil, false
+}
+
+// GetPendingState always reports that the pending state is not available
+func (DisabledBlockStore) GetPendingState(types.Hash) (state.Snapshot, bool) {
+	return nil, false
+}
+
+// BlockProducer continuously builds the pending block on top of the latest block,
+// by executing the executable (promoted) transactions from the tx pool
+type BlockProducer struct {
+	logger          hclog.Logger
+	blockchain      Blockchain
+	txPool          TxPool
+	executor        Executor
+	refreshInterval time.Duration
+
+	lock  sync.RWMutex
+	block *types.FullBlock
+	// snapshot is the state of the pending block, kept in memory only
+	snapshot state.Snapshot
+	closeCh  chan struct{}
+}
+
+// NewBlockProducer is the constructor function for BlockProducer struct
+func NewBlockProducer(logger hclog.Logger, blockchain Blockchain, txPool TxPool,
+	executor Executor, refreshInterval time.Duration) *BlockProducer {
+	if refreshInterval == 0 {
+		refreshInterval = DefaultRefreshInterval
+	}
+
+	return &BlockProducer{
+		logger:          logger.Named("pending"),
+		blockchain:      blockchain,
+		txPool:          txPool,
+		executor:        executor,
+		refreshInterval: refreshInterval,
+		closeCh:         make(chan struct{}),
+	}
+}
+
+// Start starts the routine which rebuilds the pending block
+// whenever the chain head or the tx pool changes
+func (p *BlockProducer) Start() error {
+	txWatchCh, txPoolUnsubscribe, err := p.txPool.TxPoolSubscribe(&proto.SubscribeRequest{
+		Types: []proto.EventType{
+			proto.EventType_PROMOTED,
+			proto.EventType_DROPPED,
+			proto.EventType_DEMOTED,
+			proto.EventType_PRUNED_PROMOTED,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	subscription := p.blockchain.SubscribeEvents()
+	blockWatchCh := make(chan struct{}, 1)
+
+	go func() {
+		for {
+			if evnt := subscription.GetEvent(); evnt == nil {
+				return
+			}
+
+			select {
+			case blockWatchCh <- struct{}{}:
+			default:
+			}
+		}
+	}()
+
+	go func() {
+		defer txPoolUnsubscribe()
+		defer p.blockchain.UnsubscribeEvents(subscription)
+
+		ticker := time.NewTicker(p.refreshInterval)
+		defer ticker.Stop()
+
+		// build the initial pending block
+		isDirty := true
+
+		for {
+			select {
+			case <-p.closeCh:
+				return
+			case <-blockWatchCh:
+				isDirty = true
+			case <-txWatchCh:
+				isDirty = true
+			case <-ticker.C:
+				if !isDirty {
+					continue
+				}
+
+				if err := p.refresh(); err != nil {
+					p.logger.Error("failed to build pending block", "err", err)
+
+					continue
+				}
+
+				isDirty = false
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Close stops the pending block routine
+func (p *BlockProducer) Close() {
+	close(p.closeCh)
+}
+
+// GetPendingBlock returns the block built on top of the latest block from the tx pool executables.
+// If the pending block is not built yet or it is stale (latest block has changed in the meantime),
+// false is returned
+func (p *BlockProducer) GetPendingBlock() (*types.Block, bool) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if p.block == nil || p.block.Block.ParentHash() != p.blockchain.Header().Hash {
+		return nil, false
+	}
+
+	return &types.Block{
+		Header:       p.block.Block.Header.Copy(),
+		Transactions: p.block.Block.Transactions,
+	}, true
+}
+
+// GetPendingState returns the state snapshot of the pending block, if the given root is its state root.
+// Pending state is not written to the storage, so the queries of the pending block state are served from it
+func (p *BlockProducer) GetPendingState(root types.Hash) (state.Snapshot, bool) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if p.block == nil || p.block.Block.Header.StateRoot != root {
+		return nil, false
+	}
+
+	return p.snapshot, true
+}
+
+// refresh builds the new pending block and replaces the old one
+func (p *BlockProducer) refresh() error {
+	block, snapshot, err := p.build(p.blockchain.Header())
+	if err != nil {
+		return err
+	}
+
+	p.lock.Lock()
+	p.block = block
+	p.snapshot = snapshot
+	p.lock.Unlock()
+
+	p.logger.Debug("pending block built", "number", block.Block.Number(),
+		"txs", len(block.Block.Transactions), "gasUsed", block.Block.Header.GasUsed)
+
+	return nil
+}
+
+// build executes the tx pool executables on top of the parent state and builds the pending block,
+// returning it along with its state
+func (p *BlockProducer) build(parent *types.Header) (*types.FullBlock, state.Snapshot, error) {
+	gasLimit, err := p.blockchain.CalculateGasLimit(parent.Number + 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	timestamp := uint64(time.Now().UTC().Unix())
+	if timestamp <= parent.Timestamp {
+		timestamp = parent.Timestamp + 1
+	}
+
+	header := &types.Header{
+		ParentHash:   parent.Hash,
+		Number:       parent.Number + 1,
+		Miner:        types.ZeroAddress.Bytes(),
+		Difficulty:   1,
+		StateRoot:    types.EmptyRootHash,
+		TxRoot:       types.EmptyRootHash,
+		ReceiptsRoot: types.EmptyRootHash,
+		Sha3Uncles:   types.EmptyUncleHash,
+		GasLimit:     gasLimit,
+		BaseFee:      p.blockchain.CalculateBaseFee(parent),
+		Timestamp:    timestamp,
+	}
+
+	transition, err := p.executor.BeginTxn(parent.StateRoot, header, types.ZeroAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	promoted, _ := p.txPool.GetTxs(false)
+	txs := newTxsByPrice(promoted, new(big.Int).SetUint64(header.BaseFee))
+	included := make([]*types.Transaction, 0)
+
+	for txs.Len() > 0 {
+		tx := txs.peek()
+
+		if err := transition.Write(tx); err != nil {
+			if _, ok := err.(*state.GasLimitReachedTransitionApplicationError); ok { //nolint:errorlint
+				break
+			}
+
+			// the rest of the sender transactions can not be executed because of the nonce gap
+			heap.Pop(txs)
+
+			continue
+		}
+
+		included = append(included, tx)
+		txs.shift()
+	}
+
+	// pending state is kept in memory only, so its trie nodes are not written to the storage
+	snapshot, stateRoot, err := transition.CommitInMemory()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	receipts := transition.Receipts()
+
+	header.StateRoot = stateRoot
+	header.GasUsed = transition.TotalGas()
+	header.LogsBloom = types.CreateBloom(receipts)
+
+	block := consensus.BuildBlock(consensus.BuildBlockParams{
+		Header:   header,
+		Txns:     included,
+		Receipts: receipts,
+	})
+
+	block.Header.ComputeHash()
+
+	return &types.FullBlock{
+		Block:    block,
+		Receipts: receipts,
+	}, snapshot, nil
+}
+
+// txsByPrice is a max heap of account transaction queues (sorted by nonce),
+// ordered by the effective tip of the first transaction in the queue
+type txsByPrice struct {
+	queues  [][]*types.Transaction
+	baseFee *big.Int
+}
+
+// newTxsByPrice creates txsByPrice heap from the given promoted transactions
+func newTxsByPrice(promoted map[types.Address][]*types.Transaction, baseFee *big.Int) *txsByPrice {
+	t := &txsByPrice{
+		queues:  make([][]*types.Transaction, 0, len(promoted)),
+		baseFee: baseFee,
+	}
+
+	for _, txs := range promoted {
+		// copy, since tx pool queues are shared and ordered as a heap
+		queue := make([]*types.Transaction, len(txs))
+		copy(queue, txs)
+
+		sort.Slice(queue, func(i, j int) bool {
+			return queue[i].Nonce() < queue[j].Nonce()
+		})
+
+		t.queues = append(t.queues, queue)
+	}
+
+	heap.Init(t)
+
+	return t
+}
+
+// peek returns the transaction with the highest effective tip
+func (t *txsByPrice) peek() *types.Transaction {
+	return t.queues[0][0]
+}
+
+// shift replaces the transaction with the highest effective tip
+// with the next transaction of the same sender
+func (t *txsByPrice) shift() {
+	if len(t.queues[0]) == 1 {
+		heap.Pop(t)
+
+		return
+	}
+
+	t.queues[0] = t.queues[0][1:]
+	heap.Fix(t, 0)
+}
+
+func (t *txsByPrice) Len() int { return len(t.queues) }
+
+func (t *txsByPrice) Less(i, j int) bool {
+	return t.queues[i][0].EffectiveGasTip(t.baseFee).Cmp(t.queues[j][0].EffectiveGasTip(t.baseFee)) > 0
+}
+
+func (t *txsByPrice) Swap(i, j int) { t.queues[i], t.queues[j] = t.queues[j], t.queues[i] }
+
+func (t *txsByPrice) Push(x interface{}) {
+	t.queues = append(t.queues, x.([]*types.Transaction)) //nolint:forcetypeassert
+}
+
+func (t *txsByPrice) Pop() interface{} {
+	old := t.queues
+	n := len(old)
+	x := old[n-1]
+	t.queues = old[0 : n-1]
+
+	return x
+}
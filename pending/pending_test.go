@@ -0,0 +1,223 @@
+package pending
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/Ethernal-Tech/ethgo"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+type blockchainMock struct {
+	header *types.Header
+}
+
+func (b *blockchainMock) Header() *types.Header {
+	return b.header
+}
+
+func (b *blockchainMock) CalculateGasLimit(number uint64) (uint64, error) {
+	return b.header.GasLimit, nil
+}
+
+func (b *blockchainMock) CalculateBaseFee(parent *types.Header) uint64 {
+	return 0
+}
+
+func (b *blockchainMock) SubscribeEvents() blockchain.Subscription {
+	return blockchain.NewMockSubscription()
+}
+
+func (b *blockchainMock) UnsubscribeEvents(blockchain.Subscription) {}
+
+type txPoolMock struct {
+	promoted map[types.Address][]*types.Transaction
+}
+
+func (t *txPoolMock) GetTxs(inclQueued bool) (
+	map[types.Address][]*types.Transaction, map[types.Address][]*types.Transaction) {
+	return t.promoted, nil
+}
+
+func (t *txPoolMock) TxPoolSubscribe(request *proto.SubscribeRequest) (<-chan *proto.TxPoolEvent, func(), error) {
+	return make(chan *proto.TxPoolEvent), func() {}, nil
+}
+
+func newTestTx(from types.Address, nonce uint64, gasPrice int64) *types.Transaction {
+	to := types.StringToAddress("0x1")
+
+	return types.NewTx(types.NewLegacyTx(
+		types.WithFrom(from),
+		types.WithTo(&to),
+		types.WithNonce(nonce),
+		types.WithGas(21000),
+		types.WithGasPrice(big.NewInt(gasPrice)),
+		types.WithValue(big.NewInt(1)),
+	))
+}
+
+func newTestProducer(t *testing.T, accounts ...types.Address) (*BlockProducer, *blockchainMock, *txPoolMock) {
+	t.Helper()
+
+	producer, bc, pool, _ := newTestProducerWithStorage(t, accounts...)
+
+	return producer, bc, pool
+}
+
+func newTestProducerWithStorage(t *testing.T, accounts ...types.Address) (
+	*BlockProducer, *blockchainMock, *txPoolMock, itrie.Storage) {
+	t.Helper()
+
+	logger := hclog.NewNullLogger()
+	params := &chain.Params{ChainID: 100, Forks: &chain.Forks{}}
+	storage := itrie.NewMemoryStorage()
+	executor := state.NewExecutor(params, itrie.NewState(storage), logger)
+	executor.GetHash = func(header *types.Header) func(i uint64) types.Hash {
+		return func(i uint64) types.Hash {
+			return types.ZeroHash
+		}
+	}
+
+	alloc := map[types.Address]*chain.GenesisAccount{}
+	for _, addr := range accounts {
+		alloc[addr] = &chain.GenesisAccount{Balance: ethgo.Ether(1)}
+	}
+
+	root, err := executor.WriteGenesis(alloc, types.ZeroHash)
+	require.NoError(t, err)
+
+	parent := &types.Header{Number: 5, StateRoot: root, GasLimit: 21000 * 10}
+	parent.ComputeHash()
+
+	bc := &blockchainMock{header: parent}
+	pool := &txPoolMock{promoted: map[types.Address][]*types.Transaction{}}
+
+	return NewBlockProducer(logger, bc, pool, executor, DefaultRefreshInterval), bc, pool, storage
+}
+
+func TestBlockProducer_Build(t *testing.T) {
+	t.Parallel()
+
+	var (
+		alice = types.StringToAddress("0xa")
+		bob   = types.StringToAddress("0xb")
+		carol = types.StringToAddress("0xc")
+	)
+
+	producer, bc, pool := newTestProducer(t, alice, bob, carol)
+
+	aliceTx0 := newTestTx(alice, 0, 10)
+	aliceTx1 := newTestTx(alice, 1, 10)
+	bobTx0 := newTestTx(bob, 0, 20)
+
+	// tx pool queues are not necessarily sorted by nonce
+	pool.promoted[alice] = []*types.Transaction{aliceTx1, aliceTx0}
+	pool.promoted[bob] = []*types.Transaction{bobTx0}
+	// nonce gap, can not be executed
+	pool.promoted[carol] = []*types.Transaction{newTestTx(carol, 1, 30)}
+
+	block, _, err := producer.build(bc.Header())
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(6), block.Block.Number())
+	require.Equal(t, bc.header.Hash, block.Block.ParentHash())
+	require.Equal(t, uint64(21000*3), block.Block.Header.GasUsed)
+	require.Len(t, block.Receipts, 3)
+	require.Equal(t, []types.Hash{bobTx0.Hash(), aliceTx0.Hash(), aliceTx1.Hash()},
+		[]types.Hash{
+			block.Block.Transactions[0].Hash(),
+			block.Block.Transactions[1].Hash(),
+			block.Block.Transactions[2].Hash(),
+		})
+	require.NotEqual(t, bc.header.StateRoot, block.Block.Header.StateRoot)
+
+	// tx pool queues must not be modified
+	require.Equal(t, aliceTx1, pool.promoted[alice][0])
+}
+
+func TestBlockProducer_Build_StateNotPersisted(t *testing.T) {
+	t.Parallel()
+
+	sender := types.StringToAddress("0xa")
+
+	producer, bc, pool, storage := newTestProducerWithStorage(t, sender)
+	pool.promoted[sender] = []*types.Transaction{newTestTx(sender, 0, 10)}
+
+	block, snapshot, err := producer.build(bc.Header())
+	require.NoError(t, err)
+	require.NotEqual(t, bc.header.StateRoot, block.Block.Header.StateRoot)
+
+	// pending state root is computed, but its trie nodes are not written to the storage
+	exists, err := storage.Has(block.Block.Header.StateRoot.Bytes())
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	exists, err = storage.Has(bc.header.StateRoot.Bytes())
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	// pending state is served from memory
+	account, err := snapshot.GetAccount(sender)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), account.Nonce)
+}
+
+func TestBlockProducer_Build_GasLimitReached(t *testing.T) {
+	t.Parallel()
+
+	sender := types.StringToAddress("0xa")
+
+	producer, bc, pool := newTestProducer(t, sender)
+	bc.header.GasLimit = 21000 * 2
+
+	for i := uint64(0); i < 4; i++ {
+		pool.promoted[sender] = append(pool.promoted[sender], newTestTx(sender, i, 10))
+	}
+
+	block, _, err := producer.build(bc.Header())
+	require.NoError(t, err)
+	require.Len(t, block.Block.Transactions, 2)
+	require.Equal(t, bc.header.GasLimit, block.Block.Header.GasUsed)
+}
+
+func TestBlockProducer_GetPendingBlock(t *testing.T) {
+	t.Parallel()
+
+	sender := types.StringToAddress("0xa")
+
+	producer, bc, pool := newTestProducer(t, sender)
+	pool.promoted[sender] = []*types.Transaction{newTestTx(sender, 0, 10)}
+
+	_, ok := producer.GetPendingBlock()
+	require.False(t, ok)
+
+	require.NoError(t, producer.refresh())
+
+	block, ok := producer.GetPendingBlock()
+	require.True(t, ok)
+	require.Equal(t, bc.header.Number+1, block.Number())
+	require.Len(t, block.Transactions, 1)
+
+	snapshot, ok := producer.GetPendingState(block.Header.StateRoot)
+	require.True(t, ok)
+	require.Equal(t, block.Header.StateRoot, snapshot.GetRootHash())
+
+	_, ok = producer.GetPendingState(bc.header.StateRoot)
+	require.False(t, ok)
+
+	// latest block has changed, so the pending block is stale
+	newHead := bc.header.Copy()
+	newHead.Number++
+	newHead.ComputeHash()
+	bc.header = newHead
+
+	_, ok = producer.GetPendingBlock()
+	require.False(t, ok)
+}
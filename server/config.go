@@ -1,6 +1,7 @@
 package server
 
 import (
+	"crypto/ecdsa"
 	"net"
 	"time"
 
@@ -52,7 +53,13 @@ type Config struct {
 
 	MetricsInterval time.Duration
 
+	ParallelTxExecution bool
+
 	EventTracker *EventTracker
+
+	// UnlockedAccounts are the accounts which are imported to the local keystore and kept unlocked,
+	// so the node signs the transactions sent by them (meant only for development chains)
+	UnlockedAccounts []*ecdsa.PrivateKey
 }
 
 // Telemetry holds the config details for metric services
@@ -68,6 +75,7 @@ type JSONRPC struct {
 	BlockRangeLimit          uint64
 	ConcurrentRequestsDebug  uint64
 	WebSocketReadLimit       uint64
+	PendingBlock             bool
 }
 
 type EventTracker struct {
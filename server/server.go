@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -35,6 +36,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/pending"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/server/proto"
 	"github.com/0xPolygon/polygon-edge/state"
@@ -93,6 +95,9 @@ type Server struct {
 
 	// gasHelper is providing functions regarding gas and fees
 	gasHelper *gasprice.GasHelper
+
+	// pendingBlockProducer is building the pending block from the tx pool executables
+	pendingBlockProducer *pending.BlockProducer
 }
 
 // newFileLogger returns logger instance that writes all logs to a specified file.
@@ -223,6 +228,7 @@ func NewServer(config *Config) (*Server, error) {
 	m.state = st
 
 	m.executor = state.NewExecutor(config.Chain.Params, st, logger.Named("executor"))
+	m.executor.ParallelExecution = config.ParallelTxExecution
 
 	// custom write genesis hook per consensus engine
 	engineName := m.config.Chain.Params.GetEngine()
@@ -357,6 +363,10 @@ func NewServer(config *Config) (*Server, error) {
 		}
 
 		m.accManager = accounts.NewManager(m.blockchain, keystore)
+
+		if err := unlockAccounts(keystore, config.UnlockedAccounts); err != nil {
+			return nil, fmt.Errorf("failed to unlock accounts: %w", err)
+		}
 	}
 
 	// here we can provide some other configuration
@@ -484,6 +494,10 @@ func getAccountImpl(state state.State, root types.Hash, addr types.Address) (*st
 		return nil, fmt.Errorf("unable to get snapshot for root '%s': %w", root, err)
 	}
 
+	return getSnapshotAccount(snap, addr)
+}
+
+func getSnapshotAccount(snap state.Snapshot, addr types.Address) (*state.Account, error) {
 	account, err := snap.GetAccount(addr)
 	if err != nil {
 		return nil, err
@@ -666,14 +680,35 @@ type jsonRPCHub struct {
 	consensus.Consensus
 	consensus.BridgeDataProvider
 	gasprice.GasStore
+	pending.PendingBlockStore
 }
 
 func (j *jsonRPCHub) GetPeers() int {
 	return len(j.Server.Peers())
 }
 
+// snapshotAt returns the snapshot of the pending block state kept in memory, if the root belongs to it,
+// otherwise the snapshot of the state in the storage
+func (j *jsonRPCHub) snapshotAt(root types.Hash) (state.Snapshot, error) {
+	if snap, ok := j.GetPendingState(root); ok {
+		return snap, nil
+	}
+
+	snap, err := j.state.NewSnapshot(root)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get snapshot for root '%s': %w", root, err)
+	}
+
+	return snap, nil
+}
+
 func (j *jsonRPCHub) GetAccount(root types.Hash, addr types.Address) (*jsonrpc.Account, error) {
-	acct, err := getAccountImpl(j.state, root, addr)
+	snap, err := j.snapshotAt(root)
+	if err != nil {
+		return nil, err
+	}
+
+	acct, err := getSnapshotAccount(snap, addr)
 	if err != nil {
 		return nil, err
 	}
@@ -692,12 +727,12 @@ func (j *jsonRPCHub) GetForksInTime(blockNumber uint64) chain.ForksInTime {
 }
 
 func (j *jsonRPCHub) GetStorage(stateRoot types.Hash, addr types.Address, slot types.Hash) ([]byte, error) {
-	account, err := getAccountImpl(j.state, stateRoot, addr)
+	snap, err := j.snapshotAt(stateRoot)
 	if err != nil {
 		return nil, err
 	}
 
-	snap, err := j.state.NewSnapshot(stateRoot)
+	account, err := getSnapshotAccount(snap, addr)
 	if err != nil {
 		return nil, err
 	}
@@ -723,12 +758,17 @@ func (j *jsonRPCHub) Get(key string) ([]byte, error) {
 }
 
 func (j *jsonRPCHub) GetCode(root types.Hash, addr types.Address) ([]byte, error) {
-	account, err := getAccountImpl(j.state, root, addr)
+	snap, err := j.snapshotAt(root)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := getSnapshotAccount(snap, addr)
 	if err != nil {
 		return nil, err
 	}
 
-	code, ok := j.state.GetCode(types.BytesToHash(account.CodeHash))
+	code, ok := snap.GetCode(types.BytesToHash(account.CodeHash))
 	if !ok {
 		return nil, fmt.Errorf("unable to fetch code")
 	}
@@ -784,7 +824,12 @@ func (j *jsonRPCHub) ApplyTxn(
 		return nil, err
 	}
 
-	transition, err := j.BeginTxn(header.StateRoot, header, blockCreator)
+	snap, err := j.snapshotAt(header.StateRoot)
+	if err != nil {
+		return
+	}
+
+	transition, err := j.BeginTxnAt(snap, header, blockCreator)
 	if err != nil {
 		return
 	}
@@ -952,7 +997,12 @@ func (j *jsonRPCHub) TraceCall(
 		return nil, err
 	}
 
-	transition, err := j.BeginTxn(parentHeader.StateRoot, parentHeader, blockCreator)
+	snap, err := j.snapshotAt(parentHeader.StateRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	transition, err := j.BeginTxnAt(snap, parentHeader, blockCreator)
 	if err != nil {
 		return nil, err
 	}
@@ -984,6 +1034,18 @@ func (j *jsonRPCHub) GetSyncProgression() *progress.Progression {
 
 // setupJSONRCP sets up the JSONRPC server, using the set configuration
 func (s *Server) setupJSONRPC() error {
+	var pendingBlockStore pending.PendingBlockStore = pending.DisabledBlockStore{}
+
+	if s.config.JSONRPC.PendingBlock {
+		s.pendingBlockProducer = pending.NewBlockProducer(s.logger, s.blockchain, s.txpool,
+			s.executor, pending.DefaultRefreshInterval)
+		if err := s.pendingBlockProducer.Start(); err != nil {
+			return err
+		}
+
+		pendingBlockStore = s.pendingBlockProducer
+	}
+
 	hub := &jsonRPCHub{
 		state:              s.state,
 		restoreProgression: s.restoreProgression,
@@ -994,6 +1056,7 @@ func (s *Server) setupJSONRPC() error {
 		Server:             s.network,
 		BridgeDataProvider: s.consensus.GetBridgeProvider(),
 		GasStore:           s.gasHelper,
+		PendingBlockStore:  pendingBlockStore,
 	}
 
 	conf := &jsonrpc.Config{
@@ -1082,6 +1145,11 @@ func (s *Server) Close() {
 		}
 	}
 
+	// Close the pending block producer
+	if s.pendingBlockProducer != nil {
+		s.pendingBlockProducer.Close()
+	}
+
 	// Close the txpool's main loop
 	s.txpool.Close()
 
@@ -1092,6 +1160,26 @@ func (s *Server) Close() {
 	s.accManager.Close()
 }
 
+// unlockAccounts imports given accounts to the keystore, unless they are already imported,
+// and unlocks them indefinitely
+func unlockAccounts(ks *keystore.KeyStore, keys []*ecdsa.PrivateKey) error {
+	for _, key := range keys {
+		account := accounts.Account{Address: crypto.PubKeyToAddress(&key.PublicKey)}
+
+		if !ks.HasAddress(account.Address) {
+			if _, err := ks.ImportECDSA(key, ""); err != nil {
+				return err
+			}
+		}
+
+		if err := ks.TimedUnlock(account, "", 0); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Entry is a consensus configuration entry
 type Entry struct {
 	Enabled bool
@@ -48,6 +48,11 @@ type Executor struct {
 	GenesisPostHook func(*Transition) error
 
 	IsL1OriginatedToken bool
+
+	// ParallelExecution enables optimistic parallel execution of the block transactions.
+	// It has no effect when PostHook is set, since the hook has to observe each transaction
+	// executed on top of the previous ones, so the transactions are executed sequentially
+	ParallelExecution bool
 }
 
 // NewExecutor creates a new executor
@@ -155,6 +160,22 @@ func (e *Executor) ProcessBlock(
 		return nil, err
 	}
 
+	if e.ParallelExecution && e.PostHook != nil {
+		e.logger.Debug("[Executor.ProcessBlock] post hook is set, executing transactions sequentially")
+	}
+
+	if e.ParallelExecution && e.PostHook == nil && len(block.Transactions) > 1 {
+		if err := txn.writeParallel(block.Transactions, block.Header.GasLimit); err != nil {
+			e.logger.Error("failed to write transactions to the block", "err", err)
+
+			return nil, err
+		}
+
+		e.logger.Debug("[Executor.ProcessBlock] finished.", "txs count", len(block.Transactions))
+
+		return txn, nil
+	}
+
 	var (
 		buf    bytes.Buffer
 		logLvl = e.logger.GetLevel()
@@ -208,13 +229,24 @@ func (e *Executor) BeginTxn(
 	header *types.Header,
 	coinbaseReceiver types.Address,
 ) (*Transition, error) {
-	forkConfig := e.config.Forks.At(header.Number)
-
 	snap, err := e.state.NewSnapshot(parentRoot)
 	if err != nil {
 		return nil, err
 	}
 
+	return e.BeginTxnAt(snap, header, coinbaseReceiver)
+}
+
+// BeginTxnAt begins the transition on top of the given parent snapshot
+func (e *Executor) BeginTxnAt(
+	snap Snapshot,
+	header *types.Header,
+	coinbaseReceiver types.Address,
+) (*Transition, error) {
+	var err error
+
+	forkConfig := e.config.Forks.At(header.Number)
+
 	burnContract := types.ZeroAddress
 	if forkConfig.London {
 		burnContract, err = e.config.CalculateBurnContract(header.Number)
@@ -311,6 +343,11 @@ type Transition struct {
 	accessList *runtime.AccessList
 
 	isL1OriginatedToken bool
+
+	// deferFees indicates that the transaction fees are collected into the deferredFees,
+	// instead of being paid directly (used by the speculative execution)
+	deferFees    bool
+	deferredFees []*feePayment
 }
 
 func NewTransition(logger hclog.Logger, config chain.ForksInTime, snap Snapshot, radix *Txn) *Transition {
@@ -441,6 +478,22 @@ func (t *Transition) Commit() (Snapshot, types.Hash, error) {
 	return s2, types.BytesToHash(root), nil
 }
 
+// CommitInMemory returns the snapshot of the transition state and its root,
+// without writing the state to the storage
+func (t *Transition) CommitInMemory() (Snapshot, types.Hash, error) {
+	objs, err := t.state.Commit(t.config.EIP155)
+	if err != nil {
+		return nil, types.ZeroHash, err
+	}
+
+	s2, root, err := t.snap.CommitInMemory(objs)
+	if err != nil {
+		return nil, types.ZeroHash, err
+	}
+
+	return s2, types.BytesToHash(root), nil
+}
+
 func (t *Transition) subGasPool(amount uint64) error {
 	if t.gasPool < amount {
 		return ErrBlockLimitReached
@@ -741,13 +794,13 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 
 	// Pay the coinbase fee as a miner reward using the calculated effective tip.
 	coinbaseFee := new(big.Int).Mul(new(big.Int).SetUint64(result.GasUsed), effectiveTip)
-	t.state.AddBalance(t.ctx.Coinbase, coinbaseFee)
+	t.payFee(t.ctx.Coinbase, coinbaseFee)
 
 	// Burn some amount if the london hardfork is applied and token is non mintable.
 	// Basically, burn amount is just transferred to the current burn contract.
 	if t.isL1OriginatedToken && t.config.London && msg.Type() != types.StateTxType {
 		burnAmount := new(big.Int).Mul(new(big.Int).SetUint64(result.GasUsed), t.ctx.BaseFee)
-		t.state.AddBalance(t.ctx.BurnContract, burnAmount)
+		t.payFee(t.ctx.BurnContract, burnAmount)
 	}
 
 	// return gas to the pool
@@ -756,6 +809,17 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	return result, nil
 }
 
+// payFee pays the transaction fee to the given address (coinbase or burn contract)
+func (t *Transition) payFee(addr types.Address, amount *big.Int) {
+	if t.deferFees {
+		t.deferredFees = append(t.deferredFees, &feePayment{addr: addr, amount: amount})
+
+		return
+	}
+
+	t.state.AddBalance(addr, amount)
+}
+
 func (t *Transition) Create2(
 	caller types.Address,
 	code []byte,
@@ -0,0 +1,333 @@
+package itrie
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	// counterCode increments the value at the slot 0
+	counterCode = []byte{0x60, 0x00, 0x54, 0x60, 0x01, 0x01, 0x60, 0x00, 0x55, 0x00}
+	// callerCounterCode increments the value at the slot of the caller address
+	callerCounterCode = []byte{0x33, 0x54, 0x60, 0x01, 0x01, 0x33, 0x55, 0x00}
+	// storeInitCode stores 42 at the slot 0 on deployment
+	storeInitCode = []byte{0x60, 0x2a, 0x60, 0x00, 0x55, 0x00}
+)
+
+func newTestExecutor(storage Storage, parallel bool) *state.Executor {
+	params := &chain.Params{
+		ChainID:      100,
+		Forks:        chain.AllForksEnabled,
+		BurnContract: map[uint64]types.Address{0: types.StringToAddress("0xb0")},
+	}
+
+	executor := state.NewExecutor(params, NewState(storage), hclog.NewNullLogger())
+	executor.IsL1OriginatedToken = true
+	executor.ParallelExecution = parallel
+	executor.GetHash = func(header *types.Header) state.GetHashByNumber {
+		return func(i uint64) types.Hash {
+			return types.ZeroHash
+		}
+	}
+
+	return executor
+}
+
+func newExecutorTestTx(from types.Address, to *types.Address, nonce uint64, value int64,
+	input []byte) *types.Transaction {
+	return types.NewTx(types.NewLegacyTx(
+		types.WithFrom(from),
+		types.WithTo(to),
+		types.WithNonce(nonce),
+		types.WithGas(200_000),
+		types.WithGasPrice(big.NewInt(10)),
+		types.WithValue(big.NewInt(value)),
+		types.WithInput(input),
+	))
+}
+
+func TestExecutor_ParallelExecution(t *testing.T) {
+	t.Parallel()
+
+	var (
+		coinbase      = types.StringToAddress("0xc0")
+		recipient     = types.StringToAddress("0xd0")
+		counter       = types.StringToAddress("0xe0")
+		callerCounter = types.StringToAddress("0xe1")
+		senders       = make([]types.Address, 10)
+		alloc         = map[types.Address]*chain.GenesisAccount{
+			counter:       {Balance: big.NewInt(0), Code: counterCode},
+			callerCounter: {Balance: big.NewInt(0), Code: callerCounterCode},
+		}
+	)
+
+	for i := range senders {
+		senders[i] = types.BytesToAddress([]byte{0x10, byte(i)})
+		alloc[senders[i]] = &chain.GenesisAccount{Balance: big.NewInt(1_000_000_000)}
+	}
+
+	txs := make([]*types.Transaction, 0)
+
+	// independent storage slots of the same contract
+	for _, sender := range senders {
+		txs = append(txs, newExecutorTestTx(sender, &callerCounter, 0, 0, nil))
+	}
+
+	for i, sender := range senders {
+		if i < len(senders)/2 {
+			// conflicting balance of the common recipient
+			txs = append(txs, newExecutorTestTx(sender, &recipient, 1, 1_000, nil))
+		} else {
+			// conflicting storage slot
+			txs = append(txs, newExecutorTestTx(sender, &counter, 1, 0, nil))
+		}
+	}
+
+	txs = append(txs,
+		// fee receiver modified by the transaction
+		newExecutorTestTx(senders[0], &coinbase, 2, 5, nil),
+		// contract deployment
+		newExecutorTestTx(senders[1], nil, 2, 0, storeInitCode),
+		// nonce too low, reverted by the sequential execution as well
+		newExecutorTestTx(senders[2], &recipient, 1, 1, nil),
+	)
+
+	type result struct {
+		root     types.Hash
+		receipts []*types.Receipt
+		err      error
+	}
+
+	execute := func(parallel bool, txs []*types.Transaction) result {
+		executor := newTestExecutor(NewMemoryStorage(), parallel)
+
+		genesisRoot, err := executor.WriteGenesis(alloc, types.ZeroHash)
+		require.NoError(t, err)
+
+		block := &types.Block{
+			Header: &types.Header{
+				Number:   1,
+				GasLimit: 30_000_000,
+				BaseFee:  1,
+			},
+			Transactions: txs,
+		}
+
+		transition, err := executor.ProcessBlock(genesisRoot, block, coinbase)
+		if err != nil {
+			return result{err: err}
+		}
+
+		_, root, err := transition.Commit()
+		require.NoError(t, err)
+
+		return result{root: root, receipts: transition.Receipts()}
+	}
+
+	t.Run("same state root and receipts", func(t *testing.T) {
+		t.Parallel()
+
+		sequential := execute(false, txs[:len(txs)-1])
+		parallel := execute(true, txs[:len(txs)-1])
+
+		require.NoError(t, sequential.err)
+		require.NoError(t, parallel.err)
+		require.Equal(t, sequential.root, parallel.root)
+		require.Len(t, parallel.receipts, len(txs)-1)
+
+		for i, receipt := range sequential.receipts {
+			require.Equal(t, receipt.CumulativeGasUsed, parallel.receipts[i].CumulativeGasUsed)
+			require.Equal(t, receipt.GasUsed, parallel.receipts[i].GasUsed)
+			require.Equal(t, receipt.Status, parallel.receipts[i].Status)
+			require.Equal(t, receipt.TxHash, parallel.receipts[i].TxHash)
+			require.Equal(t, receipt.ContractAddress, parallel.receipts[i].ContractAddress)
+			require.Equal(t, receipt.LogsBloom, parallel.receipts[i].LogsBloom)
+		}
+	})
+
+	t.Run("same error", func(t *testing.T) {
+		t.Parallel()
+
+		sequential := execute(false, txs)
+		parallel := execute(true, txs)
+
+		require.ErrorContains(t, sequential.err, state.ErrNonceTooLow.Error())
+		require.Equal(t, sequential.err.Error(), parallel.err.Error())
+	})
+}
+
+func TestExecutor_ParallelExecution_PersistedState(t *testing.T) {
+	t.Parallel()
+
+	var (
+		coinbase      = types.StringToAddress("0xc0")
+		counter       = types.StringToAddress("0xe0")
+		callerCounter = types.StringToAddress("0xe1")
+		dbPath        = t.TempDir()
+		alloc         = map[types.Address]*chain.GenesisAccount{
+			counter:       {Balance: big.NewInt(0), Code: counterCode},
+			callerCounter: {Balance: big.NewInt(0), Code: callerCounterCode},
+		}
+		txs = make([]*types.Transaction, 0)
+	)
+
+	for i := 0; i < 50; i++ {
+		sender := types.BytesToAddress([]byte{0x10, byte(i)})
+		alloc[sender] = &chain.GenesisAccount{Balance: big.NewInt(1_000_000_000)}
+
+		txs = append(txs,
+			newExecutorTestTx(sender, &callerCounter, 0, 0, nil),
+			newExecutorTestTx(sender, &counter, 1, 0, nil),
+		)
+	}
+
+	storage, err := NewLevelDBStorage(dbPath, hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	genesisRoot, err := newTestExecutor(storage, false).WriteGenesis(alloc, types.ZeroHash)
+	require.NoError(t, err)
+	require.NoError(t, storage.Close())
+
+	// state is reopened, so the speculative executions resolve the trie nodes from the storage
+	storage, err = NewLevelDBStorage(dbPath, hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, storage.Close())
+	})
+
+	execute := func(parallel bool) types.Hash {
+		block := &types.Block{
+			Header: &types.Header{
+				Number:   1,
+				GasLimit: 30_000_000,
+				BaseFee:  1,
+			},
+			Transactions: txs,
+		}
+
+		transition, err := newTestExecutor(storage, parallel).ProcessBlock(genesisRoot, block, coinbase)
+		require.NoError(t, err)
+
+		_, root, err := transition.Commit()
+		require.NoError(t, err)
+
+		return root
+	}
+
+	parallelRoot := execute(true)
+	require.Equal(t, execute(false), parallelRoot)
+}
+
+func TestExecutor_CommitInMemory(t *testing.T) {
+	t.Parallel()
+
+	var (
+		coinbase = types.StringToAddress("0xc0")
+		counter  = types.StringToAddress("0xe0")
+		sender   = types.StringToAddress("0x10")
+		alloc    = map[types.Address]*chain.GenesisAccount{
+			counter: {Balance: big.NewInt(0), Code: counterCode},
+			sender:  {Balance: big.NewInt(1_000_000_000)},
+		}
+		// deployCallerCounterCode deploys the callerCounterCode
+		deployCallerCounterCode = append(append([]byte{0x67}, callerCounterCode...),
+			0x60, 0x00, 0x52, 0x60, 0x08, 0x60, 0x18, 0xf3)
+		deployed = crypto.CreateAddress(sender, 1)
+	)
+
+	newHeader := func(number uint64) *types.Header {
+		return &types.Header{Number: number, GasLimit: 30_000_000, BaseFee: 1}
+	}
+
+	blocks := []*types.Block{
+		{
+			Header: newHeader(1),
+			Transactions: []*types.Transaction{
+				newExecutorTestTx(sender, &counter, 0, 0, nil),
+				newExecutorTestTx(sender, nil, 1, 0, deployCallerCounterCode),
+			},
+		},
+		{
+			Header: newHeader(2),
+			Transactions: []*types.Transaction{
+				newExecutorTestTx(sender, &deployed, 2, 0, nil),
+				newExecutorTestTx(sender, &counter, 3, 0, nil),
+			},
+		},
+	}
+
+	// expected roots of the blocks committed to the storage
+	expectedRoots := make([]types.Hash, len(blocks))
+
+	executor := newTestExecutor(NewMemoryStorage(), false)
+
+	root, err := executor.WriteGenesis(alloc, types.ZeroHash)
+	require.NoError(t, err)
+
+	for i, block := range blocks {
+		transition, err := executor.ProcessBlock(root, block, coinbase)
+		require.NoError(t, err)
+
+		_, root, err = transition.Commit()
+		require.NoError(t, err)
+
+		expectedRoots[i] = root
+	}
+
+	storage := NewMemoryStorage()
+	executor = newTestExecutor(storage, false)
+
+	genesisRoot, err := executor.WriteGenesis(alloc, types.ZeroHash)
+	require.NoError(t, err)
+
+	snap, err := NewState(storage).NewSnapshot(genesisRoot)
+	require.NoError(t, err)
+
+	for i, block := range blocks {
+		transition, err := executor.BeginTxnAt(snap, block.Header, coinbase)
+		require.NoError(t, err)
+
+		for _, tx := range block.Transactions {
+			require.NoError(t, transition.Write(tx))
+		}
+
+		snap, root, err = transition.CommitInMemory()
+		require.NoError(t, err)
+		require.Equal(t, expectedRoots[i], root)
+		require.Equal(t, root, snap.GetRootHash())
+
+		_, err = NewState(storage).NewSnapshot(root)
+		require.Error(t, err, "state must not be written to the storage")
+	}
+
+	counterAccount, err := snap.GetAccount(counter)
+	require.NoError(t, err)
+	require.Equal(t, types.BytesToHash([]byte{2}), snap.GetStorage(counter, counterAccount.Root, types.ZeroHash))
+
+	deployedAccount, err := snap.GetAccount(deployed)
+	require.NoError(t, err)
+	require.Equal(t, types.BytesToHash([]byte{1}),
+		snap.GetStorage(deployed, deployedAccount.Root, types.BytesToHash(sender.Bytes())))
+
+	code, ok := snap.GetCode(types.BytesToHash(deployedAccount.CodeHash))
+	require.True(t, ok)
+	require.Equal(t, callerCounterCode, code)
+
+	_, ok = storage.GetCode(types.BytesToHash(deployedAccount.CodeHash))
+	require.False(t, ok, "code must not be written to the storage")
+
+	_, _, err = snap.Commit(nil)
+	require.ErrorIs(t, err, errInMemorySnapshot)
+
+	_, err = snap.Fork()
+	require.ErrorIs(t, err, errInMemorySnapshot)
+}
@@ -2,6 +2,7 @@ package itrie
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 
 	"github.com/0xPolygon/polygon-edge/crypto"
@@ -13,9 +14,45 @@ import (
 type Snapshot struct {
 	state *State
 	trie  *Trie
+	// forked marks the snapshot whose tries are loaded from the storage and not shared with other snapshots
+	forked bool
+	// memory holds the storage tries and the code of the snapshot committed in memory only
+	memory *memoryState
 }
 
-var emptyStateHash = types.StringToHash("0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421")
+// memoryState holds the storage tries and the code which are not written to the storage
+type memoryState struct {
+	tries map[types.Hash]*Trie
+	code  map[types.Hash][]byte
+}
+
+// copy returns the copy of the memory state, which is empty if there is nothing to copy
+func (m *memoryState) copy() *memoryState {
+	c := &memoryState{
+		tries: map[types.Hash]*Trie{},
+		code:  map[types.Hash][]byte{},
+	}
+
+	if m != nil {
+		for root, trie := range m.tries {
+			c.tries[root] = trie
+		}
+
+		for hash, code := range m.code {
+			c.code[hash] = code
+		}
+	}
+
+	return c
+}
+
+var (
+	emptyStateHash = types.StringToHash("0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421")
+
+	// errInMemorySnapshot is returned when the snapshot committed in memory is about to be written or forked,
+	// since its trie nodes are not in the storage
+	errInMemorySnapshot = errors.New("snapshot is committed in memory only")
+)
 
 func (s *Snapshot) GetStorage(addr types.Address, root types.Hash, rawkey types.Hash) types.Hash {
 	var (
@@ -26,7 +63,7 @@ func (s *Snapshot) GetStorage(addr types.Address, root types.Hash, rawkey types.
 	if root == emptyStateHash {
 		trie = s.state.newTrie()
 	} else {
-		trie, err = s.state.newTrieAt(root)
+		trie, err = s.trieAt(root)
 		if err != nil {
 			return types.Hash{}
 		}
@@ -71,9 +108,30 @@ func (s *Snapshot) GetAccount(addr types.Address) (*state.Account, error) {
 }
 
 func (s *Snapshot) GetCode(hash types.Hash) ([]byte, bool) {
+	if s.memory != nil {
+		if code, ok := s.memory.code[hash]; ok {
+			return code, true
+		}
+	}
+
 	return s.state.GetCode(hash)
 }
 
+// trieAt returns the storage trie with the given root
+func (s *Snapshot) trieAt(root types.Hash) (*Trie, error) {
+	if s.memory != nil {
+		if trie, ok := s.memory.tries[root]; ok {
+			return trie, nil
+		}
+	}
+
+	if s.forked {
+		return s.state.loadTrieAt(root)
+	}
+
+	return s.state.newTrieAt(root)
+}
+
 func (s *Snapshot) GetRootHash() types.Hash {
 	tt := s.trie.Txn(s.state.storage)
 
@@ -85,11 +143,71 @@ func (s *Snapshot) GetRootHash() types.Hash {
 	return types.BytesToHash(res)
 }
 
+// Fork returns the snapshot of the same state, loaded from the storage independently of this snapshot
+// and of the cached tries. Trie lookups resolve the stored nodes in place, so the tries cannot be read
+// concurrently, while the forked snapshot can be read concurrently with the other ones
+func (s *Snapshot) Fork() (state.Snapshot, error) {
+	if s.memory != nil {
+		return nil, errInMemorySnapshot
+	}
+
+	if s.trie.root == nil {
+		return &Snapshot{state: s.state, trie: s.state.newTrie(), forked: true}, nil
+	}
+
+	trie, err := s.state.loadTrieAt(s.GetRootHash())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Snapshot{state: s.state, trie: trie, forked: true}, nil
+}
+
 func (s *Snapshot) Commit(objs []*state.Object) (state.Snapshot, []byte, error) {
+	// unchanged trie nodes of the snapshot committed in memory would be missing from the storage
+	if s.memory != nil {
+		return nil, types.ZeroHash[:], errInMemorySnapshot
+	}
+
 	batch := s.state.storage.Batch()
 
+	tt, root, err := s.apply(objs, batch, nil)
+	if err != nil {
+		return nil, types.ZeroHash[:], err
+	}
+
+	nTrie := tt.Commit()
+
+	// Write all the entries to db
+	if err := batch.Write(); err != nil {
+		return nil, types.ZeroHash[:], fmt.Errorf("snapshot commit db write error: %w", err)
+	}
+
+	s.state.AddState(types.BytesToHash(root), nTrie)
+
+	return &Snapshot{trie: nTrie, state: s.state}, root, nil
+}
+
+// CommitInMemory applies the objects to the snapshot and returns the resulting snapshot and its root.
+// Trie nodes and the code are kept in memory by the returned snapshot, without writing them to the storage
+func (s *Snapshot) CommitInMemory(objs []*state.Object) (state.Snapshot, []byte, error) {
+	memory := s.memory.copy()
+
+	tt, root, err := s.apply(objs, nil, memory)
+	if err != nil {
+		return nil, types.ZeroHash[:], err
+	}
+
+	return &Snapshot{state: s.state, trie: tt.Commit(), memory: memory}, root, nil
+}
+
+// apply applies the objects to the snapshot trie and returns the resulting trie transaction and root.
+// Trie nodes and the code are written to the batch if provided, otherwise they are kept in the memory state
+func (s *Snapshot) apply(objs []*state.Object, batch Batch, memory *memoryState) (*Txn, []byte, error) {
 	tt := s.trie.Txn(s.state.storage)
-	tt.batch = batch
+	if batch != nil {
+		tt.batch = batch
+	}
 
 	arena := stateArenaPool.Get()
 	defer stateArenaPool.Put(arena)
@@ -106,13 +224,15 @@ func (s *Snapshot) Commit(objs []*state.Object) (state.Snapshot, []byte, error)
 			}
 
 			if len(obj.Storage) != 0 {
-				trie, err := s.state.newTrieAt(obj.Root)
+				trie, err := s.trieAt(obj.Root)
 				if err != nil {
-					return nil, types.ZeroHash[:], fmt.Errorf("snapshot commit failed to create trie: %w", err)
+					return nil, nil, fmt.Errorf("snapshot commit failed to create trie: %w", err)
 				}
 
 				localTxn := trie.Txn(s.state.storage)
-				localTxn.batch = batch
+				if batch != nil {
+					localTxn.batch = batch
+				}
 
 				for _, entry := range obj.Storage {
 					k := hashit(entry.Key)
@@ -125,16 +245,23 @@ func (s *Snapshot) Commit(objs []*state.Object) (state.Snapshot, []byte, error)
 				}
 
 				accountStateRoot, _ := localTxn.Hash()
-				accountStateTrie := localTxn.Commit()
 
-				// Add this to the cache
-				s.state.AddState(types.BytesToHash(accountStateRoot), accountStateTrie)
+				if batch != nil {
+					// Add this to the cache
+					s.state.AddState(types.BytesToHash(accountStateRoot), localTxn.Commit())
+				} else {
+					memory.tries[types.BytesToHash(accountStateRoot)] = localTxn.Commit()
+				}
 
 				account.Root = types.BytesToHash(accountStateRoot)
 			}
 
 			if obj.DirtyCode {
-				batch.Put(GetCodeKey(obj.CodeHash), obj.Code)
+				if batch != nil {
+					batch.Put(GetCodeKey(obj.CodeHash), obj.Code)
+				} else {
+					memory.code[obj.CodeHash] = obj.Code
+				}
 			}
 
 			vv := account.MarshalWith(arena)
@@ -147,17 +274,8 @@ func (s *Snapshot) Commit(objs []*state.Object) (state.Snapshot, []byte, error)
 
 	root, err := tt.Hash()
 	if err != nil {
-		return nil, types.ZeroHash[:], fmt.Errorf("snapshot commit can not retrieve hash: %w", err)
+		return nil, nil, fmt.Errorf("snapshot commit can not retrieve hash: %w", err)
 	}
 
-	nTrie := tt.Commit()
-
-	// Write all the entries to db
-	if err := batch.Write(); err != nil {
-		return nil, types.ZeroHash[:], fmt.Errorf("snapshot commit db write error: %w", err)
-	}
-
-	s.state.AddState(types.BytesToHash(root), nTrie)
-
-	return &Snapshot{trie: nTrie, state: s.state}, root, nil
+	return tt, root, nil
 }
@@ -97,6 +97,15 @@ func (s *State) newTrieAt(root types.Hash) (*Trie, error) {
 		return t, nil
 	}
 
+	return s.loadTrieAt(root)
+}
+
+// loadTrieAt loads the trie with the given root from the storage, bypassing the cache
+func (s *State) loadTrieAt(root types.Hash) (*Trie, error) {
+	if root == types.EmptyRootHash {
+		return s.newTrie(), nil
+	}
+
 	n, ok, err := GetNode(root.Bytes(), s.storage)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get storage root %s: %w", root, err)
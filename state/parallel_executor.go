@@ -0,0 +1,330 @@
+package state
+
+import (
+	"bytes"
+	"math/big"
+	goruntime "runtime"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/addresslist"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// feePayment is a transaction fee payment deferred by the speculative execution
+type feePayment struct {
+	addr   types.Address
+	amount *big.Int
+}
+
+// storageSlot identifies a single account storage slot
+type storageSlot struct {
+	addr types.Address
+	key  types.Hash
+}
+
+var _ readSnapshot = (*readRecorder)(nil)
+
+// readRecorder is a read snapshot which records the accounts and the storage slots
+// read from the underlying snapshot, so that the speculative execution can be validated afterwards
+type readRecorder struct {
+	snapshot readSnapshot
+	accounts map[types.Address]*Account
+	storage  map[storageSlot]types.Hash
+}
+
+func newReadRecorder(snapshot readSnapshot) *readRecorder {
+	return &readRecorder{
+		snapshot: snapshot,
+		accounts: map[types.Address]*Account{},
+		storage:  map[storageSlot]types.Hash{},
+	}
+}
+
+func (r *readRecorder) GetAccount(addr types.Address) (*Account, error) {
+	account, err := r.snapshot.GetAccount(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, exists := r.accounts[addr]; !exists {
+		if account != nil {
+			r.accounts[addr] = account.Copy()
+		} else {
+			r.accounts[addr] = nil
+		}
+	}
+
+	return account, nil
+}
+
+func (r *readRecorder) GetStorage(addr types.Address, root types.Hash, key types.Hash) types.Hash {
+	value := r.snapshot.GetStorage(addr, root, key)
+
+	slot := storageSlot{addr: addr, key: key}
+	if _, exists := r.storage[slot]; !exists {
+		r.storage[slot] = value
+	}
+
+	return value
+}
+
+func (r *readRecorder) GetCode(hash types.Hash) ([]byte, bool) {
+	return r.snapshot.GetCode(hash)
+}
+
+func (r *readRecorder) GetRootHash() types.Hash {
+	return r.snapshot.GetRootHash()
+}
+
+// isValid checks whether the recorded reads match the current state of the given transaction,
+// meaning that the speculative execution would read the same values if executed on top of it
+func (r *readRecorder) isValid(txn *Txn) bool {
+	for addr, read := range r.accounts {
+		object, exists := txn.getStateObject(addr)
+		if read == nil || !exists {
+			if read != nil || exists {
+				return false
+			}
+
+			continue
+		}
+
+		current := object.Account
+		if current.Nonce != read.Nonce || current.Balance.Cmp(read.Balance) != 0 ||
+			current.Root != read.Root || !bytes.Equal(current.CodeHash, read.CodeHash) {
+			return false
+		}
+	}
+
+	for slot, read := range r.storage {
+		if txn.GetState(slot.addr, slot.key) != read {
+			return false
+		}
+	}
+
+	return true
+}
+
+// speculativeResult is the outcome of the speculative execution of a single transaction
+type speculativeResult struct {
+	transition *Transition
+	reads      *readRecorder
+	err        error
+}
+
+// writeParallel writes the given transactions to the state, producing the same state and receipts
+// as writing them one by one. Transactions are speculatively executed in parallel on top of the
+// transition state, each one recording what it has read. Afterwards, the results are validated
+// and applied in the block order, while the transactions which have read stale values are re-executed
+func (t *Transition) writeParallel(txs []*types.Transaction, blockGasLimit uint64) error {
+	results := t.speculate(txs)
+
+	for i, tx := range txs {
+		if tx.Gas() > blockGasLimit {
+			return runtime.ErrOutOfGas
+		}
+
+		applied, err := t.applySpeculative(tx, results[i])
+		if err != nil {
+			return err
+		}
+
+		if applied {
+			continue
+		}
+
+		// conflict detected, so re-execute the transaction on top of the current state
+		if err := t.Write(tx); err != nil {
+			t.logger.Error("failed to write transaction to the block", "tx", tx, "err", err)
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// speculate executes the given transactions in parallel, each one in isolation on top of the transition state
+func (t *Transition) speculate(txs []*types.Transaction) []*speculativeResult {
+	var (
+		results = make([]*speculativeResult, len(txs))
+		indexCh = make(chan int, len(txs))
+		wg      sync.WaitGroup
+	)
+
+	for i := range txs {
+		indexCh <- i
+	}
+
+	close(indexCh)
+
+	workers := goruntime.GOMAXPROCS(0)
+	if workers > len(txs) {
+		workers = len(txs)
+	}
+
+	// trie lookups resolve the stored nodes in place, so each worker reads through its own snapshot
+	snapshots := make([]readSnapshot, workers)
+
+	for w := range snapshots {
+		snapshot, err := t.snap.Fork()
+		if err != nil {
+			t.logger.Debug("failed to fork the snapshot, transactions are executed sequentially", "err", err)
+
+			for i := range results {
+				results[i] = &speculativeResult{err: err}
+			}
+
+			return results
+		}
+
+		snapshots[w] = snapshot
+	}
+
+	for _, snapshot := range snapshots {
+		snapshot := snapshot
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range indexCh {
+				reads := newReadRecorder(snapshot)
+				transition := t.speculativeTransition(reads)
+
+				results[i] = &speculativeResult{
+					transition: transition,
+					reads:      reads,
+					err:        transition.Write(txs[i]),
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+// speculativeTransition creates a transition for the speculative execution of a single transaction,
+// with the same context as the current one, which reads the state through the given recorder
+func (t *Transition) speculativeTransition(reads *readRecorder) *Transition {
+	transition := NewTransition(hclog.NewNullLogger(), t.config, t.snap, newTxn(reads))
+	transition.getHash = t.getHash
+	transition.ctx = t.ctx
+	transition.gasPool = t.ctx.GasLimit
+	transition.isL1OriginatedToken = t.isL1OriginatedToken
+	transition.deferFees = true
+
+	if t.deploymentAllowList != nil {
+		transition.deploymentAllowList = addresslist.NewAddressList(transition, t.deploymentAllowList.Addr())
+	}
+
+	if t.deploymentBlockList != nil {
+		transition.deploymentBlockList = addresslist.NewAddressList(transition, t.deploymentBlockList.Addr())
+	}
+
+	if t.txnAllowList != nil {
+		transition.txnAllowList = addresslist.NewAddressList(transition, t.txnAllowList.Addr())
+	}
+
+	if t.txnBlockList != nil {
+		transition.txnBlockList = addresslist.NewAddressList(transition, t.txnBlockList.Addr())
+	}
+
+	if t.bridgeAllowList != nil {
+		transition.bridgeAllowList = addresslist.NewAddressList(transition, t.bridgeAllowList.Addr())
+	}
+
+	if t.bridgeBlockList != nil {
+		transition.bridgeBlockList = addresslist.NewAddressList(transition, t.bridgeBlockList.Addr())
+	}
+
+	return transition
+}
+
+// applySpeculative applies the speculative execution result to the transition state,
+// if the result is still valid. It returns false, if the transaction has to be re-executed
+func (t *Transition) applySpeculative(tx *types.Transaction, result *speculativeResult) (bool, error) {
+	spec := result.transition
+
+	if result.err != nil || len(spec.receipts) != 1 ||
+		t.gasPool < tx.Gas() || !result.reads.isValid(t.state) {
+		return false, nil
+	}
+
+	written := spec.state.txn.Commit().Root()
+
+	// fees are deferred, so the speculative execution is not valid
+	// if the fee receivers are modified by the transaction itself
+	for _, fee := range spec.deferredFees {
+		if _, ok := written.Get(fee.addr.Bytes()); ok {
+			return false, nil
+		}
+	}
+
+	written.Walk(func(k []byte, v interface{}) bool {
+		object, ok := v.(*StateObject)
+		if !ok {
+			return false
+		}
+
+		t.state.txn.Insert(k, t.mergeStateObject(types.BytesToAddress(k), object, result.reads))
+
+		return false
+	})
+
+	for _, fee := range spec.deferredFees {
+		t.state.AddBalance(fee.addr, fee.amount)
+	}
+
+	if err := t.state.CleanDeleteObjects(true); err != nil {
+		return false, err
+	}
+
+	receipt := spec.receipts[0]
+
+	t.gasPool -= receipt.GasUsed
+	t.totalGas += receipt.GasUsed
+	receipt.CumulativeGasUsed = t.totalGas
+	t.receipts = append(t.receipts, receipt)
+
+	return true, nil
+}
+
+// mergeStateObject merges the state object written by the speculative execution with the one
+// from the transition state, keeping the storage slots written by the previous transactions
+func (t *Transition) mergeStateObject(addr types.Address, written *StateObject, reads *readRecorder) *StateObject {
+	read := reads.accounts[addr]
+	if written.Deleted || read == nil || read.Root != written.Account.Root {
+		// account is either deleted or (re)created by the transaction
+		return written
+	}
+
+	val, exists := t.state.txn.Get(addr.Bytes())
+	if !exists {
+		return written
+	}
+
+	current := val.(*StateObject) //nolint:forcetypeassert
+	if current.Deleted || current.Txn == nil {
+		return written
+	}
+
+	merged := written.Copy()
+	merged.Txn = current.Txn.CommitOnly().Txn()
+
+	if written.Txn != nil {
+		written.Txn.Root().Walk(func(k []byte, v interface{}) bool {
+			merged.Txn.Insert(k, v)
+
+			return false
+		})
+	}
+
+	return merged
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/Ethernal-Tech/ethgo/abi"
@@ -37,8 +38,8 @@ func (a *AddressList) Addr() types.Address {
 	return a.addr
 }
 
-func (a *AddressList) Run(c *runtime.Contract, host runtime.Host, _ *chain.ForksInTime) *runtime.ExecutionResult {
-	ret, gasUsed, err := a.runInputCall(c.Caller, c.Input, c.Gas, c.Static)
+func (a *AddressList) Run(c *runtime.Contract, host runtime.Host, config *chain.ForksInTime) *runtime.ExecutionResult {
+	ret, gasUsed, err := a.runInputCall(c.Caller, c.Input, c.Gas, c.Static, config)
 
 	res := &runtime.ExecutionResult{
 		ReturnValue: ret,
@@ -59,7 +60,7 @@ var (
 )
 
 func (a *AddressList) runInputCall(caller types.Address, input []byte,
-	gas uint64, isStatic bool) ([]byte, uint64, error) {
+	gas uint64, isStatic bool, config *chain.ForksInTime) ([]byte, uint64, error) {
 	// decode the function signature from the input
 	if len(input) < types.SignatureSize {
 		return nil, 0, errNoFunctionSignature
@@ -122,15 +123,18 @@ func (a *AddressList) runInputCall(caller types.Address, input []byte,
 		return nil, gasUsed, errWriteProtection
 	}
 
-	// Only Admin accounts can modify the role of other accounts
-	addrRole := a.GetRole(caller)
-	if addrRole != AdminRole {
-		return nil, gasUsed, runtime.ErrNotAuth
-	}
+	// Only Admin accounts can modify the role of other accounts,
+	// apart from the system caller, which applies the roles changed by the governance once the fork is active
+	if !config.GovernanceAddressLists || caller != contracts.SystemCaller {
+		addrRole := a.GetRole(caller)
+		if addrRole != AdminRole {
+			return nil, gasUsed, runtime.ErrNotAuth
+		}
 
-	// An admin can not remove himself from the list
-	if addrRole == AdminRole && caller == inputAddr {
-		return nil, gasUsed, errAdminSelfRemove
+		// An admin can not remove himself from the list
+		if addrRole == AdminRole && caller == inputAddr {
+			return nil, gasUsed, errAdminSelfRemove
+		}
 	}
 
 	a.SetRole(inputAddr, updateRole)
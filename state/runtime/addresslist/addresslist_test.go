@@ -3,6 +3,8 @@ package addresslist
 import (
 	"testing"
 
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/Ethernal-Tech/ethgo/abi"
@@ -35,19 +37,19 @@ func TestAddressList_WrongInput(t *testing.T) {
 	input := []byte{}
 
 	// no function signature
-	_, _, err := a.runInputCall(types.Address{}, input, 0, false)
+	_, _, err := a.runInputCall(types.Address{}, input, 0, false, &chain.ForksInTime{})
 	require.Equal(t, errNoFunctionSignature, err)
 
 	input = append(input, []byte{0x1, 0x2, 0x3, 0x4}...)
 
 	// no function input
-	_, _, err = a.runInputCall(types.Address{}, input, 0, false)
+	_, _, err = a.runInputCall(types.Address{}, input, 0, false, &chain.ForksInTime{})
 	require.Equal(t, errInputTooShort, err)
 
 	input = append(input, make([]byte, 32)...)
 
 	// wrong signature
-	_, _, err = a.runInputCall(types.Address{}, input, 0, false)
+	_, _, err = a.runInputCall(types.Address{}, input, 0, false, &chain.ForksInTime{})
 	require.Equal(t, errFunctionNotFound, err)
 }
 
@@ -56,10 +58,10 @@ func TestAddressList_ReadOp_NotEnoughGas(t *testing.T) {
 
 	input, _ := ReadAddressListFunc.Encode([]interface{}{types.Address{}})
 
-	_, _, err := a.runInputCall(types.Address{}, input, 0, false)
+	_, _, err := a.runInputCall(types.Address{}, input, 0, false, &chain.ForksInTime{})
 	require.Equal(t, runtime.ErrOutOfGas, err)
 
-	_, _, err = a.runInputCall(types.Address{}, input, readAddressListCost-1, false)
+	_, _, err = a.runInputCall(types.Address{}, input, readAddressListCost-1, false, &chain.ForksInTime{})
 	require.Equal(t, runtime.ErrOutOfGas, err)
 }
 
@@ -85,7 +87,7 @@ func TestAddressList_ReadOp_Full(t *testing.T) {
 
 	for _, c := range cases {
 		input, _ := ReadAddressListFunc.Encode([]interface{}{c.addr})
-		role, gasUsed, err := a.runInputCall(types.Address{}, input, readAddressListCost, false)
+		role, gasUsed, err := a.runInputCall(types.Address{}, input, readAddressListCost, false, &chain.ForksInTime{})
 		require.NoError(t, err)
 		require.Equal(t, gasUsed, readAddressListCost)
 		require.Equal(t, c.role.Bytes(), role)
@@ -97,10 +99,10 @@ func TestAddressList_WriteOp_NotEnoughGas(t *testing.T) {
 
 	input, _ := SetAdminFunc.Encode([]interface{}{types.Address{}})
 
-	_, _, err := a.runInputCall(types.Address{}, input, 0, false)
+	_, _, err := a.runInputCall(types.Address{}, input, 0, false, &chain.ForksInTime{})
 	require.Equal(t, runtime.ErrOutOfGas, err)
 
-	_, _, err = a.runInputCall(types.Address{}, input, writeAddressListCost-1, false)
+	_, _, err = a.runInputCall(types.Address{}, input, writeAddressListCost-1, false, &chain.ForksInTime{})
 	require.Equal(t, runtime.ErrOutOfGas, err)
 }
 
@@ -109,7 +111,7 @@ func TestAddressList_WriteOp_CannotWriteInStaticCall(t *testing.T) {
 
 	input, _ := SetAdminFunc.Encode([]interface{}{types.Address{}})
 
-	_, gasCost, err := a.runInputCall(types.Address{}, input, writeAddressListCost, true)
+	_, gasCost, err := a.runInputCall(types.Address{}, input, writeAddressListCost, true, &chain.ForksInTime{})
 	require.Equal(t, writeAddressListCost, gasCost)
 	require.Equal(t, err, errWriteProtection)
 }
@@ -119,7 +121,7 @@ func TestAddressList_WriteOp_OnlyAdminCanUpdate(t *testing.T) {
 
 	input, _ := SetAdminFunc.Encode([]interface{}{types.Address{}})
 
-	_, gasCost, err := a.runInputCall(types.Address{}, input, writeAddressListCost, false)
+	_, gasCost, err := a.runInputCall(types.Address{}, input, writeAddressListCost, false, &chain.ForksInTime{})
 	require.Equal(t, writeAddressListCost, gasCost)
 	require.Equal(t, err, runtime.ErrNotAuth)
 }
@@ -145,7 +147,7 @@ func TestAddressList_WriteOp_Full(t *testing.T) {
 	for _, c := range cases {
 		input, _ := c.method.Encode([]interface{}{targetAddr})
 
-		ret, gasCost, err := a.runInputCall(types.Address{}, input, writeAddressListCost, false)
+		ret, gasCost, err := a.runInputCall(types.Address{}, input, writeAddressListCost, false, &chain.ForksInTime{})
 		require.Equal(t, writeAddressListCost, gasCost)
 		require.NoError(t, err)
 		require.Empty(t, ret)
@@ -153,6 +155,31 @@ func TestAddressList_WriteOp_Full(t *testing.T) {
 	}
 }
 
+func TestAddressList_WriteOp_SystemCaller(t *testing.T) {
+	a := newMockAddressList()
+
+	targetAddr := types.Address{0x1}
+	forks := &chain.ForksInTime{GovernanceAddressLists: true}
+
+	input, _ := SetAdminFunc.Encode([]interface{}{targetAddr})
+
+	// system caller needs the admin role before the fork
+	_, _, err := a.runInputCall(contracts.SystemCaller, input, writeAddressListCost, false, &chain.ForksInTime{})
+	require.ErrorIs(t, err, runtime.ErrNotAuth)
+	require.Equal(t, NoRole, a.GetRole(targetAddr))
+
+	// system caller does not need the admin role once the fork is active
+	_, _, err = a.runInputCall(contracts.SystemCaller, input, writeAddressListCost, false, forks)
+	require.NoError(t, err)
+	require.Equal(t, AdminRole, a.GetRole(targetAddr))
+
+	input, _ = SetNoneFunc.Encode([]interface{}{targetAddr})
+
+	_, _, err = a.runInputCall(contracts.SystemCaller, input, writeAddressListCost, false, forks)
+	require.NoError(t, err)
+	require.Equal(t, NoRole, a.GetRole(targetAddr))
+}
+
 func TestRole_ToUint(t *testing.T) {
 	cases := []struct {
 		role Role
@@ -35,8 +35,12 @@ type apexBLSSignatureVerification struct {
 }
 
 // gas returns the gas required to execute the pre-compiled contract
-func (c *apexBLSSignatureVerification) gas(input []byte, _ *chain.ForksInTime) uint64 {
-	return 50000
+func (c *apexBLSSignatureVerification) gas(input []byte, config *chain.ForksInTime) uint64 {
+	if config.PrecompileGasSchedule {
+		return apexBLSVerificationGas(input)
+	}
+
+	return apexBLSVerificationLegacyGas
 }
 
 // Run runs the precompiled contract with the given input.
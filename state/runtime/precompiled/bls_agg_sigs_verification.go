@@ -32,8 +32,12 @@ type blsAggSignsVerification struct {
 }
 
 // gas returns the gas required to execute the pre-compiled contract
-func (c *blsAggSignsVerification) gas(input []byte, _ *chain.ForksInTime) uint64 {
-	return 150000
+func (c *blsAggSignsVerification) gas(input []byte, config *chain.ForksInTime) uint64 {
+	if config.PrecompileGasSchedule {
+		return blsAggSignsVerificationGas(input)
+	}
+
+	return blsAggSignsVerificationLegacyGas
 }
 
 // Run runs the precompiled contract with the given input.
@@ -0,0 +1,308 @@
+package precompiled
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/Ethernal-Tech/ethgo/abi"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/mr-tron/base58"
+)
+
+const (
+	// cardanoMainnetNetworkID is the network id of the cardano mainnet addresses
+	cardanoMainnetNetworkID = uint8(1)
+	// cardanoTestnetNetworkID is the network id of the cardano testnet addresses
+	cardanoTestnetNetworkID = uint8(0)
+
+	// cardanoByronAddressType is the address type of the legacy (base58 encoded) cardano addresses
+	cardanoByronAddressType = uint8(8)
+	// cardanoByronProtocolMagicAttribute is the key of the byron address attribute holding the protocol magic,
+	// which exists only in the testnet addresses
+	cardanoByronProtocolMagicAttribute = uint64(2)
+	// cardanoByronPayloadTag is the cbor tag of the byron address payload
+	cardanoByronPayloadTag = uint64(24)
+)
+
+var (
+	cardanoAddressPrecompileInputABIType  = abi.MustNewType("tuple(string)")
+	cardanoAddressPrecompileOutputABIType = abi.MustNewType(
+		"tuple(bool isValid, uint8 networkId, uint8 addressType, bytes28 paymentHash, bool isPaymentScript, " +
+			"bytes28 stakeHash, bool isStakeScript)")
+
+	errInvalidCardanoAddress = errors.New("invalid cardano address")
+)
+
+// cardanoAddress holds the parsed parts of a cardano address
+type cardanoAddress struct {
+	networkID       uint8
+	addressType     uint8
+	paymentHash     [cardanoKeyHashSize]byte
+	isPaymentScript bool
+	stakeHash       [cardanoKeyHashSize]byte
+	isStakeScript   bool
+}
+
+// cardanoAddressPrecompile is a concrete implementation of the contract interface.
+// It validates cardano bech32 (shelley) and base58 (byron) addresses and returns their network and key hashes
+type cardanoAddressPrecompile struct {
+}
+
+// gas returns the gas required to execute the pre-compiled contract
+func (c *cardanoAddressPrecompile) gas(input []byte, _ *chain.ForksInTime) uint64 {
+	return baseGasCalc(input, 3_000, 60)
+}
+
+// Run runs the precompiled contract with the given input.
+// parseAddress(string address):
+// Output could be an error or ABI encoded
+// "(bool isValid, uint8 networkId, uint8 addressType, bytes28 paymentHash, bool isPaymentScript,
+// bytes28 stakeHash, bool isStakeScript)" value.
+// Invalid address is not an error, it is reported by the isValid flag
+func (c *cardanoAddressPrecompile) run(input []byte, _ types.Address, _ runtime.Host) ([]byte, error) {
+	rawData, err := abi.Decode(cardanoAddressPrecompileInputABIType, input)
+	if err != nil {
+		return nil, errors.Join(runtime.ErrInvalidInputData, err)
+	}
+
+	data := rawData.(map[string]interface{}) //nolint: forcetypeassert
+	rawAddress := data["0"].(string)         //nolint: forcetypeassert
+
+	addr, err := parseCardanoAddress(rawAddress)
+	if err != nil {
+		addr = &cardanoAddress{}
+	}
+
+	return cardanoAddressPrecompileOutputABIType.Encode(map[string]interface{}{
+		"isValid":         err == nil,
+		"networkId":       addr.networkID,
+		"addressType":     addr.addressType,
+		"paymentHash":     addr.paymentHash,
+		"isPaymentScript": addr.isPaymentScript,
+		"stakeHash":       addr.stakeHash,
+		"isStakeScript":   addr.isStakeScript,
+	})
+}
+
+// parseCardanoAddress parses bech32 encoded shelley address or base58 encoded byron address
+func parseCardanoAddress(rawAddress string) (*cardanoAddress, error) {
+	hrp, data, err := bech32.DecodeNoLimit(rawAddress)
+	if err != nil {
+		return parseCardanoByronAddress(rawAddress)
+	}
+
+	raw, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return nil, errors.Join(errInvalidCardanoAddress, err)
+	}
+
+	addr, err := parseCardanoShelleyAddress(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	// human readable part must match both the address type and the network
+	expectedHrp, err := cardanoAddressHrp(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if hrp != expectedHrp {
+		return nil, fmt.Errorf("%w: invalid prefix %s, expected %s", errInvalidCardanoAddress, hrp, expectedHrp)
+	}
+
+	return addr, nil
+}
+
+// encodeCardanoAddress encodes raw cardano address to the bech32 (shelley) or base58 (byron) string
+func encodeCardanoAddress(raw []byte) (string, error) {
+	if len(raw) > 0 && raw[0]>>4 == cardanoByronAddressType {
+		if _, err := parseCardanoByronAddressBytes(raw); err != nil {
+			return "", err
+		}
+
+		return base58.Encode(raw), nil
+	}
+
+	addr, err := parseCardanoShelleyAddress(raw)
+	if err != nil {
+		return "", err
+	}
+
+	hrp, err := cardanoAddressHrp(addr)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := bech32.ConvertBits(raw, 8, 5, true)
+	if err != nil {
+		return "", errors.Join(errInvalidCardanoAddress, err)
+	}
+
+	return bech32.Encode(hrp, data)
+}
+
+// cardanoAddressHrp returns bech32 human readable part of the shelley address, as specified by the CIP-5
+func cardanoAddressHrp(addr *cardanoAddress) (string, error) {
+	hrp := "addr"
+	if addr.addressType >= 14 {
+		hrp = "stake"
+	}
+
+	switch addr.networkID {
+	case cardanoMainnetNetworkID:
+		return hrp, nil
+	case cardanoTestnetNetworkID:
+		return hrp + "_test", nil
+	default:
+		return "", fmt.Errorf("%w: unknown network id %d", errInvalidCardanoAddress, addr.networkID)
+	}
+}
+
+// parseCardanoShelleyAddress parses raw shelley address, as specified by the CIP-19
+func parseCardanoShelleyAddress(raw []byte) (*cardanoAddress, error) {
+	if len(raw) < 1+cardanoKeyHashSize {
+		return nil, fmt.Errorf("%w: invalid length %d", errInvalidCardanoAddress, len(raw))
+	}
+
+	addr := &cardanoAddress{
+		addressType: raw[0] >> 4,
+		networkID:   raw[0] & 0x0f,
+	}
+	payload := raw[1:]
+
+	switch addr.addressType {
+	case 0, 1, 2, 3: // base addresses
+		if len(payload) != 2*cardanoKeyHashSize {
+			return nil, fmt.Errorf("%w: invalid base address length %d", errInvalidCardanoAddress, len(raw))
+		}
+
+		copy(addr.paymentHash[:], payload[:cardanoKeyHashSize])
+		copy(addr.stakeHash[:], payload[cardanoKeyHashSize:])
+		addr.isPaymentScript = addr.addressType&0x1 != 0
+		addr.isStakeScript = addr.addressType&0x2 != 0
+	case 4, 5: // pointer addresses
+		if err := validateCardanoStakePointer(payload[cardanoKeyHashSize:]); err != nil {
+			return nil, err
+		}
+
+		copy(addr.paymentHash[:], payload[:cardanoKeyHashSize])
+		addr.isPaymentScript = addr.addressType == 5
+	case 6, 7: // enterprise addresses
+		if len(payload) != cardanoKeyHashSize {
+			return nil, fmt.Errorf("%w: invalid enterprise address length %d", errInvalidCardanoAddress, len(raw))
+		}
+
+		copy(addr.paymentHash[:], payload)
+		addr.isPaymentScript = addr.addressType == 7
+	case 14, 15: // reward addresses
+		if len(payload) != cardanoKeyHashSize {
+			return nil, fmt.Errorf("%w: invalid reward address length %d", errInvalidCardanoAddress, len(raw))
+		}
+
+		copy(addr.stakeHash[:], payload)
+		addr.isStakeScript = addr.addressType == 15
+	default:
+		return nil, fmt.Errorf("%w: unsupported address type %d", errInvalidCardanoAddress, addr.addressType)
+	}
+
+	return addr, nil
+}
+
+// validateCardanoStakePointer checks that the pointer consists of exactly three variable length naturals
+// (slot, transaction index and certificate index)
+func validateCardanoStakePointer(pointer []byte) error {
+	for i := 0; i < 3; i++ {
+		size := 0
+
+		for {
+			if size >= len(pointer) || size >= 9 {
+				return fmt.Errorf("%w: invalid stake pointer", errInvalidCardanoAddress)
+			}
+
+			size++
+
+			if pointer[size-1]&0x80 == 0 {
+				break
+			}
+		}
+
+		pointer = pointer[size:]
+	}
+
+	if len(pointer) != 0 {
+		return fmt.Errorf("%w: invalid stake pointer length", errInvalidCardanoAddress)
+	}
+
+	return nil
+}
+
+// parseCardanoByronAddress parses base58 encoded byron address,
+// which is a cbor array of the tagged payload and its crc32 checksum
+func parseCardanoByronAddress(rawAddress string) (*cardanoAddress, error) {
+	raw, err := base58.Decode(rawAddress)
+	if err != nil {
+		return nil, errors.Join(errInvalidCardanoAddress, err)
+	}
+
+	return parseCardanoByronAddressBytes(raw)
+}
+
+// parseCardanoByronAddressBytes parses raw byron address
+func parseCardanoByronAddressBytes(raw []byte) (*cardanoAddress, error) {
+	var address struct {
+		_        struct{} `cbor:",toarray"`
+		Payload  cbor.RawTag
+		Checksum uint32
+	}
+
+	if err := cbor.Unmarshal(raw, &address); err != nil {
+		return nil, errors.Join(errInvalidCardanoAddress, err)
+	}
+
+	if address.Payload.Number != cardanoByronPayloadTag {
+		return nil, fmt.Errorf("%w: invalid byron payload tag %d", errInvalidCardanoAddress, address.Payload.Number)
+	}
+
+	var payload []byte
+	if err := cbor.Unmarshal(address.Payload.Content, &payload); err != nil {
+		return nil, errors.Join(errInvalidCardanoAddress, err)
+	}
+
+	if crc32.ChecksumIEEE(payload) != address.Checksum {
+		return nil, fmt.Errorf("%w: invalid byron address checksum", errInvalidCardanoAddress)
+	}
+
+	var content struct {
+		_          struct{} `cbor:",toarray"`
+		Root       []byte
+		Attributes map[uint64]cbor.RawMessage
+		Type       uint64
+	}
+
+	if err := cbor.Unmarshal(payload, &content); err != nil {
+		return nil, errors.Join(errInvalidCardanoAddress, err)
+	}
+
+	if len(content.Root) != cardanoKeyHashSize {
+		return nil, fmt.Errorf("%w: invalid byron address root length %d", errInvalidCardanoAddress, len(content.Root))
+	}
+
+	addr := &cardanoAddress{
+		addressType: cardanoByronAddressType,
+		networkID:   cardanoMainnetNetworkID,
+	}
+
+	if _, exists := content.Attributes[cardanoByronProtocolMagicAttribute]; exists {
+		addr.networkID = cardanoTestnetNetworkID
+	}
+
+	copy(addr.paymentHash[:], content.Root)
+
+	return addr, nil
+}
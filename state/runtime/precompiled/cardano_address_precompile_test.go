@@ -0,0 +1,364 @@
+package precompiled
+
+import (
+	"hash/crc32"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+	cardanowallet "github.com/Ethernal-Tech/cardano-infrastructure/wallet"
+	"github.com/Ethernal-Tech/ethgo/abi"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/require"
+)
+
+// test vectors are taken from the CIP-19 (cardano addresses) specification
+const (
+	testCardanoPaymentKeyHash = "9493315cd92eb5d8c4304e67b7e16ae36d61d34502694657811a2c8e"
+	testCardanoStakeKeyHash   = "337b62cfff6403a06a3acbc34f8c46003c69fe79a3628cefa9c47251"
+	testCardanoScriptHash     = "c37b1b5dc0669f1d3c61a6fddb2e8fde96be87b881c60bce8e8d542f"
+)
+
+func Test_cardanoAddressPrecompile(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		address  string
+		expected *cardanoAddress
+	}{
+		{
+			name:    "base address with payment key and stake key",
+			address: "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x",
+			expected: &cardanoAddress{
+				networkID:   cardanoMainnetNetworkID,
+				addressType: 0,
+				paymentHash: decodeTestCardanoHash(t, testCardanoPaymentKeyHash),
+				stakeHash:   decodeTestCardanoHash(t, testCardanoStakeKeyHash),
+			},
+		},
+		{
+			name:    "base address with script payment and stake key",
+			address: "addr1z8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gten0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs9yc0hh",
+			expected: &cardanoAddress{
+				networkID:       cardanoMainnetNetworkID,
+				addressType:     1,
+				paymentHash:     decodeTestCardanoHash(t, testCardanoScriptHash),
+				isPaymentScript: true,
+				stakeHash:       decodeTestCardanoHash(t, testCardanoStakeKeyHash),
+			},
+		},
+		{
+			name:    "base address with payment key and script stake",
+			address: "addr1yx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerkr0vd4msrxnuwnccdxlhdjar77j6lg0wypcc9uar5d2shs2z78ve",
+			expected: &cardanoAddress{
+				networkID:     cardanoMainnetNetworkID,
+				addressType:   2,
+				paymentHash:   decodeTestCardanoHash(t, testCardanoPaymentKeyHash),
+				stakeHash:     decodeTestCardanoHash(t, testCardanoScriptHash),
+				isStakeScript: true,
+			},
+		},
+		{
+			name:    "base address with script payment and script stake",
+			address: "addr1x8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gt7r0vd4msrxnuwnccdxlhdjar77j6lg0wypcc9uar5d2shskhj42g",
+			expected: &cardanoAddress{
+				networkID:       cardanoMainnetNetworkID,
+				addressType:     3,
+				paymentHash:     decodeTestCardanoHash(t, testCardanoScriptHash),
+				isPaymentScript: true,
+				stakeHash:       decodeTestCardanoHash(t, testCardanoScriptHash),
+				isStakeScript:   true,
+			},
+		},
+		{
+			name:    "pointer address with payment key",
+			address: "addr1gx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer5pnz75xxcrzqf96k",
+			expected: &cardanoAddress{
+				networkID:   cardanoMainnetNetworkID,
+				addressType: 4,
+				paymentHash: decodeTestCardanoHash(t, testCardanoPaymentKeyHash),
+			},
+		},
+		{
+			name:    "pointer address with script payment",
+			address: "addr128phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtupnz75xxcrtw79hu",
+			expected: &cardanoAddress{
+				networkID:       cardanoMainnetNetworkID,
+				addressType:     5,
+				paymentHash:     decodeTestCardanoHash(t, testCardanoScriptHash),
+				isPaymentScript: true,
+			},
+		},
+		{
+			name:    "enterprise address with payment key",
+			address: "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8",
+			expected: &cardanoAddress{
+				networkID:   cardanoMainnetNetworkID,
+				addressType: 6,
+				paymentHash: decodeTestCardanoHash(t, testCardanoPaymentKeyHash),
+			},
+		},
+		{
+			name:    "enterprise address with script payment",
+			address: "addr1w8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wx",
+			expected: &cardanoAddress{
+				networkID:       cardanoMainnetNetworkID,
+				addressType:     7,
+				paymentHash:     decodeTestCardanoHash(t, testCardanoScriptHash),
+				isPaymentScript: true,
+			},
+		},
+		{
+			name:    "testnet enterprise address",
+			address: "addr_test1vz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerspjrlsz",
+			expected: &cardanoAddress{
+				networkID:   cardanoTestnetNetworkID,
+				addressType: 6,
+				paymentHash: decodeTestCardanoHash(t, testCardanoPaymentKeyHash),
+			},
+		},
+		{
+			name:    "reward address with stake key",
+			address: "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw",
+			expected: &cardanoAddress{
+				networkID:   cardanoMainnetNetworkID,
+				addressType: 14,
+				stakeHash:   decodeTestCardanoHash(t, testCardanoStakeKeyHash),
+			},
+		},
+		{
+			name:    "testnet reward address with script stake",
+			address: "stake_test17rphkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcljw6kf",
+			expected: &cardanoAddress{
+				networkID:     cardanoTestnetNetworkID,
+				addressType:   15,
+				stakeHash:     decodeTestCardanoHash(t, testCardanoScriptHash),
+				isStakeScript: true,
+			},
+		},
+		{
+			name:    "byron mainnet address",
+			address: "Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAi",
+			expected: &cardanoAddress{
+				networkID:   cardanoMainnetNetworkID,
+				addressType: cardanoByronAddressType,
+				paymentHash: decodeTestCardanoHash(t, "ba970ad36654d8dd8f74274b733452ddeab9a62a397746be3c42ccdd"),
+			},
+		},
+		{
+			name: "byron mainnet address with derivation path",
+			address: "DdzFFzCqrhsw3prhfMFDNFowbzUku3QmrMwarfjUbWXRisodn97R436SHc1rimp4MhPNmbdYb1aTdqtGSJixMVMi5MkArDQ" +
+				"J6Sc1n3Ez",
+			expected: &cardanoAddress{
+				networkID:   cardanoMainnetNetworkID,
+				addressType: cardanoByronAddressType,
+				paymentHash: decodeTestCardanoHash(t, "83ff43ed8337e0b719c5c2fc4ec75de4c70aa4865c0b269fb29bb9f6"),
+			},
+		},
+		{
+			name: "byron testnet address",
+			address: "37btjrVyb4KDXBNC4haBVPCrro8AQPHwvCMp3RFhhSVWwfFmZ6wwzSK6JK1hY6wHNmtrpTf1kdbva8TCneM2YsiXT7mrzT21Ea" +
+				"cHnPpz5YyUdj64na",
+			expected: &cardanoAddress{
+				networkID:   cardanoTestnetNetworkID,
+				addressType: cardanoByronAddressType,
+				paymentHash: decodeTestCardanoHash(t, "7e9ee4a9527dea9091e2d580edd6716888c42f75d96276290f98fe0b"),
+			},
+		},
+		{
+			name:    "byron testnet address with protocol magic",
+			address: createTestCardanoByronAddress(t, decodeTestCardanoHash(t, testCardanoPaymentKeyHash), true, false),
+			expected: &cardanoAddress{
+				networkID:   cardanoTestnetNetworkID,
+				addressType: cardanoByronAddressType,
+				paymentHash: decodeTestCardanoHash(t, testCardanoPaymentKeyHash),
+			},
+		},
+		{
+			name:    "invalid bech32 checksum",
+			address: "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl9",
+		},
+		{
+			name:    "network not matching the prefix",
+			address: createTestCardanoShelleyAddress(t, "addr", 0x60, hex.MustDecodeHex(testCardanoPaymentKeyHash)),
+		},
+		{
+			name:    "address type not matching the prefix",
+			address: createTestCardanoShelleyAddress(t, "stake", 0x61, hex.MustDecodeHex(testCardanoPaymentKeyHash)),
+		},
+		{
+			name:    "unknown network",
+			address: createTestCardanoShelleyAddress(t, "addr", 0x62, hex.MustDecodeHex(testCardanoPaymentKeyHash)),
+		},
+		{
+			name:    "unsupported address type",
+			address: createTestCardanoShelleyAddress(t, "addr", 0x91, hex.MustDecodeHex(testCardanoPaymentKeyHash)),
+		},
+		{
+			name:    "invalid enterprise address length",
+			address: createTestCardanoShelleyAddress(t, "addr", 0x61, hex.MustDecodeHex(testCardanoPaymentKeyHash)[1:]),
+		},
+		{
+			name: "invalid stake pointer",
+			address: createTestCardanoShelleyAddress(t, "addr", 0x41,
+				append(hex.MustDecodeHex(testCardanoPaymentKeyHash), 0x81, 0x01)),
+		},
+		{
+			name:    "byron address with invalid checksum",
+			address: createTestCardanoByronAddress(t, decodeTestCardanoHash(t, testCardanoPaymentKeyHash), false, true),
+		},
+		{
+			name:    "random string",
+			address: "not a cardano address",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			requireCardanoAddressPrecompileResult(t, c.address, c.expected)
+		})
+	}
+}
+
+func Test_cardanoAddressPrecompile_WalletAddresses(t *testing.T) {
+	t.Parallel()
+
+	wallet, err := cardanowallet.GenerateWallet(true)
+	require.NoError(t, err)
+
+	paymentKeyHash, err := cardanowallet.GetKeyHash(wallet.VerificationKey)
+	require.NoError(t, err)
+
+	stakeKeyHash, err := cardanowallet.GetKeyHash(wallet.StakeVerificationKey)
+	require.NoError(t, err)
+
+	networks := map[cardanowallet.CardanoNetworkType]uint8{
+		cardanowallet.MainNetNetwork: cardanoMainnetNetworkID,
+		cardanowallet.TestNetNetwork: cardanoTestnetNetworkID,
+	}
+
+	for network, networkID := range networks {
+		enterpriseAddress, err := cardanowallet.NewEnterpriseAddress(network, wallet.VerificationKey)
+		require.NoError(t, err)
+
+		requireCardanoAddressPrecompileResult(t, enterpriseAddress.String(), &cardanoAddress{
+			networkID:   networkID,
+			addressType: 6,
+			paymentHash: decodeTestCardanoHash(t, paymentKeyHash),
+		})
+
+		baseAddress, err := cardanowallet.NewBaseAddress(network, wallet.VerificationKey, wallet.StakeVerificationKey)
+		require.NoError(t, err)
+
+		requireCardanoAddressPrecompileResult(t, baseAddress.String(), &cardanoAddress{
+			networkID:   networkID,
+			addressType: 0,
+			paymentHash: decodeTestCardanoHash(t, paymentKeyHash),
+			stakeHash:   decodeTestCardanoHash(t, stakeKeyHash),
+		})
+
+		policyScriptAddress, err := cardanowallet.NewPolicyScriptAddress(network, testCardanoScriptHash)
+		require.NoError(t, err)
+
+		requireCardanoAddressPrecompileResult(t, policyScriptAddress.String(), &cardanoAddress{
+			networkID:       networkID,
+			addressType:     7,
+			paymentHash:     decodeTestCardanoHash(t, testCardanoScriptHash),
+			isPaymentScript: true,
+		})
+	}
+}
+
+func Test_cardanoAddressPrecompile_InvalidInput(t *testing.T) {
+	t.Parallel()
+
+	_, err := (&cardanoAddressPrecompile{}).run([]byte{1, 2, 3}, types.ZeroAddress, nil)
+	require.Error(t, err)
+}
+
+// requireCardanoAddressPrecompileResult runs the precompile for the given address and checks its output.
+// If expected is nil, the address is expected to be invalid
+func requireCardanoAddressPrecompileResult(t *testing.T, address string, expected *cardanoAddress) {
+	t.Helper()
+
+	input, err := cardanoAddressPrecompileInputABIType.Encode([]interface{}{address})
+	require.NoError(t, err)
+
+	output, err := (&cardanoAddressPrecompile{}).run(input, types.ZeroAddress, nil)
+	require.NoError(t, err)
+
+	rawResult, err := abi.Decode(cardanoAddressPrecompileOutputABIType, output)
+	require.NoError(t, err)
+
+	result := rawResult.(map[string]interface{}) //nolint: forcetypeassert
+
+	if expected == nil {
+		require.False(t, result["isValid"].(bool), address) //nolint: forcetypeassert
+
+		return
+	}
+
+	require.Equal(t, map[string]interface{}{
+		"isValid":         true,
+		"networkId":       expected.networkID,
+		"addressType":     expected.addressType,
+		"paymentHash":     expected.paymentHash,
+		"isPaymentScript": expected.isPaymentScript,
+		"stakeHash":       expected.stakeHash,
+		"isStakeScript":   expected.isStakeScript,
+	}, result, address)
+}
+
+func decodeTestCardanoHash(t *testing.T, raw string) (hash [cardanoKeyHashSize]byte) {
+	t.Helper()
+
+	copy(hash[:], hex.MustDecodeHex(raw))
+
+	return hash
+}
+
+// createTestCardanoShelleyAddress creates bech32 encoded shelley address with the given header and payload
+func createTestCardanoShelleyAddress(t *testing.T, hrp string, header byte, payload []byte) string {
+	t.Helper()
+
+	data, err := bech32.ConvertBits(append([]byte{header}, payload...), 8, 5, true)
+	require.NoError(t, err)
+
+	address, err := bech32.Encode(hrp, data)
+	require.NoError(t, err)
+
+	return address
+}
+
+// createTestCardanoByronAddress creates base58 encoded byron address with the given root
+func createTestCardanoByronAddress(t *testing.T, root [cardanoKeyHashSize]byte, testnet, invalidChecksum bool) string {
+	t.Helper()
+
+	attributes := map[uint64][]byte{}
+
+	if testnet {
+		protocolMagic, err := cbor.Marshal(uint32(1097911063))
+		require.NoError(t, err)
+
+		attributes[cardanoByronProtocolMagicAttribute] = protocolMagic
+	}
+
+	payload, err := cbor.Marshal([]interface{}{root[:], attributes, 0})
+	require.NoError(t, err)
+
+	checksum := crc32.ChecksumIEEE(payload)
+	if invalidChecksum {
+		checksum++
+	}
+
+	raw, err := cbor.Marshal([]interface{}{cbor.Tag{Number: cardanoByronPayloadTag, Content: payload}, checksum})
+	require.NoError(t, err)
+
+	return base58.Encode(raw)
+}
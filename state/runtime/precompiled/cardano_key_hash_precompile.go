@@ -0,0 +1,60 @@
+package precompiled
+
+import (
+	"errors"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/Ethernal-Tech/ethgo/abi"
+	"golang.org/x/crypto/blake2b"
+)
+
+// cardanoKeyHashSize is the size of the cardano key and script hashes (blake2b-224)
+const cardanoKeyHashSize = 28
+
+var (
+	cardanoKeyHashPrecompileInputABIType  = abi.MustNewType("tuple(bytes)")
+	cardanoKeyHashPrecompileOutputABIType = abi.MustNewType("bytes28")
+)
+
+// cardanoKeyHashPrecompile is a concrete implementation of the contract interface.
+// It calculates the cardano key hash (blake2b-224) of the given verification key
+type cardanoKeyHashPrecompile struct {
+}
+
+// gas returns the gas required to execute the pre-compiled contract
+func (c *cardanoKeyHashPrecompile) gas(input []byte, _ *chain.ForksInTime) uint64 {
+	return baseGasCalc(input, 100, 12)
+}
+
+// Run runs the precompiled contract with the given input.
+// keyHash(bytes verificationKey):
+// Output could be an error or ABI encoded "bytes28" value
+func (c *cardanoKeyHashPrecompile) run(input []byte, _ types.Address, _ runtime.Host) ([]byte, error) {
+	rawData, err := abi.Decode(cardanoKeyHashPrecompileInputABIType, input)
+	if err != nil {
+		return nil, errors.Join(runtime.ErrInvalidInputData, err)
+	}
+
+	data := rawData.(map[string]interface{}) //nolint: forcetypeassert
+	key := data["0"].([]byte)                //nolint: forcetypeassert
+
+	return cardanoKeyHashPrecompileOutputABIType.Encode(cardanoKeyHash(key))
+}
+
+// cardanoKeyHash returns blake2b-224 hash of the given data
+func cardanoKeyHash(data ...[]byte) [cardanoKeyHashSize]byte {
+	// error is returned only for the invalid size or too long key
+	hasher, _ := blake2b.New(cardanoKeyHashSize, nil)
+
+	for _, d := range data {
+		hasher.Write(d)
+	}
+
+	var hash [cardanoKeyHashSize]byte
+
+	copy(hash[:], hasher.Sum(nil))
+
+	return hash
+}
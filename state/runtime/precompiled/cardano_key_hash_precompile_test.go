@@ -0,0 +1,76 @@
+package precompiled
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	cardanowallet "github.com/Ethernal-Tech/cardano-infrastructure/wallet"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_cardanoKeyHashPrecompile(t *testing.T) {
+	t.Parallel()
+
+	// verification keys from the CIP-19 test vectors
+	cases := []struct {
+		verificationKey string
+		expectedHash    string
+	}{
+		{
+			verificationKey: "addr_vk1w0l2sr2zgfm26ztc6nl9xy8ghsk5sh6ldwemlpmp9xylzy4dtf7st80zhd",
+			expectedHash:    testCardanoPaymentKeyHash,
+		},
+		{
+			verificationKey: "stake_vk1px4j0r2fk7ux5p23shz8f3y5y2qam7s954rgf3lg5merqcj6aetsft99wu",
+			expectedHash:    testCardanoStakeKeyHash,
+		},
+	}
+
+	prec := &cardanoKeyHashPrecompile{}
+
+	for _, c := range cases {
+		_, data, err := bech32.Decode(c.verificationKey)
+		require.NoError(t, err)
+
+		key, err := bech32.ConvertBits(data, 5, 8, false)
+		require.NoError(t, err)
+
+		input, err := cardanoKeyHashPrecompileInputABIType.Encode([]interface{}{key})
+		require.NoError(t, err)
+
+		output, err := prec.run(input, types.ZeroAddress, nil)
+		require.NoError(t, err)
+
+		expected, err := cardanoKeyHashPrecompileOutputABIType.Encode(decodeTestCardanoHash(t, c.expectedHash))
+		require.NoError(t, err)
+		require.Equal(t, expected, output)
+	}
+
+	_, err := prec.run([]byte{1, 2, 3}, types.ZeroAddress, nil)
+	require.Error(t, err)
+}
+
+func Test_cardanoKeyHashPrecompile_WalletKeys(t *testing.T) {
+	t.Parallel()
+
+	wallet, err := cardanowallet.GenerateWallet(true)
+	require.NoError(t, err)
+
+	prec := &cardanoKeyHashPrecompile{}
+
+	for _, key := range [][]byte{wallet.VerificationKey, wallet.StakeVerificationKey} {
+		keyHash, err := cardanowallet.GetKeyHash(key)
+		require.NoError(t, err)
+
+		input, err := cardanoKeyHashPrecompileInputABIType.Encode([]interface{}{key})
+		require.NoError(t, err)
+
+		output, err := prec.run(input, types.ZeroAddress, nil)
+		require.NoError(t, err)
+
+		expected, err := cardanoKeyHashPrecompileOutputABIType.Encode(decodeTestCardanoHash(t, keyHash))
+		require.NoError(t, err)
+		require.Equal(t, expected, output)
+	}
+}
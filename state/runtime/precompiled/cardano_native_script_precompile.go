@@ -0,0 +1,271 @@
+package precompiled
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/Ethernal-Tech/ethgo/abi"
+	"github.com/fxamacker/cbor/v2"
+)
+
+const (
+	// cardanoNativeScriptMaxDepth is the maximum nesting depth of the native script
+	cardanoNativeScriptMaxDepth = 16
+
+	// cardanoNativeScriptWitnessGas is the gas required to verify a single witness signature
+	cardanoNativeScriptWitnessGas = 50_000
+)
+
+// cardanoNativeScriptType is the type of the cardano native script, as defined in the ledger cddl
+type cardanoNativeScriptType uint64
+
+const (
+	cardanoNativeScriptSig              cardanoNativeScriptType = 0
+	cardanoNativeScriptAll              cardanoNativeScriptType = 1
+	cardanoNativeScriptAny              cardanoNativeScriptType = 2
+	cardanoNativeScriptAtLeast          cardanoNativeScriptType = 3
+	cardanoNativeScriptInvalidBefore    cardanoNativeScriptType = 4
+	cardanoNativeScriptInvalidHereafter cardanoNativeScriptType = 5
+)
+
+var (
+	cardanoNativeScriptPrecompileInputABIType = abi.MustNewType(
+		"tuple(bytes script, bytes32 txHash, bytes[] witnesses, uint64 validFrom, uint64 validTo)")
+	cardanoNativeScriptPrecompileOutputABIType = abi.MustNewType("tuple(bool isValid, bytes28 scriptHash)")
+
+	errInvalidCardanoNativeScript = errors.New("invalid cardano native script")
+)
+
+// cardanoNativeScript is the parsed cardano native script
+type cardanoNativeScript struct {
+	scriptType cardanoNativeScriptType
+	keyHash    [cardanoKeyHashSize]byte
+	required   uint64
+	slot       uint64
+	scripts    []*cardanoNativeScript
+}
+
+// cardanoNativeScriptPrecompile is a concrete implementation of the contract interface.
+// It evaluates the cbor encoded cardano native (multisig and timelock) script
+// against the transaction witnesses and the transaction validity interval
+type cardanoNativeScriptPrecompile struct {
+}
+
+// gas returns the gas required to execute the pre-compiled contract
+func (c *cardanoNativeScriptPrecompile) gas(input []byte, _ *chain.ForksInTime) uint64 {
+	gas := baseGasCalc(input, 10_000, 60)
+
+	// each witness signature is verified, so it is charged separately
+	if rawData, err := abi.Decode(cardanoNativeScriptPrecompileInputABIType, input); err == nil {
+		witnesses := rawData.(map[string]interface{})["witnesses"].([][]byte) //nolint: forcetypeassert
+		gas += uint64(len(witnesses)) * cardanoNativeScriptWitnessGas
+	}
+
+	return gas
+}
+
+// Run runs the precompiled contract with the given input.
+// evaluate(bytes script, bytes32 txHash, bytes[] witnesses, uint64 validFrom, uint64 validTo):
+// Output could be an error or ABI encoded "(bool isValid, bytes28 scriptHash)" value.
+// Witnesses are cbor encoded vkey witnesses ([verificationKey, signature]) of the transaction,
+// and only the ones with valid signature of the transaction hash are considered.
+// validFrom and validTo are transaction validity interval slots, zero value means that the bound is not set
+func (c *cardanoNativeScriptPrecompile) run(input []byte, _ types.Address, _ runtime.Host) ([]byte, error) {
+	rawData, err := abi.Decode(cardanoNativeScriptPrecompileInputABIType, input)
+	if err != nil {
+		return nil, errors.Join(runtime.ErrInvalidInputData, err)
+	}
+
+	data := rawData.(map[string]interface{})  //nolint: forcetypeassert
+	rawScript := data["script"].([]byte)      //nolint: forcetypeassert
+	txHash := data["txHash"].([32]byte)       //nolint: forcetypeassert
+	witnesses := data["witnesses"].([][]byte) //nolint: forcetypeassert
+	validFrom := data["validFrom"].(uint64)   //nolint: forcetypeassert
+	validTo := data["validTo"].(uint64)       //nolint: forcetypeassert
+
+	script, err := parseCardanoNativeScript(rawScript, 0)
+	if err != nil {
+		return nil, errors.Join(runtime.ErrInvalidInputData, err)
+	}
+
+	signers := make(map[[cardanoKeyHashSize]byte]struct{}, len(witnesses))
+
+	for _, witness := range witnesses {
+		verificationKey, ok := verifyCardanoVKeyWitness(txHash[:], witness)
+		if ok {
+			signers[cardanoKeyHash(verificationKey)] = struct{}{}
+		}
+	}
+
+	return cardanoNativeScriptPrecompileOutputABIType.Encode(map[string]interface{}{
+		"isValid": script.evaluate(signers, validFrom, validTo),
+		// native script hash is prefixed with the native script language tag (zero)
+		"scriptHash": cardanoKeyHash([]byte{0}, rawScript),
+	})
+}
+
+// verifyCardanoVKeyWitness verifies the signature of the cbor encoded vkey witness
+// and returns its verification key, if the signature is valid
+func verifyCardanoVKeyWitness(message []byte, rawWitness []byte) ([]byte, bool) {
+	var witness struct {
+		_               struct{} `cbor:",toarray"`
+		VerificationKey []byte
+		Signature       []byte
+	}
+
+	if err := cbor.Unmarshal(rawWitness, &witness); err != nil ||
+		len(witness.VerificationKey) != ed25519.PublicKeySize || len(witness.Signature) != ed25519.SignatureSize {
+		return nil, false
+	}
+
+	return witness.VerificationKey, ed25519.Verify(witness.VerificationKey, message, witness.Signature)
+}
+
+// parseCardanoNativeScript parses cbor encoded native script
+func parseCardanoNativeScript(raw []byte, depth int) (*cardanoNativeScript, error) {
+	if depth > cardanoNativeScriptMaxDepth {
+		return nil, fmt.Errorf("%w: max depth %d exceeded", errInvalidCardanoNativeScript, cardanoNativeScriptMaxDepth)
+	}
+
+	var items []cbor.RawMessage
+	if err := cbor.Unmarshal(raw, &items); err != nil {
+		return nil, errors.Join(errInvalidCardanoNativeScript, err)
+	}
+
+	if len(items) < 2 {
+		return nil, fmt.Errorf("%w: invalid number of items %d", errInvalidCardanoNativeScript, len(items))
+	}
+
+	script := &cardanoNativeScript{}
+	if err := cbor.Unmarshal(items[0], &script.scriptType); err != nil {
+		return nil, errors.Join(errInvalidCardanoNativeScript, err)
+	}
+
+	expectedItems := 2
+
+	switch script.scriptType {
+	case cardanoNativeScriptSig:
+		var keyHash []byte
+		if err := cbor.Unmarshal(items[1], &keyHash); err != nil {
+			return nil, errors.Join(errInvalidCardanoNativeScript, err)
+		}
+
+		if len(keyHash) != cardanoKeyHashSize {
+			return nil, fmt.Errorf("%w: invalid key hash length %d", errInvalidCardanoNativeScript, len(keyHash))
+		}
+
+		copy(script.keyHash[:], keyHash)
+	case cardanoNativeScriptAll, cardanoNativeScriptAny:
+		scripts, err := parseCardanoNativeScripts(items[1], depth)
+		if err != nil {
+			return nil, err
+		}
+
+		script.scripts = scripts
+	case cardanoNativeScriptAtLeast:
+		expectedItems = 3
+
+		if len(items) != expectedItems {
+			break
+		}
+
+		if err := cbor.Unmarshal(items[1], &script.required); err != nil {
+			return nil, errors.Join(errInvalidCardanoNativeScript, err)
+		}
+
+		scripts, err := parseCardanoNativeScripts(items[2], depth)
+		if err != nil {
+			return nil, err
+		}
+
+		script.scripts = scripts
+	case cardanoNativeScriptInvalidBefore, cardanoNativeScriptInvalidHereafter:
+		if err := cbor.Unmarshal(items[1], &script.slot); err != nil {
+			return nil, errors.Join(errInvalidCardanoNativeScript, err)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown script type %d", errInvalidCardanoNativeScript, script.scriptType)
+	}
+
+	if len(items) != expectedItems {
+		return nil, fmt.Errorf("%w: invalid number of items %d for script type %d",
+			errInvalidCardanoNativeScript, len(items), script.scriptType)
+	}
+
+	return script, nil
+}
+
+// parseCardanoNativeScripts parses cbor encoded array of the nested native scripts
+func parseCardanoNativeScripts(raw []byte, depth int) ([]*cardanoNativeScript, error) {
+	var items []cbor.RawMessage
+	if err := cbor.Unmarshal(raw, &items); err != nil {
+		return nil, errors.Join(errInvalidCardanoNativeScript, err)
+	}
+
+	scripts := make([]*cardanoNativeScript, len(items))
+
+	for i, item := range items {
+		script, err := parseCardanoNativeScript(item, depth+1)
+		if err != nil {
+			return nil, err
+		}
+
+		scripts[i] = script
+	}
+
+	return scripts, nil
+}
+
+// evaluate evaluates the native script against the key hashes of the transaction signers
+// and the transaction validity interval, following the ledger rules
+func (s *cardanoNativeScript) evaluate(
+	signers map[[cardanoKeyHashSize]byte]struct{}, validFrom, validTo uint64) bool {
+	switch s.scriptType {
+	case cardanoNativeScriptSig:
+		_, exists := signers[s.keyHash]
+
+		return exists
+	case cardanoNativeScriptAll:
+		for _, script := range s.scripts {
+			if !script.evaluate(signers, validFrom, validTo) {
+				return false
+			}
+		}
+
+		return true
+	case cardanoNativeScriptAny:
+		for _, script := range s.scripts {
+			if script.evaluate(signers, validFrom, validTo) {
+				return true
+			}
+		}
+
+		return false
+	case cardanoNativeScriptAtLeast:
+		valid := uint64(0)
+
+		for _, script := range s.scripts {
+			if valid >= s.required {
+				break
+			}
+
+			if script.evaluate(signers, validFrom, validTo) {
+				valid++
+			}
+		}
+
+		return valid >= s.required
+	case cardanoNativeScriptInvalidBefore:
+		// transaction must not be valid before the script slot
+		return validFrom != 0 && s.slot <= validFrom
+	case cardanoNativeScriptInvalidHereafter:
+		// transaction must not be valid after the script slot
+		return validTo != 0 && validTo <= s.slot
+	default:
+		return false
+	}
+}
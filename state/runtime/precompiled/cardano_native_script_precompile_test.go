@@ -0,0 +1,235 @@
+package precompiled
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/Ethernal-Tech/ethgo/abi"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_cardanoNativeScriptPrecompile(t *testing.T) {
+	t.Parallel()
+
+	txHash := types.StringToHash("0x1234")
+	keys := make([]ed25519.PrivateKey, 3)
+	sigScripts := make([]interface{}, len(keys))
+
+	for i := range keys {
+		keys[i] = ed25519.NewKeyFromSeed(types.StringToHash(string(rune('a' + i))).Bytes())
+		keyHash := cardanoKeyHash(keys[i].Public().(ed25519.PublicKey)) //nolint: forcetypeassert
+		sigScripts[i] = []interface{}{cardanoNativeScriptSig, keyHash[:]}
+	}
+
+	witness := func(key ed25519.PrivateKey, message []byte) []byte {
+		raw, err := cbor.Marshal([]interface{}{key.Public(), ed25519.Sign(key, message)})
+		require.NoError(t, err)
+
+		return raw
+	}
+
+	cases := []struct {
+		name      string
+		script    interface{}
+		witnesses [][]byte
+		validFrom uint64
+		validTo   uint64
+		expected  bool
+	}{
+		{
+			name:      "sig with valid witness",
+			script:    sigScripts[0],
+			witnesses: [][]byte{witness(keys[0], txHash[:])},
+			expected:  true,
+		},
+		{
+			name:      "sig with other key witness",
+			script:    sigScripts[0],
+			witnesses: [][]byte{witness(keys[1], txHash[:])},
+		},
+		{
+			name:      "sig with witness of other transaction",
+			script:    sigScripts[0],
+			witnesses: [][]byte{witness(keys[0], types.StringToHash("0x1").Bytes())},
+		},
+		{
+			name:      "sig with malformed witness",
+			script:    sigScripts[0],
+			witnesses: [][]byte{{0x1, 0x2}},
+		},
+		{
+			name:      "all with all witnesses",
+			script:    []interface{}{cardanoNativeScriptAll, sigScripts},
+			witnesses: [][]byte{witness(keys[0], txHash[:]), witness(keys[1], txHash[:]), witness(keys[2], txHash[:])},
+			expected:  true,
+		},
+		{
+			name:      "all with missing witness",
+			script:    []interface{}{cardanoNativeScriptAll, sigScripts},
+			witnesses: [][]byte{witness(keys[0], txHash[:]), witness(keys[2], txHash[:])},
+		},
+		{
+			name:      "any with single witness",
+			script:    []interface{}{cardanoNativeScriptAny, sigScripts},
+			witnesses: [][]byte{witness(keys[2], txHash[:])},
+			expected:  true,
+		},
+		{
+			name:   "any without witnesses",
+			script: []interface{}{cardanoNativeScriptAny, sigScripts},
+		},
+		{
+			name:      "at least reached",
+			script:    []interface{}{cardanoNativeScriptAtLeast, 2, sigScripts},
+			witnesses: [][]byte{witness(keys[0], txHash[:]), witness(keys[2], txHash[:])},
+			expected:  true,
+		},
+		{
+			name:      "at least not reached because of duplicated witness",
+			script:    []interface{}{cardanoNativeScriptAtLeast, 2, sigScripts},
+			witnesses: [][]byte{witness(keys[1], txHash[:]), witness(keys[1], txHash[:])},
+		},
+		{
+			name: "timelocked multisig within validity interval",
+			script: []interface{}{cardanoNativeScriptAll, []interface{}{
+				[]interface{}{cardanoNativeScriptInvalidBefore, 100},
+				[]interface{}{cardanoNativeScriptInvalidHereafter, 200},
+				[]interface{}{cardanoNativeScriptAtLeast, 1, sigScripts},
+			}},
+			witnesses: [][]byte{witness(keys[1], txHash[:])},
+			validFrom: 100,
+			validTo:   200,
+			expected:  true,
+		},
+		{
+			name:      "invalid before with earlier validity start",
+			script:    []interface{}{cardanoNativeScriptInvalidBefore, 100},
+			validFrom: 99,
+		},
+		{
+			name:   "invalid before without validity start",
+			script: []interface{}{cardanoNativeScriptInvalidBefore, 100},
+		},
+		{
+			name:    "invalid hereafter with later validity end",
+			script:  []interface{}{cardanoNativeScriptInvalidHereafter, 200},
+			validTo: 201,
+		},
+		{
+			name:   "invalid hereafter without validity end",
+			script: []interface{}{cardanoNativeScriptInvalidHereafter, 200},
+		},
+	}
+
+	prec := &cardanoNativeScriptPrecompile{}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			rawScript, err := cbor.Marshal(c.script)
+			require.NoError(t, err)
+
+			output, err := prec.run(
+				encodeCardanoNativeScript(t, rawScript, txHash, c.witnesses, c.validFrom, c.validTo),
+				types.ZeroAddress, nil)
+			require.NoError(t, err)
+
+			result, err := abi.Decode(cardanoNativeScriptPrecompileOutputABIType, output)
+			require.NoError(t, err)
+			require.Equal(t, map[string]interface{}{
+				"isValid":    c.expected,
+				"scriptHash": cardanoKeyHash(append([]byte{0}, rawScript...)),
+			}, result)
+		})
+	}
+}
+
+func Test_cardanoNativeScriptPrecompile_InvalidInput(t *testing.T) {
+	t.Parallel()
+
+	keyHash := decodeTestCardanoHash(t, testCardanoPaymentKeyHash)
+
+	deepScript := interface{}([]interface{}{cardanoNativeScriptSig, keyHash[:]})
+	for i := 0; i <= cardanoNativeScriptMaxDepth; i++ {
+		deepScript = []interface{}{cardanoNativeScriptAll, []interface{}{deepScript}}
+	}
+
+	scripts := []interface{}{
+		[]interface{}{cardanoNativeScriptSig, keyHash[:10]},
+		[]interface{}{cardanoNativeScriptSig, keyHash[:], 1},
+		[]interface{}{cardanoNativeScriptAtLeast, []interface{}{}},
+		[]interface{}{6, 1},
+		[]interface{}{cardanoNativeScriptAll},
+		"not a script",
+		deepScript,
+	}
+
+	prec := &cardanoNativeScriptPrecompile{}
+
+	for _, script := range scripts {
+		rawScript, err := cbor.Marshal(script)
+		require.NoError(t, err)
+
+		_, err = prec.run(encodeCardanoNativeScript(t, rawScript, types.ZeroHash, nil, 0, 0), types.ZeroAddress, nil)
+		require.ErrorIs(t, err, runtime.ErrInvalidInputData)
+	}
+
+	_, err := prec.run([]byte{1, 2, 3}, types.ZeroAddress, nil)
+	require.ErrorIs(t, err, runtime.ErrInvalidInputData)
+}
+
+func Test_cardanoNativeScriptPrecompile_Gas(t *testing.T) {
+	t.Parallel()
+
+	prec := &cardanoNativeScriptPrecompile{}
+	input := encodeCardanoNativeScript(t, []byte{0x1}, types.ZeroHash, nil, 0, 0)
+	inputWithWitnesses := encodeCardanoNativeScript(t, []byte{0x1}, types.ZeroHash, [][]byte{{0x1}, {0x2}}, 0, 0)
+
+	require.Equal(t, baseGasCalc(input, 10_000, 60), prec.gas(input, nil))
+	require.Equal(t, baseGasCalc(inputWithWitnesses, 10_000, 60)+2*cardanoNativeScriptWitnessGas,
+		prec.gas(inputWithWitnesses, nil))
+}
+
+func Test_cardanoPrecompiles_CanRun(t *testing.T) {
+	t.Parallel()
+
+	p := NewPrecompiled()
+
+	for _, addr := range []types.Address{
+		contracts.CardanoAddressPrecompile,
+		contracts.CardanoKeyHashPrecompile,
+		contracts.CardanoNativeScriptPrecompile,
+	} {
+		contract := &runtime.Contract{CodeAddress: addr}
+
+		require.False(t, p.CanRun(contract, nil, &chain.ForksInTime{}))
+		require.True(t, p.CanRun(contract, nil, &chain.ForksInTime{CardanoPrecompiles: true}))
+	}
+}
+
+func encodeCardanoNativeScript(t *testing.T, script []byte, txHash types.Hash,
+	witnesses [][]byte, validFrom, validTo uint64) []byte {
+	t.Helper()
+
+	if witnesses == nil {
+		witnesses = [][]byte{}
+	}
+
+	encoded, err := cardanoNativeScriptPrecompileInputABIType.Encode(map[string]interface{}{
+		"script":    script,
+		"txHash":    txHash,
+		"witnesses": witnesses,
+		"validFrom": validFrom,
+		"validTo":   validTo,
+	})
+	require.NoError(t, err)
+
+	return encoded
+}
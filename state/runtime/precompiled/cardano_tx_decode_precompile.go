@@ -0,0 +1,426 @@
+package precompiled
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/Ethernal-Tech/ethgo/abi"
+	"github.com/fxamacker/cbor/v2"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// cardanoTxMaxSize is the maximum size of the raw cardano transaction (max tx size protocol parameter)
+	cardanoTxMaxSize = 16_384
+	// cardanoTxMaxItems is the maximum number of the items of any cbor array or map in the cardano transaction
+	cardanoTxMaxItems = 1_024
+	// cardanoTxMaxNestedLevels is the maximum nesting level of the cbor items in the cardano transaction
+	cardanoTxMaxNestedLevels = 16
+
+	// cardanoSetTag is the cbor tag of the sets (introduced in the conway era)
+	cardanoSetTag = uint64(258)
+	// cardanoAuxiliaryDataTag is the cbor tag of the alonzo (and later) auxiliary data
+	cardanoAuxiliaryDataTag = uint64(259)
+
+	// cardano transaction body keys, as defined in the ledger cddl
+	cardanoTxBodyInputs        = uint64(0)
+	cardanoTxBodyOutputs       = uint64(1)
+	cardanoTxBodyFee           = uint64(2)
+	cardanoTxBodyTTL           = uint64(3)
+	cardanoTxBodyValidityStart = uint64(8)
+
+	// cardano post-alonzo transaction output keys, as defined in the ledger cddl
+	cardanoTxOutputAddress = uint64(0)
+	cardanoTxOutputAmount  = uint64(1)
+)
+
+var (
+	cardanoTxDecodePrecompileInputABIType  = abi.MustNewType("tuple(bytes)")
+	cardanoTxDecodePrecompileOutputABIType = abi.MustNewType(
+		"tuple(bytes32 txHash, tuple(bytes32 txHash, uint64 index)[] inputs, " +
+			"tuple(string addr, uint64 amount, tuple(bytes28 policyId, bytes name, uint64 amount)[] tokens)[] outputs, " +
+			"uint64 fee, uint64 ttl, uint64 validityStart, tuple(uint64 label, bytes value)[] metadata)")
+
+	// cardanoTxDecMode is the cbor decoding mode which enforces the limits of the cardano transaction
+	cardanoTxDecMode = func() cbor.DecMode {
+		decMode, err := cbor.DecOptions{
+			DupMapKey:        cbor.DupMapKeyEnforcedAPF,
+			MaxNestedLevels:  cardanoTxMaxNestedLevels,
+			MaxArrayElements: cardanoTxMaxItems,
+			MaxMapPairs:      cardanoTxMaxItems,
+		}.DecMode()
+		if err != nil {
+			panic(err)
+		}
+
+		return decMode
+	}()
+
+	errInvalidCardanoTx = errors.New("invalid cardano transaction")
+)
+
+// cardanoTxInput is the decoded input of the cardano transaction
+type cardanoTxInput struct {
+	TxHash types.Hash `abi:"txHash"`
+	Index  uint64     `abi:"index"`
+}
+
+// cardanoTxToken is the decoded native token amount of the cardano transaction output
+type cardanoTxToken struct {
+	PolicyID [cardanoKeyHashSize]byte `abi:"policyId"`
+	Name     []byte                   `abi:"name"`
+	Amount   uint64                   `abi:"amount"`
+}
+
+// cardanoTxOutput is the decoded output of the cardano transaction
+type cardanoTxOutput struct {
+	Address string            `abi:"addr"`
+	Amount  uint64            `abi:"amount"`
+	Tokens  []*cardanoTxToken `abi:"tokens"`
+}
+
+// cardanoTxMetadata is the metadata of the cardano transaction, with the cbor encoded value
+type cardanoTxMetadata struct {
+	Label uint64 `abi:"label"`
+	Value []byte `abi:"value"`
+}
+
+// cardanoTx is the decoded cardano transaction
+type cardanoTx struct {
+	TxHash        types.Hash           `abi:"txHash"`
+	Inputs        []*cardanoTxInput    `abi:"inputs"`
+	Outputs       []*cardanoTxOutput   `abi:"outputs"`
+	Fee           uint64               `abi:"fee"`
+	TTL           uint64               `abi:"ttl"`
+	ValidityStart uint64               `abi:"validityStart"`
+	Metadata      []*cardanoTxMetadata `abi:"metadata"`
+}
+
+// cardanoTxDecodePrecompile is a concrete implementation of the contract interface.
+// It decodes the raw cbor cardano transaction into its ABI encoded structured view
+type cardanoTxDecodePrecompile struct {
+}
+
+// gas returns the gas required to execute the pre-compiled contract
+func (c *cardanoTxDecodePrecompile) gas(input []byte, _ *chain.ForksInTime) uint64 {
+	return baseGasCalc(input, 10_000, 300)
+}
+
+// Run runs the precompiled contract with the given input.
+// decodeTx(bytes rawTx):
+// Output could be an error or ABI encoded
+// "(bytes32 txHash, (bytes32 txHash, uint64 index)[] inputs,
+// (string addr, uint64 amount, (bytes28 policyId, bytes name, uint64 amount)[] tokens)[] outputs,
+// uint64 fee, uint64 ttl, uint64 validityStart, (uint64 label, bytes value)[] metadata)" value.
+// ttl and validityStart are zero if they are not set, metadata values are cbor encoded metadatums
+func (c *cardanoTxDecodePrecompile) run(input []byte, _ types.Address, _ runtime.Host) ([]byte, error) {
+	rawData, err := abi.Decode(cardanoTxDecodePrecompileInputABIType, input)
+	if err != nil {
+		return nil, errors.Join(runtime.ErrInvalidInputData, err)
+	}
+
+	data := rawData.(map[string]interface{}) //nolint: forcetypeassert
+	rawTx := data["0"].([]byte)              //nolint: forcetypeassert
+
+	tx, err := decodeCardanoTx(rawTx)
+	if err != nil {
+		return nil, errors.Join(runtime.ErrInvalidInputData, err)
+	}
+
+	return cardanoTxDecodePrecompileOutputABIType.Encode(tx)
+}
+
+// decodeCardanoTx decodes the raw cardano transaction
+// ([body, witness set, is valid, auxiliary data] or [body, witness set, auxiliary data] before the alonzo era)
+func decodeCardanoTx(rawTx []byte) (*cardanoTx, error) {
+	if len(rawTx) > cardanoTxMaxSize {
+		return nil, fmt.Errorf("%w: size %d exceeds the limit %d", errInvalidCardanoTx, len(rawTx), cardanoTxMaxSize)
+	}
+
+	var items []cbor.RawMessage
+	if err := cardanoTxDecMode.Unmarshal(rawTx, &items); err != nil {
+		return nil, errors.Join(errInvalidCardanoTx, err)
+	}
+
+	if len(items) != 3 && len(items) != 4 {
+		return nil, fmt.Errorf("%w: invalid number of items %d", errInvalidCardanoTx, len(items))
+	}
+
+	var body map[uint64]cbor.RawMessage
+	if err := cardanoTxDecMode.Unmarshal(items[0], &body); err != nil {
+		return nil, errors.Join(errInvalidCardanoTx, err)
+	}
+
+	// transaction hash is the hash of the body, exactly as it is encoded in the transaction
+	tx := &cardanoTx{TxHash: blake2b.Sum256(items[0])}
+
+	inputs, err := decodeCardanoTxInputs(body[cardanoTxBodyInputs])
+	if err != nil {
+		return nil, err
+	}
+
+	outputs, err := decodeCardanoTxOutputs(body[cardanoTxBodyOutputs])
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Inputs, tx.Outputs = inputs, outputs
+
+	for _, field := range []struct {
+		key   uint64
+		value *uint64
+	}{
+		{key: cardanoTxBodyFee, value: &tx.Fee},
+		{key: cardanoTxBodyTTL, value: &tx.TTL},
+		{key: cardanoTxBodyValidityStart, value: &tx.ValidityStart},
+	} {
+		raw, exists := body[field.key]
+		if !exists {
+			continue
+		}
+
+		if err := cardanoTxDecMode.Unmarshal(raw, field.value); err != nil {
+			return nil, errors.Join(errInvalidCardanoTx, err)
+		}
+	}
+
+	metadata, err := decodeCardanoTxMetadata(items[len(items)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Metadata = metadata
+
+	return tx, nil
+}
+
+// decodeCardanoTxInputs decodes the set of the transaction inputs ([tx hash, index])
+func decodeCardanoTxInputs(raw cbor.RawMessage) ([]*cardanoTxInput, error) {
+	var items []struct {
+		_      struct{} `cbor:",toarray"`
+		TxHash []byte
+		Index  uint64
+	}
+
+	if err := unmarshalCardanoSet(raw, &items); err != nil {
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: no inputs", errInvalidCardanoTx)
+	}
+
+	inputs := make([]*cardanoTxInput, len(items))
+
+	for i, item := range items {
+		if len(item.TxHash) != types.HashLength {
+			return nil, fmt.Errorf("%w: invalid input hash length %d", errInvalidCardanoTx, len(item.TxHash))
+		}
+
+		inputs[i] = &cardanoTxInput{TxHash: types.BytesToHash(item.TxHash), Index: item.Index}
+	}
+
+	return inputs, nil
+}
+
+// decodeCardanoTxOutputs decodes the transaction outputs, which are either legacy
+// ([address, amount, ?datum hash]) or post-alonzo ({0: address, 1: amount, ...}) outputs
+func decodeCardanoTxOutputs(raw cbor.RawMessage) ([]*cardanoTxOutput, error) {
+	var items []cbor.RawMessage
+	if err := cardanoTxDecMode.Unmarshal(raw, &items); err != nil {
+		return nil, errors.Join(errInvalidCardanoTx, err)
+	}
+
+	outputs := make([]*cardanoTxOutput, len(items))
+
+	for i, item := range items {
+		var rawAddress []byte
+
+		var rawAmount cbor.RawMessage
+
+		if isCardanoCborArray(item) {
+			var fields []cbor.RawMessage
+			if err := cardanoTxDecMode.Unmarshal(item, &fields); err != nil {
+				return nil, errors.Join(errInvalidCardanoTx, err)
+			}
+
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("%w: invalid output %d", errInvalidCardanoTx, i)
+			}
+
+			rawAmount = fields[1]
+
+			if err := cardanoTxDecMode.Unmarshal(fields[0], &rawAddress); err != nil {
+				return nil, errors.Join(errInvalidCardanoTx, err)
+			}
+		} else {
+			var fields map[uint64]cbor.RawMessage
+			if err := cardanoTxDecMode.Unmarshal(item, &fields); err != nil {
+				return nil, errors.Join(errInvalidCardanoTx, err)
+			}
+
+			rawAmount = fields[cardanoTxOutputAmount]
+
+			if err := cardanoTxDecMode.Unmarshal(fields[cardanoTxOutputAddress], &rawAddress); err != nil {
+				return nil, errors.Join(errInvalidCardanoTx, err)
+			}
+		}
+
+		address, err := encodeCardanoAddress(rawAddress)
+		if err != nil {
+			return nil, errors.Join(errInvalidCardanoTx, err)
+		}
+
+		output, err := decodeCardanoTxAmount(rawAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		output.Address = address
+		outputs[i] = output
+	}
+
+	return outputs, nil
+}
+
+// decodeCardanoTxAmount decodes the output amount, which is either lovelace amount
+// or [lovelace amount, {policy id: {asset name: amount}}]
+func decodeCardanoTxAmount(raw cbor.RawMessage) (*cardanoTxOutput, error) {
+	output := &cardanoTxOutput{Tokens: []*cardanoTxToken{}}
+
+	if !isCardanoCborArray(raw) {
+		if err := cardanoTxDecMode.Unmarshal(raw, &output.Amount); err != nil {
+			return nil, errors.Join(errInvalidCardanoTx, err)
+		}
+
+		return output, nil
+	}
+
+	var value struct {
+		_          struct{} `cbor:",toarray"`
+		Amount     uint64
+		MultiAsset map[cbor.ByteString]map[cbor.ByteString]uint64
+	}
+
+	if err := cardanoTxDecMode.Unmarshal(raw, &value); err != nil {
+		return nil, errors.Join(errInvalidCardanoTx, err)
+	}
+
+	output.Amount = value.Amount
+
+	for policyID, assets := range value.MultiAsset {
+		if len(policyID) != cardanoKeyHashSize {
+			return nil, fmt.Errorf("%w: invalid policy id length %d", errInvalidCardanoTx, len(policyID))
+		}
+
+		for name, amount := range assets {
+			token := &cardanoTxToken{Name: []byte(name), Amount: amount}
+			copy(token.PolicyID[:], policyID)
+
+			output.Tokens = append(output.Tokens, token)
+		}
+	}
+
+	// multi asset maps are decoded in random order, so tokens are sorted to be deterministic
+	sort.Slice(output.Tokens, func(i, j int) bool {
+		if cmp := bytes.Compare(output.Tokens[i].PolicyID[:], output.Tokens[j].PolicyID[:]); cmp != 0 {
+			return cmp < 0
+		}
+
+		return bytes.Compare(output.Tokens[i].Name, output.Tokens[j].Name) < 0
+	})
+
+	return output, nil
+}
+
+// decodeCardanoTxMetadata decodes the metadata of the transaction auxiliary data, which is either
+// shelley metadata map, shelley-ma [metadata, scripts] or alonzo tagged {0: metadata, ...} auxiliary data
+func decodeCardanoTxMetadata(raw cbor.RawMessage) ([]*cardanoTxMetadata, error) {
+	var auxiliaryData interface{}
+	if err := cardanoTxDecMode.Unmarshal(raw, &auxiliaryData); err != nil {
+		return nil, errors.Join(errInvalidCardanoTx, err)
+	}
+
+	rawMetadata := raw
+
+	switch {
+	case auxiliaryData == nil:
+		return []*cardanoTxMetadata{}, nil
+	case isCardanoCborArray(raw):
+		var items []cbor.RawMessage
+		if err := cardanoTxDecMode.Unmarshal(raw, &items); err != nil || len(items) == 0 {
+			return nil, fmt.Errorf("%w: invalid auxiliary data", errInvalidCardanoTx)
+		}
+
+		rawMetadata = items[0]
+	case isCardanoCborTag(raw):
+		var tag cbor.RawTag
+		if err := cardanoTxDecMode.Unmarshal(raw, &tag); err != nil || tag.Number != cardanoAuxiliaryDataTag {
+			return nil, fmt.Errorf("%w: invalid auxiliary data", errInvalidCardanoTx)
+		}
+
+		var fields map[uint64]cbor.RawMessage
+		if err := cardanoTxDecMode.Unmarshal(tag.Content, &fields); err != nil {
+			return nil, errors.Join(errInvalidCardanoTx, err)
+		}
+
+		rawMetadata = fields[0]
+		if rawMetadata == nil {
+			return []*cardanoTxMetadata{}, nil
+		}
+	}
+
+	var metadataMap map[uint64]cbor.RawMessage
+	if err := cardanoTxDecMode.Unmarshal(rawMetadata, &metadataMap); err != nil {
+		return nil, errors.Join(errInvalidCardanoTx, err)
+	}
+
+	metadata := make([]*cardanoTxMetadata, 0, len(metadataMap))
+
+	for label, value := range metadataMap {
+		metadata = append(metadata, &cardanoTxMetadata{Label: label, Value: value})
+	}
+
+	sort.Slice(metadata, func(i, j int) bool {
+		return metadata[i].Label < metadata[j].Label
+	})
+
+	return metadata, nil
+}
+
+// unmarshalCardanoSet decodes the cbor array, which can be tagged as a set since the conway era
+func unmarshalCardanoSet(raw cbor.RawMessage, out interface{}) error {
+	if isCardanoCborTag(raw) {
+		var tag cbor.RawTag
+		if err := cardanoTxDecMode.Unmarshal(raw, &tag); err != nil {
+			return errors.Join(errInvalidCardanoTx, err)
+		}
+
+		if tag.Number != cardanoSetTag {
+			return fmt.Errorf("%w: invalid set tag %d", errInvalidCardanoTx, tag.Number)
+		}
+
+		raw = tag.Content
+	}
+
+	if err := cardanoTxDecMode.Unmarshal(raw, out); err != nil {
+		return errors.Join(errInvalidCardanoTx, err)
+	}
+
+	return nil
+}
+
+// isCardanoCborArray returns true if the raw cbor item is an array
+func isCardanoCborArray(raw cbor.RawMessage) bool {
+	return len(raw) > 0 && raw[0]>>5 == 4
+}
+
+// isCardanoCborTag returns true if the raw cbor item is a tagged item
+func isCardanoCborTag(raw cbor.RawMessage) bool {
+	return len(raw) > 0 && raw[0]>>5 == 6
+}
@@ -0,0 +1,320 @@
+package precompiled
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/Ethernal-Tech/ethgo/abi"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+)
+
+func Test_cardanoTxDecodePrecompile(t *testing.T) {
+	t.Parallel()
+
+	const (
+		enterpriseAddress = "addr_test1vz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerspjrlsz"
+		baseAddress       = "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x"
+		byronAddress      = "Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAi"
+	)
+
+	inputHash := types.StringToHash("0xabcd")
+	policyIDs := [][]byte{
+		hex.MustDecodeHex(testCardanoScriptHash),
+		hex.MustDecodeHex(testCardanoPaymentKeyHash),
+	}
+	rawBridgingMetadata := mustMarshalCbor(t, map[string]interface{}{"t": "bridge", "d": "prime"})
+	bridgingMetadata := map[uint64]interface{}{1: cbor.RawMessage(rawBridgingMetadata)}
+
+	expectedOutputs := []*cardanoTxOutput{
+		{Address: enterpriseAddress, Amount: 1_000_000, Tokens: []*cardanoTxToken{}},
+		{
+			Address: baseAddress,
+			Amount:  2_000_000,
+			Tokens: []*cardanoTxToken{
+				{PolicyID: decodeTestCardanoHash(t, testCardanoPaymentKeyHash), Name: []byte("a"), Amount: 10},
+				{PolicyID: decodeTestCardanoHash(t, testCardanoScriptHash), Name: []byte("a"), Amount: 30},
+				{PolicyID: decodeTestCardanoHash(t, testCardanoScriptHash), Name: []byte("b"), Amount: 20},
+			},
+		},
+		{Address: byronAddress, Amount: 3_000_000, Tokens: []*cardanoTxToken{}},
+	}
+
+	outputs := []interface{}{
+		// legacy output with datum hash
+		[]interface{}{decodeTestCardanoAddress(t, enterpriseAddress), 1_000_000, types.StringToHash("0x1").Bytes()},
+		// post-alonzo output with native tokens
+		map[uint64]interface{}{
+			cardanoTxOutputAddress: decodeTestCardanoAddress(t, baseAddress),
+			cardanoTxOutputAmount: []interface{}{2_000_000, map[cbor.ByteString]map[cbor.ByteString]uint64{
+				cbor.ByteString(policyIDs[0]): {"b": 20, "a": 30},
+				cbor.ByteString(policyIDs[1]): {"a": 10},
+			}},
+		},
+		[]interface{}{decodeTestCardanoAddress(t, byronAddress), 3_000_000},
+	}
+
+	cases := []struct {
+		name          string
+		inputs        interface{}
+		ttl           uint64
+		validityStart uint64
+		items         []interface{}
+		metadata      []*cardanoTxMetadata
+	}{
+		{
+			name:     "shelley transaction with metadata",
+			inputs:   []interface{}{[]interface{}{inputHash[:], 1}},
+			ttl:      1000,
+			items:    []interface{}{map[uint64]interface{}{}, bridgingMetadata},
+			metadata: []*cardanoTxMetadata{{Label: 1, Value: rawBridgingMetadata}},
+		},
+		{
+			name:          "shelley-ma transaction with metadata and scripts",
+			inputs:        []interface{}{[]interface{}{inputHash[:], 1}},
+			validityStart: 500,
+			items:         []interface{}{map[uint64]interface{}{}, []interface{}{bridgingMetadata, []interface{}{}}},
+			metadata:      []*cardanoTxMetadata{{Label: 1, Value: rawBridgingMetadata}},
+		},
+		{
+			name:   "alonzo transaction with auxiliary data",
+			inputs: []interface{}{[]interface{}{inputHash[:], 1}},
+			ttl:    1000,
+			items: []interface{}{map[uint64]interface{}{}, true,
+				cbor.Tag{Number: cardanoAuxiliaryDataTag, Content: map[uint64]interface{}{0: bridgingMetadata}}},
+			metadata: []*cardanoTxMetadata{{Label: 1, Value: rawBridgingMetadata}},
+		},
+		{
+			name:          "conway transaction with tagged inputs and without metadata",
+			inputs:        cbor.Tag{Number: cardanoSetTag, Content: []interface{}{[]interface{}{inputHash[:], 1}}},
+			ttl:           1000,
+			validityStart: 500,
+			items:         []interface{}{map[uint64]interface{}{}, true, nil},
+			metadata:      []*cardanoTxMetadata{},
+		},
+	}
+
+	prec := &cardanoTxDecodePrecompile{}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			body := map[uint64]interface{}{
+				cardanoTxBodyInputs:  c.inputs,
+				cardanoTxBodyOutputs: outputs,
+				cardanoTxBodyFee:     uint64(170_000),
+			}
+
+			if c.ttl != 0 {
+				body[cardanoTxBodyTTL] = c.ttl
+			}
+
+			if c.validityStart != 0 {
+				body[cardanoTxBodyValidityStart] = c.validityStart
+			}
+
+			rawBody, err := cbor.Marshal(body)
+			require.NoError(t, err)
+
+			input, err := cardanoTxDecodePrecompileInputABIType.Encode(
+				[]interface{}{createTestCardanoTx(t, rawBody, c.items...)})
+			require.NoError(t, err)
+
+			output, err := prec.run(input, types.ZeroAddress, nil)
+			require.NoError(t, err)
+
+			expected, err := cardanoTxDecodePrecompileOutputABIType.Encode(&cardanoTx{
+				TxHash:        blake2b.Sum256(rawBody),
+				Inputs:        []*cardanoTxInput{{TxHash: inputHash, Index: 1}},
+				Outputs:       expectedOutputs,
+				Fee:           170_000,
+				TTL:           c.ttl,
+				ValidityStart: c.validityStart,
+				Metadata:      c.metadata,
+			})
+			require.NoError(t, err)
+			require.Equal(t, expected, output)
+
+			_, err = abi.Decode(cardanoTxDecodePrecompileOutputABIType, output)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_cardanoTxDecodePrecompile_InvalidInput(t *testing.T) {
+	t.Parallel()
+
+	validOutput := []interface{}{decodeTestCardanoAddress(t,
+		"addr_test1vz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerspjrlsz"), 1_000_000}
+	validInputs := []interface{}{[]interface{}{types.StringToHash("0x1").Bytes(), 0}}
+
+	bodyWithDuplicatedKeys := []byte{0xa2, 0x02, 0x01, 0x02, 0x02}
+
+	cases := []struct {
+		name  string
+		rawTx func(t *testing.T) []byte
+	}{
+		{
+			name: "not a cbor",
+			rawTx: func(t *testing.T) []byte {
+				t.Helper()
+
+				return []byte{0xff, 0x1}
+			},
+		},
+		{
+			name: "too large transaction",
+			rawTx: func(t *testing.T) []byte {
+				t.Helper()
+
+				return createTestCardanoTx(t, mustMarshalCbor(t, map[uint64]interface{}{
+					cardanoTxBodyInputs:  validInputs,
+					cardanoTxBodyOutputs: []interface{}{validOutput},
+					cardanoTxBodyFee:     1,
+					// auxiliary data hash is not validated, so it is used as a padding
+					7: bytes.Repeat([]byte{0x1}, cardanoTxMaxSize),
+				}), map[uint64]interface{}{}, nil)
+			},
+		},
+		{
+			name: "invalid number of items",
+			rawTx: func(t *testing.T) []byte {
+				t.Helper()
+
+				return mustMarshalCbor(t, []interface{}{map[uint64]interface{}{}})
+			},
+		},
+		{
+			name: "duplicated body keys",
+			rawTx: func(t *testing.T) []byte {
+				t.Helper()
+
+				return createTestCardanoTx(t, bodyWithDuplicatedKeys, map[uint64]interface{}{}, nil)
+			},
+		},
+		{
+			name: "without inputs",
+			rawTx: func(t *testing.T) []byte {
+				t.Helper()
+
+				return createTestCardanoTx(t, mustMarshalCbor(t, map[uint64]interface{}{
+					cardanoTxBodyInputs:  []interface{}{},
+					cardanoTxBodyOutputs: []interface{}{validOutput},
+				}), map[uint64]interface{}{}, nil)
+			},
+		},
+		{
+			name: "invalid input hash",
+			rawTx: func(t *testing.T) []byte {
+				t.Helper()
+
+				return createTestCardanoTx(t, mustMarshalCbor(t, map[uint64]interface{}{
+					cardanoTxBodyInputs:  []interface{}{[]interface{}{[]byte{0x1}, 0}},
+					cardanoTxBodyOutputs: []interface{}{validOutput},
+				}), map[uint64]interface{}{}, nil)
+			},
+		},
+		{
+			name: "invalid set tag",
+			rawTx: func(t *testing.T) []byte {
+				t.Helper()
+
+				return createTestCardanoTx(t, mustMarshalCbor(t, map[uint64]interface{}{
+					cardanoTxBodyInputs:  cbor.Tag{Number: 1000, Content: validInputs},
+					cardanoTxBodyOutputs: []interface{}{validOutput},
+				}), map[uint64]interface{}{}, nil)
+			},
+		},
+		{
+			name: "invalid output address",
+			rawTx: func(t *testing.T) []byte {
+				t.Helper()
+
+				return createTestCardanoTx(t, mustMarshalCbor(t, map[uint64]interface{}{
+					cardanoTxBodyInputs:  validInputs,
+					cardanoTxBodyOutputs: []interface{}{[]interface{}{[]byte{0x61, 0x1}, 1}},
+				}), map[uint64]interface{}{}, nil)
+			},
+		},
+		{
+			name: "invalid policy id",
+			rawTx: func(t *testing.T) []byte {
+				t.Helper()
+
+				return createTestCardanoTx(t, mustMarshalCbor(t, map[uint64]interface{}{
+					cardanoTxBodyInputs: validInputs,
+					cardanoTxBodyOutputs: []interface{}{[]interface{}{validOutput[0], []interface{}{1,
+						map[cbor.ByteString]map[cbor.ByteString]uint64{"policy": {"a": 1}}}}},
+				}), map[uint64]interface{}{}, nil)
+			},
+		},
+		{
+			name: "invalid auxiliary data tag",
+			rawTx: func(t *testing.T) []byte {
+				t.Helper()
+
+				return createTestCardanoTx(t, mustMarshalCbor(t, map[uint64]interface{}{
+					cardanoTxBodyInputs:  validInputs,
+					cardanoTxBodyOutputs: []interface{}{validOutput},
+				}), map[uint64]interface{}{}, true, cbor.Tag{Number: 1000, Content: map[uint64]interface{}{}})
+			},
+		},
+	}
+
+	prec := &cardanoTxDecodePrecompile{}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			input, err := cardanoTxDecodePrecompileInputABIType.Encode([]interface{}{c.rawTx(t)})
+			require.NoError(t, err)
+
+			_, err = prec.run(input, types.ZeroAddress, nil)
+			require.ErrorIs(t, err, runtime.ErrInvalidInputData)
+		})
+	}
+}
+
+// createTestCardanoTx creates raw cardano transaction with the given raw body and the rest of the items
+func createTestCardanoTx(t *testing.T, rawBody []byte, items ...interface{}) []byte {
+	t.Helper()
+
+	return mustMarshalCbor(t, append([]interface{}{cbor.RawMessage(rawBody)}, items...))
+}
+
+func mustMarshalCbor(t *testing.T, value interface{}) []byte {
+	t.Helper()
+
+	raw, err := cbor.Marshal(value)
+	require.NoError(t, err)
+
+	return raw
+}
+
+// decodeTestCardanoAddress returns raw bytes of the bech32 or base58 encoded cardano address
+func decodeTestCardanoAddress(t *testing.T, address string) []byte {
+	t.Helper()
+
+	_, data, err := bech32.DecodeNoLimit(address)
+	if err != nil {
+		raw, err := base58.Decode(address)
+		require.NoError(t, err)
+
+		return raw
+	}
+
+	raw, err := bech32.ConvertBits(data, 5, 8, false)
+	require.NoError(t, err)
+
+	return raw
+}
@@ -19,8 +19,12 @@ type cardanoVerifySignaturePrecompile struct {
 }
 
 // gas returns the gas required to execute the pre-compiled contract
-func (c *cardanoVerifySignaturePrecompile) gas(_ []byte, config *chain.ForksInTime) uint64 {
-	return 50_000
+func (c *cardanoVerifySignaturePrecompile) gas(input []byte, config *chain.ForksInTime) uint64 {
+	if config.PrecompileGasSchedule {
+		return baseGasCalc(input, cardanoVerifySignatureBaseGas, signatureVerificationPerWordGas)
+	}
+
+	return cardanoVerifySignatureLegacyGas
 }
 
 // Run runs the precompiled contract with the given input.
@@ -0,0 +1,183 @@
+package precompiled
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/bls"
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bitmap"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/Ethernal-Tech/ethgo/abi"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// precompileGasCalibrationEnv is the environment variable which enables the gas calibration harness
+	precompileGasCalibrationEnv = "PRECOMPILE_GAS_CALIBRATION"
+
+	// ecrecoverGas is the gas of the ecrecover precompile, which is the reference of the calibration
+	ecrecoverGas = 3000
+)
+
+// gasCalibrationCase is a precompile benchmarked for the different sizes of the input,
+// where the size is the number of the public keys (signers) or the number of the input words
+type gasCalibrationCase struct {
+	name       string
+	precompile contract
+	sizes      []int
+	input      func(t *testing.T, size int) []byte
+}
+
+// TestPrecompileGasCalibration benchmarks the signature verification precompiles and prints the calibration table,
+// used to derive the values of the gas schedule. Execution time is converted to gas by the ecrecover precompile,
+// and base and per unit costs are fitted by the least squares method. Run it with:
+// PRECOMPILE_GAS_CALIBRATION=1 go test -run TestPrecompileGasCalibration -v ./state/runtime/precompiled/
+func TestPrecompileGasCalibration(t *testing.T) {
+	if os.Getenv(precompileGasCalibrationEnv) == "" {
+		t.Skipf("gas calibration is enabled by the %s environment variable", precompileGasCalibrationEnv)
+	}
+
+	ecrecoverInput := hex.MustDecodeHex("38d18acb67d25c8bb9942764b62f18e17054f66a817bd4295423adf9ed98873e" +
+		"000000000000000000000000000000000000000000000000000000000000001b" +
+		"38d18acb67d25c8bb9942764b62f18e17054f66a817bd4295423adf9ed98873e" +
+		"789d1dd423d25f0772d2748d60f7e4b81bb14d086eba8e8e8efb6dcff8a4ae02")
+	gasPerNs := float64(ecrecoverGas) / benchmarkPrecompile(&ecrecover{&Precompiled{}}, ecrecoverInput)
+
+	table := &strings.Builder{}
+	fmt.Fprintf(table, "reference: ecrecover %d gas, %.4f gas/ns\n", ecrecoverGas, gasPerNs)
+	fmt.Fprintf(table, "%-28s %8s %12s %12s %12s %8s\n", "precompile", "size", "ns/op", "measured", "scheduled", "ratio")
+
+	for _, c := range gasCalibrationCases(t) {
+		measured := make([]float64, len(c.sizes))
+
+		for i, size := range c.sizes {
+			input := c.input(t, size)
+			measured[i] = benchmarkPrecompile(c.precompile, input) * gasPerNs
+			scheduled := c.precompile.gas(input, &chain.ForksInTime{PrecompileGasSchedule: true})
+
+			fmt.Fprintf(table, "%-28s %8d %12.0f %12.0f %12d %8.2f\n",
+				c.name, size, measured[i]/gasPerNs, measured[i], scheduled, float64(scheduled)/measured[i])
+		}
+
+		base, perUnit := fitLinear(c.sizes, measured)
+		fmt.Fprintf(table, "%-28s fitted base gas %.0f, per unit gas %.1f\n", c.name, base, perUnit)
+	}
+
+	t.Log("\n" + table.String())
+}
+
+// gasCalibrationCases returns the calibrated precompiles
+func gasCalibrationCases(t *testing.T) []*gasCalibrationCase {
+	t.Helper()
+
+	message := crypto.Keccak256([]byte("gas calibration"))
+	domain := crypto.Keccak256([]byte("gas calibration domain"))
+
+	return []*gasCalibrationCase{
+		{
+			name:       "blsAggSignsVerification",
+			precompile: &blsAggSignsVerification{},
+			sizes:      []int{1, 10, 50, 100, 200},
+			input: func(t *testing.T, keys int) []byte {
+				t.Helper()
+
+				publicKeys, signatures := generatePubKeysAndSignature(t, keys, message)
+
+				return generateInput(t, message, publicKeys, signatures)
+			},
+		},
+		{
+			name:       "apexBLSSignatureVerification",
+			precompile: &apexBLSSignatureVerification{domain: domain},
+			sizes:      []int{1, 10, 50, 100, 200},
+			input: func(t *testing.T, signers int) []byte {
+				t.Helper()
+
+				validators, err := bls.CreateRandomBlsKeys(signers)
+				require.NoError(t, err)
+
+				publicKeys := make([][4]*big.Int, signers)
+				signatures := make(bls.Signatures, signers)
+				bmp := bitmap.Bitmap{}
+
+				for i, validator := range validators {
+					signatures[i], err = validator.Sign(message, domain)
+					require.NoError(t, err)
+
+					publicKeys[i] = validator.PublicKey().ToBigInt()
+					bmp.Set(uint64(i))
+				}
+
+				signature, err := signatures.Aggregate().Marshal()
+				require.NoError(t, err)
+
+				encoded, err := apexBLSInputDataMultiABIType.Encode(
+					[]interface{}{message, signature, publicKeys, new(big.Int).SetBytes(bmp)})
+				require.NoError(t, err)
+
+				return append([]byte{apexBLSMultiTypeByte}, encoded...)
+			},
+		},
+		{
+			name:       "cardanoVerifySignature",
+			precompile: &cardanoVerifySignaturePrecompile{},
+			sizes:      []int{1, 32, 128, 512},
+			input: func(t *testing.T, words int) []byte {
+				t.Helper()
+
+				key := ed25519.NewKeyFromSeed(types.StringToHash("0x1").Bytes())
+				data := make([]byte, words*32)
+
+				encoded, err := abi.Encode([]interface{}{data, ed25519.Sign(key, data), key.Public(), false},
+					cardanoVerifySignaturePrecompileInputABIType)
+				require.NoError(t, err)
+
+				return encoded
+			},
+		},
+	}
+}
+
+// benchmarkPrecompile returns the average execution time of the precompile in nanoseconds
+func benchmarkPrecompile(precompile contract, input []byte) float64 {
+	result := testing.Benchmark(func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = precompile.run(input, types.ZeroAddress, nil)
+		}
+	})
+
+	return float64(result.T.Nanoseconds()) / float64(result.N)
+}
+
+// fitLinear fits the values to the base + perUnit * size line by the least squares method
+func fitLinear(sizes []int, values []float64) (float64, float64) {
+	var sumX, sumY, sumXY, sumXX float64
+
+	for i, size := range sizes {
+		x := float64(size)
+		sumX += x
+		sumY += values[i]
+		sumXY += x * values[i]
+		sumXX += x * x
+	}
+
+	n := float64(len(sizes))
+	perUnit := (n*sumXY - sumX*sumY) / (n*sumXX - sumX*sumX)
+
+	return (sumY - perUnit*sumX) / n, perUnit
+}
+
+func Test_fitLinear(t *testing.T) {
+	t.Parallel()
+
+	base, perUnit := fitLinear([]int{1, 2, 3, 4}, []float64{12, 14, 16, 18})
+	require.InDelta(t, 10, base, 1e-9)
+	require.InDelta(t, 2, perUnit, 1e-9)
+}
@@ -0,0 +1,79 @@
+package precompiled
+
+import (
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bitmap"
+	"github.com/Ethernal-Tech/ethgo/abi"
+)
+
+// Gas schedule of the signature verification precompiles, enabled by the PrecompileGasSchedule fork.
+// Precompiles are charged per verified signature, per public key and per input word,
+// with the values calibrated by the TestPrecompileGasCalibration harness,
+// which uses ecrecover precompile (3000 gas) as the reference for the gas price of the execution time
+const (
+	// blsAggSignsVerificationBaseGas is the cost of the aggregated signature verification (two pairings)
+	blsAggSignsVerificationBaseGas = 50_000
+	// blsAggSignsVerificationPerKeyGas is the cost of the unmarshalling and aggregating of a public key
+	blsAggSignsVerificationPerKeyGas = 8_000
+
+	// apexBLSVerificationBaseGas is the cost of the aggregated signature verification (two pairings)
+	apexBLSVerificationBaseGas = 50_000
+	// apexBLSVerificationPerSignerGas is the cost of the unmarshalling and aggregating of a signer public key
+	apexBLSVerificationPerSignerGas = 8_000
+
+	// cardanoVerifySignatureBaseGas is the cost of the ed25519 signature verification
+	cardanoVerifySignatureBaseGas = 3_000
+
+	// signatureVerificationPerWordGas is the cost of the decoding, parsing and hashing of an input word
+	signatureVerificationPerWordGas = 12
+)
+
+// legacy flat gas costs of the signature verification precompiles, used before the PrecompileGasSchedule fork
+const (
+	blsAggSignsVerificationLegacyGas = 150_000
+	apexBLSVerificationLegacyGas     = 50_000
+	cardanoVerifySignatureLegacyGas  = 50_000
+)
+
+// blsAggSignsVerificationGas returns the gas of the aggregated signature verification
+// for the number of the public keys in the input
+func blsAggSignsVerificationGas(input []byte) uint64 {
+	keys := 0
+
+	if rawData, err := abi.Decode(inputDataABIType, input); err == nil {
+		blsVerification := rawData.(map[string]interface{})["2"].([]byte) //nolint:forcetypeassert
+
+		if decoded, err := BlsVerificationABIType.Decode(blsVerification); err == nil {
+			keys = len(decoded.(map[string]interface{})["0"].([][]byte)) //nolint:forcetypeassert
+		}
+	}
+
+	return baseGasCalc(input, blsAggSignsVerificationBaseGas, signatureVerificationPerWordGas) +
+		uint64(keys)*blsAggSignsVerificationPerKeyGas
+}
+
+// apexBLSVerificationGas returns the gas of the apex signature verification
+// for the number of the signers (public keys set in the bitmap) in the input
+func apexBLSVerificationGas(input []byte) uint64 {
+	signers := 0
+
+	if len(input) > 0 {
+		if input[0] == apexBLSSingleTypeByte {
+			signers = 1
+		} else if rawData, err := abi.Decode(apexBLSInputDataMultiABIType, input[1:]); err == nil {
+			data := rawData.(map[string]interface{})           //nolint:forcetypeassert
+			keys := data["2"].([][4]*big.Int)                  //nolint:forcetypeassert
+			bmp := bitmap.Bitmap(data["3"].(*big.Int).Bytes()) //nolint:forcetypeassert
+
+			for i := range keys {
+				if bmp.IsSet(uint64(i)) {
+					signers++
+				}
+			}
+		}
+	}
+
+	return baseGasCalc(input, apexBLSVerificationBaseGas, signatureVerificationPerWordGas) +
+		uint64(signers)*apexBLSVerificationPerSignerGas
+}
@@ -0,0 +1,114 @@
+package precompiled
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bitmap"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/Ethernal-Tech/ethgo/abi"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_precompileGasSchedule(t *testing.T) {
+	t.Parallel()
+
+	message := crypto.Keccak256([]byte("gas schedule"))
+	publicKeys, signatures := generatePubKeysAndSignature(t, 10, message)
+	blsAggInput := generateInput(t, message, publicKeys, signatures)
+
+	apexPublicKeys := make([][4]*big.Int, 4)
+	for i := range apexPublicKeys {
+		apexPublicKeys[i] = [4]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4)}
+	}
+
+	bmp := bitmap.Bitmap{}
+	bmp.Set(0)
+	bmp.Set(2)
+	bmp.Set(3)
+	// bits out of the public keys range are not charged
+	bmp.Set(7)
+
+	apexMultiInput, err := apexBLSInputDataMultiABIType.Encode(
+		[]interface{}{message, []byte{0x1}, apexPublicKeys, new(big.Int).SetBytes(bmp)})
+	require.NoError(t, err)
+
+	apexMultiInput = append([]byte{apexBLSMultiTypeByte}, apexMultiInput...)
+
+	apexSingleInput, err := apexBLSInputDataSingleABIType.Encode(
+		[]interface{}{message, []byte{0x1}, apexPublicKeys[0]})
+	require.NoError(t, err)
+
+	apexSingleInput = append([]byte{apexBLSSingleTypeByte}, apexSingleInput...)
+
+	cardanoInput, err := abi.Encode([]interface{}{make([]byte, 1000), []byte{0x1}, [32]byte{}, false},
+		cardanoVerifySignaturePrecompileInputABIType)
+	require.NoError(t, err)
+
+	invalidInput := []byte{0x1, 0x2, 0x3}
+
+	cases := []struct {
+		name        string
+		precompile  contract
+		input       []byte
+		legacyGas   uint64
+		scheduleGas uint64
+	}{
+		{
+			name:       "bls aggregated signatures",
+			precompile: &blsAggSignsVerification{},
+			input:      blsAggInput,
+			legacyGas:  blsAggSignsVerificationLegacyGas,
+			scheduleGas: baseGasCalc(blsAggInput, blsAggSignsVerificationBaseGas, signatureVerificationPerWordGas) +
+				10*blsAggSignsVerificationPerKeyGas,
+		},
+		{
+			name:        "bls aggregated signatures invalid input",
+			precompile:  &blsAggSignsVerification{},
+			input:       invalidInput,
+			legacyGas:   blsAggSignsVerificationLegacyGas,
+			scheduleGas: blsAggSignsVerificationBaseGas + signatureVerificationPerWordGas,
+		},
+		{
+			name:       "apex bls multi",
+			precompile: &apexBLSSignatureVerification{},
+			input:      apexMultiInput,
+			legacyGas:  apexBLSVerificationLegacyGas,
+			scheduleGas: baseGasCalc(apexMultiInput, apexBLSVerificationBaseGas, signatureVerificationPerWordGas) +
+				3*apexBLSVerificationPerSignerGas,
+		},
+		{
+			name:       "apex bls single",
+			precompile: &apexBLSSignatureVerification{},
+			input:      apexSingleInput,
+			legacyGas:  apexBLSVerificationLegacyGas,
+			scheduleGas: baseGasCalc(apexSingleInput, apexBLSVerificationBaseGas, signatureVerificationPerWordGas) +
+				apexBLSVerificationPerSignerGas,
+		},
+		{
+			name:        "apex bls invalid input",
+			precompile:  &apexBLSSignatureVerification{},
+			input:       invalidInput,
+			legacyGas:   apexBLSVerificationLegacyGas,
+			scheduleGas: apexBLSVerificationBaseGas + signatureVerificationPerWordGas,
+		},
+		{
+			name:        "cardano signature",
+			precompile:  &cardanoVerifySignaturePrecompile{},
+			input:       cardanoInput,
+			legacyGas:   cardanoVerifySignatureLegacyGas,
+			scheduleGas: baseGasCalc(cardanoInput, cardanoVerifySignatureBaseGas, signatureVerificationPerWordGas),
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, c.legacyGas, c.precompile.gas(c.input, &chain.ForksInTime{}))
+			require.Equal(t, c.scheduleGas, c.precompile.gas(c.input, &chain.ForksInTime{PrecompileGasSchedule: true}))
+		})
+	}
+}
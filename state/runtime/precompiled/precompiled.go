@@ -84,6 +84,12 @@ func (p *Precompiled) setupContracts() {
 	// CardanoVerifySignature precompile
 	p.register(contracts.CardanoVerifySignaturePrecompile.String(), &cardanoVerifySignaturePrecompile{})
 
+	// Cardano address, key hash, native script and transaction decoding precompiles
+	p.register(contracts.CardanoAddressPrecompile.String(), &cardanoAddressPrecompile{})
+	p.register(contracts.CardanoKeyHashPrecompile.String(), &cardanoKeyHashPrecompile{})
+	p.register(contracts.CardanoNativeScriptPrecompile.String(), &cardanoNativeScriptPrecompile{})
+	p.register(contracts.CardanoTxDecodePrecompile.String(), &cardanoTxDecodePrecompile{})
+
 	// APEX BLS signatures verification precompile
 	p.register(contracts.ApexBLSSignaturesVerificationPrecompile.String(), &apexBLSSignatureVerification{
 		domain: signer.DomainApexBridgeEVM,
@@ -131,6 +137,15 @@ func (p *Precompiled) CanRun(c *runtime.Contract, _ runtime.Host, config *chain.
 		return config.Istanbul
 	}
 
+	// cardano precompiles
+	switch c.CodeAddress {
+	case contracts.CardanoAddressPrecompile,
+		contracts.CardanoKeyHashPrecompile,
+		contracts.CardanoNativeScriptPrecompile,
+		contracts.CardanoTxDecodePrecompile:
+		return config.CardanoPrecompiles
+	}
+
 	return true
 }
 
@@ -61,6 +61,13 @@ type Snapshot interface {
 	readSnapshot
 
 	Commit(objs []*Object) (Snapshot, []byte, error)
+
+	// CommitInMemory returns the snapshot with the given objects applied and its root.
+	// The returned snapshot keeps the changes in memory only, so it can not be committed or forked
+	CommitInMemory(objs []*Object) (Snapshot, []byte, error)
+
+	// Fork returns the snapshot of the same state, which can be read concurrently with this one
+	Fork() (Snapshot, error)
 }
 
 // DumpAccount represents an account in the state.
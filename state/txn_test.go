@@ -55,6 +55,14 @@ func (m *mockSnapshot) Commit(objs []*Object) (Snapshot, []byte, error) {
 	return nil, nil, nil
 }
 
+func (m *mockSnapshot) CommitInMemory(objs []*Object) (Snapshot, []byte, error) {
+	return nil, nil, nil
+}
+
+func (m *mockSnapshot) Fork() (Snapshot, error) {
+	return m, nil
+}
+
 func newStateWithPreState(preState map[types.Address]*PreState) Snapshot {
 	return &mockSnapshot{state: preState}
 }
@@ -0,0 +1,103 @@
+package txrelayer
+
+import (
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// NonceTracker tracks nonces of the accounts which send transactions through the TxRelayer,
+// so that the pending nonce is not fetched from the node on every send and concurrent sends
+// (possibly from different TxRelayer instances sharing the same key) get unique nonces
+type NonceTracker struct {
+	accounts map[types.Address]*accountNonces
+	lock     sync.Mutex
+}
+
+// accountNonces holds the nonce state of a single account
+type accountNonces struct {
+	// synced indicates whether the next nonce is synced with the node pending nonce
+	synced bool
+	// next is the next nonce which has never been reserved
+	next uint64
+
+	lock sync.Mutex
+}
+
+// NewNonceTracker creates a new instance of NonceTracker
+func NewNonceTracker() *NonceTracker {
+	return &NonceTracker{accounts: map[types.Address]*accountNonces{}}
+}
+
+// getAccount returns the nonce state of given account, creating it if it does not exist
+func (n *NonceTracker) getAccount(addr types.Address) *accountNonces {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	account, exists := n.accounts[addr]
+	if !exists {
+		account = &accountNonces{}
+		n.accounts[addr] = account
+	}
+
+	return account
+}
+
+// reserve returns the nonce for the next transaction of given account. If the account is not synced,
+// its pending nonce is fetched by the provided function
+func (n *NonceTracker) reserve(addr types.Address, fetchPendingNonce func() (uint64, error)) (uint64, error) {
+	account := n.getAccount(addr)
+
+	account.lock.Lock()
+	defer account.lock.Unlock()
+
+	if !account.synced {
+		nonce, err := fetchPendingNonce()
+		if err != nil {
+			return 0, err
+		}
+
+		account.next = nonce
+		account.synced = true
+	}
+
+	nonce := account.next
+	account.next++
+
+	return nonce, nil
+}
+
+// release returns the reserved nonce of given account, whose transaction was not sent.
+// The last reserved nonce is reused by the next transaction, while the lower one is not given back,
+// since the transactions with the higher nonces might be already sent. It returns true in the latter case,
+// so that the caller fills the gap left by the released nonce
+func (n *NonceTracker) release(addr types.Address, nonce uint64) bool {
+	account := n.getAccount(addr)
+
+	account.lock.Lock()
+	defer account.lock.Unlock()
+
+	if !account.synced || nonce >= account.next {
+		// account got resynced in the meantime
+		return false
+	}
+
+	if nonce == account.next-1 {
+		account.next--
+
+		return false
+	}
+
+	return true
+}
+
+// resync marks given account as not synced, so that its pending nonce is fetched from the node
+// on the next reservation (e.g. when a nonce is rejected, or a transaction got dropped)
+func (n *NonceTracker) resync(addr types.Address) {
+	account := n.getAccount(addr)
+
+	account.lock.Lock()
+	defer account.lock.Unlock()
+
+	account.synced = false
+}
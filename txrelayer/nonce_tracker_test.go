@@ -0,0 +1,130 @@
+package txrelayer
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestNonceTracker_Reserve(t *testing.T) {
+	t.Parallel()
+
+	var (
+		tracker = NewNonceTracker()
+		addr    = types.StringToAddress("0x1")
+		fetches = 0
+	)
+
+	fetch := func() (uint64, error) {
+		fetches++
+
+		return 5, nil
+	}
+
+	for i := uint64(0); i < 3; i++ {
+		nonce, err := tracker.reserve(addr, fetch)
+		require.NoError(t, err)
+		require.Equal(t, 5+i, nonce)
+	}
+
+	require.Equal(t, 1, fetches)
+
+	// nonce in the middle is not given back, since the higher nonces might be already sent
+	require.True(t, tracker.release(addr, 6))
+
+	nonce, err := tracker.reserve(addr, fetch)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), nonce)
+
+	// last reserved nonces are given back
+	require.False(t, tracker.release(addr, 8))
+	require.False(t, tracker.release(addr, 7))
+
+	nonce, err = tracker.reserve(addr, fetch)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), nonce)
+
+	nonce, err = tracker.reserve(addr, fetch)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), nonce)
+
+	// resync fetches the pending nonce again
+	tracker.resync(addr)
+
+	nonce, err = tracker.reserve(addr, fetch)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), nonce)
+	require.Equal(t, 2, fetches)
+
+	// release after resync is ignored
+	require.False(t, tracker.release(addr, 8))
+
+	nonce, err = tracker.reserve(addr, fetch)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), nonce)
+}
+
+func TestNonceTracker_FetchError(t *testing.T) {
+	t.Parallel()
+
+	var (
+		tracker = NewNonceTracker()
+		addr    = types.StringToAddress("0x1")
+	)
+
+	_, err := tracker.reserve(addr, func() (uint64, error) { return 0, errors.New("unavailable") })
+	require.ErrorContains(t, err, "unavailable")
+
+	nonce, err := tracker.reserve(addr, func() (uint64, error) { return 3, nil })
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), nonce)
+}
+
+func TestNonceTracker_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	const reservations = 100
+
+	var (
+		tracker = NewNonceTracker()
+		addr    = types.StringToAddress("0x1")
+		nonces  = make(chan uint64, reservations)
+		wg      sync.WaitGroup
+	)
+
+	for i := 0; i < reservations; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			nonce, err := tracker.reserve(addr, func() (uint64, error) { return 0, nil })
+			require.NoError(t, err)
+
+			nonces <- nonce
+		}()
+	}
+
+	wg.Wait()
+	close(nonces)
+
+	unique := map[uint64]struct{}{}
+	for nonce := range nonces {
+		unique[nonce] = struct{}{}
+	}
+
+	require.Len(t, unique, reservations)
+}
+
+func TestBumpFee(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, big.NewInt(120), bumpFee(big.NewInt(100), 20))
+	require.Equal(t, big.NewInt(2), bumpFee(big.NewInt(1), 20))
+	require.Equal(t, big.NewInt(1), bumpFee(nil, 20))
+}
@@ -25,11 +25,16 @@ const (
 	feeIncreasePercentage      = 100
 	DefaultTimeoutTransactions = 50 * time.Second
 	DefaultPollFreq            = 1 * time.Second
+	DefaultReplacementTimeout  = 15 * time.Second
+	DefaultFeeBumpPercentage   = 20
+	nonceGapFillerGas          = 21000 // gas of the plain value transfer
 )
 
 var (
 	errNoAccounts     = errors.New("no accounts registered")
 	errMethodNotFound = errors.New("method not found")
+	errNonceTooLow    = errors.New("nonce too low")
+	errClosed         = errors.New("tx relayer is closed")
 
 	// dynamicFeeTxFallbackErrs represents known errors which are the reason to fallback
 	// from sending dynamic fee tx to legacy tx
@@ -48,6 +53,10 @@ type TxRelayer interface {
 	Client() *jsonrpc.EthClient
 	// GetTxnHashes returns hashes of sent transactions
 	GetTxnHashes() []types.Hash
+	// GetReplacedTxnHashes returns hashes of the replaced transactions, mapped to the hashes of their replacements
+	GetReplacedTxnHashes() map[types.Hash]types.Hash
+	// Close stops watching the sent transactions and waits for the background watchers to finish
+	Close()
 }
 
 var _ TxRelayer = (*TxRelayerImpl)(nil)
@@ -62,11 +71,20 @@ type TxRelayerImpl struct {
 	nonceGet            bool
 	collectTxnHashes    bool
 	chainID             *big.Int
+	nonceTracker        *NonceTracker
+	replaceAfter        time.Duration
+	feeBumpPercentage   uint64
 
-	txnHashes []types.Hash
+	txnHashes         []types.Hash
+	replacedTxnHashes map[types.Hash]types.Hash
 
 	lock sync.Mutex
 
+	// closeCh stops the transaction watchers, which are tracked by the wg
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+
 	writer io.Writer
 }
 
@@ -76,11 +94,16 @@ func NewTxRelayer(opts ...TxRelayerOption) (TxRelayer, error) {
 		receiptsPollFreq: DefaultPollFreq,
 		receiptsTimeout:  DefaultTimeoutTransactions,
 		nonceGet:         true,
+		closeCh:          make(chan struct{}),
 	}
 	for _, opt := range opts {
 		opt(t)
 	}
 
+	if t.nonceTracker == nil {
+		t.nonceTracker = NewNonceTracker()
+	}
+
 	// Calculate receiptsPollFreq based on receiptsTimeout
 	if t.receiptsTimeout >= time.Minute {
 		t.receiptsPollFreq = 2 * time.Second
@@ -106,9 +129,37 @@ func NewTxRelayer(opts ...TxRelayerOption) (TxRelayer, error) {
 
 // GetTxnHashes returns hashes of sent transactions
 func (t *TxRelayerImpl) GetTxnHashes() []types.Hash {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
 	return t.txnHashes
 }
 
+// GetReplacedTxnHashes returns hashes of the replaced transactions, mapped to the hashes of their replacements.
+// Replacements are recorded regardless of the hashes collection, since the transaction that gets processed
+// might be the replacement of the sent one
+func (t *TxRelayerImpl) GetReplacedTxnHashes() map[types.Hash]types.Hash {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	replacedTxnHashes := make(map[types.Hash]types.Hash, len(t.replacedTxnHashes))
+	for replaced, replacement := range t.replacedTxnHashes {
+		replacedTxnHashes[replaced] = replacement
+	}
+
+	return replacedTxnHashes
+}
+
+// Close stops watching the sent transactions (the ones sent in the no waiting mode included)
+// and waits for the background watchers to finish
+func (t *TxRelayerImpl) Close() {
+	t.closeOnce.Do(func() {
+		close(t.closeCh)
+	})
+
+	t.wg.Wait()
+}
+
 // Call executes a message call immediately without creating a transaction on the blockchain
 func (t *TxRelayerImpl) Call(from types.Address, to types.Address, input []byte) (string, error) {
 	callMsg := &jsonrpc.CallMsg{
@@ -122,7 +173,7 @@ func (t *TxRelayerImpl) Call(from types.Address, to types.Address, input []byte)
 
 // SendTransaction signs given transaction by provided key and sends it to the blockchain
 func (t *TxRelayerImpl) SendTransaction(txn *types.Transaction, key crypto.Key) (*ethgo.Receipt, error) {
-	txnHash, err := t.sendTransactionLocked(txn, key)
+	txnHash, err := t.sendTransaction(txn, key)
 	if err != nil {
 		if txn.Type() != types.LegacyTxType {
 			for _, fallbackErr := range dynamicFeeTxFallbackErrs {
@@ -147,11 +198,28 @@ func (t *TxRelayerImpl) SendTransaction(txn *types.Transaction, key crypto.Key)
 		return nil, err
 	}
 
-	if t.collectTxnHashes {
-		t.txnHashes = append(t.txnHashes, txnHash)
+	t.collectTxnHash(txnHash)
+
+	if t.replaceAfter == 0 {
+		return t.waitForReceipt(txnHash)
 	}
 
-	return t.waitForReceipt(txnHash)
+	if t.noWaitReceipt {
+		// nobody waits for the receipt, so watch the transaction in the background
+		txnCopy := txn.Copy()
+
+		t.wg.Add(1)
+
+		go func() {
+			defer t.wg.Done()
+
+			_, _ = t.waitForReceiptOrReplace(txnCopy, key, txnHash)
+		}()
+
+		return nil, nil
+	}
+
+	return t.waitForReceiptOrReplace(txn, key, txnHash)
 }
 
 // Client returns jsonrpc client
@@ -159,28 +227,111 @@ func (t *TxRelayerImpl) Client() *jsonrpc.EthClient {
 	return t.client
 }
 
-func (t *TxRelayerImpl) sendTransactionLocked(txn *types.Transaction, key crypto.Key) (types.Hash, error) {
-	t.lock.Lock()
-	defer t.lock.Unlock()
+// sendTransaction sends the transaction with the nonce reserved from the nonce tracker.
+// If the nonce is rejected as too low, nonces are resynced with the node and the transaction is resent once
+func (t *TxRelayerImpl) sendTransaction(txn *types.Transaction, key crypto.Key) (types.Hash, error) {
+	if !t.nonceGet {
+		return t.prepareAndSendTransaction(txn, key)
+	}
 
-	var err error
+	sender := key.Address()
+	fetchPendingNonce := func() (uint64, error) {
+		return t.client.GetNonce(sender, jsonrpc.PendingBlockNumberOrHash)
+	}
 
-	if t.nonceGet {
-		nonce, err := t.client.GetNonce(key.Address(), jsonrpc.PendingBlockNumberOrHash)
+	for attempt := 0; ; attempt++ {
+		nonce, err := t.nonceTracker.reserve(sender, fetchPendingNonce)
 		if err != nil {
 			return types.ZeroHash, fmt.Errorf("failed to get nonce: %w", err)
 		}
 
 		txn.SetNonce(nonce)
+
+		txnHash, err := t.prepareAndSendTransaction(txn, key)
+		if err == nil {
+			return txnHash, nil
+		}
+
+		if !strings.Contains(err.Error(), errNonceTooLow.Error()) {
+			if t.nonceTracker.release(sender, nonce) {
+				t.fillNonceGap(txn, key)
+			}
+
+			return types.ZeroHash, err
+		}
+
+		// nonce got used outside of this tracker
+		t.nonceTracker.resync(sender)
+
+		if attempt > 0 {
+			return types.ZeroHash, err
+		}
+	}
+}
+
+// fillNonceGap sends the transaction transferring nothing to the sender itself, with the nonce of given transaction
+// which was not sent, so that the already sent transactions with the higher nonces are not stuck behind the gap.
+// Nonces are resynced with the node, if the filler transaction is not sent either
+func (t *TxRelayerImpl) fillNonceGap(txn *types.Transaction, key crypto.Key) {
+	sender := key.Address()
+	opts := []types.TxOption{
+		types.WithFrom(sender),
+		types.WithTo(&sender),
+		types.WithNonce(txn.Nonce()),
+		types.WithValue(big.NewInt(0)),
+		types.WithGas(nonceGapFillerGas),
 	}
 
-	chainID := t.chainID
+	var filler *types.Transaction
+
+	if txn.Type() == types.DynamicFeeTxType {
+		filler = types.NewTx(types.NewDynamicFeeTx(
+			append(opts, types.WithGasTipCap(txn.GetGasTipCap()), types.WithGasFeeCap(txn.GetGasFeeCap()))...))
+	} else {
+		filler = types.NewTx(types.NewLegacyTx(append(opts, types.WithGasPrice(txn.GasPrice()))...))
+	}
+
+	if _, err := t.prepareAndSendTransaction(filler, key); err != nil {
+		t.write(fmt.Sprintf("[TxRelayer.FillNonceGap]\nFrom = %s\nNonce = %d\nError = %v\n",
+			sender, txn.Nonce(), err))
+
+		t.nonceTracker.resync(sender)
+	}
+}
+
+// getChainID returns the chain id, fetching it from the node the first time it is requested
+func (t *TxRelayerImpl) getChainID() (*big.Int, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
 
-	if chainID == nil {
-		chainID, err = t.client.ChainID()
+	if t.chainID == nil {
+		chainID, err := t.client.ChainID()
 		if err != nil {
-			return types.ZeroHash, err
+			return nil, err
 		}
+
+		t.chainID = chainID
+	}
+
+	return t.chainID, nil
+}
+
+// collectTxnHash saves the hash of the sent transaction, if hashes are collected
+func (t *TxRelayerImpl) collectTxnHash(txnHash types.Hash) {
+	if !t.collectTxnHashes {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.txnHashes = append(t.txnHashes, txnHash)
+}
+
+func (t *TxRelayerImpl) prepareAndSendTransaction(txn *types.Transaction, key crypto.Key) (types.Hash, error) {
+	chainID, err := t.getChainID()
+	if err != nil {
+		return types.ZeroHash, err
 	}
 
 	txn.SetChainID(chainID)
@@ -249,7 +400,13 @@ func (t *TxRelayerImpl) sendTransactionLocked(txn *types.Transaction, key crypto
 		txn.SetGas(gasLimit)
 	}
 
-	signer := crypto.NewLondonSigner(chainID.Uint64())
+	return t.signAndSendTransaction(txn, key, "[TxRelayer.SendTransaction]")
+}
+
+// signAndSendTransaction signs given transaction by provided key and sends it as a raw transaction
+func (t *TxRelayerImpl) signAndSendTransaction(txn *types.Transaction, key crypto.Key,
+	title string) (types.Hash, error) {
+	signer := crypto.NewLondonSigner(txn.ChainID().Uint64())
 
 	signedTxn, err := signer.SignTxWithCallback(txn,
 		func(hash types.Hash) (sig []byte, err error) {
@@ -263,12 +420,12 @@ func (t *TxRelayerImpl) sendTransactionLocked(txn *types.Transaction, key crypto
 		var msg string
 
 		if txn.Type() == types.DynamicFeeTxType {
-			msg = fmt.Sprintf("[TxRelayer.SendTransaction]\nFrom = %s\nGas = %d\n"+
+			msg = fmt.Sprintf("%s\nFrom = %s\nNonce = %d\nGas = %d\n"+
 				"Max Fee Per Gas = %d\nMax Priority Fee Per Gas = %d\n",
-				txn.From(), txn.Gas(), txn.GasFeeCap(), txn.GasTipCap())
+				title, txn.From(), txn.Nonce(), txn.Gas(), txn.GasFeeCap(), txn.GasTipCap())
 		} else {
-			msg = fmt.Sprintf("[TxRelayer.SendTransaction]\nFrom = %s\nGas = %d\nGas Price = %d\n",
-				txn.From(), txn.Gas(), txn.GasPrice())
+			msg = fmt.Sprintf("%s\nFrom = %s\nNonce = %d\nGas = %d\nGas Price = %d\n",
+				title, txn.From(), txn.Nonce(), txn.Gas(), txn.GasPrice())
 		}
 
 		_, _ = t.writer.Write([]byte(msg))
@@ -287,9 +444,7 @@ func (t *TxRelayerImpl) SendTransactionLocal(txn *types.Transaction) (*ethgo.Rec
 		return nil, err
 	}
 
-	if t.collectTxnHashes {
-		t.txnHashes = append(t.txnHashes, txnHash)
-	}
+	t.collectTxnHash(txnHash)
 
 	return t.waitForReceipt(txnHash)
 }
@@ -346,22 +501,136 @@ func (t *TxRelayerImpl) waitForReceipt(hash types.Hash) (*ethgo.Receipt, error)
 	for {
 		select {
 		case <-ticker.C:
-			receipt, err := t.client.GetTransactionReceipt(hash)
-			if err != nil {
-				if err.Error() != "not found" {
-					return nil, err
+			receipt, err := t.getReceipt(hash)
+			if err != nil || receipt != nil {
+				return receipt, err
+			}
+		case <-timer.C:
+			return nil, fmt.Errorf("timeout while waiting for transaction %s to be processed", hash)
+		case <-t.closeCh:
+			return nil, errClosed
+		}
+	}
+}
+
+// waitForReceiptOrReplace waits for the receipt of given transaction. If the receipt has not arrived
+// within the replacement timeout, the transaction is replaced by the one with bumped fees and the same nonce.
+// The receipt of either the original transaction or any of its replacements is returned
+func (t *TxRelayerImpl) waitForReceiptOrReplace(txn *types.Transaction, key crypto.Key,
+	hash types.Hash) (*ethgo.Receipt, error) {
+	timer := time.NewTimer(t.receiptsTimeout)
+	defer timer.Stop()
+
+	ticker := time.NewTicker(t.receiptsPollFreq)
+	defer ticker.Stop()
+
+	replaceTicker := time.NewTicker(t.replaceAfter)
+	defer replaceTicker.Stop()
+
+	hashes := []types.Hash{hash}
+
+	for {
+		select {
+		case <-ticker.C:
+			for _, h := range hashes {
+				receipt, err := t.getReceipt(h)
+				if err != nil || receipt != nil {
+					return receipt, err
 				}
 			}
+		case <-replaceTicker.C:
+			replacementHash, err := t.replaceTransaction(txn, key)
+			if err != nil {
+				t.write(fmt.Sprintf("[TxRelayer.ReplaceTransaction]\nTx Hash = %s\nError = %v\n",
+					hashes[len(hashes)-1], err))
+
+				if strings.Contains(err.Error(), errNonceTooLow.Error()) {
+					// one of the sent transactions got processed, so only its receipt is awaited
+					replaceTicker.Stop()
+				}
 
-			if receipt != nil {
-				return receipt, nil
+				continue
 			}
+
+			t.saveReplacedTxnHash(hashes[len(hashes)-1], replacementHash)
+			hashes = append(hashes, replacementHash)
 		case <-timer.C:
+			// transaction might have been dropped, leaving a nonce gap
+			if t.nonceGet {
+				t.nonceTracker.resync(key.Address())
+			}
+
 			return nil, fmt.Errorf("timeout while waiting for transaction %s to be processed", hash)
+		case <-t.closeCh:
+			return nil, errClosed
 		}
 	}
 }
 
+// replaceTransaction bumps fees of given transaction, re-signs it and sends it,
+// returning the hash of the replacement transaction
+func (t *TxRelayerImpl) replaceTransaction(txn *types.Transaction, key crypto.Key) (types.Hash, error) {
+	if txn.Type() == types.DynamicFeeTxType {
+		txn.SetGasTipCap(bumpFee(txn.GetGasTipCap(), t.feeBumpPercentage))
+		txn.SetGasFeeCap(bumpFee(txn.GetGasFeeCap(), t.feeBumpPercentage))
+	} else {
+		txn.SetGasPrice(bumpFee(txn.GasPrice(), t.feeBumpPercentage))
+	}
+
+	return t.signAndSendTransaction(txn, key, "[TxRelayer.ReplaceTransaction]")
+}
+
+// saveReplacedTxnHash saves and reports the hash of the replaced transaction, along with its replacement
+func (t *TxRelayerImpl) saveReplacedTxnHash(replaced, replacement types.Hash) {
+	t.write(fmt.Sprintf("[TxRelayer.ReplaceTransaction]\nReplaced Tx Hash = %s\nReplacement Tx Hash = %s\n",
+		replaced, replacement))
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.replacedTxnHashes == nil {
+		t.replacedTxnHashes = map[types.Hash]types.Hash{}
+	}
+
+	t.replacedTxnHashes[replaced] = replacement
+
+	if t.collectTxnHashes {
+		t.txnHashes = append(t.txnHashes, replacement)
+	}
+}
+
+// getReceipt returns the receipt of given transaction, or nil if the transaction is not processed yet
+func (t *TxRelayerImpl) getReceipt(hash types.Hash) (*ethgo.Receipt, error) {
+	receipt, err := t.client.GetTransactionReceipt(hash)
+	if err != nil && err.Error() != "not found" {
+		return nil, err
+	}
+
+	return receipt, nil
+}
+
+func (t *TxRelayerImpl) write(msg string) {
+	if t.writer != nil {
+		_, _ = t.writer.Write([]byte(msg))
+	}
+}
+
+// bumpFee increases given fee by the provided percentage, making sure the result is strictly greater
+func bumpFee(fee *big.Int, percentage uint64) *big.Int {
+	if fee == nil {
+		fee = big.NewInt(0)
+	}
+
+	bumped := new(big.Int).Mul(fee, new(big.Int).SetUint64(100+percentage))
+	bumped.Div(bumped, big.NewInt(100))
+
+	if bumped.Cmp(fee) <= 0 {
+		bumped.Add(fee, big.NewInt(1))
+	}
+
+	return bumped
+}
+
 // ConvertTxnToCallMsg converts txn instance to call message
 func ConvertTxnToCallMsg(txn *types.Transaction) *jsonrpc.CallMsg {
 	var (
@@ -451,3 +720,21 @@ func WithCollectTxnHashes() TxRelayerOption {
 		t.collectTxnHashes = true
 	}
 }
+
+// WithNonceTracker sets the nonce tracker, which can be shared between the TxRelayer instances
+// sending transactions with the same keys to the same chain
+func WithNonceTracker(nonceTracker *NonceTracker) TxRelayerOption {
+	return func(t *TxRelayerImpl) {
+		t.nonceTracker = nonceTracker
+	}
+}
+
+// WithTxReplacement enables replacement of the transactions whose receipts have not arrived within
+// the replaceAfter duration. Transaction is replaced by the same one (with the same nonce),
+// with fees bumped by the given percentage. Replacements are repeated until the receipts timeout
+func WithTxReplacement(replaceAfter time.Duration, feeBumpPercentage uint64) TxRelayerOption {
+	return func(t *TxRelayerImpl) {
+		t.replaceAfter = replaceAfter
+		t.feeBumpPercentage = feeBumpPercentage
+	}
+}
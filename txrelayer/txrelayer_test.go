@@ -0,0 +1,462 @@
+package txrelayer
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestTxRelayer_WaitForReceiptOrReplace(t *testing.T) {
+	t.Parallel()
+
+	t.Run("replacement processed", func(t *testing.T) {
+		t.Parallel()
+
+		server := newMockRPCServer(t)
+		// only the first replacement gets processed
+		server.isProcessed = func(sentIdx int) bool { return sentIdx == 1 }
+
+		txRelayer, key := newTestTxRelayer(t, server, time.Second)
+		txn := newTestTxn(t, txRelayer, key)
+
+		receipt, err := txRelayer.waitForReceiptOrReplace(txn, key, server.sentHash(0))
+		require.NoError(t, err)
+		require.NotNil(t, receipt)
+		require.Equal(t, server.sentHash(1).Bytes(), receipt.TransactionHash.Bytes())
+
+		// replacement has the same nonce and bumped fees
+		sent := server.sentTxns()
+		require.GreaterOrEqual(t, len(sent), 2)
+		require.Equal(t, sent[0].Nonce(), sent[1].Nonce())
+		require.Equal(t, big.NewInt(12), sent[1].GasTipCap())
+		require.Equal(t, big.NewInt(120), sent[1].GasFeeCap())
+
+		// replacement is recorded, even though the hashes are not collected
+		require.Equal(t, server.sentHash(1), txRelayer.GetReplacedTxnHashes()[server.sentHash(0)])
+		require.Empty(t, txRelayer.GetTxnHashes())
+	})
+
+	t.Run("nonce too low on replacement", func(t *testing.T) {
+		t.Parallel()
+
+		server := newMockRPCServer(t)
+		server.sendErr = errors.New("nonce too low")
+
+		// original transaction gets processed a few replacement periods after its replacement is rejected
+		start := time.Now()
+		server.isProcessed = func(sentIdx int) bool {
+			return sentIdx == 0 && time.Since(start) > 500*time.Millisecond
+		}
+
+		txRelayer, key := newTestTxRelayer(t, server, 2*time.Second)
+		txn := newTestTxn(t, txRelayer, key)
+
+		receipt, err := txRelayer.waitForReceiptOrReplace(txn, key, server.sentHash(0))
+		require.NoError(t, err)
+		require.NotNil(t, receipt)
+		require.Equal(t, server.sentHash(0).Bytes(), receipt.TransactionHash.Bytes())
+
+		// no replacement is attempted after the nonce is reported as used
+		require.Equal(t, 2, server.sendAttempts())
+		require.Empty(t, txRelayer.GetReplacedTxnHashes())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		t.Parallel()
+
+		server := newMockRPCServer(t)
+		server.isProcessed = func(int) bool { return false }
+
+		txRelayer, key := newTestTxRelayer(t, server, 300*time.Millisecond)
+		txn := newTestTxn(t, txRelayer, key)
+
+		receipt, err := txRelayer.waitForReceiptOrReplace(txn, key, server.sentHash(0))
+		require.ErrorContains(t, err, "timeout while waiting for transaction")
+		require.Nil(t, receipt)
+
+		// every replacement is chained to the previous one
+		replaced := txRelayer.GetReplacedTxnHashes()
+		require.NotEmpty(t, replaced)
+
+		for i := 1; i < len(server.sentTxns()); i++ {
+			require.Equal(t, server.sentHash(i), replaced[server.sentHash(i-1)])
+		}
+
+		// nonce is refetched from the node on the next send, since the transaction might be dropped
+		nonce, err := txRelayer.nonceTracker.reserve(key.Address(), func() (uint64, error) { return 7, nil })
+		require.NoError(t, err)
+		require.Equal(t, uint64(7), nonce)
+	})
+}
+
+func TestTxRelayer_SendTransaction_FailedInBatch(t *testing.T) {
+	t.Parallel()
+
+	// sendBatch sends the batch of concurrent transactions, where the transaction with the nonce 1 is rejected
+	// once the transaction with the nonce 2 is sent, and returns the number of failed sends
+	sendBatch := func(t *testing.T, server *mockRPCServer, rejectFiller bool) (*TxRelayerImpl, crypto.Key, int) {
+		t.Helper()
+
+		var (
+			nonce2Sent = make(chan struct{})
+			closeOnce  sync.Once
+		)
+
+		server.rejectSend = func(txn *types.Transaction) error {
+			isFiller := *txn.To() != types.ZeroAddress
+
+			switch {
+			case txn.Nonce() == 2:
+				closeOnce.Do(func() { close(nonce2Sent) })
+			case txn.Nonce() == 1 && !isFiller:
+				<-nonce2Sent
+
+				return errors.New("rejected")
+			case isFiller && rejectFiller:
+				return errors.New("filler rejected")
+			}
+
+			return nil
+		}
+
+		txRelayer, key := newTestTxRelayer(t, server, time.Second)
+
+		var (
+			wg     sync.WaitGroup
+			failed atomic.Int32
+		)
+
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				txn := types.NewTx(types.NewDynamicFeeTx(
+					types.WithGasTipCap(big.NewInt(10)),
+					types.WithGasFeeCap(big.NewInt(100)),
+					types.WithGas(21000),
+					types.WithTo(&types.ZeroAddress),
+				))
+
+				if _, err := txRelayer.sendTransaction(txn, key); err != nil {
+					failed.Add(1)
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		return txRelayer, key, int(failed.Load())
+	}
+
+	t.Run("nonce gap filled", func(t *testing.T) {
+		t.Parallel()
+
+		server := newMockRPCServer(t)
+		txRelayer, key, failed := sendBatch(t, server, false)
+		require.Equal(t, 1, failed)
+
+		sent := map[uint64]*types.Transaction{}
+		for _, txn := range server.sentTxns() {
+			sent[txn.Nonce()] = txn
+		}
+
+		require.Len(t, sent, 3)
+
+		// transaction with the rejected nonce transfers nothing to the sender
+		filler := sent[1]
+		require.Equal(t, key.Address(), *filler.To())
+		require.Equal(t, big.NewInt(0), filler.Value())
+		require.Equal(t, uint64(nonceGapFillerGas), filler.Gas())
+		require.Equal(t, big.NewInt(10), filler.GasTipCap())
+		require.Equal(t, big.NewInt(100), filler.GasFeeCap())
+
+		// filled nonce is not reused
+		nonce, err := txRelayer.nonceTracker.reserve(key.Address(), func() (uint64, error) { return 7, nil })
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), nonce)
+	})
+
+	t.Run("nonce gap not filled", func(t *testing.T) {
+		t.Parallel()
+
+		server := newMockRPCServer(t)
+		txRelayer, key, failed := sendBatch(t, server, true)
+		require.Equal(t, 1, failed)
+		require.Len(t, server.sentTxns(), 2)
+
+		// nonce is refetched from the node on the next send
+		nonce, err := txRelayer.nonceTracker.reserve(key.Address(), func() (uint64, error) { return 7, nil })
+		require.NoError(t, err)
+		require.Equal(t, uint64(7), nonce)
+	})
+}
+
+func TestTxRelayer_ReplaceTransaction(t *testing.T) {
+	t.Parallel()
+
+	server := newMockRPCServer(t)
+	txRelayer, key := newTestTxRelayer(t, server, time.Second)
+
+	txn := types.NewTx(types.NewDynamicFeeTx(
+		types.WithGasTipCap(big.NewInt(10)),
+		types.WithGasFeeCap(big.NewInt(100)),
+		types.WithGas(21000),
+		types.WithNonce(3),
+		types.WithChainID(big.NewInt(100)),
+	))
+
+	hash, err := txRelayer.replaceTransaction(txn, key)
+	require.NoError(t, err)
+	require.Equal(t, server.sentHash(0), hash)
+
+	sent := server.sentTxns()
+	require.Len(t, sent, 1)
+	require.Equal(t, uint64(3), sent[0].Nonce())
+	require.Equal(t, big.NewInt(12), sent[0].GasTipCap())
+	require.Equal(t, big.NewInt(120), sent[0].GasFeeCap())
+}
+
+func TestTxRelayer_Close_NoWaiting(t *testing.T) {
+	t.Parallel()
+
+	server := newMockRPCServer(t)
+	server.isProcessed = func(int) bool { return false }
+
+	client, err := jsonrpc.NewEthClient(server.URL)
+	require.NoError(t, err)
+
+	relayer, err := NewTxRelayer(
+		WithClient(client),
+		WithNoWaiting(),
+		WithReceiptsTimeout(time.Minute),
+		WithTxReplacement(time.Minute, DefaultFeeBumpPercentage))
+	require.NoError(t, err)
+
+	key, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	txn := types.NewTx(types.NewDynamicFeeTx(
+		types.WithGasTipCap(big.NewInt(10)),
+		types.WithGasFeeCap(big.NewInt(100)),
+		types.WithGas(21000),
+		types.WithTo(&types.ZeroAddress),
+	))
+
+	receipt, err := relayer.SendTransaction(txn, key)
+	require.NoError(t, err)
+	require.Nil(t, receipt)
+
+	closed := make(chan struct{})
+
+	go func() {
+		relayer.Close()
+		close(closed)
+	}()
+
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("tx relayer did not stop watching the sent transaction")
+	}
+}
+
+// mockRPCServer is a json-rpc server serving the transactions sent by the tx relayer.
+// Hash of the sent transaction is determined by the order in which it is sent
+type mockRPCServer struct {
+	*httptest.Server
+
+	// isProcessed reports whether the transaction with given index in the sent order is processed
+	isProcessed func(sentIdx int) bool
+	// sendErr is returned when sending any but the first transaction
+	sendErr error
+	// rejectSend returns the error for the transaction which is rejected, if set
+	rejectSend func(txn *types.Transaction) error
+
+	lock     sync.Mutex
+	sent     []*types.Transaction
+	attempts int
+}
+
+func newMockRPCServer(t *testing.T) *mockRPCServer {
+	t.Helper()
+
+	m := &mockRPCServer{}
+	m.Server = httptest.NewServer(http.HandlerFunc(m.serveHTTP))
+	t.Cleanup(m.Close)
+
+	return m
+}
+
+func (m *mockRPCServer) sentHash(sentIdx int) types.Hash {
+	return types.BytesToHash([]byte{byte(sentIdx + 1)})
+}
+
+func (m *mockRPCServer) sentTxns() []*types.Transaction {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return append([]*types.Transaction(nil), m.sent...)
+}
+
+func (m *mockRPCServer) sendAttempts() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.attempts
+}
+
+func (m *mockRPCServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     interface{}       `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+
+	resp := map[string]interface{}{"jsonrpc": "2.0"}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		resp["error"] = map[string]interface{}{"code": -32700, "message": err.Error()}
+	} else if result, err := m.handle(req.Method, req.Params); err != nil {
+		resp["id"] = req.ID
+		resp["error"] = map[string]interface{}{"code": -32000, "message": err.Error()}
+	} else {
+		resp["id"] = req.ID
+		resp["result"] = result
+	}
+
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (m *mockRPCServer) handle(method string, params []json.RawMessage) (interface{}, error) {
+	switch method {
+	case "eth_chainId":
+		return "0x64", nil
+	case "eth_getTransactionCount":
+		return "0x0", nil
+	case "eth_sendRawTransaction":
+		var rawTxn string
+		if err := json.Unmarshal(params[0], &rawTxn); err != nil {
+			return nil, err
+		}
+
+		buf, err := hex.DecodeHex(rawTxn)
+		if err != nil {
+			return nil, err
+		}
+
+		txn := &types.Transaction{}
+		if err := txn.UnmarshalRLP(buf); err != nil {
+			return nil, err
+		}
+
+		if m.rejectSend != nil {
+			if err := m.rejectSend(txn); err != nil {
+				return nil, err
+			}
+		}
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		m.attempts++
+
+		if m.sendErr != nil && m.attempts > 1 {
+			return nil, m.sendErr
+		}
+
+		m.sent = append(m.sent, txn)
+
+		return m.sentHash(len(m.sent) - 1), nil
+	case "eth_getTransactionReceipt":
+		var hash types.Hash
+		if err := json.Unmarshal(params[0], &hash); err != nil {
+			return nil, err
+		}
+
+		m.lock.Lock()
+		sentCount := len(m.sent)
+		m.lock.Unlock()
+
+		for i := 0; i < sentCount; i++ {
+			if m.sentHash(i) == hash && m.isProcessed(i) {
+				return map[string]interface{}{
+					"transactionHash":   hash,
+					"blockHash":         types.ZeroHash,
+					"from":              types.ZeroAddress,
+					"transactionIndex":  "0x0",
+					"blockNumber":       "0x1",
+					"gasUsed":           "0x5208",
+					"cumulativeGasUsed": "0x5208",
+					"status":            "0x1",
+					"logsBloom":         "0x" + strings.Repeat("00", types.BloomByteLength),
+					"logs":              []interface{}{},
+				}, nil
+			}
+		}
+
+		return nil, nil
+	default:
+		return nil, errors.New("method not found")
+	}
+}
+
+// newTestTxRelayer creates a tx relayer connected to the mock server, which replaces the transactions
+// every 100ms and waits for the receipts until the given timeout
+func newTestTxRelayer(t *testing.T, server *mockRPCServer,
+	receiptsTimeout time.Duration) (*TxRelayerImpl, crypto.Key) {
+	t.Helper()
+
+	client, err := jsonrpc.NewEthClient(server.URL)
+	require.NoError(t, err)
+
+	relayer, err := NewTxRelayer(
+		WithClient(client),
+		WithReceiptsTimeout(receiptsTimeout),
+		WithTxReplacement(100*time.Millisecond, DefaultFeeBumpPercentage))
+	require.NoError(t, err)
+
+	txRelayer, ok := relayer.(*TxRelayerImpl)
+	require.True(t, ok)
+
+	txRelayer.receiptsPollFreq = 20 * time.Millisecond
+
+	t.Cleanup(txRelayer.Close)
+
+	key, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	return txRelayer, key
+}
+
+// newTestTxn sends a dynamic fee transaction through the mock server, whose hash is the first sent hash
+func newTestTxn(t *testing.T, txRelayer *TxRelayerImpl, key crypto.Key) *types.Transaction {
+	t.Helper()
+
+	txn := types.NewTx(types.NewDynamicFeeTx(
+		types.WithGasTipCap(big.NewInt(10)),
+		types.WithGasFeeCap(big.NewInt(100)),
+		types.WithGas(21000),
+		types.WithTo(&types.ZeroAddress),
+	))
+
+	_, err := txRelayer.sendTransaction(txn, key)
+	require.NoError(t, err)
+
+	return txn
+}
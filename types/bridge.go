@@ -0,0 +1,163 @@
+package types
+
+// BridgeTransferType is the type of the bridge transfer
+type BridgeTransferType string
+
+const (
+	// StateSyncTransfer is a transfer from the rootchain to the child chain (deposit)
+	StateSyncTransfer BridgeTransferType = "stateSync"
+	// ExitTransfer is a transfer from the child chain to the rootchain (withdrawal)
+	ExitTransfer BridgeTransferType = "exit"
+)
+
+const (
+	// StateSyncedStage is the stage in which StateSynced event is emitted on the rootchain
+	StateSyncedStage = "stateSynced"
+	// CommittedStage is the stage in which state sync is included in a commitment submitted on the child chain
+	CommittedStage = "committed"
+	// ExecutedStage is the stage in which state sync is executed on the child chain
+	ExecutedStage = "executed"
+
+	// ExitEventStage is the stage in which exit event is emitted on the child chain
+	ExitEventStage = "exitEvent"
+	// CheckpointedStage is the stage in which exit event block is checkpointed on the rootchain
+	CheckpointedStage = "checkpointed"
+	// ProofAvailableStage is the stage in which exit proof can be generated
+	ProofAvailableStage = "proofAvailable"
+	// ExitedStage is the stage in which exit is processed on the rootchain
+	ExitedStage = "exited"
+)
+
+// StateSyncTransferStages are the stages of a state sync transfer, in order
+var StateSyncTransferStages = []string{StateSyncedStage, CommittedStage, ExecutedStage}
+
+// ExitTransferStages are the stages of an exit transfer, in order
+var ExitTransferStages = []string{ExitEventStage, CheckpointedStage, ProofAvailableStage, ExitedStage}
+
+// BridgeTransferStage is a single stage of the bridge transfer
+type BridgeTransferStage struct {
+	// Name is the stage name
+	Name string `json:"name"`
+
+	// Completed indicates whether the transfer has reached the stage
+	Completed bool `json:"completed"`
+
+	// Rootchain indicates whether the stage happens on the rootchain (otherwise on the child chain)
+	Rootchain bool `json:"rootchain"`
+
+	// BlockNumber is the block in which the stage is completed
+	BlockNumber uint64 `json:"blockNumber,omitempty"`
+
+	// TxHash is the hash of the transaction which completed the stage
+	TxHash Hash `json:"txHash,omitempty"`
+
+	// Error is set if the transaction which completed the stage failed
+	Error string `json:"error,omitempty"`
+}
+
+// BridgeTransferStatus is the status of the bridge transfer, reported through all of its stages
+type BridgeTransferStatus struct {
+	Type   BridgeTransferType     `json:"type"`
+	ID     uint64                 `json:"id"`
+	Stages []*BridgeTransferStage `json:"stages"`
+}
+
+// BridgeTransferQuery identifies the bridge transfers, either by the state sync id,
+// the exit id or the hash of the originating transaction
+type BridgeTransferQuery struct {
+	StateSyncID *uint64
+	ExitID      *uint64
+	TxHash      *Hash
+}
+
+// BridgeRelayerEvent is a bridge event which is relayed (executed) on the destination chain
+// by the bridge relayer
+type BridgeRelayerEvent struct {
+	// EventID is the state sync id or the exit id of the event
+	EventID uint64 `json:"eventId"`
+
+	// CountTries is the number of attempts to relay the event
+	CountTries uint64 `json:"countTries"`
+
+	// BlockNumber is the block in which the event was last sent
+	BlockNumber uint64 `json:"blockNumber"`
+
+	// LastError is the reason of the last sending or execution failure
+	LastError string `json:"lastError,omitempty"`
+}
+
+// BridgeTokenType is the standard of the token mapped by the bridge predicates
+type BridgeTokenType string
+
+const (
+	// ERC20BridgeToken is a fungible token mapped by the ERC20 predicates
+	ERC20BridgeToken BridgeTokenType = "erc20"
+	// ERC721BridgeToken is a non-fungible token mapped by the ERC721 predicates
+	ERC721BridgeToken BridgeTokenType = "erc721"
+	// ERC1155BridgeToken is a multi token mapped by the ERC1155 predicates
+	ERC1155BridgeToken BridgeTokenType = "erc1155"
+)
+
+// BridgeTokenTypes are all the token types supported by the bridge predicates
+var BridgeTokenTypes = []BridgeTokenType{ERC20BridgeToken, ERC721BridgeToken, ERC1155BridgeToken}
+
+// BridgeTokenMapping is a mapping between the rootchain token and its child chain counterpart
+type BridgeTokenMapping struct {
+	// TokenType is the standard of the mapped token
+	TokenType BridgeTokenType `json:"tokenType"`
+
+	// RootToken is the address of the token on the rootchain
+	RootToken Address `json:"rootToken"`
+
+	// ChildToken is the address of the token on the child chain
+	ChildToken Address `json:"childToken"`
+
+	// ChildOrigin indicates whether the token originates from the child chain,
+	// in which case it is mapped by the child mintable predicates
+	ChildOrigin bool `json:"childOrigin"`
+
+	// RootBlock is the rootchain block in which the mapping event is emitted
+	RootBlock uint64 `json:"rootBlock,omitempty"`
+
+	// ChildBlock is the child chain block in which the mapping event is emitted
+	ChildBlock uint64 `json:"childBlock,omitempty"`
+}
+
+// BridgeTokenMappingQuery filters and pages the token mappings
+type BridgeTokenMappingQuery struct {
+	// TokenType filters the mappings by the token type (all types are returned if empty)
+	TokenType BridgeTokenType
+
+	// Offset is the number of mappings which are skipped
+	Offset uint64
+
+	// Limit is the maximum number of returned mappings
+	Limit uint64
+}
+
+// BridgeTokenMappings is a single page of the token mappings
+type BridgeTokenMappings struct {
+	// Total is the number of all the mappings matching the query filter
+	Total uint64 `json:"total"`
+
+	// Mappings are the mappings of the requested page
+	Mappings []*BridgeTokenMapping `json:"mappings"`
+}
+
+// BridgeCheckpointLag describes how far the checkpoints submitted to the rootchain lag behind the child chain
+type BridgeCheckpointLag struct {
+	// ChildHeight is the latest child chain block
+	ChildHeight uint64 `json:"childHeight"`
+
+	// LastCheckpointBlock is the latest child chain block checkpointed on the rootchain
+	LastCheckpointBlock uint64 `json:"lastCheckpointBlock"`
+
+	// Lag is the number of child chain blocks which are not checkpointed on the rootchain
+	Lag uint64 `json:"lag"`
+
+	// AlertThreshold is the lag after which the node raises the checkpoint lag alert
+	AlertThreshold uint64 `json:"alertThreshold"`
+
+	// Alert indicates whether the lag exceeds the alert threshold
+	Alert bool `json:"alert"`
+}